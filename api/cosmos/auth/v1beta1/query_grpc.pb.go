@@ -46,6 +46,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys of an account replaced by public key
+	// rotations, ordered by the height at which they were replaced.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
+	// PubKeyAtHeight returns the public key of an account at a given height.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyAtHeight(ctx context.Context, in *QueryPubKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryPubKeyAtHeightResponse, error)
 }
 
 type queryClient struct {
@@ -119,6 +128,24 @@ func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStrin
 	return out, nil
 }
 
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyAtHeight(ctx context.Context, in *QueryPubKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryPubKeyAtHeightResponse, error) {
+	out := new(QueryPubKeyAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -147,6 +174,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys of an account replaced by public key
+	// rotations, ordered by the height at which they were replaced.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
+	// PubKeyAtHeight returns the public key of an account at a given height.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyAtHeight(context.Context, *QueryPubKeyAtHeightRequest) (*QueryPubKeyAtHeightResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -175,6 +211,12 @@ func (UnimplementedQueryServer) AddressBytesToString(context.Context, *AddressBy
 func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
 }
+func (UnimplementedQueryServer) PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
+func (UnimplementedQueryServer) PubKeyAtHeight(context.Context, *QueryPubKeyAtHeightRequest) (*QueryPubKeyAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyAtHeight not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +356,42 @@ func _Query_AddressStringToBytes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyAtHeight(ctx, req.(*QueryPubKeyAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +427,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddressStringToBytes",
 			Handler:    _Query_AddressStringToBytes_Handler,
 		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
+		{
+			MethodName: "PubKeyAtHeight",
+			Handler:    _Query_PubKeyAtHeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
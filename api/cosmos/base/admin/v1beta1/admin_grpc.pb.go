@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/admin/v1beta1/admin.proto
+
+package adminv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GetConfig returns the runtime configuration of the node.
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	// SetMinGasPrices updates the minimum gas prices of the transactions accepted
+	// in the mempool.
+	SetMinGasPrices(ctx context.Context, in *SetMinGasPricesRequest, opts ...grpc.CallOption) (*SetMinGasPricesResponse, error)
+	// SetHalt updates the block height and time at which the node halts.
+	SetHalt(ctx context.Context, in *SetHaltRequest, opts ...grpc.CallOption) (*SetHaltResponse, error)
+	// SetPruning updates the pruning options of the state.
+	SetPruning(ctx context.Context, in *SetPruningRequest, opts ...grpc.CallOption) (*SetPruningResponse, error)
+	// SetLogLevel updates the level of the node logs.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the latest committed height.
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	// ListSnapshots lists the state sync snapshots of the node.
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// DeleteSnapshot deletes a state sync snapshot of the node.
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error)
+	// ExportGenesis exports the state of a committed height to a genesis
+	// document, read from an immutable view of the height while the node keeps
+	// committing blocks. The JSON encoded document is streamed in chunks.
+	ExportGenesis(ctx context.Context, in *ExportGenesisRequest, opts ...grpc.CallOption) (Service_ExportGenesisClient, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetMinGasPrices(ctx context.Context, in *SetMinGasPricesRequest, opts ...grpc.CallOption) (*SetMinGasPricesResponse, error) {
+	out := new(SetMinGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetMinGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetHalt(ctx context.Context, in *SetHaltRequest, opts ...grpc.CallOption) (*SetHaltResponse, error) {
+	out := new(SetHaltResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetPruning(ctx context.Context, in *SetPruningRequest, opts ...grpc.CallOption) (*SetPruningResponse, error) {
+	out := new(SetPruningResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetPruning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotResponse, error) {
+	out := new(DeleteSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.admin.v1beta1.Service/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ExportGenesis(ctx context.Context, in *ExportGenesisRequest, opts ...grpc.CallOption) (Service_ExportGenesisClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], "/cosmos.base.admin.v1beta1.Service/ExportGenesis", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceExportGenesisClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_ExportGenesisClient interface {
+	Recv() (*ExportGenesisResponse, error)
+	grpc.ClientStream
+}
+
+type serviceExportGenesisClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceExportGenesisClient) Recv() (*ExportGenesisResponse, error) {
+	m := new(ExportGenesisResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// GetConfig returns the runtime configuration of the node.
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	// SetMinGasPrices updates the minimum gas prices of the transactions accepted
+	// in the mempool.
+	SetMinGasPrices(context.Context, *SetMinGasPricesRequest) (*SetMinGasPricesResponse, error)
+	// SetHalt updates the block height and time at which the node halts.
+	SetHalt(context.Context, *SetHaltRequest) (*SetHaltResponse, error)
+	// SetPruning updates the pruning options of the state.
+	SetPruning(context.Context, *SetPruningRequest) (*SetPruningResponse, error)
+	// SetLogLevel updates the level of the node logs.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// CreateSnapshot takes a state sync snapshot of the latest committed height.
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	// ListSnapshots lists the state sync snapshots of the node.
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// DeleteSnapshot deletes a state sync snapshot of the node.
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error)
+	// ExportGenesis exports the state of a committed height to a genesis
+	// document, read from an immutable view of the height while the node keeps
+	// committing blocks. The JSON encoded document is streamed in chunks.
+	ExportGenesis(*ExportGenesisRequest, Service_ExportGenesisServer) error
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedServiceServer) SetMinGasPrices(context.Context, *SetMinGasPricesRequest) (*SetMinGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinGasPrices not implemented")
+}
+func (UnimplementedServiceServer) SetHalt(context.Context, *SetHaltRequest) (*SetHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHalt not implemented")
+}
+func (UnimplementedServiceServer) SetPruning(context.Context, *SetPruningRequest) (*SetPruningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPruning not implemented")
+}
+func (UnimplementedServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedServiceServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedServiceServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedServiceServer) ExportGenesis(*ExportGenesisRequest, Service_ExportGenesisServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportGenesis not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetMinGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMinGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetMinGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetMinGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetMinGasPrices(ctx, req.(*SetMinGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetHalt(ctx, req.(*SetHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetPruning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPruningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetPruning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetPruning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetPruning(ctx, req.(*SetPruningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.admin.v1beta1.Service/DeleteSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ExportGenesis_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGenesisRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).ExportGenesis(m, &serviceExportGenesisServer{stream})
+}
+
+type Service_ExportGenesisServer interface {
+	Send(*ExportGenesisResponse) error
+	grpc.ServerStream
+}
+
+type serviceExportGenesisServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceExportGenesisServer) Send(m *ExportGenesisResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.admin.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConfig",
+			Handler:    _Service_GetConfig_Handler,
+		},
+		{
+			MethodName: "SetMinGasPrices",
+			Handler:    _Service_SetMinGasPrices_Handler,
+		},
+		{
+			MethodName: "SetHalt",
+			Handler:    _Service_SetHalt_Handler,
+		},
+		{
+			MethodName: "SetPruning",
+			Handler:    _Service_SetPruning_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Service_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Service_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Service_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _Service_DeleteSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportGenesis",
+			Handler:       _Service_ExportGenesis_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/admin/v1beta1/admin.proto",
+}
@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/ormschema/v1alpha1/query.proto
+
+package ormschemav1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleSchemas returns the ORM schemas of all the modules of the app.
+	ModuleSchemas(ctx context.Context, in *QueryModuleSchemasRequest, opts ...grpc.CallOption) (*QueryModuleSchemasResponse, error)
+	// ModuleSchema returns the ORM schema of a module.
+	ModuleSchema(ctx context.Context, in *QueryModuleSchemaRequest, opts ...grpc.CallOption) (*QueryModuleSchemaResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleSchemas(ctx context.Context, in *QueryModuleSchemasRequest, opts ...grpc.CallOption) (*QueryModuleSchemasResponse, error) {
+	out := new(QueryModuleSchemasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleSchema(ctx context.Context, in *QueryModuleSchemaRequest, opts ...grpc.CallOption) (*QueryModuleSchemaResponse, error) {
+	out := new(QueryModuleSchemaResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// ModuleSchemas returns the ORM schemas of all the modules of the app.
+	ModuleSchemas(context.Context, *QueryModuleSchemasRequest) (*QueryModuleSchemasResponse, error)
+	// ModuleSchema returns the ORM schema of a module.
+	ModuleSchema(context.Context, *QueryModuleSchemaRequest) (*QueryModuleSchemaResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) ModuleSchemas(context.Context, *QueryModuleSchemasRequest) (*QueryModuleSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleSchemas not implemented")
+}
+func (UnimplementedQueryServer) ModuleSchema(context.Context, *QueryModuleSchemaRequest) (*QueryModuleSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleSchema not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_ModuleSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleSchemas(ctx, req.(*QueryModuleSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleSchema(ctx, req.(*QueryModuleSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.ormschema.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleSchemas",
+			Handler:    _Query_ModuleSchemas_Handler,
+		},
+		{
+			MethodName: "ModuleSchema",
+			Handler:    _Query_ModuleSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/ormschema/v1alpha1/query.proto",
+}
@@ -40,6 +40,13 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// GetBlockResults queries the results of the execution of the block at a
+	// given height: the results of its txs and the events emitted in BeginBlock
+	// and EndBlock, optionally along with the block itself and the typed events
+	// decoded to JSON.
+	//
+	// Since: cosmos-sdk 0.47
+	GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error)
 }
 
 type serviceClient struct {
@@ -113,6 +120,15 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error) {
+	out := new(GetBlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetBlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -135,6 +151,13 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// GetBlockResults queries the results of the execution of the block at a
+	// given height: the results of its txs and the events emitted in BeginBlock
+	// and EndBlock, optionally along with the block itself and the typed events
+	// decoded to JSON.
+	//
+	// Since: cosmos-sdk 0.47
+	GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -163,6 +186,9 @@ func (UnimplementedServiceServer) GetValidatorSetByHeight(context.Context, *GetV
 func (UnimplementedServiceServer) ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (UnimplementedServiceServer) GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResults not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -302,6 +328,24 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetBlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/GetBlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockResults(ctx, req.(*GetBlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -337,6 +381,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "GetBlockResults",
+			Handler:    _Service_GetBlockResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
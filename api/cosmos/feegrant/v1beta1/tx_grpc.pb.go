@@ -28,6 +28,16 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// SetBudget creates or resets a budget shared by the allowances of the
+	// granter which are linked to it.
+	//
+	// Since: cosmos-sdk 0.47
+	SetBudget(ctx context.Context, in *MsgSetBudget, opts ...grpc.CallOption) (*MsgSetBudgetResponse, error)
+	// DeleteBudget deletes a budget of the granter. The allowances linked to it
+	// can no longer be used.
+	//
+	// Since: cosmos-sdk 0.47
+	DeleteBudget(ctx context.Context, in *MsgDeleteBudget, opts ...grpc.CallOption) (*MsgDeleteBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -56,6 +66,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) SetBudget(ctx context.Context, in *MsgSetBudget, opts ...grpc.CallOption) (*MsgSetBudgetResponse, error) {
+	out := new(MsgSetBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/SetBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteBudget(ctx context.Context, in *MsgDeleteBudget, opts ...grpc.CallOption) (*MsgDeleteBudgetResponse, error) {
+	out := new(MsgDeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/DeleteBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -66,6 +94,16 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// SetBudget creates or resets a budget shared by the allowances of the
+	// granter which are linked to it.
+	//
+	// Since: cosmos-sdk 0.47
+	SetBudget(context.Context, *MsgSetBudget) (*MsgSetBudgetResponse, error)
+	// DeleteBudget deletes a budget of the granter. The allowances linked to it
+	// can no longer be used.
+	//
+	// Since: cosmos-sdk 0.47
+	DeleteBudget(context.Context, *MsgDeleteBudget) (*MsgDeleteBudgetResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -79,6 +117,12 @@ func (UnimplementedMsgServer) GrantAllowance(context.Context, *MsgGrantAllowance
 func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (UnimplementedMsgServer) SetBudget(context.Context, *MsgSetBudget) (*MsgSetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedMsgServer) DeleteBudget(context.Context, *MsgDeleteBudget) (*MsgDeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -128,6 +172,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/SetBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBudget(ctx, req.(*MsgSetBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/DeleteBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBudget(ctx, req.(*MsgDeleteBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -143,6 +223,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _Msg_SetBudget_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _Msg_DeleteBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
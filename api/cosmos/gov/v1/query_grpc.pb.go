@@ -38,6 +38,10 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ConvictionLocks queries the conviction locks of a voter.
+	//
+	// Since: cosmos-sdk 0.47
+	ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error)
 }
 
 type queryClient struct {
@@ -120,6 +124,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error) {
+	out := new(QueryConvictionLocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ConvictionLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -140,6 +153,10 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ConvictionLocks queries the conviction locks of a voter.
+	//
+	// Since: cosmos-sdk 0.47
+	ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -171,6 +188,9 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvictionLocks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -328,6 +348,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConvictionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvictionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvictionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ConvictionLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvictionLocks(ctx, req.(*QueryConvictionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -367,6 +405,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ConvictionLocks",
+			Handler:    _Query_ConvictionLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -33,6 +33,11 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// CancelProposalExecution defines a method for the guardian to cancel the
+	// execution of a passed proposal during its execution delay.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposalExecution(ctx context.Context, in *MsgCancelProposalExecution, opts ...grpc.CallOption) (*MsgCancelProposalExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -88,6 +93,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CancelProposalExecution(ctx context.Context, in *MsgCancelProposalExecution, opts ...grpc.CallOption) (*MsgCancelProposalExecutionResponse, error) {
+	out := new(MsgCancelProposalExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelProposalExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -103,6 +117,11 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// CancelProposalExecution defines a method for the guardian to cancel the
+	// execution of a passed proposal during its execution delay.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposalExecution(context.Context, *MsgCancelProposalExecution) (*MsgCancelProposalExecutionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +144,9 @@ func (UnimplementedMsgServer) VoteWeighted(context.Context, *MsgVoteWeighted) (*
 func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedMsgServer) CancelProposalExecution(context.Context, *MsgCancelProposalExecution) (*MsgCancelProposalExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposalExecution not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -228,6 +250,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposalExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposalExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposalExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelProposalExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposalExecution(ctx, req.(*MsgCancelProposalExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,6 +295,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "CancelProposalExecution",
+			Handler:    _Msg_CancelProposalExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
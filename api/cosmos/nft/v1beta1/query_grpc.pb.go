@@ -37,6 +37,19 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Approved queries the account approved to send an NFT based on its class and id, same as getApproved in ERC721
+	//
+	// Since: cosmos-sdk 0.47
+	Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error)
+	// IsApprovedForAll queries whether an operator is approved to send all the NFTs of a class owned by the owner, same
+	// as isApprovedForAll in ERC721
+	//
+	// Since: cosmos-sdk 0.47
+	IsApprovedForAll(ctx context.Context, in *QueryIsApprovedForAllRequest, opts ...grpc.CallOption) (*QueryIsApprovedForAllResponse, error)
+	// Operators queries all the operators approved to send the NFTs of a class owned by the owner
+	//
+	// Since: cosmos-sdk 0.47
+	Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error)
 }
 
 type queryClient struct {
@@ -110,6 +123,33 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Approved(ctx context.Context, in *QueryApprovedRequest, opts ...grpc.CallOption) (*QueryApprovedResponse, error) {
+	out := new(QueryApprovedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Approved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsApprovedForAll(ctx context.Context, in *QueryIsApprovedForAllRequest, opts ...grpc.CallOption) (*QueryIsApprovedForAllResponse, error) {
+	out := new(QueryIsApprovedForAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/IsApprovedForAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Operators(ctx context.Context, in *QueryOperatorsRequest, opts ...grpc.CallOption) (*QueryOperatorsResponse, error) {
+	out := new(QueryOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Operators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -129,6 +169,19 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Approved queries the account approved to send an NFT based on its class and id, same as getApproved in ERC721
+	//
+	// Since: cosmos-sdk 0.47
+	Approved(context.Context, *QueryApprovedRequest) (*QueryApprovedResponse, error)
+	// IsApprovedForAll queries whether an operator is approved to send all the NFTs of a class owned by the owner, same
+	// as isApprovedForAll in ERC721
+	//
+	// Since: cosmos-sdk 0.47
+	IsApprovedForAll(context.Context, *QueryIsApprovedForAllRequest) (*QueryIsApprovedForAllResponse, error)
+	// Operators queries all the operators approved to send the NFTs of a class owned by the owner
+	//
+	// Since: cosmos-sdk 0.47
+	Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -157,6 +210,15 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) Approved(context.Context, *QueryApprovedRequest) (*QueryApprovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approved not implemented")
+}
+func (UnimplementedQueryServer) IsApprovedForAll(context.Context, *QueryIsApprovedForAllRequest) (*QueryIsApprovedForAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsApprovedForAll not implemented")
+}
+func (UnimplementedQueryServer) Operators(context.Context, *QueryOperatorsRequest) (*QueryOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -296,6 +358,60 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Approved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Approved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Approved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Approved(ctx, req.(*QueryApprovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsApprovedForAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsApprovedForAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsApprovedForAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/IsApprovedForAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsApprovedForAll(ctx, req.(*QueryIsApprovedForAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Operators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Operators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Operators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Operators(ctx, req.(*QueryOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +447,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Approved",
+			Handler:    _Query_Approved_Handler,
+		},
+		{
+			MethodName: "IsApprovedForAll",
+			Handler:    _Query_IsApprovedForAll_Handler,
+		},
+		{
+			MethodName: "Operators",
+			Handler:    _Query_Operators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
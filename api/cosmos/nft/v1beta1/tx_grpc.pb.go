@@ -24,6 +24,25 @@ const _ = grpc.SupportPackageIsVersion7
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// Approve defines a method to approve an account to send a nft on behalf of
+	// its owner, same as approve in ERC721.
+	//
+	// Since: cosmos-sdk 0.47
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
+	// Revoke defines a method to revoke the approval of a nft.
+	//
+	// Since: cosmos-sdk 0.47
+	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// SetApprovalForAll defines a method to approve an operator to send all the
+	// nfts of a class on behalf of their owner, similar to setApprovalForAll in ERC721.
+	//
+	// Since: cosmos-sdk 0.47
+	SetApprovalForAll(ctx context.Context, in *MsgSetApprovalForAll, opts ...grpc.CallOption) (*MsgSetApprovalForAllResponse, error)
+	// RevokeApprovalForAll defines a method to revoke the approval of an operator
+	// for all the nfts of a class.
+	//
+	// Since: cosmos-sdk 0.47
+	RevokeApprovalForAll(ctx context.Context, in *MsgRevokeApprovalForAll, opts ...grpc.CallOption) (*MsgRevokeApprovalForAllResponse, error)
 }
 
 type msgClient struct {
@@ -43,12 +62,67 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error) {
+	out := new(MsgRevokeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Msg/Revoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetApprovalForAll(ctx context.Context, in *MsgSetApprovalForAll, opts ...grpc.CallOption) (*MsgSetApprovalForAllResponse, error) {
+	out := new(MsgSetApprovalForAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Msg/SetApprovalForAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeApprovalForAll(ctx context.Context, in *MsgRevokeApprovalForAll, opts ...grpc.CallOption) (*MsgRevokeApprovalForAllResponse, error) {
+	out := new(MsgRevokeApprovalForAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Msg/RevokeApprovalForAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// Approve defines a method to approve an account to send a nft on behalf of
+	// its owner, same as approve in ERC721.
+	//
+	// Since: cosmos-sdk 0.47
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
+	// Revoke defines a method to revoke the approval of a nft.
+	//
+	// Since: cosmos-sdk 0.47
+	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// SetApprovalForAll defines a method to approve an operator to send all the
+	// nfts of a class on behalf of their owner, similar to setApprovalForAll in ERC721.
+	//
+	// Since: cosmos-sdk 0.47
+	SetApprovalForAll(context.Context, *MsgSetApprovalForAll) (*MsgSetApprovalForAllResponse, error)
+	// RevokeApprovalForAll defines a method to revoke the approval of an operator
+	// for all the nfts of a class.
+	//
+	// Since: cosmos-sdk 0.47
+	RevokeApprovalForAll(context.Context, *MsgRevokeApprovalForAll) (*MsgRevokeApprovalForAllResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -59,6 +133,18 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedMsgServer) SetApprovalForAll(context.Context, *MsgSetApprovalForAll) (*MsgSetApprovalForAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalForAll not implemented")
+}
+func (UnimplementedMsgServer) RevokeApprovalForAll(context.Context, *MsgRevokeApprovalForAll) (*MsgRevokeApprovalForAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApprovalForAll not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +176,78 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevoke)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Msg/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Revoke(ctx, req.(*MsgRevoke))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetApprovalForAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetApprovalForAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetApprovalForAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Msg/SetApprovalForAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetApprovalForAll(ctx, req.(*MsgSetApprovalForAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeApprovalForAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeApprovalForAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeApprovalForAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Msg/RevokeApprovalForAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeApprovalForAll(ctx, req.(*MsgRevokeApprovalForAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +259,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _Msg_Revoke_Handler,
+		},
+		{
+			MethodName: "SetApprovalForAll",
+			Handler:    _Msg_SetApprovalForAll_Handler,
+		},
+		{
+			MethodName: "RevokeApprovalForAll",
+			Handler:    _Msg_RevokeApprovalForAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -34,6 +34,11 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// ProfileGas simulates executing a transaction and returns the breakdown of
+	// the consumed gas, including in pprof format. It is meant for debugging.
+	//
+	// Since: cosmos-sdk 0.47
+	ProfileGas(ctx context.Context, in *ProfileGasRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error)
 }
 
 type serviceClient struct {
@@ -89,6 +94,15 @@ func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxs
 	return out, nil
 }
 
+func (c *serviceClient) ProfileGas(ctx context.Context, in *ProfileGasRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error) {
+	out := new(ProfileGasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/ProfileGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -105,6 +119,11 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
+	// ProfileGas simulates executing a transaction and returns the breakdown of
+	// the consumed gas, including in pprof format. It is meant for debugging.
+	//
+	// Since: cosmos-sdk 0.47
+	ProfileGas(context.Context, *ProfileGasRequest) (*ProfileGasResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -127,6 +146,9 @@ func (UnimplementedServiceServer) GetTxsEvent(context.Context, *GetTxsEventReque
 func (UnimplementedServiceServer) GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
 }
+func (UnimplementedServiceServer) ProfileGas(context.Context, *ProfileGasRequest) (*ProfileGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileGas not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +252,24 @@ func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ProfileGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ProfileGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/ProfileGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ProfileGas(ctx, req.(*ProfileGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -257,6 +297,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockWithTxs",
 			Handler:    _Service_GetBlockWithTxs_Handler,
 		},
+		{
+			MethodName: "ProfileGas",
+			Handler:    _Service_ProfileGas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -38,6 +38,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// MigrationProgress queries the progress of the incremental migrations of
+	// the modules from state.
+	//
+	// Since: cosmos-sdk 0.47
+	MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error)
+	// Halt queries the emergency halt of the chain, scheduled or in progress.
+	//
+	// Since: cosmos-sdk 0.47
+	Halt(ctx context.Context, in *QueryHaltRequest, opts ...grpc.CallOption) (*QueryHaltResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -89,6 +98,24 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error) {
+	out := new(QueryMigrationProgressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/MigrationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Halt(ctx context.Context, in *QueryHaltRequest, opts ...grpc.CallOption) (*QueryHaltResponse, error) {
+	out := new(QueryHaltResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Halt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Authority", in, out, opts...)
@@ -118,6 +145,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// MigrationProgress queries the progress of the incremental migrations of
+	// the modules from state.
+	//
+	// Since: cosmos-sdk 0.47
+	MigrationProgress(context.Context, *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error)
+	// Halt queries the emergency halt of the chain, scheduled or in progress.
+	//
+	// Since: cosmos-sdk 0.47
+	Halt(context.Context, *QueryHaltRequest) (*QueryHaltResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -141,6 +177,12 @@ func (UnimplementedQueryServer) UpgradedConsensusState(context.Context, *QueryUp
 func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (UnimplementedQueryServer) MigrationProgress(context.Context, *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationProgress not implemented")
+}
+func (UnimplementedQueryServer) Halt(context.Context, *QueryHaltRequest) (*QueryHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Halt not implemented")
+}
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -229,6 +271,42 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MigrationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/MigrationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationProgress(ctx, req.(*QueryMigrationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Halt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Halt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/Halt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Halt(ctx, req.(*QueryHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -270,6 +348,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "MigrationProgress",
+			Handler:    _Query_MigrationProgress_Handler,
+		},
+		{
+			MethodName: "Halt",
+			Handler:    _Query_Halt_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
@@ -315,6 +315,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	// Apply the configuration updates requested while the block was executed.
+	app.applyRuntimeUpdates(header.Height)
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -332,6 +335,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
 		halt = true
+
+	case app.haltAfterCommit:
+		app.haltAfterCommit = false
+		halt = true
 	}
 
 	if halt {
@@ -350,6 +357,13 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 }
 
+// HaltAfterCommit requests the node to halt gracefully once the current block
+// is committed, like when the halt height is reached. It is meant to be called
+// by the application while executing the block.
+func (app *BaseApp) HaltAfterCommit() {
+	app.haltAfterCommit = true
+}
+
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
@@ -629,11 +643,19 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
+	).WithMinGasPrices(app.MinGasPrices()).WithBlockHeight(height)
 
 	return ctx, nil
 }
 
+// NewContextAtHeight returns a CheckTx context branching an immutable view of
+// the state committed at height, or at the latest height if it is 0. The writes
+// to the context are discarded, so that it can be used while the app keeps
+// committing blocks, e.g. to export the state of a past height.
+func (app *BaseApp) NewContextAtHeight(height int64) (sdk.Context, error) {
+	return app.createQueryContext(height, false)
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from Tendermint. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -101,6 +102,10 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// halt the chain and gracefully shutdown once the current block is committed,
+	// as requested by the application during the block execution
+	haltAfterCommit bool
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from Tendermint. It is used as part of the process of determining the
@@ -133,6 +138,12 @@ type BaseApp struct { // nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// runtime updates of the configuration, applied on Commit, and the logger
+	// auditing them
+	runtimeUpdatesMtx sync.Mutex
+	runtimeUpdates    []*runtimeUpdate
+	auditLogger       log.Logger
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -601,6 +612,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// record a gas profile when simulating, to return a breakdown of the
+	// consumed gas along with the gas info
+	var gasProfiler *sdk.GasProfiler
+	if mode == runTxModeSimulate {
+		gasProfiler = sdk.NewGasProfiler()
+		ctx = ctx.WithGasProfiler(gasProfiler, sdk.GasProfileHandlerAnte)
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -613,6 +632,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if gasProfiler != nil {
+			gInfo.GasProfile = gasProfiler.Profile()
+		}
 	}()
 
 	blockGasConsumed := false
@@ -701,7 +723,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		//
 		// Note: If the postHandler fails, we also revert the runMsgs state.
 		if app.postHandler != nil {
-			newCtx, err := app.postHandler(runMsgCtx, tx, mode == runTxModeSimulate)
+			postCtx := runMsgCtx
+			if gasProfiler != nil {
+				postCtx = postCtx.WithGasProfiler(gasProfiler, sdk.GasProfileHandlerPost)
+			}
+
+			newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate)
 			if err != nil {
 				return gInfo, nil, nil, priority, err
 			}
@@ -748,6 +775,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err          error
 		)
 
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			ctx = ctx.WithGasProfiler(profiler, sdk.MsgTypeURL(msg))
+		}
+
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
 			msgResult, err = handler(ctx, msg)
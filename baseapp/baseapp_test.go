@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -91,6 +92,30 @@ func defaultLogger() log.Logger {
 	return log.MustNewDefaultLogger("plain", "info", false).With("module", "sdk/app")
 }
 
+// recordingLogger records the messages logged at every level, along with their
+// key/value pairs.
+type recordingLogger struct {
+	mtx  sync.Mutex
+	logs []string
+}
+
+func (l *recordingLogger) record(msg string, keyVals []interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.logs = append(l.logs, fmt.Sprint(append([]interface{}{msg}, keyVals...)...))
+}
+
+func (l *recordingLogger) Debug(msg string, keyVals ...interface{}) { l.record(msg, keyVals) }
+func (l *recordingLogger) Info(msg string, keyVals ...interface{})  { l.record(msg, keyVals) }
+func (l *recordingLogger) Error(msg string, keyVals ...interface{}) { l.record(msg, keyVals) }
+func (l *recordingLogger) With(_ ...interface{}) log.Logger         { return l }
+
+func (l *recordingLogger) String() string {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return strings.Join(l.logs, "\n")
+}
+
 func newBaseApp(name string, options ...func(*BaseApp)) *BaseApp {
 	logger := defaultLogger()
 	db := dbm.NewMemDB()
@@ -549,6 +574,70 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestRuntimeUpdates(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	commit := func(height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	pendingUpdates := func() int {
+		app.runtimeUpdatesMtx.Lock()
+		defer app.runtimeUpdatesMtx.Unlock()
+		return len(app.runtimeUpdates)
+	}
+
+	// updates are applied on the next commit
+	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
+	errCh := make(chan error)
+	go func() {
+		errCh <- app.UpdateMinGasPrices(context.Background(), minGasPrices)
+	}()
+	require.Eventually(t, func() bool { return pendingUpdates() == 1 }, time.Second, time.Millisecond)
+	require.Empty(t, app.MinGasPrices())
+
+	commit(1)
+	require.NoError(t, <-errCh)
+	require.Equal(t, minGasPrices, app.MinGasPrices())
+	require.Equal(t, minGasPrices, app.checkState.ctx.MinGasPrices())
+
+	// canceled updates are not applied
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, app.UpdateHalt(ctx, 10, 0), context.DeadlineExceeded)
+	require.Zero(t, pendingUpdates())
+	commit(2)
+	require.Zero(t, app.HaltHeight())
+
+	// invalid updates are rejected
+	err := app.UpdatePruning(context.Background(), pruningtypes.NewCustomPruningOptions(1, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	err = app.UpdateMinGasPrices(context.Background(), sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1), sdk.NewInt64DecCoin("stake", 2)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	go func() {
+		errCh <- app.UpdatePruning(context.Background(), pruningtypes.NewCustomPruningOptions(100, 10))
+	}()
+	require.Eventually(t, func() bool { return pendingUpdates() == 1 }, time.Second, time.Millisecond)
+	commit(3)
+	require.NoError(t, <-errCh)
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(100, 10), app.PruningOptions())
+
+	// the applied updates are logged with the audit logger
+	auditLogger := &recordingLogger{}
+	app.SetAuditLogger(auditLogger)
+	go func() {
+		errCh <- app.UpdateHalt(context.Background(), 100, 0)
+	}()
+	require.Eventually(t, func() bool { return pendingUpdates() == 1 }, time.Second, time.Millisecond)
+	commit(4)
+	require.NoError(t, <-errCh)
+	require.Contains(t, auditLogger.String(), "applied runtime update")
+	require.Contains(t, auditLogger.String(), "halt_height100")
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -1138,6 +1227,13 @@ func TestSimulateTx(t *testing.T) {
 		require.NotNil(t, result)
 		require.Equal(t, gasConsumed, gInfo.GasUsed)
 
+		// check the gas profile
+		require.NotNil(t, gInfo.GasProfile)
+		require.Equal(t, gasConsumed, gInfo.GasProfile.TotalGas())
+		require.Len(t, gInfo.GasProfile.Entries, 1)
+		require.Equal(t, "test", gInfo.GasProfile.Entries[0].GasDescriptor)
+		require.Equal(t, sdk.MsgTypeURL(tx.Msgs[0]), gInfo.GasProfile.Entries[0].Handler)
+
 		// simulate again, same result
 		gInfo, result, err = app.Simulate(txBytes)
 		require.NoError(t, err)
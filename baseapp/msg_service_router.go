@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	interceptors      []MsgInterceptor
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -31,6 +32,23 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// MsgInterceptor defines a function type which wraps the handling of a Msg
+// service message. It may run logic before and after calling next, which
+// invokes the following interceptor of the chain or, for the last one, the
+// Msg service handler itself. It may also return early without calling next
+// to reject the message.
+//
+// Interceptors run for every message routed through the MsgServiceRouter,
+// including the ones dispatched by modules such as x/authz, x/group and x/gov,
+// so they are part of the state machine and MUST be deterministic: they must
+// not depend on local configuration, wall-clock time, randomness or map
+// iteration order. Gas consumed through the context's gas meter and KVStores is
+// charged to the transaction like the gas consumed by the handler, and the
+// events emitted on the context's event manager are part of the message events,
+// along with the events of the handler, even if an interceptor replaces the
+// event manager.
+type MsgInterceptor = func(ctx sdk.Context, msg sdk.Msg, next MsgServiceHandler) (*sdk.Result, error)
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -106,8 +124,7 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
-			ctx = ctx.WithEventManager(sdk.NewEventManager())
+		msgHandler := func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 				return handler(goCtx, req)
@@ -126,9 +143,63 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 
 			return sdk.WrapServiceResult(ctx, resMsg, err)
 		}
+
+		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			if len(msr.interceptors) == 0 {
+				return msgHandler(ctx, req)
+			}
+
+			// the handler emits its events on an event manager of its own, then on
+			// the one of the route, so that they are kept even if an interceptor
+			// replaced the event manager of the context
+			em := ctx.EventManager()
+			handler := func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				res, err := msgHandler(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+
+				em.EmitEvents(ctx.EventManager().Events())
+				return res, nil
+			}
+
+			res, err := msr.intercept(ctx, req, 0, handler)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "msg interceptor returned no result for %s", requestTypeName)
+			}
+
+			// include the events emitted by the interceptors around the handler
+			res.Events = em.ABCIEvents()
+			return res, nil
+		}
 	}
 }
 
+// SetInterceptors sets the chain of interceptors wrapping every Msg service
+// handler of the router. Interceptors are called in the given order, the first
+// one being the outermost. See MsgInterceptor for the rules interceptors must
+// follow.
+func (msr *MsgServiceRouter) SetInterceptors(interceptors ...MsgInterceptor) {
+	msr.interceptors = interceptors
+}
+
+// intercept calls the i-th interceptor of the chain with a next handler calling
+// the following one, the last interceptor calling the Msg service handler.
+func (msr *MsgServiceRouter) intercept(ctx sdk.Context, msg sdk.Msg, i int, handler MsgServiceHandler) (*sdk.Result, error) {
+	if i == len(msr.interceptors) {
+		return handler(ctx, msg)
+	}
+
+	return msr.interceptors[i](ctx, msg, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return msr.intercept(ctx, msg, i+1, handler)
+	})
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -1,6 +1,7 @@
 package baseapp_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,7 +13,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -119,3 +123,100 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestMsgServiceInterceptors(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.MustNewDefaultLogger("plain", "info", false), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	var calls []string
+	recorder := func(name string) baseapp.MsgInterceptor {
+		return func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgServiceHandler) (*sdk.Result, error) {
+			calls = append(calls, name+" before")
+			ctx.GasMeter().ConsumeGas(10, name)
+			res, err := next(ctx, msg)
+			calls = append(calls, name+" after")
+			ctx.EventManager().EmitEvent(sdk.NewEvent(name))
+			return res, err
+		}
+	}
+	rejectDogs := func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgServiceHandler) (*sdk.Result, error) {
+		if dog, ok := msg.(*testdata.MsgCreateDog); ok && dog.Dog.Name == "Rex" {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("no more Rex")
+		}
+		return next(ctx, msg)
+	}
+	app.SetMsgInterceptors(recorder("first"), recorder("second"), rejectDogs)
+
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first before", "second before", "second after", "first after"}, calls)
+	require.Equal(t, sdk.Gas(20), ctx.GasMeter().GasConsumed())
+
+	var resMsg testdata.MsgCreateDogResponse
+	require.NoError(t, encCfg.Codec.Unmarshal(res.Data, &resMsg))
+	require.Equal(t, "Spot", resMsg.Name)
+	require.Len(t, res.Events, 2)
+	require.Equal(t, "second", res.Events[0].Type)
+	require.Equal(t, "first", res.Events[1].Type)
+
+	_, err = handler(ctx, &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+// eventMsgServer is a testdata.MsgServer emitting an event for every dog created.
+type eventMsgServer struct {
+	testdata.MsgServerImpl
+}
+
+func (m eventMsgServer) CreateDog(goCtx context.Context, msg *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(sdk.NewEvent("create_dog"))
+	return m.MsgServerImpl.CreateDog(goCtx, msg)
+}
+
+func TestMsgServiceInterceptorEventManager(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.MustNewDefaultLogger("plain", "info", false), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		eventMsgServer{},
+	)
+
+	// the interceptor passes the handler a context with a new event manager
+	replaceEventManager := func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgServiceHandler) (*sdk.Result, error) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("before"))
+		res, err := next(ctx.WithEventManager(sdk.NewEventManager()), msg)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("after"))
+		return res, err
+	}
+	app.SetMsgInterceptors(replaceEventManager)
+
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	res, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+
+	// the events of the handler are kept, once, between the ones of the interceptor
+	var types []string
+	for _, event := range res.Events {
+		types = append(types, event.Type)
+	}
+	require.Equal(t, []string{"before", "create_dog", "after"}, types)
+}
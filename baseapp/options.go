@@ -161,6 +161,17 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetMsgInterceptors sets the chain of interceptors wrapping the Msg service
+// handlers of the app's MsgServiceRouter. See MsgInterceptor for the rules
+// interceptors must follow.
+func (app *BaseApp) SetMsgInterceptors(interceptors ...MsgInterceptor) {
+	if app.sealed {
+		panic("SetMsgInterceptors() on sealed BaseApp")
+	}
+
+	app.msgServiceRouter.SetInterceptors(interceptors...)
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
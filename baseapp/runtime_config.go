@@ -0,0 +1,146 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// runtimeUpdate is a change of the BaseApp configuration requested while the
+// node is running. It is applied on the next Commit, between two blocks.
+type runtimeUpdate struct {
+	name    string
+	keyVals []interface{}
+	apply   func()
+	applied chan struct{}
+}
+
+// MinGasPrices returns the minimum gas prices of the transactions accepted by CheckTx.
+func (app *BaseApp) MinGasPrices() sdk.DecCoins {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+	return app.minGasPrices
+}
+
+// HaltHeight returns the block height at which the node halts, 0 if it is disabled.
+func (app *BaseApp) HaltHeight() uint64 {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+	return app.haltHeight
+}
+
+// HaltTime returns the minimum block time (in Unix seconds) at which the node
+// halts, 0 if it is disabled.
+func (app *BaseApp) HaltTime() uint64 {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+	return app.haltTime
+}
+
+// PruningOptions returns the pruning options of the commit multi-store.
+func (app *BaseApp) PruningOptions() pruningtypes.PruningOptions {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+	return app.cms.GetPruning()
+}
+
+// SetAuditLogger sets the logger recording the applied runtime updates, which
+// must log regardless of the log level of the node. The logger of the BaseApp
+// is used if it is not set.
+func (app *BaseApp) SetAuditLogger(logger log.Logger) {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+	app.auditLogger = logger
+}
+
+// UpdateMinGasPrices updates the minimum gas prices of the transactions accepted
+// by CheckTx on the next Commit. It blocks until the update is applied, or until
+// ctx is done, in which case the update is canceled.
+func (app *BaseApp) UpdateMinGasPrices(ctx context.Context, gasPrices sdk.DecCoins) error {
+	if err := gasPrices.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return app.scheduleRuntimeUpdate(ctx, "min gas prices", func() {
+		app.setMinGasPrices(gasPrices)
+	}, "min_gas_prices", gasPrices.String())
+}
+
+// UpdateHalt updates the block height and time at which the node halts on the
+// next Commit, zero values disabling them. It blocks until the update is
+// applied, or until ctx is done, in which case the update is canceled.
+func (app *BaseApp) UpdateHalt(ctx context.Context, haltHeight, haltTime uint64) error {
+	return app.scheduleRuntimeUpdate(ctx, "halt", func() {
+		app.setHaltHeight(haltHeight)
+		app.setHaltTime(haltTime)
+	}, "halt_height", haltHeight, "halt_time", haltTime)
+}
+
+// UpdatePruning updates the pruning options of the commit multi-store on the
+// next Commit. It blocks until the update is applied, or until ctx is done, in
+// which case the update is canceled.
+func (app *BaseApp) UpdatePruning(ctx context.Context, opts pruningtypes.PruningOptions) error {
+	if err := opts.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if opts.GetPruningStrategy() == pruningtypes.PruningEverything && app.snapshotManager != nil && app.snapshotManager.GetInterval() > 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot prune everything with state sync snapshots enabled")
+	}
+
+	return app.scheduleRuntimeUpdate(ctx, "pruning", func() {
+		app.cms.SetPruning(opts)
+	}, "keep_recent", opts.KeepRecent, "interval", opts.Interval)
+}
+
+// scheduleRuntimeUpdate schedules apply to run on the next Commit, and waits
+// until it is applied or ctx is done.
+func (app *BaseApp) scheduleRuntimeUpdate(ctx context.Context, name string, apply func(), keyVals ...interface{}) error {
+	update := &runtimeUpdate{name: name, keyVals: keyVals, apply: apply, applied: make(chan struct{})}
+
+	app.runtimeUpdatesMtx.Lock()
+	app.runtimeUpdates = append(app.runtimeUpdates, update)
+	app.runtimeUpdatesMtx.Unlock()
+
+	select {
+	case <-update.applied:
+		return nil
+
+	case <-ctx.Done():
+		app.runtimeUpdatesMtx.Lock()
+		defer app.runtimeUpdatesMtx.Unlock()
+
+		for i, u := range app.runtimeUpdates {
+			if u == update {
+				app.runtimeUpdates = append(app.runtimeUpdates[:i], app.runtimeUpdates[i+1:]...)
+				return fmt.Errorf("%s update canceled before the next commit: %w", name, ctx.Err())
+			}
+		}
+
+		// the update was applied concurrently
+		return nil
+	}
+}
+
+// applyRuntimeUpdates applies the pending runtime updates in the order they
+// were scheduled.
+func (app *BaseApp) applyRuntimeUpdates(height int64) {
+	app.runtimeUpdatesMtx.Lock()
+	defer app.runtimeUpdatesMtx.Unlock()
+
+	logger := app.auditLogger
+	if logger == nil {
+		logger = app.logger
+	}
+
+	for _, update := range app.runtimeUpdates {
+		update.apply()
+		close(update.applied)
+		logger.Info("applied runtime update", append([]interface{}{"update", update.name, "height", height}, update.keyVals...)...)
+	}
+	app.runtimeUpdates = nil
+}
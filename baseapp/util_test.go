@@ -59,10 +59,3 @@ func (app *BaseApp) GetName() string {
 func (app *BaseApp) CreateQueryContext(height int64, prove bool) (types.Context, error) {
 	return app.createQueryContext(height, prove)
 }
-
-// MinGasPrices returns minGasPrices.
-//
-// This method is only accessible in baseapp tests.
-func (app *BaseApp) MinGasPrices() types.DecCoins {
-	return app.minGasPrices
-}
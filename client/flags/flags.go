@@ -39,6 +39,10 @@ const (
 	SignModeDirectAux = "direct-aux"
 	// SignModeEIP191 is the value of the --sign-mode flag for SIGN_MODE_EIP_191
 	SignModeEIP191 = "eip-191"
+
+	// OutputFormatJSONL is the value of the --output flag printing the items
+	// of the paginated list queries as JSON lines
+	OutputFormatJSONL = "jsonl"
 )
 
 // List of CLI flags
@@ -79,6 +83,9 @@ const (
 	FlagReverse          = "reverse"
 	FlagTip              = "tip"
 	FlagAux              = "aux"
+	FlagAll              = "all"
+	FlagRateLimit        = "rate-limit"
+	FlagCursorFile       = "cursor-file"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -93,7 +100,7 @@ var LineBreak = &cobra.Command{Run: func(*cobra.Command, []string) {}}
 func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
-	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json), or jsonl for the paginated list queries")
 
 	// some base commands does not require chainID e.g `simd testnet` while subcommands do
 	// hence the flag should not be required for those commands
@@ -138,6 +145,9 @@ func AddPaginationFlagsToCmd(cmd *cobra.Command, query string) {
 	cmd.Flags().Uint64(FlagLimit, 100, fmt.Sprintf("pagination limit of %s to query for", query))
 	cmd.Flags().Bool(FlagCountTotal, false, fmt.Sprintf("count total number of records in %s to query for", query))
 	cmd.Flags().Bool(FlagReverse, false, "results are sorted in descending order")
+	cmd.Flags().Bool(FlagAll, false, fmt.Sprintf("query all the pages of %s following the next keys, streamed as JSON lines with --output %s", query, OutputFormatJSONL))
+	cmd.Flags().Float64(FlagRateLimit, 5, "maximum number of pages queried per second with --all, 0 for no limit")
+	cmd.Flags().String(FlagCursorFile, "", fmt.Sprintf("file saving the next key after each page with --all and --output %s, to resume an interrupted query", OutputFormatJSONL))
 }
 
 // GasSetting encapsulates the possible values passed through the --gas flag.
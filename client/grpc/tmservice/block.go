@@ -31,3 +31,13 @@ func GetProtoBlock(ctx context.Context, clientCtx client.Context, height *int64)
 
 	return protoBlockID, protoBlock, nil
 }
+
+func getBlockResults(ctx context.Context, clientCtx client.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	// get the node
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	return node.BlockResults(ctx, height)
+}
@@ -0,0 +1,39 @@
+package tmservice
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// decodeEvents decodes the typed events of a list of events to JSON, resolving
+// their Any fields with the interface registry. The events which are not typed
+// events, such as the legacy events, are skipped.
+func decodeEvents(interfaceRegistry codectypes.InterfaceRegistry, events []abci.Event) (DecodedEvents, error) {
+	decoded := DecodedEvents{}
+	for i, event := range events {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		if err := codectypes.UnpackInterfaces(msg, interfaceRegistry); err != nil {
+			return DecodedEvents{}, err
+		}
+
+		bz, err := codec.ProtoMarshalJSON(msg, interfaceRegistry)
+		if err != nil {
+			return DecodedEvents{}, err
+		}
+
+		decoded.Events = append(decoded.Events, DecodedEvent{
+			Index: uint32(i),
+			Type:  event.Type,
+			Json:  string(bz),
+		})
+	}
+
+	return decoded, nil
+}
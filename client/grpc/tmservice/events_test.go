@@ -0,0 +1,37 @@
+package tmservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDecodeEvents(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+
+	animal, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield", Lives: 6})
+	require.NoError(t, err)
+
+	em := sdk.NewEventManager()
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", "10stake")))
+	require.NoError(t, em.EmitTypedEvent(&testdata.HasAnimal{X: 1000, Animal: animal}))
+
+	decoded, err := decodeEvents(interfaceRegistry, em.ABCIEvents())
+	require.NoError(t, err)
+	require.Equal(t, []DecodedEvent{{
+		Index: 1,
+		Type:  "testdata.HasAnimal",
+		Json:  `{"animal":{"@type":"/testdata.Cat","moniker":"Garfield","lives":6},"x":"1000"}`,
+	}}, decoded.Events)
+
+	// no typed events
+	decoded, err = decodeEvents(interfaceRegistry, []abci.Event{{Type: "message"}})
+	require.NoError(t, err)
+	require.Empty(t, decoded.Events)
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/tendermint/tendermint/abci/types"
 	p2p "github.com/tendermint/tendermint/proto/tendermint/p2p"
 	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -425,6 +426,331 @@ func (m *GetBlockByHeightResponse) GetBlock() *types1.Block {
 	return nil
 }
 
+// GetBlockResultsRequest is the request type for the Query/GetBlockResults RPC method.
+//
+// Since: cosmos-sdk 0.47
+type GetBlockResultsRequest struct {
+	// height is the height of the block, the latest block is queried if it is 0.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// include_block sets the block in the response, read at the same height as
+	// its results.
+	IncludeBlock bool `protobuf:"varint,2,opt,name=include_block,json=includeBlock,proto3" json:"include_block,omitempty"`
+	// decode_events sets the typed events of the block decoded to JSON in the
+	// response.
+	DecodeEvents bool `protobuf:"varint,3,opt,name=decode_events,json=decodeEvents,proto3" json:"decode_events,omitempty"`
+}
+
+func (m *GetBlockResultsRequest) Reset()         { *m = GetBlockResultsRequest{} }
+func (m *GetBlockResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsRequest) ProtoMessage()    {}
+func (*GetBlockResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{7}
+}
+func (m *GetBlockResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsRequest.Merge(m, src)
+}
+func (m *GetBlockResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsRequest proto.InternalMessageInfo
+
+func (m *GetBlockResultsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsRequest) GetIncludeBlock() bool {
+	if m != nil {
+		return m.IncludeBlock
+	}
+	return false
+}
+
+func (m *GetBlockResultsRequest) GetDecodeEvents() bool {
+	if m != nil {
+		return m.DecodeEvents
+	}
+	return false
+}
+
+// GetBlockResultsResponse is the response type for the Query/GetBlockResults RPC method.
+//
+// Since: cosmos-sdk 0.47
+type GetBlockResultsResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block_id and block are only set if include_block is set in the request.
+	BlockId               *types1.BlockID             `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block                 *types1.Block               `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	TxsResults            []*types2.ResponseDeliverTx `protobuf:"bytes,4,rep,name=txs_results,json=txsResults,proto3" json:"txs_results,omitempty"`
+	TotalGasUsed          int64                       `protobuf:"varint,5,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty"`
+	BeginBlockEvents      []types2.Event              `protobuf:"bytes,6,rep,name=begin_block_events,json=beginBlockEvents,proto3" json:"begin_block_events"`
+	EndBlockEvents        []types2.Event              `protobuf:"bytes,7,rep,name=end_block_events,json=endBlockEvents,proto3" json:"end_block_events"`
+	ValidatorUpdates      []types2.ValidatorUpdate    `protobuf:"bytes,8,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	ConsensusParamUpdates *types1.ConsensusParams     `protobuf:"bytes,9,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
+	// txs_decoded_events are the typed events of each tx of txs_results decoded
+	// to JSON, only set if decode_events is set in the request.
+	TxsDecodedEvents []DecodedEvents `protobuf:"bytes,10,rep,name=txs_decoded_events,json=txsDecodedEvents,proto3" json:"txs_decoded_events"`
+	// begin_block_decoded_events are the typed events of begin_block_events
+	// decoded to JSON, only set if decode_events is set in the request.
+	BeginBlockDecodedEvents DecodedEvents `protobuf:"bytes,11,opt,name=begin_block_decoded_events,json=beginBlockDecodedEvents,proto3" json:"begin_block_decoded_events"`
+	// end_block_decoded_events are the typed events of end_block_events decoded
+	// to JSON, only set if decode_events is set in the request.
+	EndBlockDecodedEvents DecodedEvents `protobuf:"bytes,12,opt,name=end_block_decoded_events,json=endBlockDecodedEvents,proto3" json:"end_block_decoded_events"`
+}
+
+func (m *GetBlockResultsResponse) Reset()         { *m = GetBlockResultsResponse{} }
+func (m *GetBlockResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsResponse) ProtoMessage()    {}
+func (*GetBlockResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{8}
+}
+func (m *GetBlockResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsResponse.Merge(m, src)
+}
+func (m *GetBlockResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsResponse proto.InternalMessageInfo
+
+func (m *GetBlockResultsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsResponse) GetBlockId() *types1.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetBlock() *types1.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetTxsResults() []*types2.ResponseDeliverTx {
+	if m != nil {
+		return m.TxsResults
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetTotalGasUsed() int64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+func (m *GetBlockResultsResponse) GetBeginBlockEvents() []types2.Event {
+	if m != nil {
+		return m.BeginBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetEndBlockEvents() []types2.Event {
+	if m != nil {
+		return m.EndBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetValidatorUpdates() []types2.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetConsensusParamUpdates() *types1.ConsensusParams {
+	if m != nil {
+		return m.ConsensusParamUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetTxsDecodedEvents() []DecodedEvents {
+	if m != nil {
+		return m.TxsDecodedEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetBeginBlockDecodedEvents() DecodedEvents {
+	if m != nil {
+		return m.BeginBlockDecodedEvents
+	}
+	return DecodedEvents{}
+}
+
+func (m *GetBlockResultsResponse) GetEndBlockDecodedEvents() DecodedEvents {
+	if m != nil {
+		return m.EndBlockDecodedEvents
+	}
+	return DecodedEvents{}
+}
+
+// DecodedEvents is the list of the typed events of a list of events decoded
+// to JSON.
+//
+// Since: cosmos-sdk 0.47
+type DecodedEvents struct {
+	Events []DecodedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+}
+
+func (m *DecodedEvents) Reset()         { *m = DecodedEvents{} }
+func (m *DecodedEvents) String() string { return proto.CompactTextString(m) }
+func (*DecodedEvents) ProtoMessage()    {}
+func (*DecodedEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{9}
+}
+func (m *DecodedEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedEvents.Merge(m, src)
+}
+func (m *DecodedEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedEvents proto.InternalMessageInfo
+
+func (m *DecodedEvents) GetEvents() []DecodedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// DecodedEvent is a typed event decoded to JSON.
+//
+// Since: cosmos-sdk 0.47
+type DecodedEvent struct {
+	// index is the index of the event in its list of events.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// type is the proto message name of the event.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// json is the event encoded to JSON, with its Any fields resolved by the
+	// interface registry.
+	Json string `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (m *DecodedEvent) Reset()         { *m = DecodedEvent{} }
+func (m *DecodedEvent) String() string { return proto.CompactTextString(m) }
+func (*DecodedEvent) ProtoMessage()    {}
+func (*DecodedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40c93fb3ef485c5d, []int{10}
+}
+func (m *DecodedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedEvent.Merge(m, src)
+}
+func (m *DecodedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedEvent proto.InternalMessageInfo
+
+func (m *DecodedEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DecodedEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedEvent) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
 // GetLatestBlockRequest is the request type for the Query/GetLatestBlock RPC method.
 type GetLatestBlockRequest struct {
 }
@@ -433,7 +759,7 @@ func (m *GetLatestBlockRequest) Reset()         { *m = GetLatestBlockRequest{} }
 func (m *GetLatestBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLatestBlockRequest) ProtoMessage()    {}
 func (*GetLatestBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{7}
+	return fileDescriptor_40c93fb3ef485c5d, []int{11}
 }
 func (m *GetLatestBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -472,7 +798,7 @@ func (m *GetLatestBlockResponse) Reset()         { *m = GetLatestBlockResponse{}
 func (m *GetLatestBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLatestBlockResponse) ProtoMessage()    {}
 func (*GetLatestBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{8}
+	return fileDescriptor_40c93fb3ef485c5d, []int{12}
 }
 func (m *GetLatestBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +849,7 @@ func (m *GetSyncingRequest) Reset()         { *m = GetSyncingRequest{} }
 func (m *GetSyncingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSyncingRequest) ProtoMessage()    {}
 func (*GetSyncingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{9}
+	return fileDescriptor_40c93fb3ef485c5d, []int{13}
 }
 func (m *GetSyncingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +887,7 @@ func (m *GetSyncingResponse) Reset()         { *m = GetSyncingResponse{} }
 func (m *GetSyncingResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSyncingResponse) ProtoMessage()    {}
 func (*GetSyncingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{10}
+	return fileDescriptor_40c93fb3ef485c5d, []int{14}
 }
 func (m *GetSyncingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +931,7 @@ func (m *GetNodeInfoRequest) Reset()         { *m = GetNodeInfoRequest{} }
 func (m *GetNodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoRequest) ProtoMessage()    {}
 func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{11}
+	return fileDescriptor_40c93fb3ef485c5d, []int{15}
 }
 func (m *GetNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +970,7 @@ func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
 func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoResponse) ProtoMessage()    {}
 func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{12}
+	return fileDescriptor_40c93fb3ef485c5d, []int{16}
 }
 func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +1030,7 @@ func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
 func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
 func (*VersionInfo) ProtoMessage()    {}
 func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{13}
+	return fileDescriptor_40c93fb3ef485c5d, []int{17}
 }
 func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +1129,7 @@ func (m *Module) Reset()         { *m = Module{} }
 func (m *Module) String() string { return proto.CompactTextString(m) }
 func (*Module) ProtoMessage()    {}
 func (*Module) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{14}
+	return fileDescriptor_40c93fb3ef485c5d, []int{18}
 }
 func (m *Module) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1191,7 @@ func (m *ABCIQueryRequest) Reset()         { *m = ABCIQueryRequest{} }
 func (m *ABCIQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*ABCIQueryRequest) ProtoMessage()    {}
 func (*ABCIQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{15}
+	return fileDescriptor_40c93fb3ef485c5d, []int{19}
 }
 func (m *ABCIQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,7 +1268,7 @@ func (m *ABCIQueryResponse) Reset()         { *m = ABCIQueryResponse{} }
 func (m *ABCIQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*ABCIQueryResponse) ProtoMessage()    {}
 func (*ABCIQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{16}
+	return fileDescriptor_40c93fb3ef485c5d, []int{20}
 }
 func (m *ABCIQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1049,7 +1375,7 @@ func (m *ProofOp) Reset()         { *m = ProofOp{} }
 func (m *ProofOp) String() string { return proto.CompactTextString(m) }
 func (*ProofOp) ProtoMessage()    {}
 func (*ProofOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{17}
+	return fileDescriptor_40c93fb3ef485c5d, []int{21}
 }
 func (m *ProofOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1436,7 @@ func (m *ProofOps) Reset()         { *m = ProofOps{} }
 func (m *ProofOps) String() string { return proto.CompactTextString(m) }
 func (*ProofOps) ProtoMessage()    {}
 func (*ProofOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40c93fb3ef485c5d, []int{18}
+	return fileDescriptor_40c93fb3ef485c5d, []int{22}
 }
 func (m *ProofOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,6 +1480,10 @@ func init() {
 	proto.RegisterType((*Validator)(nil), "cosmos.base.tendermint.v1beta1.Validator")
 	proto.RegisterType((*GetBlockByHeightRequest)(nil), "cosmos.base.tendermint.v1beta1.GetBlockByHeightRequest")
 	proto.RegisterType((*GetBlockByHeightResponse)(nil), "cosmos.base.tendermint.v1beta1.GetBlockByHeightResponse")
+	proto.RegisterType((*GetBlockResultsRequest)(nil), "cosmos.base.tendermint.v1beta1.GetBlockResultsRequest")
+	proto.RegisterType((*GetBlockResultsResponse)(nil), "cosmos.base.tendermint.v1beta1.GetBlockResultsResponse")
+	proto.RegisterType((*DecodedEvents)(nil), "cosmos.base.tendermint.v1beta1.DecodedEvents")
+	proto.RegisterType((*DecodedEvent)(nil), "cosmos.base.tendermint.v1beta1.DecodedEvent")
 	proto.RegisterType((*GetLatestBlockRequest)(nil), "cosmos.base.tendermint.v1beta1.GetLatestBlockRequest")
 	proto.RegisterType((*GetLatestBlockResponse)(nil), "cosmos.base.tendermint.v1beta1.GetLatestBlockResponse")
 	proto.RegisterType((*GetSyncingRequest)(nil), "cosmos.base.tendermint.v1beta1.GetSyncingRequest")
@@ -1173,91 +1503,116 @@ func init() {
 }
 
 var fileDescriptor_40c93fb3ef485c5d = []byte{
-	// 1343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xda, 0x21, 0xb6, 0x9f, 0xf9, 0x7e, 0x15, 0x86, 0x14, 0x8c, 0x15, 0x0c, 0xf5, 0x01,
-	0xc2, 0x8f, 0xec, 0xd6, 0xe6, 0xe7, 0x81, 0x82, 0x08, 0xd0, 0x90, 0x42, 0x69, 0xba, 0xa9, 0x7a,
-	0xa8, 0x2a, 0x59, 0x6b, 0xef, 0x64, 0xb3, 0x8a, 0xbd, 0x33, 0xec, 0x8c, 0xdd, 0x5a, 0x15, 0x6a,
-	0xd5, 0x3f, 0xa0, 0xaa, 0xd4, 0x7f, 0x81, 0x43, 0xdb, 0x4b, 0x2f, 0x55, 0x8f, 0x9c, 0x39, 0x22,
-	0x2a, 0x55, 0xa8, 0x87, 0xaa, 0x82, 0xfe, 0x21, 0xd5, 0xbc, 0x99, 0x5d, 0xaf, 0x81, 0x60, 0x27,
-	0x87, 0x4a, 0x3d, 0x79, 0xf6, 0xfd, 0xfc, 0x7c, 0xde, 0x9b, 0x79, 0x33, 0x86, 0xd3, 0x1d, 0x26,
-	0x7a, 0x4c, 0x38, 0x6d, 0x4f, 0x50, 0x47, 0xd2, 0xc8, 0xa7, 0x71, 0x2f, 0x8c, 0xa4, 0x33, 0x68,
-	0xb4, 0xa9, 0xf4, 0x1a, 0xce, 0xfd, 0x3e, 0x8d, 0x87, 0x36, 0x8f, 0x99, 0x64, 0xa4, 0xa6, 0x6d,
-	0x6d, 0x65, 0x6b, 0x8f, 0x6c, 0x6d, 0x63, 0x5b, 0x5d, 0x08, 0x58, 0xc0, 0xd0, 0xd4, 0x51, 0x2b,
-	0xed, 0x55, 0x3d, 0x12, 0x30, 0x16, 0x74, 0xa9, 0x83, 0x5f, 0xed, 0xfe, 0xa6, 0xe3, 0x45, 0x26,
-	0x60, 0x75, 0xd1, 0xa8, 0x3c, 0x1e, 0x3a, 0x5e, 0x14, 0x31, 0xe9, 0xc9, 0x90, 0x45, 0xc2, 0x68,
-	0xab, 0x19, 0x38, 0xbc, 0xc9, 0x1d, 0x39, 0xe4, 0x34, 0xd1, 0x2d, 0x66, 0x74, 0x28, 0x77, 0xda,
-	0x5d, 0xd6, 0xd9, 0xde, 0x51, 0x9b, 0xf5, 0x1d, 0xa3, 0x8c, 0xfc, 0x52, 0xb6, 0xdc, 0x0b, 0xc2,
-	0x08, 0x41, 0x24, 0xe0, 0xb5, 0x6d, 0x4b, 0xb3, 0x32, 0xfc, 0xf1, 0xa3, 0xfe, 0xb5, 0x05, 0xb5,
-	0x55, 0x2a, 0x3f, 0xf1, 0xba, 0xa1, 0xef, 0x49, 0x16, 0x6f, 0x50, 0xb9, 0x32, 0xbc, 0x4d, 0xc3,
-	0x60, 0x4b, 0xba, 0xf4, 0x7e, 0x9f, 0x0a, 0x49, 0x0e, 0xc1, 0xdc, 0x16, 0x0a, 0x2a, 0xd6, 0x71,
-	0x6b, 0x29, 0xef, 0x9a, 0x2f, 0xf2, 0x1e, 0xc0, 0x28, 0x53, 0x25, 0x77, 0xdc, 0x5a, 0x2a, 0x37,
-	0x4f, 0xd8, 0xd9, 0xea, 0xea, 0xb2, 0x1b, 0x58, 0xf6, 0xba, 0x17, 0x50, 0x13, 0xd3, 0xcd, 0x78,
-	0xd6, 0x9f, 0x59, 0x70, 0x6c, 0x47, 0x08, 0x82, 0xb3, 0x48, 0x50, 0xf2, 0x36, 0xec, 0xc7, 0xd2,
-	0xb4, 0xc6, 0x90, 0x94, 0x51, 0xa6, 0x4d, 0xc9, 0x1a, 0xc0, 0x20, 0x09, 0x21, 0x2a, 0xb9, 0xe3,
-	0xf9, 0xa5, 0x72, 0xf3, 0x94, 0xfd, 0xe6, 0x66, 0xdb, 0x69, 0x52, 0x37, 0xe3, 0x4c, 0x56, 0xc7,
-	0x98, 0xe5, 0x91, 0xd9, 0xc9, 0x89, 0xcc, 0x34, 0xd4, 0x31, 0x6a, 0x9b, 0xb0, 0xb8, 0x4a, 0xe5,
-	0x5d, 0x4f, 0x52, 0x31, 0xc6, 0x2f, 0x29, 0xed, 0x78, 0x09, 0xad, 0x3d, 0x97, 0xf0, 0x77, 0x0b,
-	0x8e, 0xee, 0x90, 0xe8, 0xbf, 0x5d, 0xc0, 0x47, 0x16, 0x94, 0xd2, 0x14, 0xa4, 0x09, 0x05, 0xcf,
-	0xf7, 0x63, 0x2a, 0x04, 0xe2, 0x2f, 0xad, 0x54, 0x9e, 0xfe, 0xb2, 0xbc, 0x60, 0xc2, 0x5e, 0xd7,
-	0x9a, 0x0d, 0x19, 0x87, 0x51, 0xe0, 0x26, 0x86, 0x64, 0x19, 0x0a, 0xbc, 0xdf, 0x6e, 0x6d, 0xd3,
-	0xa1, 0xd9, 0xa2, 0x0b, 0xb6, 0x3e, 0xaf, 0x76, 0x72, 0x94, 0xed, 0xeb, 0xd1, 0xd0, 0x9d, 0xe3,
-	0xfd, 0xf6, 0x1d, 0x3a, 0x54, 0x75, 0x1a, 0x30, 0x19, 0x46, 0x41, 0x8b, 0xb3, 0xcf, 0x69, 0x8c,
-	0xd8, 0xf3, 0x6e, 0x59, 0xcb, 0xd6, 0x95, 0x88, 0x9c, 0x81, 0x03, 0x3c, 0x66, 0x9c, 0x09, 0x1a,
-	0xb7, 0x78, 0x1c, 0xb2, 0x38, 0x94, 0xc3, 0xca, 0x2c, 0xda, 0xcd, 0x27, 0x8a, 0x75, 0x23, 0xaf,
-	0x37, 0xe0, 0xf0, 0x2a, 0x95, 0x2b, 0xaa, 0xcc, 0x53, 0x9e, 0xab, 0xfa, 0x57, 0x50, 0x79, 0xd5,
-	0xc5, 0xb4, 0xf1, 0x3c, 0x14, 0x75, 0x1b, 0x43, 0xdf, 0x6c, 0x97, 0x23, 0xd9, 0xae, 0xe8, 0x01,
-	0x81, 0xae, 0x6b, 0x37, 0xdd, 0x02, 0x9a, 0xae, 0xf9, 0x64, 0x19, 0xf6, 0xe1, 0xd2, 0x54, 0xe0,
-	0xf0, 0x0e, 0x2e, 0xae, 0xb6, 0xaa, 0x1f, 0x86, 0xb7, 0xd2, 0xcd, 0xa4, 0x15, 0x1a, 0x71, 0xfd,
-	0x01, 0x1c, 0x7a, 0x59, 0xf1, 0x6f, 0xe2, 0x3a, 0x08, 0x07, 0x56, 0xa9, 0xdc, 0x18, 0x46, 0x1d,
-	0xd5, 0x61, 0x83, 0xc9, 0x06, 0x92, 0x15, 0x1a, 0x3c, 0x15, 0x28, 0x08, 0x2d, 0x42, 0x38, 0x45,
-	0x37, 0xf9, 0xac, 0x2f, 0xa0, 0xfd, 0x3d, 0xe6, 0xd3, 0xb5, 0x68, 0x93, 0x25, 0x51, 0x7e, 0xb2,
-	0xe0, 0xe0, 0x98, 0xd8, 0xc4, 0xb9, 0x00, 0xa5, 0x88, 0xf9, 0xb4, 0x15, 0x46, 0x9b, 0xcc, 0x10,
-	0xab, 0x64, 0x51, 0xf2, 0x26, 0xb7, 0x53, 0xa7, 0x62, 0x64, 0x56, 0xe4, 0x33, 0x38, 0xe8, 0x71,
-	0xde, 0x0d, 0x3b, 0xb8, 0x8b, 0x5b, 0x03, 0x1a, 0x8b, 0xd1, 0x8c, 0x3c, 0x33, 0xf1, 0x4c, 0x69,
-	0x73, 0x8c, 0x49, 0x32, 0x71, 0x8c, 0xbc, 0xfe, 0x43, 0x0e, 0xca, 0x19, 0x1b, 0x42, 0x60, 0x36,
-	0xf2, 0x7a, 0x54, 0x9f, 0x09, 0x17, 0xd7, 0xe4, 0x08, 0x14, 0x3d, 0xce, 0x5b, 0x28, 0xcf, 0xa1,
-	0xbc, 0xe0, 0x71, 0x7e, 0x4f, 0xa9, 0x2a, 0x50, 0x48, 0x00, 0xe5, 0xb5, 0xc6, 0x7c, 0x92, 0xa3,
-	0x00, 0x41, 0x28, 0x5b, 0x1d, 0xd6, 0xeb, 0x85, 0x12, 0xb7, 0x74, 0xc9, 0x2d, 0x05, 0xa1, 0xbc,
-	0x81, 0x02, 0xa5, 0x6e, 0xf7, 0xc3, 0xae, 0xdf, 0x92, 0x5e, 0x20, 0x2a, 0xfb, 0xb4, 0x1a, 0x25,
-	0x1f, 0x7b, 0x81, 0x40, 0x6f, 0x96, 0x72, 0x9d, 0x33, 0xde, 0xcc, 0x20, 0x25, 0xb7, 0x12, 0x6f,
-	0x9f, 0x72, 0x51, 0x29, 0xe0, 0x78, 0x39, 0x31, 0xa9, 0x14, 0x1f, 0x30, 0xbf, 0xdf, 0xa5, 0x26,
-	0xcb, 0x4d, 0xca, 0x05, 0x39, 0x0b, 0xc4, 0xdc, 0x66, 0xc2, 0xdf, 0x4e, 0xb3, 0x15, 0x31, 0xdb,
-	0xbc, 0xd6, 0x6c, 0xf8, 0xdb, 0x49, 0xa9, 0x6e, 0xc3, 0x9c, 0x0e, 0xa1, 0x8a, 0xc4, 0x3d, 0xb9,
-	0x95, 0x14, 0x49, 0xad, 0xb3, 0x95, 0xc8, 0x8d, 0x57, 0x62, 0x1e, 0xf2, 0xa2, 0xdf, 0x33, 0xf5,
-	0x51, 0xcb, 0xfa, 0x16, 0xcc, 0x5f, 0x5f, 0xb9, 0xb1, 0xf6, 0x91, 0x9a, 0x5b, 0xc9, 0x09, 0x26,
-	0x30, 0xeb, 0x7b, 0xd2, 0xc3, 0x98, 0xfb, 0x5d, 0x5c, 0xa7, 0x79, 0x72, 0x99, 0x3c, 0xa3, 0x93,
-	0x9e, 0x1f, 0xbb, 0x41, 0x17, 0x60, 0x1f, 0x8f, 0xd9, 0x80, 0x62, 0xa9, 0x8b, 0xae, 0xfe, 0xa8,
-	0x7f, 0x9b, 0x83, 0x03, 0x99, 0x54, 0x66, 0x27, 0x12, 0x98, 0xed, 0x30, 0x5f, 0x37, 0xf9, 0x7f,
-	0x2e, 0xae, 0x15, 0xca, 0x2e, 0x0b, 0x12, 0x94, 0x5d, 0x16, 0x28, 0x2b, 0xdc, 0xaa, 0xba, 0x77,
-	0xb8, 0x56, 0x59, 0xc2, 0xc8, 0xa7, 0x5f, 0x60, 0xc7, 0xf2, 0xae, 0xfe, 0x50, 0xbe, 0x6a, 0x26,
-	0xce, 0x21, 0x74, 0xb5, 0x54, 0x76, 0x03, 0xaf, 0xdb, 0xa7, 0x95, 0x02, 0xca, 0xf4, 0x07, 0xb9,
-	0x05, 0x25, 0x1e, 0x33, 0xb6, 0xd9, 0x62, 0x5c, 0x60, 0x99, 0xcb, 0xcd, 0xa5, 0x49, 0x5d, 0x5b,
-	0x57, 0x0e, 0x1f, 0x72, 0xe1, 0x16, 0xb9, 0x59, 0x65, 0x4a, 0x50, 0x1a, 0x2b, 0xc1, 0x22, 0x94,
-	0x14, 0x15, 0xc1, 0xbd, 0x0e, 0xad, 0x80, 0xde, 0x33, 0xa9, 0xe0, 0xfd, 0xd9, 0x62, 0x6e, 0x3e,
-	0x5f, 0xbf, 0x01, 0x05, 0x13, 0x51, 0xf1, 0x53, 0x83, 0x21, 0xe9, 0xa2, 0x5a, 0x27, 0x4c, 0x72,
-	0x23, 0x26, 0x49, 0x5f, 0xf2, 0xa3, 0xbe, 0xd4, 0xef, 0x40, 0x31, 0x81, 0x45, 0xae, 0x41, 0x5e,
-	0xb1, 0xb1, 0x70, 0x0f, 0x9e, 0x9c, 0x92, 0xcd, 0xca, 0xec, 0xe3, 0x3f, 0x8f, 0xcd, 0xb8, 0xca,
-	0xb3, 0xf9, 0x33, 0x40, 0x61, 0x83, 0xc6, 0x83, 0xb0, 0x43, 0xc9, 0x8f, 0x16, 0x94, 0x33, 0xa3,
-	0x83, 0x34, 0x27, 0xc5, 0x7b, 0x75, 0xfc, 0x54, 0xcf, 0xed, 0xca, 0x47, 0xef, 0x88, 0x7a, 0xe3,
-	0x9b, 0xdf, 0xfe, 0xfe, 0x3e, 0x77, 0x86, 0x9c, 0x72, 0x26, 0xbc, 0x7e, 0xd3, 0x09, 0x46, 0x1e,
-	0x5a, 0x00, 0xa3, 0x69, 0x49, 0x1a, 0x53, 0xa4, 0x1d, 0x1f, 0xb7, 0xd5, 0xe6, 0x6e, 0x5c, 0x0c,
-	0x50, 0x07, 0x81, 0x9e, 0x22, 0x27, 0x27, 0x01, 0x35, 0x33, 0x9a, 0xfc, 0x6a, 0xc1, 0xff, 0xc7,
-	0x2f, 0x1a, 0x72, 0x61, 0x8a, 0xbc, 0xaf, 0xde, 0x58, 0xd5, 0x8b, 0xbb, 0x75, 0x33, 0x90, 0x2f,
-	0x20, 0x64, 0x87, 0x2c, 0x4f, 0x82, 0x8c, 0x37, 0x93, 0x70, 0xba, 0x18, 0x83, 0x3c, 0xb2, 0x60,
-	0xfe, 0xe5, 0xbb, 0x9b, 0x5c, 0x9a, 0x02, 0xc3, 0xeb, 0x1e, 0x08, 0xd5, 0xcb, 0xbb, 0x77, 0x34,
-	0xf0, 0x2f, 0x21, 0xfc, 0x06, 0x71, 0xa6, 0x84, 0xff, 0xa5, 0x3e, 0x8d, 0x0f, 0xc8, 0x53, 0x2b,
-	0x73, 0xf7, 0x67, 0x1f, 0x92, 0xe4, 0xca, 0xd4, 0x95, 0x7c, 0xcd, 0x43, 0xb7, 0xfa, 0xee, 0x1e,
-	0xbd, 0x0d, 0x9f, 0x2b, 0xc8, 0xe7, 0x22, 0x39, 0x3f, 0x89, 0xcf, 0xe8, 0x0d, 0x4a, 0x65, 0xda,
-	0x95, 0x3f, 0x2c, 0x7c, 0x84, 0xbd, 0xee, 0x0f, 0x06, 0xb9, 0x3a, 0x05, 0xb0, 0x37, 0xfc, 0x39,
-	0xaa, 0x5e, 0xdb, 0xb3, 0xbf, 0xa1, 0x76, 0x15, 0xa9, 0x5d, 0x26, 0x17, 0x77, 0x47, 0x2d, 0xed,
-	0xd8, 0x43, 0x0b, 0x4a, 0xe9, 0x6d, 0x41, 0xde, 0x99, 0x04, 0xe7, 0xe5, 0x3b, 0xac, 0xda, 0xd8,
-	0x85, 0x87, 0x81, 0xdc, 0x44, 0xc8, 0x67, 0xc9, 0xe9, 0x49, 0x90, 0xbd, 0x76, 0x27, 0x6c, 0xe1,
-	0x4b, 0x7f, 0xe5, 0xee, 0xe3, 0xe7, 0x35, 0xeb, 0xc9, 0xf3, 0x9a, 0xf5, 0xd7, 0xf3, 0x9a, 0xf5,
-	0xdd, 0x8b, 0xda, 0xcc, 0x93, 0x17, 0xb5, 0x99, 0x67, 0x2f, 0x6a, 0x33, 0x9f, 0x36, 0x83, 0x50,
-	0x6e, 0xf5, 0xdb, 0x76, 0x87, 0xf5, 0x92, 0x78, 0xfa, 0x67, 0x59, 0xf8, 0xdb, 0x4e, 0xa7, 0x1b,
-	0xd2, 0x48, 0x3a, 0x41, 0xcc, 0x3b, 0x8e, 0xec, 0x09, 0x3d, 0x73, 0xdb, 0x73, 0xf8, 0x76, 0x3f,
-	0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb1, 0xf9, 0xc8, 0xf8, 0x0f, 0x00, 0x00,
+	// 1740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xb2, 0x48, 0x3e, 0xca, 0xae, 0x3c, 0x96, 0x2d, 0x9a, 0xb5, 0x15, 0x65, 0x5b,
+	0xc4, 0x76, 0x6c, 0x71, 0x2b, 0x26, 0xb6, 0x73, 0x48, 0x13, 0x58, 0xb2, 0xa3, 0xc8, 0x71, 0x53,
+	0x75, 0x95, 0x14, 0xe8, 0x1f, 0x60, 0xb1, 0xe4, 0x8e, 0x56, 0x5b, 0x2d, 0x77, 0x26, 0x3b, 0x43,
+	0x56, 0x6a, 0x11, 0xb4, 0xe8, 0xa9, 0xa7, 0xa2, 0x40, 0xbf, 0x42, 0x0e, 0x6d, 0xcf, 0x45, 0x8f,
+	0x39, 0xf4, 0x94, 0x63, 0x90, 0x02, 0x45, 0xd0, 0x43, 0x51, 0xd8, 0xfd, 0x08, 0xfd, 0x00, 0xc5,
+	0xbc, 0x99, 0x5d, 0xee, 0x4a, 0x56, 0x48, 0xea, 0x50, 0x20, 0x27, 0xce, 0xbe, 0x79, 0x7f, 0x7e,
+	0xef, 0xf7, 0x76, 0xde, 0xdb, 0x21, 0xbc, 0xda, 0x67, 0x62, 0xc0, 0x84, 0xd3, 0xf3, 0x05, 0x75,
+	0x24, 0x4d, 0x02, 0x9a, 0x0e, 0xa2, 0x44, 0x3a, 0xa3, 0xf5, 0x1e, 0x95, 0xfe, 0xba, 0xf3, 0xd1,
+	0x90, 0xa6, 0x47, 0x1d, 0x9e, 0x32, 0xc9, 0xc8, 0x8a, 0xd6, 0xed, 0x28, 0xdd, 0xce, 0x58, 0xb7,
+	0x63, 0x74, 0xdb, 0x4b, 0x21, 0x0b, 0x19, 0xaa, 0x3a, 0x6a, 0xa5, 0xad, 0xda, 0xd7, 0x42, 0xc6,
+	0xc2, 0x98, 0x3a, 0xf8, 0xd4, 0x1b, 0xee, 0x39, 0x7e, 0x62, 0x1c, 0xb6, 0xaf, 0x9b, 0x2d, 0x9f,
+	0x47, 0x8e, 0x9f, 0x24, 0x4c, 0xfa, 0x32, 0x62, 0x89, 0x30, 0xbb, 0xdf, 0x2c, 0xc0, 0xf1, 0x7b,
+	0xfd, 0xc8, 0x91, 0x47, 0x9c, 0x66, 0x9b, 0xed, 0xc2, 0x26, 0xef, 0xf2, 0xd2, 0xde, 0xf5, 0xc2,
+	0x1e, 0xca, 0x9d, 0x5e, 0xcc, 0xfa, 0x07, 0x66, 0xf7, 0xc6, 0x89, 0x5d, 0xee, 0xa7, 0xfe, 0xe0,
+	0x74, 0xe3, 0xa2, 0xeb, 0x12, 0x5d, 0xc8, 0x4d, 0xce, 0x14, 0xf7, 0xc3, 0x28, 0xc1, 0x04, 0xb2,
+	0xc4, 0xb5, 0xae, 0xa7, 0x19, 0xd1, 0x0f, 0x7a, 0xcb, 0xfe, 0xb5, 0x05, 0x2b, 0x5b, 0x54, 0xfe,
+	0xd0, 0x8f, 0xa3, 0xc0, 0x97, 0x2c, 0xdd, 0xa5, 0x72, 0xe3, 0xe8, 0x5d, 0x1a, 0x85, 0xfb, 0xd2,
+	0xa5, 0x1f, 0x0d, 0xa9, 0x90, 0xe4, 0x2a, 0xcc, 0xef, 0xa3, 0xa0, 0x65, 0xad, 0x5a, 0xb7, 0xaa,
+	0xae, 0x79, 0x22, 0xef, 0x00, 0x8c, 0x23, 0xb5, 0x2a, 0xab, 0xd6, 0xad, 0x66, 0xf7, 0x95, 0x4e,
+	0xb1, 0x32, 0xba, 0x64, 0x06, 0x56, 0x67, 0xc7, 0x0f, 0xa9, 0xf1, 0xe9, 0x16, 0x2c, 0xed, 0x2f,
+	0x2d, 0x78, 0xe9, 0x54, 0x08, 0x82, 0xb3, 0x44, 0x50, 0xf2, 0x32, 0x2c, 0x20, 0x73, 0x5e, 0x09,
+	0x49, 0x13, 0x65, 0x5a, 0x95, 0x6c, 0x03, 0x8c, 0x32, 0x17, 0xa2, 0x55, 0x59, 0xad, 0xde, 0x6a,
+	0x76, 0x6f, 0x77, 0xbe, 0xfa, 0x45, 0xe9, 0xe4, 0x41, 0xdd, 0x82, 0x31, 0xd9, 0x2a, 0x65, 0x56,
+	0xc5, 0xcc, 0x6e, 0x4e, 0xcc, 0x4c, 0x43, 0x2d, 0xa5, 0xb6, 0x07, 0xd7, 0xb7, 0xa8, 0x7c, 0xea,
+	0x4b, 0x2a, 0x4a, 0xf9, 0x65, 0xd4, 0x96, 0x29, 0xb4, 0xce, 0x4c, 0xe1, 0x3f, 0x2c, 0xb8, 0x71,
+	0x4a, 0xa0, 0xaf, 0x37, 0x81, 0x9f, 0x5a, 0xd0, 0xc8, 0x43, 0x90, 0x2e, 0xd4, 0xfc, 0x20, 0x48,
+	0xa9, 0x10, 0x88, 0xbf, 0xb1, 0xd1, 0xfa, 0xe2, 0x2f, 0x6b, 0x4b, 0xc6, 0xed, 0x43, 0xbd, 0xb3,
+	0x2b, 0xd3, 0x28, 0x09, 0xdd, 0x4c, 0x91, 0xac, 0x41, 0x8d, 0x0f, 0x7b, 0xde, 0x01, 0x3d, 0x32,
+	0xaf, 0xe8, 0x52, 0x47, 0x9f, 0xf5, 0x4e, 0xd6, 0x06, 0x3a, 0x0f, 0x93, 0x23, 0x77, 0x9e, 0x0f,
+	0x7b, 0xef, 0xd1, 0x23, 0xc5, 0xd3, 0x88, 0xc9, 0x28, 0x09, 0x3d, 0xce, 0x7e, 0x4e, 0x53, 0xc4,
+	0x5e, 0x75, 0x9b, 0x5a, 0xb6, 0xa3, 0x44, 0xe4, 0x0e, 0x5c, 0xe2, 0x29, 0xe3, 0x4c, 0xd0, 0xd4,
+	0xe3, 0x69, 0xc4, 0xd2, 0x48, 0x1e, 0xb5, 0xe6, 0x50, 0x6f, 0x31, 0xdb, 0xd8, 0x31, 0x72, 0x7b,
+	0x1d, 0x96, 0xb7, 0xa8, 0xdc, 0x50, 0x34, 0x4f, 0x79, 0xae, 0xec, 0x5f, 0x41, 0xeb, 0xa4, 0x89,
+	0x29, 0xe3, 0xeb, 0x50, 0xd7, 0x65, 0x8c, 0x02, 0xf3, 0xba, 0x5c, 0x2b, 0x56, 0x45, 0x37, 0x08,
+	0x34, 0xdd, 0x7e, 0xe4, 0xd6, 0x50, 0x75, 0x3b, 0x20, 0x6b, 0x70, 0x1e, 0x97, 0x86, 0x81, 0xe5,
+	0x53, 0x4c, 0x5c, 0xad, 0x65, 0xff, 0x02, 0xae, 0x66, 0x00, 0x5c, 0x2a, 0x86, 0xb1, 0x14, 0x93,
+	0x5a, 0xc1, 0xb7, 0xe0, 0x42, 0x94, 0xf4, 0xe3, 0x61, 0x40, 0xbd, 0x71, 0xa0, 0xba, 0xbb, 0x60,
+	0x84, 0xe8, 0x4a, 0x29, 0x05, 0xb4, 0xcf, 0x02, 0xea, 0xd1, 0x11, 0x4d, 0xa4, 0x40, 0x6e, 0xeb,
+	0xee, 0x82, 0x16, 0x3e, 0x46, 0x99, 0xfd, 0xdb, 0x1a, 0x2c, 0x9f, 0x08, 0x6e, 0x92, 0x3f, 0x2d,
+	0x7a, 0x91, 0x94, 0xca, 0xec, 0xa4, 0x54, 0xa7, 0x21, 0x85, 0x6c, 0x42, 0x53, 0x1e, 0x0a, 0x2f,
+	0xd5, 0x98, 0x5a, 0x73, 0x78, 0x3c, 0xec, 0xa2, 0x91, 0x9a, 0x0c, 0x9d, 0x0c, 0xec, 0x23, 0x1a,
+	0x47, 0x23, 0x9a, 0x7e, 0x70, 0xe8, 0x82, 0x3c, 0x14, 0x26, 0x13, 0xf2, 0x6d, 0xb8, 0x28, 0x99,
+	0xf4, 0x63, 0x2f, 0xf4, 0x85, 0x37, 0x14, 0x34, 0x68, 0x9d, 0xc7, 0x4c, 0x16, 0x50, 0xba, 0xe5,
+	0x8b, 0x0f, 0x05, 0x0d, 0xc8, 0x13, 0x20, 0x3d, 0x1a, 0x46, 0x89, 0xe6, 0x32, 0x63, 0x6b, 0x1e,
+	0x23, 0x5e, 0x3d, 0x11, 0x11, 0x89, 0xdb, 0x98, 0xfb, 0xec, 0x5f, 0x2f, 0x9d, 0x73, 0x17, 0xd1,
+	0x0e, 0x71, 0xa3, 0x58, 0x90, 0x77, 0x60, 0x91, 0x26, 0x41, 0xd9, 0x53, 0x6d, 0x0a, 0x4f, 0x17,
+	0x69, 0x12, 0x14, 0xfd, 0xec, 0xc2, 0xa5, 0xfc, 0x7c, 0x7b, 0x43, 0x1e, 0xf8, 0x92, 0x8a, 0x56,
+	0x1d, 0x1d, 0xad, 0x9e, 0x70, 0x94, 0x9f, 0xd8, 0x0f, 0x51, 0x31, 0x03, 0x37, 0x2a, 0x8b, 0x05,
+	0xf9, 0x11, 0x2c, 0xf7, 0x15, 0x59, 0x89, 0x18, 0x0a, 0x0f, 0x67, 0x5f, 0xee, 0xba, 0x81, 0x45,
+	0x79, 0xf9, 0x64, 0x51, 0x36, 0x33, 0x83, 0x1d, 0xa5, 0x2f, 0xdc, 0x2b, 0xfd, 0x92, 0x20, 0x73,
+	0xed, 0x03, 0x51, 0xe5, 0xd2, 0xef, 0x56, 0x90, 0x65, 0x0e, 0x08, 0x78, 0x6d, 0x52, 0x53, 0x7b,
+	0xa4, 0xad, 0x74, 0xea, 0x19, 0x7a, 0x79, 0x28, 0x4a, 0x72, 0xc2, 0xa1, 0x5d, 0x2c, 0xd3, 0xb1,
+	0x50, 0xcd, 0x55, 0xeb, 0xac, 0xa1, 0x96, 0xc7, 0x55, 0x2c, 0x47, 0x8c, 0xa1, 0x35, 0x2e, 0xe6,
+	0xb1, 0x78, 0x0b, 0x67, 0x8f, 0x77, 0x25, 0xab, 0x75, 0x69, 0xd3, 0xfe, 0x09, 0x5c, 0x28, 0x87,
+	0x7f, 0x02, 0xf3, 0x26, 0x98, 0x85, 0x3c, 0xde, 0x9d, 0x25, 0x98, 0x89, 0x65, 0x3c, 0xd8, 0x4f,
+	0x61, 0xa1, 0xb8, 0x4b, 0x96, 0xe0, 0x7c, 0x94, 0x04, 0xf4, 0x10, 0x8f, 0xf6, 0x05, 0x57, 0x3f,
+	0x10, 0x02, 0x73, 0xaa, 0xea, 0x78, 0xaa, 0x1b, 0x2e, 0xae, 0x95, 0xec, 0x67, 0xc2, 0x4c, 0x95,
+	0x86, 0x8b, 0x6b, 0x7b, 0x19, 0xae, 0xe4, 0xe3, 0xcf, 0xb4, 0x0e, 0x6c, 0x58, 0xf6, 0xc7, 0x70,
+	0xf5, 0xf8, 0xc6, 0xff, 0xb3, 0x93, 0x5e, 0x86, 0x4b, 0x5b, 0x54, 0xee, 0x1e, 0x25, 0x7d, 0x35,
+	0x93, 0x0c, 0xa6, 0x0e, 0x90, 0xa2, 0xd0, 0xe0, 0x69, 0x41, 0x4d, 0x68, 0x11, 0xc2, 0xa9, 0xbb,
+	0xd9, 0xa3, 0xbd, 0x84, 0xfa, 0xef, 0xb3, 0x80, 0x6e, 0x27, 0x7b, 0x2c, 0xf3, 0xf2, 0x67, 0x0b,
+	0x2e, 0x97, 0xc4, 0xc6, 0xcf, 0x3d, 0x68, 0x24, 0xaa, 0xc7, 0x46, 0xc9, 0x1e, 0x33, 0x89, 0xb5,
+	0x8a, 0x28, 0x79, 0x97, 0x77, 0x72, 0xa3, 0x7a, 0x62, 0x56, 0xe4, 0xa7, 0x70, 0xd9, 0xe7, 0x3c,
+	0x8e, 0xfa, 0x38, 0x77, 0xbd, 0x11, 0x4d, 0xc5, 0xf8, 0xab, 0xee, 0xce, 0xc4, 0xaf, 0x00, 0xad,
+	0x8e, 0x3e, 0x49, 0xc1, 0x8f, 0x91, 0xdb, 0x7f, 0xac, 0x40, 0xb3, 0xa0, 0xa3, 0x6a, 0x98, 0xf8,
+	0x03, 0xaa, 0xa7, 0xb8, 0x8b, 0x6b, 0x72, 0x0d, 0xea, 0x3e, 0xe7, 0x1e, 0xca, 0x75, 0xbd, 0x6b,
+	0x3e, 0xe7, 0xef, 0xab, 0xad, 0x16, 0xd4, 0x32, 0x40, 0xba, 0xea, 0xd9, 0x23, 0xb9, 0x01, 0x10,
+	0x46, 0xd2, 0xeb, 0xb3, 0xc1, 0x20, 0x92, 0x38, 0x84, 0x1b, 0x6e, 0x23, 0x8c, 0xe4, 0x26, 0x0a,
+	0xd4, 0x76, 0x6f, 0x18, 0xc5, 0x81, 0x27, 0xfd, 0x50, 0x60, 0xaf, 0x6d, 0xb8, 0x0d, 0x94, 0x7c,
+	0xe0, 0x87, 0x02, 0xad, 0x59, 0x9e, 0xeb, 0xbc, 0xb1, 0x66, 0x06, 0x29, 0x79, 0x9c, 0x59, 0x07,
+	0x94, 0x67, 0x5d, 0xf3, 0x95, 0x49, 0x54, 0x7c, 0x8f, 0x05, 0xc3, 0x98, 0x9a, 0x28, 0x8f, 0x28,
+	0x17, 0xe4, 0x2e, 0x10, 0xf3, 0xfd, 0x2d, 0x82, 0x83, 0x3c, 0x5a, 0x1d, 0xa3, 0x2d, 0xea, 0x9d,
+	0xdd, 0xe0, 0x20, 0xa3, 0xea, 0x5d, 0x98, 0xd7, 0x2e, 0x14, 0x49, 0xdc, 0x97, 0xfb, 0x19, 0x49,
+	0x6a, 0x5d, 0x64, 0xa2, 0x52, 0x66, 0x62, 0x11, 0xaa, 0x62, 0x38, 0x30, 0xfc, 0xa8, 0xa5, 0xbd,
+	0x0f, 0x8b, 0x0f, 0x37, 0x36, 0xb7, 0x7f, 0xa0, 0xbe, 0xb4, 0xb2, 0x01, 0x4e, 0x60, 0x2e, 0xf0,
+	0xa5, 0x8f, 0x3e, 0x17, 0x5c, 0x5c, 0xe7, 0x71, 0x2a, 0x85, 0x38, 0xe3, 0x51, 0x5b, 0x2d, 0x8d,
+	0xda, 0x25, 0x38, 0xcf, 0x53, 0x36, 0xa2, 0x48, 0x75, 0xdd, 0xd5, 0x0f, 0xf6, 0xef, 0x2a, 0x70,
+	0xa9, 0x10, 0xca, 0xbc, 0x89, 0x04, 0xe6, 0xd4, 0x01, 0x37, 0x27, 0x1a, 0xd7, 0x0a, 0x65, 0xcc,
+	0xc2, 0x0c, 0x65, 0xcc, 0x42, 0xa5, 0x85, 0xaf, 0xaa, 0xae, 0x1d, 0xae, 0xc7, 0xcd, 0x40, 0x4f,
+	0x47, 0xfd, 0xa0, 0x6c, 0xd5, 0x57, 0xdc, 0x3c, 0x42, 0x57, 0x4b, 0xa5, 0x37, 0xf2, 0xe3, 0x21,
+	0x6d, 0xd5, 0x50, 0xa6, 0x1f, 0xc8, 0x63, 0x68, 0xf0, 0x94, 0xb1, 0x3d, 0x8f, 0x71, 0x81, 0x34,
+	0x37, 0xbb, 0xb7, 0x26, 0x55, 0x6d, 0x47, 0x19, 0x7c, 0x9f, 0x0b, 0xb7, 0xce, 0xcd, 0xaa, 0x40,
+	0x41, 0xa3, 0x44, 0xc1, 0x75, 0x68, 0xa8, 0x54, 0x04, 0xf7, 0xfb, 0xb4, 0x05, 0xfa, 0x9d, 0xc9,
+	0x05, 0x4f, 0xe6, 0xea, 0x95, 0xc5, 0xaa, 0xbd, 0x09, 0x35, 0xe3, 0x31, 0x6f, 0x61, 0x56, 0xa1,
+	0x85, 0x99, 0x4c, 0x2a, 0xe3, 0x4c, 0xb2, 0xba, 0x54, 0xc7, 0x75, 0xb1, 0xdf, 0x83, 0x7a, 0x06,
+	0x8b, 0xbc, 0x0d, 0x55, 0xc6, 0xb3, 0xbe, 0x7b, 0x73, 0xca, 0x6c, 0x4c, 0xcb, 0x55, 0x96, 0xdd,
+	0xff, 0x36, 0xa1, 0xb6, 0x4b, 0xd3, 0x51, 0xd4, 0xa7, 0xe4, 0x4f, 0x16, 0x34, 0x0b, 0xad, 0x83,
+	0x74, 0x27, 0xf9, 0x3b, 0xd9, 0x7e, 0xda, 0xaf, 0xcd, 0x64, 0xa3, 0xdf, 0x08, 0x7b, 0xfd, 0x37,
+	0x7f, 0xff, 0xcf, 0x1f, 0x2a, 0x77, 0xc8, 0x6d, 0x67, 0xc2, 0x5d, 0x3f, 0xef, 0x60, 0xe4, 0x13,
+	0x0b, 0x60, 0xdc, 0x2d, 0xc9, 0xfa, 0x14, 0x61, 0xcb, 0xed, 0xb6, 0xdd, 0x9d, 0xc5, 0xc4, 0x00,
+	0x75, 0x10, 0xe8, 0x6d, 0x72, 0x73, 0x12, 0x50, 0xd3, 0xa3, 0xc9, 0x5f, 0x2d, 0xb8, 0x58, 0x1e,
+	0x34, 0xe4, 0xde, 0x14, 0x71, 0x4f, 0x4e, 0xac, 0xf6, 0xfd, 0x59, 0xcd, 0x0c, 0xe4, 0x7b, 0x08,
+	0xd9, 0x21, 0x6b, 0x93, 0x20, 0xe3, 0x64, 0x12, 0x4e, 0x8c, 0x3e, 0xc8, 0xa7, 0x16, 0x2c, 0x1e,
+	0xbf, 0x6d, 0x90, 0x07, 0x53, 0x60, 0x78, 0xd1, 0x95, 0xa6, 0xfd, 0xc6, 0xec, 0x86, 0x06, 0xfe,
+	0x03, 0x84, 0xbf, 0x4e, 0x9c, 0x29, 0xe1, 0xff, 0x52, 0x9f, 0xc6, 0x8f, 0xc9, 0x17, 0x56, 0x61,
+	0xf6, 0x17, 0xaf, 0xbe, 0xe4, 0xcd, 0xa9, 0x99, 0x7c, 0xc1, 0xd5, 0xbc, 0xfd, 0xdd, 0x33, 0x5a,
+	0x9b, 0x7c, 0xde, 0xc4, 0x7c, 0xee, 0x93, 0xd7, 0x27, 0xe5, 0x33, 0xbe, 0x35, 0x53, 0x99, 0x57,
+	0xe5, 0x9f, 0x16, 0xde, 0x82, 0x5e, 0xf4, 0x97, 0x08, 0x79, 0x6b, 0x0a, 0x60, 0x5f, 0xf1, 0x77,
+	0x4e, 0xfb, 0xed, 0x33, 0xdb, 0x9b, 0xd4, 0xde, 0xc2, 0xd4, 0xde, 0x20, 0xf7, 0x67, 0x4b, 0x2d,
+	0xaf, 0xd8, 0x27, 0x16, 0x34, 0xf2, 0x69, 0x41, 0xbe, 0x33, 0x09, 0xce, 0xf1, 0x19, 0xd6, 0x5e,
+	0x9f, 0xc1, 0xc2, 0x40, 0xee, 0x22, 0xe4, 0xbb, 0xe4, 0xd5, 0x49, 0x90, 0xd5, 0xf5, 0xc5, 0xc3,
+	0xff, 0x26, 0xc8, 0xdf, 0x2c, 0xf8, 0xc6, 0xb1, 0x9b, 0x28, 0xb9, 0x3f, 0xed, 0xfb, 0x5d, 0xbe,
+	0x37, 0xb7, 0x1f, 0xcc, 0x6c, 0x37, 0x2b, 0xd7, 0xfa, 0x5b, 0xd6, 0xdc, 0x4e, 0x73, 0xae, 0x37,
+	0x9e, 0x7e, 0xf6, 0x6c, 0xc5, 0xfa, 0xfc, 0xd9, 0x8a, 0xf5, 0xef, 0x67, 0x2b, 0xd6, 0xef, 0x9f,
+	0xaf, 0x9c, 0xfb, 0xfc, 0xf9, 0xca, 0xb9, 0x2f, 0x9f, 0xaf, 0x9c, 0xfb, 0x71, 0x37, 0x8c, 0xe4,
+	0xfe, 0xb0, 0xd7, 0xe9, 0xb3, 0x41, 0xe6, 0x5b, 0xff, 0xac, 0x89, 0xe0, 0xc0, 0xe9, 0xc7, 0x11,
+	0x4d, 0xa4, 0x13, 0xa6, 0xbc, 0xef, 0xc8, 0x81, 0xd0, 0x83, 0xa3, 0x37, 0x8f, 0x7f, 0x99, 0xbc,
+	0xf6, 0xbf, 0x01, 0x00, 0xf7, 0x63, 0x3c, 0x30, 0xab, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1290,6 +1645,13 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// GetBlockResults queries the results of the execution of the block at a
+	// given height: the results of its txs and the events emitted in BeginBlock
+	// and EndBlock, optionally along with the block itself and the typed events
+	// decoded to JSON.
+	//
+	// Since: cosmos-sdk 0.47
+	GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error)
 }
 
 type serviceClient struct {
@@ -1363,6 +1725,15 @@ func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error) {
+	out := new(GetBlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.tendermint.v1beta1.Service/GetBlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// GetNodeInfo queries the current node info.
@@ -1383,6 +1754,13 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// GetBlockResults queries the results of the execution of the block at a
+	// given height: the results of its txs and the events emitted in BeginBlock
+	// and EndBlock, optionally along with the block itself and the typed events
+	// decoded to JSON.
+	//
+	// Since: cosmos-sdk 0.47
+	GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1410,6 +1788,9 @@ func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context,
 func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
 }
+func (*UnimplementedServiceServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResults not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1541,6 +1922,24 @@ func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetBlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.tendermint.v1beta1.Service/GetBlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockResults(ctx, req.(*GetBlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.tendermint.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1573,6 +1972,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ABCIQuery",
 			Handler:    _Service_ABCIQuery_Handler,
 		},
+		{
+			MethodName: "GetBlockResults",
+			Handler:    _Service_GetBlockResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/tendermint/v1beta1/query.proto",
@@ -1888,7 +2291,7 @@ func (m *GetBlockByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetBlockResultsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1898,20 +2301,45 @@ func (m *GetLatestBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLatestBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetBlockResultsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLatestBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetBlockResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DecodeEvents {
+		i--
+		if m.DecodeEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludeBlock {
+		i--
+		if m.IncludeBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLatestBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetBlockResultsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1921,7 +2349,268 @@ func (m *GetLatestBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLatestBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetBlockResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndBlockDecodedEvents.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.BeginBlockDecodedEvents.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.TxsDecodedEvents) > 0 {
+		for iNdEx := len(m.TxsDecodedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxsDecodedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		{
+			size, err := m.ConsensusParamUpdates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for iNdEx := len(m.EndBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for iNdEx := len(m.BeginBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TotalGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxsResults) > 0 {
+		for iNdEx := len(m.TxsResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxsResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2568,21 +3257,33 @@ func (m *GetBlockByHeightResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetLatestBlockRequest) Size() (n int) {
+func (m *GetBlockResultsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.IncludeBlock {
+		n += 2
+	}
+	if m.DecodeEvents {
+		n += 2
+	}
 	return n
 }
 
-func (m *GetLatestBlockResponse) Size() (n int) {
+func (m *GetBlockResultsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
 	if m.BlockId != nil {
 		l = m.BlockId.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2591,29 +3292,131 @@ func (m *GetLatestBlockResponse) Size() (n int) {
 		l = m.Block.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.TxsResults) > 0 {
+		for _, e := range m.TxsResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGasUsed))
+	}
+	if len(m.BeginBlockEvents) > 0 {
+		for _, e := range m.BeginBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EndBlockEvents) > 0 {
+		for _, e := range m.EndBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		l = m.ConsensusParamUpdates.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TxsDecodedEvents) > 0 {
+		for _, e := range m.TxsDecodedEvents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.BeginBlockDecodedEvents.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EndBlockDecodedEvents.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *GetSyncingRequest) Size() (n int) {
+func (m *DecodedEvents) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetSyncingResponse) Size() (n int) {
+func (m *DecodedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Syncing {
-		n += 2
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
 	}
-	return n
-}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLatestBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSyncingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSyncingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	return n
+}
 
 func (m *GetNodeInfoRequest) Size() (n int) {
 	if m == nil {
@@ -3628,6 +4431,764 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetBlockResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeBlock = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DecodeEvents = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types1.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types1.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsResults = append(m.TxsResults, &types2.ResponseDeliverTx{})
+			if err := m.TxsResults[len(m.TxsResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+			}
+			m.TotalGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockEvents = append(m.BeginBlockEvents, types2.Event{})
+			if err := m.BeginBlockEvents[len(m.BeginBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockEvents = append(m.EndBlockEvents, types2.Event{})
+			if err := m.EndBlockEvents[len(m.EndBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types2.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParamUpdates == nil {
+				m.ConsensusParamUpdates = &types1.ConsensusParams{}
+			}
+			if err := m.ConsensusParamUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsDecodedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsDecodedEvents = append(m.TxsDecodedEvents, DecodedEvents{})
+			if err := m.TxsDecodedEvents[len(m.TxsDecodedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockDecodedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BeginBlockDecodedEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockDecodedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndBlockDecodedEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, DecodedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetLatestBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -99,6 +99,77 @@ func (s queryServer) GetBlockByHeight(ctx context.Context, req *GetBlockByHeight
 	}, nil
 }
 
+// GetBlockResults implements ServiceServer.GetBlockResults
+func (s queryServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	chainHeight, err := rpc.GetChainHeight(s.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "requested block height cannot be negative")
+	}
+
+	if req.Height > chainHeight {
+		return nil, status.Error(codes.InvalidArgument, "requested block height is bigger then the chain length")
+	}
+
+	// resolve the latest height once so that the block and its results are
+	// read at the same height
+	height := req.Height
+	if height == 0 {
+		height = chainHeight
+	}
+
+	results, err := getBlockResults(ctx, s.clientCtx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetBlockResultsResponse{
+		Height:                results.Height,
+		TxsResults:            results.TxsResults,
+		TotalGasUsed:          results.TotalGasUsed,
+		BeginBlockEvents:      results.BeginBlockEvents,
+		EndBlockEvents:        results.EndBlockEvents,
+		ValidatorUpdates:      results.ValidatorUpdates,
+		ConsensusParamUpdates: results.ConsensusParamUpdates,
+	}
+
+	if req.IncludeBlock {
+		protoBlockID, protoBlock, err := GetProtoBlock(ctx, s.clientCtx, &height)
+		if err != nil {
+			return nil, err
+		}
+
+		res.BlockId = &protoBlockID
+		res.Block = protoBlock
+	}
+
+	if req.DecodeEvents {
+		res.TxsDecodedEvents = make([]DecodedEvents, len(results.TxsResults))
+		for i, txResult := range results.TxsResults {
+			if res.TxsDecodedEvents[i], err = decodeEvents(s.interfaceRegistry, txResult.Events); err != nil {
+				return nil, err
+			}
+		}
+
+		if res.BeginBlockDecodedEvents, err = decodeEvents(s.interfaceRegistry, results.BeginBlockEvents); err != nil {
+			return nil, err
+		}
+
+		if res.EndBlockDecodedEvents, err = decodeEvents(s.interfaceRegistry, results.EndBlockEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
 // GetLatestValidatorSet implements ServiceServer.GetLatestValidatorSet
 func (s queryServer) GetLatestValidatorSet(ctx context.Context, req *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error) {
 	page, limit, err := qtypes.ParsePagination(req.Pagination)
@@ -100,6 +100,34 @@ func (s IntegrationTestSuite) TestQueryBlockByHeight() {
 	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &blockInfoRes))
 }
 
+func (s *IntegrationTestSuite) TestQueryBlockResults() {
+	val := s.network.Validators[0]
+
+	res, err := s.queryClient.GetBlockResults(context.Background(), &tmservice.GetBlockResultsRequest{Height: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), res.Height)
+	s.Require().NotEmpty(res.BeginBlockEvents)
+	s.Require().Nil(res.Block)
+	s.Require().Nil(res.TxsDecodedEvents)
+
+	// latest block, along with the block and the decoded events
+	res, err = s.queryClient.GetBlockResults(context.Background(), &tmservice.GetBlockResultsRequest{IncludeBlock: true, DecodeEvents: true})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Block)
+	s.Require().Equal(res.Height, res.Block.Header.Height)
+	s.Require().Len(res.TxsDecodedEvents, len(res.TxsResults))
+
+	_, err = s.queryClient.GetBlockResults(context.Background(), &tmservice.GetBlockResultsRequest{Height: res.Height + 1000})
+	s.Require().Error(err)
+
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/block_results/%d?include_block=true", val.APIAddress, 1))
+	s.Require().NoError(err)
+	var blockResultsRes tmservice.GetBlockResultsResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(restRes, &blockResultsRes))
+	s.Require().Equal(int64(1), blockResultsRes.Height)
+	s.Require().NotNil(blockResultsRes.Block)
+}
+
 func (s IntegrationTestSuite) TestQueryLatestValidatorSet() {
 	val := s.network.Validators[0]
 
@@ -0,0 +1,292 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PaginatedResponse is the response of a list query paginated with a
+// query.PageRequest.
+type PaginatedResponse interface {
+	proto.Message
+	GetPagination() *query.PageResponse
+}
+
+// PageQuerier queries a page of a list query.
+type PageQuerier func(ctx context.Context, pageReq *query.PageRequest) (PaginatedResponse, error)
+
+// PrintPaginatedProto queries a list with the page request read from the
+// pagination flags of cmd, and prints the response like PrintProto. With the
+// jsonl output format, the items of the list are printed as JSON lines instead.
+//
+// With the --all flag, the next keys of the responses are followed to query all
+// the pages, at most --rate-limit pages per second. The items are streamed page
+// by page with the jsonl output format, and merged into a single response
+// otherwise. With the --cursor-file flag, the next key is saved after printing
+// each page, and an interrupted query resumes from the saved key.
+func PrintPaginatedProto(cmd *cobra.Command, clientCtx Context, queryPage PageQuerier) error {
+	return printPaginatedProto(cmd, clientCtx, queryPage, false)
+}
+
+// PrintOptionalPaginatedProto is like PrintPaginatedProto, but queries the list
+// with a nil page request, i.e. with the default pagination of the query
+// server, unless a pagination flag is set. It keeps the output of the list
+// queries which used to be sent without pagination by the CLI.
+func PrintOptionalPaginatedProto(cmd *cobra.Command, clientCtx Context, queryPage PageQuerier) error {
+	return printPaginatedProto(cmd, clientCtx, queryPage, true)
+}
+
+// paginationFlags are the flags added by flags.AddPaginationFlagsToCmd which
+// set the page request.
+var paginationFlags = []string{
+	flags.FlagPage, flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse, flags.FlagAll,
+}
+
+func printPaginatedProto(cmd *cobra.Command, clientCtx Context, queryPage PageQuerier, optional bool) error {
+	pageReq, err := ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	if optional {
+		paginated := false
+		for _, flag := range paginationFlags {
+			paginated = paginated || cmd.Flags().Changed(flag)
+		}
+		if !paginated {
+			pageReq = nil
+		}
+	}
+
+	jsonl := clientCtx.OutputFormat == flags.OutputFormatJSONL
+	all, _ := cmd.Flags().GetBool(flags.FlagAll)
+	if !all {
+		res, err := queryPage(cmd.Context(), pageReq)
+		if err != nil {
+			return err
+		}
+		if !jsonl {
+			return clientCtx.PrintProto(res)
+		}
+
+		page, err := decodePage(clientCtx, res)
+		if err != nil {
+			return err
+		}
+		return printJSONLines(clientCtx, page.lists)
+	}
+
+	if cmd.Flags().Changed(flags.FlagPage) || cmd.Flags().Changed(flags.FlagOffset) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "--%s cannot be used with --%s or --%s", flags.FlagAll, flags.FlagPage, flags.FlagOffset)
+	}
+	rateLimit, _ := cmd.Flags().GetFloat64(flags.FlagRateLimit)
+	cursorFile, _ := cmd.Flags().GetString(flags.FlagCursorFile)
+	if cursorFile != "" {
+		if !jsonl {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "--%s requires the %s output format", flags.FlagCursorFile, flags.OutputFormatJSONL)
+		}
+
+		key, err := readCursor(cursorFile)
+		if err != nil {
+			return err
+		}
+		if key != nil {
+			pageReq.Key = key
+		}
+	}
+
+	var (
+		merged    *jsonPage
+		lastQuery time.Time
+	)
+	for {
+		if err := waitRateLimit(cmd.Context(), lastQuery, rateLimit); err != nil {
+			return err
+		}
+		lastQuery = time.Now()
+
+		res, err := queryPage(cmd.Context(), pageReq)
+		if err != nil {
+			return err
+		}
+		page, err := decodePage(clientCtx, res)
+		if err != nil {
+			return err
+		}
+
+		nextKey := res.GetPagination().GetNextKey()
+		if jsonl {
+			if err := printJSONLines(clientCtx, page.lists); err != nil {
+				return err
+			}
+			if cursorFile != "" {
+				if err := writeCursor(cursorFile, nextKey); err != nil {
+					return err
+				}
+			}
+		} else if merged == nil {
+			merged = page
+		} else {
+			for name, items := range page.lists {
+				merged.lists[name] = append(merged.lists[name], items...)
+			}
+		}
+
+		if len(nextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: nextKey, Limit: pageReq.Limit, Reverse: pageReq.Reverse}
+	}
+
+	if jsonl {
+		return nil
+	}
+
+	// the merged response has no next key, and the total of the first page
+	pagination, err := clientCtx.Codec.MarshalJSON(&query.PageResponse{Total: merged.total})
+	if err != nil {
+		return err
+	}
+	merged.fields["pagination"] = pagination
+	for name, items := range merged.lists {
+		if merged.fields[name], err = json.Marshal(items); err != nil {
+			return err
+		}
+	}
+
+	out, err := json.Marshal(merged.fields)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(out)
+}
+
+// jsonPage is the JSON encoding of a page of a list query.
+type jsonPage struct {
+	fields map[string]json.RawMessage
+	lists  map[string][]json.RawMessage // the items of the list fields by name
+	total  uint64
+}
+
+// decodePage decodes the JSON fields of res, and the items of its list fields.
+func decodePage(clientCtx Context, res PaginatedResponse) (*jsonPage, error) {
+	bz, err := clientCtx.Codec.MarshalJSON(res)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &jsonPage{lists: make(map[string][]json.RawMessage), total: res.GetPagination().GetTotal()}
+	if err := json.Unmarshal(bz, &page.fields); err != nil {
+		return nil, err
+	}
+	for name, field := range page.fields {
+		if !bytes.HasPrefix(bytes.TrimSpace(field), []byte("[")) {
+			continue
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(field, &items); err != nil {
+			return nil, err
+		}
+		page.lists[name] = items
+	}
+
+	return page, nil
+}
+
+// printJSONLines prints the items of the lists as JSON lines, the lists being
+// sorted by name.
+func printJSONLines(clientCtx Context, lists map[string][]json.RawMessage) error {
+	names := make([]string, 0, len(lists))
+	for name := range lists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, item := range lists[name] {
+			var line bytes.Buffer
+			if err := json.Compact(&line, item); err != nil {
+				return err
+			}
+			line.WriteByte('\n')
+
+			if err := clientCtx.PrintBytes(line.Bytes()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitRateLimit waits until a page can be queried after the one queried at
+// lastQuery, with at most rateLimit pages per second.
+func waitRateLimit(ctx context.Context, lastQuery time.Time, rateLimit float64) error {
+	if rateLimit <= 0 || lastQuery.IsZero() {
+		return nil
+	}
+
+	wait := time.Duration(float64(time.Second)/rateLimit) - time.Since(lastQuery)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readCursor reads the next key saved in the cursor file, nil if the file
+// doesn't exist.
+func readCursor(cursorFile string) ([]byte, error) {
+	bz, err := os.ReadFile(cursorFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor in %s: %w", cursorFile, err)
+	}
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	return key, nil
+}
+
+// writeCursor saves the next key in the cursor file, or removes the file once
+// there is no next key.
+func writeCursor(cursorFile string, nextKey []byte) error {
+	if len(nextKey) == 0 {
+		if err := os.Remove(cursorFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	return os.WriteFile(cursorFile, []byte(base64.StdEncoding.EncodeToString(nextKey)+"\n"), 0o600)
+}
@@ -0,0 +1,219 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// balancePages serves the balances in pages of two coins, failing the
+// queries of the pages with a key in failKeys.
+type balancePages struct {
+	balances sdk.Coins
+	failKeys map[string]bool
+	requests []*query.PageRequest
+}
+
+func (p *balancePages) queryPage(_ context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+	p.requests = append(p.requests, pageReq)
+	if p.failKeys[string(pageReq.Key)] {
+		return nil, errors.New("query failed")
+	}
+
+	start := 0
+	if len(pageReq.Key) > 0 {
+		start = int(pageReq.Key[0])
+	}
+	end := start + 2
+	res := &banktypes.QueryAllBalancesResponse{Pagination: &query.PageResponse{}}
+	if end < len(p.balances) {
+		res.Pagination.NextKey = []byte{byte(end)}
+	} else {
+		end = len(p.balances)
+	}
+	if pageReq.CountTotal {
+		res.Pagination.Total = uint64(len(p.balances))
+	}
+	res.Balances = p.balances[start:end]
+
+	return res, nil
+}
+
+func runPaginatedCmd(pages *balancePages, outputFormat string, args ...string) (string, error) {
+	out := &bytes.Buffer{}
+	clientCtx := client.Context{}.
+		WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry())).
+		WithOutput(out).
+		WithOutputFormat(outputFormat)
+
+	cmd := &cobra.Command{
+		Use:          "balances",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return client.PrintPaginatedProto(cmd, clientCtx, pages.queryPage)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "balances")
+	cmd.SetArgs(append(args, fmt.Sprintf("--%s=0", flags.FlagRateLimit)))
+	cmd.SetOut(out)
+
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestPrintPaginatedProto(t *testing.T) {
+	balances := sdk.NewCoins(
+		sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("btc", 2), sdk.NewInt64Coin("eth", 3),
+		sdk.NewInt64Coin("osmo", 4), sdk.NewInt64Coin("stake", 5),
+	)
+	lines := `{"denom":"atom","amount":"1"}
+{"denom":"btc","amount":"2"}
+{"denom":"eth","amount":"3"}
+{"denom":"osmo","amount":"4"}
+{"denom":"stake","amount":"5"}
+`
+
+	testCases := []struct {
+		name         string
+		outputFormat string
+		args         []string
+		expOut       string
+		expErr       string
+		expRequests  int
+	}{
+		{
+			"single page",
+			"json",
+			nil,
+			`{"balances":[{"denom":"atom","amount":"1"},{"denom":"btc","amount":"2"}],"pagination":{"next_key":"Ag==","total":"0"}}` + "\n",
+			"",
+			1,
+		},
+		{
+			"single page as JSON lines",
+			flags.OutputFormatJSONL,
+			[]string{fmt.Sprintf("--%s=%s", flags.FlagPageKey, "\x02")},
+			`{"denom":"eth","amount":"3"}` + "\n" + `{"denom":"osmo","amount":"4"}` + "\n",
+			"",
+			1,
+		},
+		{
+			"all pages merged",
+			"json",
+			[]string{fmt.Sprintf("--%s", flags.FlagAll), fmt.Sprintf("--%s", flags.FlagCountTotal)},
+			`{"balances":[{"denom":"atom","amount":"1"},{"denom":"btc","amount":"2"},{"denom":"eth","amount":"3"},{"denom":"osmo","amount":"4"},{"denom":"stake","amount":"5"}],"pagination":{"next_key":null,"total":"5"}}` + "\n",
+			"",
+			3,
+		},
+		{
+			"all pages as text",
+			"text",
+			[]string{fmt.Sprintf("--%s", flags.FlagAll), fmt.Sprintf("--%s=3", flags.FlagLimit)},
+			"balances:\n- amount: \"1\"\n  denom: atom\n- amount: \"2\"\n  denom: btc\n- amount: \"3\"\n  denom: eth\n- amount: \"4\"\n  denom: osmo\n- amount: \"5\"\n  denom: stake\npagination:\n  next_key: null\n  total: \"0\"\n",
+			"",
+			3,
+		},
+		{
+			"all pages streamed as JSON lines",
+			flags.OutputFormatJSONL,
+			[]string{fmt.Sprintf("--%s", flags.FlagAll)},
+			lines,
+			"",
+			3,
+		},
+		{
+			"all pages with a page",
+			flags.OutputFormatJSONL,
+			[]string{fmt.Sprintf("--%s", flags.FlagAll), fmt.Sprintf("--%s=2", flags.FlagPage)},
+			"",
+			"--all cannot be used with --page or --offset",
+			0,
+		},
+		{
+			"cursor file without JSON lines",
+			"json",
+			[]string{fmt.Sprintf("--%s", flags.FlagAll), fmt.Sprintf("--%s=%s", flags.FlagCursorFile, filepath.Join(t.TempDir(), "cursor"))},
+			"",
+			"--cursor-file requires the jsonl output format",
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pages := &balancePages{balances: balances}
+			out, err := runPaginatedCmd(pages, tc.outputFormat, tc.args...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expOut, out)
+			}
+			require.Len(t, pages.requests, tc.expRequests)
+		})
+	}
+
+	t.Run("all pages resumed from a cursor file", func(t *testing.T) {
+		cursorFile := filepath.Join(t.TempDir(), "cursor")
+		args := []string{fmt.Sprintf("--%s", flags.FlagAll), fmt.Sprintf("--%s=%s", flags.FlagCursorFile, cursorFile)}
+
+		pages := &balancePages{balances: balances, failKeys: map[string]bool{"\x04": true}}
+		out, err := runPaginatedCmd(pages, flags.OutputFormatJSONL, args...)
+		require.ErrorContains(t, err, "query failed")
+		require.Len(t, pages.requests, 3)
+		bz, err := os.ReadFile(cursorFile)
+		require.NoError(t, err)
+		require.Equal(t, "BA==\n", string(bz))
+
+		pages.failKeys = nil
+		resumed, err := runPaginatedCmd(pages, flags.OutputFormatJSONL, args...)
+		require.NoError(t, err)
+		require.Equal(t, []byte{4}, pages.requests[3].Key)
+		require.Equal(t, lines, out+resumed)
+		require.NoFileExists(t, cursorFile)
+	})
+}
+
+func TestPrintOptionalPaginatedProto(t *testing.T) {
+	runCmd := func(args ...string) *query.PageRequest {
+		var pageReq *query.PageRequest
+		clientCtx := client.Context{}.
+			WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry())).
+			WithOutput(&bytes.Buffer{})
+
+		cmd := &cobra.Command{
+			Use: "balances",
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(_ context.Context, req *query.PageRequest) (client.PaginatedResponse, error) {
+					pageReq = req
+					return &banktypes.QueryAllBalancesResponse{}, nil
+				})
+			},
+		}
+		flags.AddPaginationFlagsToCmd(cmd, "balances")
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.ExecuteContext(context.Background()))
+		return pageReq
+	}
+
+	// the default pagination of the query server is used without pagination flags
+	require.Nil(t, runCmd())
+
+	require.Equal(t, &query.PageRequest{Key: []byte{}, Limit: 100, CountTotal: true}, runCmd(fmt.Sprintf("--%s", flags.FlagCountTotal)))
+	require.Equal(t, &query.PageRequest{Key: []byte{}, Limit: 10}, runCmd(fmt.Sprintf("--%s=10", flags.FlagLimit)))
+}
@@ -0,0 +1,32 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthClient sends the queries of the auth module
+type AuthClient struct {
+	c     *Client
+	query authtypes.QueryClient
+}
+
+// Auth returns the client of the auth module
+func (c *Client) Auth() AuthClient {
+	return AuthClient{c: c, query: authtypes.NewQueryClient(c.conn)}
+}
+
+// Account returns the account at addr
+func (ac AuthClient) Account(ctx context.Context, addr sdk.AccAddress) (authtypes.AccountI, error) {
+	res, err := ac.query.Account(ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	var acc authtypes.AccountI
+	if err := ac.c.registry.UnpackAny(res.Account, &acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
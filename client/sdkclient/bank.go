@@ -0,0 +1,53 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankClient sends the transactions and queries of the bank module
+type BankClient struct {
+	c     *Client
+	query banktypes.QueryClient
+}
+
+// Bank returns the client of the bank module
+func (c *Client) Bank() BankClient {
+	return BankClient{c: c, query: banktypes.NewQueryClient(c.conn)}
+}
+
+// Send sends amount from the signer to toAddr
+func (bc BankClient) Send(ctx context.Context, toAddr sdk.AccAddress, amount sdk.Coins) (*sdk.TxResponse, error) {
+	return bc.c.BroadcastTx(ctx, banktypes.NewMsgSend(bc.c.Address(), toAddr, amount))
+}
+
+// Balance returns the balance of addr in denom
+func (bc BankClient) Balance(ctx context.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	res, err := bc.query.Balance(ctx, banktypes.NewQueryBalanceRequest(addr, denom))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return *res.Balance, nil
+}
+
+// Balances returns all the balances of addr
+func (bc BankClient) Balances(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	var (
+		balances sdk.Coins
+		pageReq  = &query.PageRequest{}
+	)
+	for {
+		res, err := bc.query.AllBalances(ctx, banktypes.NewQueryAllBalancesRequest(addr, pageReq))
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
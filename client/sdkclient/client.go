@@ -0,0 +1,359 @@
+/*
+Package sdkclient implements a high-level Go client for Cosmos SDK chains.
+
+A Client is built from the address of the gRPC server of a node and a Signer.
+It exposes typed sub-clients for the core modules, e.g. Bank().Send or
+Staking().Delegate, which build, sign and broadcast transactions, taking care of
+the account number, the sequence and the gas estimation, and wait for them to
+be included in a block.
+
+App modules can be supported by wrapping a Client, building their query clients
+from Client.Conn and sending their messages with Client.BroadcastTx. Their
+interfaces must be registered in the InterfaceRegistry of the Client, see
+WithInterfaceRegistry.
+*/
+package sdkclient
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// DefaultGasAdjustment is the factor applied to the simulated gas of a transaction
+	DefaultGasAdjustment = 1.5
+	// DefaultTxTimeout is the duration after which waiting for a transaction to be included in a block fails
+	DefaultTxTimeout = time.Minute
+	// DefaultPollInterval is the interval at which the inclusion of a transaction in a block is checked
+	DefaultPollInterval = 500 * time.Millisecond
+)
+
+// Client sends transactions and queries to a node over gRPC
+type Client struct {
+	conn     *grpc.ClientConn
+	signer   Signer
+	registry codectypes.InterfaceRegistry
+	txConfig client.TxConfig
+
+	chainID       string
+	gasPrices     sdk.DecCoins
+	gasAdjustment float64
+	txTimeout     time.Duration
+	pollInterval  time.Duration
+	dialOpts      []grpc.DialOption
+
+	// serializes the transactions of the signer, so that they use successive sequences
+	txLock sync.Mutex
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithChainID sets the chain ID of the transactions, which is otherwise fetched from the node
+func WithChainID(chainID string) Option {
+	return func(c *Client) {
+		c.chainID = chainID
+	}
+}
+
+// WithGasPrices sets the gas prices used to compute the fees of the transactions
+func WithGasPrices(gasPrices sdk.DecCoins) Option {
+	return func(c *Client) {
+		c.gasPrices = gasPrices
+	}
+}
+
+// WithGasAdjustment sets the factor applied to the simulated gas of the transactions
+func WithGasAdjustment(gasAdjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = gasAdjustment
+	}
+}
+
+// WithTxTimeout sets the duration after which waiting for a transaction to be included in a block fails
+func WithTxTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.txTimeout = timeout
+	}
+}
+
+// WithPollInterval sets the interval at which the inclusion of a transaction in a block is checked
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.pollInterval = interval
+	}
+}
+
+// WithInterfaceRegistry sets the InterfaceRegistry used to encode transactions and decode
+// query responses. It must contain the interfaces of all the modules used through the Client,
+// by default only the core modules are registered.
+func WithInterfaceRegistry(registry codectypes.InterfaceRegistry) Option {
+	return func(c *Client) {
+		c.registry = registry
+	}
+}
+
+// WithDialOptions sets additional options used to connect to the gRPC server, e.g. transport
+// credentials. The connection is insecure by default.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client) {
+		c.dialOpts = append(c.dialOpts, opts...)
+	}
+}
+
+// New creates a Client connected to the gRPC server at grpcAddr, sending the transactions signed by signer
+func New(grpcAddr string, signer Signer, opts ...Option) (*Client, error) {
+	c := &Client{
+		signer:        signer,
+		gasAdjustment: DefaultGasAdjustment,
+		txTimeout:     DefaultTxTimeout,
+		pollInterval:  DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.registry == nil {
+		c.registry = NewInterfaceRegistry()
+	}
+	cdc := codec.NewProtoCodec(c.registry)
+	c.txConfig = authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	}, c.dialOpts...)
+	conn, err := grpc.Dial(grpcAddr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+
+	if c.chainID == "" {
+		res, err := tmservice.NewServiceClient(conn).GetNodeInfo(context.Background(), &tmservice.GetNodeInfoRequest{})
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to fetch the chain ID: %w", err)
+		}
+		c.chainID = res.NodeInfo.Network
+	}
+
+	return c, nil
+}
+
+// NewInterfaceRegistry returns an InterfaceRegistry containing the interfaces of the core modules
+func NewInterfaceRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	distrtypes.RegisterInterfaces(registry)
+	slashingtypes.RegisterInterfaces(registry)
+	govv1.RegisterInterfaces(registry)
+	govv1beta1.RegisterInterfaces(registry)
+	return registry
+}
+
+// Close closes the connection to the gRPC server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Conn returns the connection to the gRPC server, to build the query clients of app modules
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// InterfaceRegistry returns the InterfaceRegistry of the Client
+func (c *Client) InterfaceRegistry() codectypes.InterfaceRegistry {
+	return c.registry
+}
+
+// TxConfig returns the TxConfig used to build the transactions
+func (c *Client) TxConfig() client.TxConfig {
+	return c.txConfig
+}
+
+// ChainID returns the chain ID of the transactions
+func (c *Client) ChainID() string {
+	return c.chainID
+}
+
+// Address returns the address of the signer of the transactions
+func (c *Client) Address() sdk.AccAddress {
+	return c.signer.Address()
+}
+
+// BroadcastTx builds a transaction containing msgs, estimates its gas, signs it, broadcasts it and
+// waits for its inclusion in a block. An error is returned along with the response if the
+// execution of the transaction failed.
+func (c *Client) BroadcastTx(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	c.txLock.Lock()
+	defer c.txLock.Unlock()
+
+	acc, err := c.Auth().Account(ctx, c.signer.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder := c.txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	// the simulation doesn't check the signature nor run out of gas, but the fees
+	// must match the minimum gas prices of the node for the gas limit
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+	txBuilder.SetFeeAmount(c.fees(flags.DefaultGasLimit))
+	if err := c.setSignature(txBuilder, acc.GetSequence(), nil); err != nil {
+		return nil, err
+	}
+	simTxBytes, err := c.txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	simRes, err := tx.NewServiceClient(c.conn).Simulate(ctx, &tx.SimulateRequest{TxBytes: simTxBytes})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate the transaction: %w", err)
+	}
+	gas := uint64(c.gasAdjustment * float64(simRes.GasInfo.GasUsed))
+	txBuilder.SetGasLimit(gas)
+	txBuilder.SetFeeAmount(c.fees(gas))
+
+	if err := c.sign(txBuilder, acc); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	broadcastRes, err := tx.NewServiceClient(c.conn).BroadcastTx(ctx, &tx.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    tx.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res := broadcastRes.TxResponse; res.Code != sdkerrors.SuccessABCICode {
+		return res, sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+
+	return c.WaitForTx(ctx, broadcastRes.TxResponse.TxHash)
+}
+
+// WaitForTx waits for the transaction with the given hash to be included in a block and returns
+// its response. An error is returned along with the response if the execution of the transaction failed.
+func (c *Client) WaitForTx(ctx context.Context, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.txTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := tx.NewServiceClient(c.conn).GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+		if err == nil {
+			if res.TxResponse.Code != sdkerrors.SuccessABCICode {
+				return res.TxResponse, sdkerrors.ABCIError(res.TxResponse.Codespace, res.TxResponse.Code, res.TxResponse.RawLog)
+			}
+			return res.TxResponse, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s wasn't included in a block: %w", hash, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// UnmarshalMsgResponse unmarshals the response of the i-th message of the transaction of res into msgRes
+func UnmarshalMsgResponse(res *sdk.TxResponse, i int, msgRes codec.ProtoMarshaler) error {
+	bz, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return err
+	}
+	var txMsgData sdk.TxMsgData
+	if err := txMsgData.Unmarshal(bz); err != nil {
+		return err
+	}
+	if i < 0 || i >= len(txMsgData.MsgResponses) {
+		return fmt.Errorf("no response for message %d of transaction %s", i, res.TxHash)
+	}
+	return msgRes.Unmarshal(txMsgData.MsgResponses[i].Value)
+}
+
+// fees returns the fees of a transaction consuming gas at the gas prices of the Client
+func (c *Client) fees(gas uint64) sdk.Coins {
+	fees := make(sdk.Coins, len(c.gasPrices))
+	glDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	for i, gp := range c.gasPrices {
+		fees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+	return fees
+}
+
+// sign signs the transaction in txBuilder for acc in SIGN_MODE_DIRECT
+func (c *Client) sign(txBuilder client.TxBuilder, acc authtypes.AccountI) error {
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	// the signer infos are part of the sign bytes in SIGN_MODE_DIRECT
+	if err := c.setSignature(txBuilder, acc.GetSequence(), nil); err != nil {
+		return err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       c.chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        c.signer.PubKey(),
+		Address:       c.signer.Address().String(),
+	}
+	signBytes, err := c.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	sig, err := c.signer.Sign(signBytes)
+	if err != nil {
+		return err
+	}
+	return c.setSignature(txBuilder, acc.GetSequence(), sig)
+}
+
+func (c *Client) setSignature(txBuilder client.TxBuilder, sequence uint64, sig []byte) error {
+	return txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: c.signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: sig,
+		},
+		Sequence: sequence,
+	})
+}
@@ -0,0 +1,157 @@
+package sdkclient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/sdkclient"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	client  *sdkclient.Client
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	s.cfg = network.DefaultConfig()
+	s.cfg.NumValidators = 1
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	signer, err := sdkclient.NewKeyringSigner(val.ClientCtx.Keyring, val.Moniker)
+	s.Require().NoError(err)
+	s.client = s.newClient(signer)
+	s.Require().Equal(s.cfg.ChainID, s.client.ChainID())
+	s.Require().Equal(val.Address, s.client.Address())
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.Require().NoError(s.client.Close())
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) newClient(signer sdkclient.Signer) *sdkclient.Client {
+	gasPrices, err := sdk.ParseDecCoins(s.cfg.MinGasPrices)
+	s.Require().NoError(err)
+
+	client, err := sdkclient.New(s.network.Validators[0].AppConfig.GRPC.Address, signer,
+		sdkclient.WithGasPrices(gasPrices),
+		sdkclient.WithPollInterval(100*time.Millisecond),
+	)
+	s.Require().NoError(err)
+	return client
+}
+
+func (s *IntegrationTestSuite) TestBankSend() {
+	ctx := context.Background()
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000000))
+	res, err := s.client.Bank().Send(ctx, addr, amount)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Code)
+	s.Require().Positive(res.Height)
+
+	balances, err := s.client.Bank().Balances(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(amount, balances)
+
+	// send coins back, signing with a raw key
+	client := s.newClient(sdkclient.NewPrivKeySigner(privKey))
+	defer client.Close()
+	_, err = client.Bank().Send(ctx, s.client.Address(), sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)))
+	s.Require().NoError(err)
+	// successive transactions use successive sequences
+	_, err = client.Bank().Send(ctx, s.client.Address(), sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)))
+	s.Require().NoError(err)
+
+	balance, err := client.Bank().Balance(ctx, addr, s.cfg.BondDenom)
+	s.Require().NoError(err)
+	s.Require().True(balance.Amount.LT(sdk.NewInt(998000)), balance)
+
+	acc, err := client.Auth().Account(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), acc.GetSequence())
+
+	// not enough funds
+	_, err = client.Bank().Send(ctx, s.client.Address(), amount)
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestStaking() {
+	ctx := context.Background()
+	valAddr := s.network.Validators[0].ValAddress
+
+	before, err := s.client.Staking().Delegation(ctx, s.client.Address(), valAddr)
+	s.Require().NoError(err)
+
+	amount := sdk.NewInt64Coin(s.cfg.BondDenom, 100)
+	_, err = s.client.Staking().Delegate(ctx, valAddr, amount)
+	s.Require().NoError(err)
+
+	after, err := s.client.Staking().Delegation(ctx, s.client.Address(), valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(before.Balance.Add(amount), after.Balance)
+
+	_, err = s.client.Staking().Undelegate(ctx, valAddr, amount)
+	s.Require().NoError(err)
+
+	validator, err := s.client.Staking().Validator(ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(valAddr.String(), validator.OperatorAddress)
+
+	_, err = s.client.Distribution().WithdrawRewards(ctx, valAddr)
+	s.Require().NoError(err)
+	_, err = s.client.Distribution().Rewards(ctx, s.client.Address(), valAddr)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestGov() {
+	ctx := context.Background()
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10000000))
+
+	proposalID, _, err := s.client.Gov().SubmitProposal(ctx, nil, deposit, "ipfs://CID")
+	s.Require().NoError(err)
+
+	proposal, err := s.client.Gov().Proposal(ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+
+	_, err = s.client.Gov().Deposit(ctx, proposalID, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1)))
+	s.Require().NoError(err)
+
+	_, err = s.client.Gov().Vote(ctx, proposalID, govv1.OptionYes)
+	s.Require().NoError(err)
+
+	vote, err := s.client.Gov().GetVote(ctx, proposalID, s.client.Address())
+	s.Require().NoError(err)
+	s.Require().Len(vote.Options, 1)
+	s.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+
+	// unknown proposal
+	_, err = s.client.Gov().Vote(ctx, proposalID+1, govv1.OptionYes)
+	s.Require().Error(err)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,41 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// DistributionClient sends the transactions and queries of the distribution module
+type DistributionClient struct {
+	c     *Client
+	query distrtypes.QueryClient
+}
+
+// Distribution returns the client of the distribution module
+func (c *Client) Distribution() DistributionClient {
+	return DistributionClient{c: c, query: distrtypes.NewQueryClient(c.conn)}
+}
+
+// WithdrawRewards withdraws the rewards of the delegation of the signer to valAddr
+func (dc DistributionClient) WithdrawRewards(ctx context.Context, valAddr sdk.ValAddress) (*sdk.TxResponse, error) {
+	return dc.c.BroadcastTx(ctx, distrtypes.NewMsgWithdrawDelegatorReward(dc.c.Address(), valAddr))
+}
+
+// SetWithdrawAddress sets the address receiving the rewards of the signer
+func (dc DistributionClient) SetWithdrawAddress(ctx context.Context, withdrawAddr sdk.AccAddress) (*sdk.TxResponse, error) {
+	return dc.c.BroadcastTx(ctx, distrtypes.NewMsgSetWithdrawAddress(dc.c.Address(), withdrawAddr))
+}
+
+// Rewards returns the rewards of the delegation of delAddr to valAddr
+func (dc DistributionClient) Rewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	res, err := dc.query.DelegationRewards(ctx, &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Rewards, nil
+}
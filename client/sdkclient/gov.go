@@ -0,0 +1,65 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GovClient sends the transactions and queries of the gov module
+type GovClient struct {
+	c     *Client
+	query govv1.QueryClient
+}
+
+// Gov returns the client of the gov module
+func (c *Client) Gov() GovClient {
+	return GovClient{c: c, query: govv1.NewQueryClient(c.conn)}
+}
+
+// SubmitProposal submits a proposal executing msgs, with the initial deposit of the signer, and
+// returns its ID
+func (gc GovClient) SubmitProposal(ctx context.Context, msgs []sdk.Msg, initialDeposit sdk.Coins, metadata string) (uint64, *sdk.TxResponse, error) {
+	msg, err := govv1.NewMsgSubmitProposal(msgs, initialDeposit, gc.c.Address().String(), metadata)
+	if err != nil {
+		return 0, nil, err
+	}
+	res, err := gc.c.BroadcastTx(ctx, msg)
+	if err != nil {
+		return 0, res, err
+	}
+	var msgRes govv1.MsgSubmitProposalResponse
+	if err := UnmarshalMsgResponse(res, 0, &msgRes); err != nil {
+		return 0, res, err
+	}
+	return msgRes.ProposalId, res, nil
+}
+
+// Deposit deposits amount from the signer on the proposal
+func (gc GovClient) Deposit(ctx context.Context, proposalID uint64, amount sdk.Coins) (*sdk.TxResponse, error) {
+	return gc.c.BroadcastTx(ctx, govv1.NewMsgDeposit(gc.c.Address(), proposalID, amount))
+}
+
+// Vote casts the vote of the signer on the proposal
+func (gc GovClient) Vote(ctx context.Context, proposalID uint64, option govv1.VoteOption) (*sdk.TxResponse, error) {
+	return gc.c.BroadcastTx(ctx, govv1.NewMsgVote(gc.c.Address(), proposalID, option, ""))
+}
+
+// Proposal returns the proposal with the given ID
+func (gc GovClient) Proposal(ctx context.Context, proposalID uint64) (*govv1.Proposal, error) {
+	res, err := gc.query.Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Proposal, nil
+}
+
+// GetVote returns the vote of voter on the proposal
+func (gc GovClient) GetVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress) (*govv1.Vote, error) {
+	res, err := gc.query.Vote(ctx, &govv1.QueryVoteRequest{ProposalId: proposalID, Voter: voter.String()})
+	if err != nil {
+		return nil, err
+	}
+	return res.Vote, nil
+}
@@ -0,0 +1,66 @@
+package sdkclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Signer signs the transactions of an account
+type Signer interface {
+	// Address returns the address of the account
+	Address() sdk.AccAddress
+	// PubKey returns the public key verifying the signatures
+	PubKey() cryptotypes.PubKey
+	// Sign signs the given sign bytes
+	Sign(msg []byte) ([]byte, error)
+}
+
+type keyringSigner struct {
+	kr     keyring.Keyring
+	uid    string
+	pubKey cryptotypes.PubKey
+	addr   sdk.AccAddress
+}
+
+// NewKeyringSigner returns a Signer signing with the key named uid in kr
+func NewKeyringSigner(kr keyring.Keyring, uid string) (Signer, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return keyringSigner{kr: kr, uid: uid, pubKey: pubKey, addr: addr}, nil
+}
+
+func (s keyringSigner) Address() sdk.AccAddress    { return s.addr }
+func (s keyringSigner) PubKey() cryptotypes.PubKey { return s.pubKey }
+
+func (s keyringSigner) Sign(msg []byte) ([]byte, error) {
+	sig, _, err := s.kr.Sign(s.uid, msg)
+	return sig, err
+}
+
+type privKeySigner struct {
+	privKey cryptotypes.PrivKey
+	addr    sdk.AccAddress
+}
+
+// NewPrivKeySigner returns a Signer signing with privKey for the account whose address is derived from its public key
+func NewPrivKeySigner(privKey cryptotypes.PrivKey) Signer {
+	return privKeySigner{privKey: privKey, addr: sdk.AccAddress(privKey.PubKey().Address())}
+}
+
+func (s privKeySigner) Address() sdk.AccAddress    { return s.addr }
+func (s privKeySigner) PubKey() cryptotypes.PubKey { return s.privKey.PubKey() }
+
+func (s privKeySigner) Sign(msg []byte) ([]byte, error) {
+	return s.privKey.Sign(msg)
+}
@@ -0,0 +1,33 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SlashingClient sends the transactions and queries of the slashing module
+type SlashingClient struct {
+	c     *Client
+	query slashingtypes.QueryClient
+}
+
+// Slashing returns the client of the slashing module
+func (c *Client) Slashing() SlashingClient {
+	return SlashingClient{c: c, query: slashingtypes.NewQueryClient(c.conn)}
+}
+
+// Unjail unjails the validator operated by the signer
+func (sc SlashingClient) Unjail(ctx context.Context) (*sdk.TxResponse, error) {
+	return sc.c.BroadcastTx(ctx, slashingtypes.NewMsgUnjail(sdk.ValAddress(sc.c.Address())))
+}
+
+// SigningInfo returns the signing info of the validator with the given consensus address
+func (sc SlashingClient) SigningInfo(ctx context.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error) {
+	res, err := sc.query.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+	if err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, err
+	}
+	return res.ValSigningInfo, nil
+}
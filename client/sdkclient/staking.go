@@ -0,0 +1,55 @@
+package sdkclient
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingClient sends the transactions and queries of the staking module
+type StakingClient struct {
+	c     *Client
+	query stakingtypes.QueryClient
+}
+
+// Staking returns the client of the staking module
+func (c *Client) Staking() StakingClient {
+	return StakingClient{c: c, query: stakingtypes.NewQueryClient(c.conn)}
+}
+
+// Delegate delegates amount from the signer to valAddr
+func (sc StakingClient) Delegate(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) (*sdk.TxResponse, error) {
+	return sc.c.BroadcastTx(ctx, stakingtypes.NewMsgDelegate(sc.c.Address(), valAddr, amount))
+}
+
+// Undelegate undelegates amount of the delegation of the signer to valAddr
+func (sc StakingClient) Undelegate(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) (*sdk.TxResponse, error) {
+	return sc.c.BroadcastTx(ctx, stakingtypes.NewMsgUndelegate(sc.c.Address(), valAddr, amount))
+}
+
+// Redelegate redelegates amount of the delegation of the signer from valSrcAddr to valDstAddr
+func (sc StakingClient) Redelegate(ctx context.Context, valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin) (*sdk.TxResponse, error) {
+	return sc.c.BroadcastTx(ctx, stakingtypes.NewMsgBeginRedelegate(sc.c.Address(), valSrcAddr, valDstAddr, amount))
+}
+
+// Validator returns the validator at valAddr
+func (sc StakingClient) Validator(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+	res, err := sc.query.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+	return res.Validator, nil
+}
+
+// Delegation returns the delegation of delAddr to valAddr
+func (sc StakingClient) Delegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationResponse, error) {
+	res, err := sc.query.Delegation(ctx, &stakingtypes.QueryDelegationRequest{
+		DelegatorAddr: delAddr.String(),
+		ValidatorAddr: valAddr.String(),
+	})
+	if err != nil {
+		return stakingtypes.DelegationResponse{}, err
+	}
+	return *res.DelegationResponse, nil
+}
@@ -0,0 +1,253 @@
+// Package ormstream decodes the state changes of the stores of modules built
+// with the ORM, as streamed by the nodes, into row events. It is meant to be
+// used by indexers along with the ORM schemas published by the nodes.
+package ormstream
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+)
+
+// EventType is the type of change of a row.
+type EventType int
+
+const (
+	// EventInsert indicates that a row was inserted.
+	EventInsert EventType = iota
+
+	// EventUpdate indicates that an existing row was updated.
+	EventUpdate
+
+	// EventDelete indicates that a row was deleted.
+	EventDelete
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventInsert:
+		return "insert"
+	case EventUpdate:
+		return "update"
+	case EventDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("EventType(%d)", int(t))
+	}
+}
+
+// Event is the change of a row of an ORM table.
+type Event struct {
+	// Type is the type of change.
+	Type EventType
+
+	// Module is the name of the module owning the table.
+	Module string
+
+	// TableName is the table of the row, equivalent to the fully-qualified
+	// proto message name of its rows.
+	TableName protoreflect.FullName
+
+	// PrimaryKey are the primary key values of the row.
+	PrimaryKey []protoreflect.Value
+
+	// Row is the new value of the row, it is nil for deletes.
+	Row proto.Message
+}
+
+// RowExistsFunc reports whether the row of the table of module stored under
+// the raw primary key is already known, to tell the inserts from the updates.
+type RowExistsFunc func(module string, table protoreflect.FullName, primaryKey []byte) (bool, error)
+
+// DecoderOptions are options for constructing a Decoder.
+type DecoderOptions struct {
+	// TypeResolver is an optional type resolver of the row messages. If it is
+	// nil, protoregistry.GlobalTypes will be used.
+	TypeResolver ormtable.TypeResolver
+
+	// FileResolver is an optional resolver of the files defining the tables.
+	// If it is nil, protoregistry.GlobalFiles will be used.
+	FileResolver protodesc.Resolver
+
+	// RowExists is an optional lookup of the rows known by the indexer. If it
+	// is nil, the decoder keeps track in memory of the primary keys it decoded,
+	// which is only suitable for indexing the state from genesis.
+	RowExists RowExistsFunc
+}
+
+// Decoder decodes the state changes of the stores of ORM modules into row
+// events.
+type Decoder struct {
+	modules   map[string]ormdb.ModuleDB
+	rowExists RowExistsFunc
+
+	// rows are the raw primary keys of the rows known by module, tracked when
+	// no RowExists lookup is provided
+	rows map[string]map[string]bool
+}
+
+// NewDecoder returns a Decoder of the state changes of the modules described by
+// schemas, by module name.
+func NewDecoder(schemas map[string]*ormv1alpha1.ModuleSchemaDescriptor, options DecoderOptions) (*Decoder, error) {
+	decoder := &Decoder{
+		modules:   map[string]ormdb.ModuleDB{},
+		rowExists: options.RowExists,
+	}
+	if decoder.rowExists == nil {
+		decoder.rows = map[string]map[string]bool{}
+	}
+
+	for name, schema := range schemas {
+		db, err := ormdb.NewModuleDB(schema, ormdb.ModuleDBOptions{
+			TypeResolver: options.TypeResolver,
+			FileResolver: options.FileResolver,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", name, err)
+		}
+
+		decoder.modules[name] = db
+	}
+
+	return decoder, nil
+}
+
+// Decode decodes the state change of the key in the store of module into a row
+// event. value is ignored if the key was deleted. It returns nil if the key
+// doesn't store a row, such as the index and sequence keys of the tables or
+// the keys of the modules not built with the ORM.
+func (d *Decoder) Decode(module string, key, value []byte, deleted bool) (*Event, error) {
+	db, ok := d.modules[module]
+	if !ok {
+		return nil, nil
+	}
+
+	if deleted {
+		value = nil
+	}
+
+	entry, err := db.DecodeEntry(key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	pkEntry, ok := entry.(*ormkv.PrimaryKeyEntry)
+	if !ok {
+		return nil, nil
+	}
+
+	event := &Event{
+		Module:     module,
+		TableName:  pkEntry.TableName,
+		PrimaryKey: pkEntry.Key,
+	}
+
+	exists, err := d.exists(module, pkEntry.TableName, key, deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case deleted:
+		event.Type = EventDelete
+	case exists:
+		event.Type = EventUpdate
+		event.Row = pkEntry.Value
+	default:
+		event.Type = EventInsert
+		event.Row = pkEntry.Value
+	}
+
+	return event, nil
+}
+
+// NewDescriptorResolvers returns the resolvers of the files and of the dynamic
+// message types defined by the serialized file descriptors, as published by
+// the ORM schema discovery service of the nodes. Every file must be provided
+// along with all its dependencies.
+func NewDescriptorResolvers(fileDescriptors [][]byte) (*protoregistry.Files, *protoregistry.Types, error) {
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	for _, bz := range fileDescriptors {
+		fdp := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(bz, fdp); err != nil {
+			return nil, nil, err
+		}
+
+		fdSet.File = append(fdSet.File, fdp)
+	}
+
+	files, err := protodesc.NewFiles(fdSet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	types := &protoregistry.Types{}
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		err = registerTypes(types, file.Messages(), file.Extensions())
+		return err == nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return files, types, nil
+}
+
+func registerTypes(types *protoregistry.Types, messages protoreflect.MessageDescriptors, extensions protoreflect.ExtensionDescriptors) error {
+	for i := 0; i < extensions.Len(); i++ {
+		if err := types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i))); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+
+		if err := types.RegisterMessage(dynamicpb.NewMessageType(message)); err != nil {
+			return err
+		}
+
+		if err := registerTypes(types, message.Messages(), message.Extensions()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exists reports whether the row stored under the raw primary key is known and
+// keeps track of the change of the row if no RowExists lookup is provided.
+func (d *Decoder) exists(module string, table protoreflect.FullName, primaryKey []byte, deleted bool) (bool, error) {
+	if d.rowExists != nil {
+		return d.rowExists(module, table, primaryKey)
+	}
+
+	rows, ok := d.rows[module]
+	if !ok {
+		rows = map[string]bool{}
+		d.rows[module] = rows
+	}
+
+	exists := rows[string(primaryKey)]
+	if deleted {
+		delete(rows, string(primaryKey))
+	} else {
+		rows[string(primaryKey)] = true
+	}
+
+	return exists, nil
+}
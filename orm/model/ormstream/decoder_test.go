@@ -0,0 +1,160 @@
+package ormstream_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gotest.tools/v3/assert"
+
+	ormv1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1"
+	ormv1alpha1 "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/internal/testpb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormstream"
+)
+
+var bankSchema = &ormv1alpha1.ModuleSchemaDescriptor{
+	SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{
+			Id:            1,
+			ProtoFileName: testpb.File_testpb_bank_proto.Path(),
+		},
+	},
+	Prefix: []byte{0xa},
+}
+
+type kvPair struct {
+	key, value []byte
+}
+
+func encodeBalance(t *testing.T, db ormdb.ModuleDB, balance *testpb.Balance) (row, index kvPair) {
+	pk := []protoreflect.Value{protoreflect.ValueOfString(balance.Address), protoreflect.ValueOfString(balance.Denom)}
+
+	k, v, err := db.EncodeEntry(&ormkv.PrimaryKeyEntry{TableName: "testpb.Balance", Key: pk, Value: balance})
+	assert.NilError(t, err)
+	row = kvPair{k, v}
+
+	k, v, err = db.EncodeEntry(&ormkv.IndexKeyEntry{
+		TableName:   "testpb.Balance",
+		Fields:      []protoreflect.Name{"denom"},
+		IndexValues: []protoreflect.Value{protoreflect.ValueOfString(balance.Denom)},
+		PrimaryKey:  pk,
+	})
+	assert.NilError(t, err)
+	index = kvPair{k, v}
+
+	return row, index
+}
+
+func TestDecoder(t *testing.T) {
+	db, err := ormdb.NewModuleDB(bankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	// decode the rows as an indexer without the Go types of the module
+	var fileDescriptors [][]byte
+	for _, file := range []protoreflect.FileDescriptor{
+		descriptorpb.File_google_protobuf_descriptor_proto,
+		ormv1.File_cosmos_orm_v1_orm_proto,
+		testpb.File_testpb_bank_proto,
+	} {
+		bz, err := proto.Marshal(protodesc.ToFileDescriptorProto(file))
+		assert.NilError(t, err)
+		fileDescriptors = append(fileDescriptors, bz)
+	}
+	files, types, err := ormstream.NewDescriptorResolvers(fileDescriptors)
+	assert.NilError(t, err)
+
+	decoder, err := ormstream.NewDecoder(map[string]*ormv1alpha1.ModuleSchemaDescriptor{"bank": bankSchema}, ormstream.DecoderOptions{
+		TypeResolver: types,
+		FileResolver: files,
+	})
+	assert.NilError(t, err)
+
+	balance := &testpb.Balance{Address: "alice", Denom: "foo", Amount: 10}
+	row, index := encodeBalance(t, db, balance)
+
+	assertRowEvent := func(event *ormstream.Event, typ ormstream.EventType, expected *testpb.Balance) {
+		assert.Equal(t, typ, event.Type)
+		assert.Equal(t, "bank", event.Module)
+		assert.Equal(t, protoreflect.FullName("testpb.Balance"), event.TableName)
+		assert.Equal(t, 2, len(event.PrimaryKey))
+		assert.Equal(t, "alice", event.PrimaryKey[0].String())
+		assert.Equal(t, "foo", event.PrimaryKey[1].String())
+
+		if expected == nil {
+			assert.Assert(t, event.Row == nil)
+			return
+		}
+
+		_, ok := event.Row.(*dynamicpb.Message)
+		assert.Assert(t, ok)
+		bz, err := proto.Marshal(event.Row)
+		assert.NilError(t, err)
+		var actual testpb.Balance
+		assert.NilError(t, proto.Unmarshal(bz, &actual))
+		assert.Assert(t, proto.Equal(expected, &actual))
+	}
+
+	event, err := decoder.Decode("bank", row.key, row.value, false)
+	assert.NilError(t, err)
+	assertRowEvent(event, ormstream.EventInsert, balance)
+
+	// index keys don't store rows
+	event, err = decoder.Decode("bank", index.key, index.value, false)
+	assert.NilError(t, err)
+	assert.Assert(t, event == nil)
+
+	balance.Amount = 5
+	row, _ = encodeBalance(t, db, balance)
+	event, err = decoder.Decode("bank", row.key, row.value, false)
+	assert.NilError(t, err)
+	assertRowEvent(event, ormstream.EventUpdate, balance)
+
+	event, err = decoder.Decode("bank", row.key, nil, true)
+	assert.NilError(t, err)
+	assertRowEvent(event, ormstream.EventDelete, nil)
+
+	// the row is inserted again after its deletion
+	event, err = decoder.Decode("bank", row.key, row.value, false)
+	assert.NilError(t, err)
+	assertRowEvent(event, ormstream.EventInsert, balance)
+
+	// the keys of the other modules are ignored
+	event, err = decoder.Decode("staking", row.key, row.value, false)
+	assert.NilError(t, err)
+	assert.Assert(t, event == nil)
+
+	// the keys outside of the ORM schema can't be decoded
+	_, err = decoder.Decode("bank", []byte{0xb, 0x1}, nil, false)
+	assert.Assert(t, err != nil)
+}
+
+func TestDecoderRowExists(t *testing.T) {
+	db, err := ormdb.NewModuleDB(bankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	row, _ := encodeBalance(t, db, &testpb.Balance{Address: "alice", Denom: "foo", Amount: 10})
+
+	decoder, err := ormstream.NewDecoder(map[string]*ormv1alpha1.ModuleSchemaDescriptor{"bank": bankSchema}, ormstream.DecoderOptions{
+		RowExists: func(module string, table protoreflect.FullName, primaryKey []byte) (bool, error) {
+			assert.Equal(t, "bank", module)
+			assert.Equal(t, protoreflect.FullName("testpb.Balance"), table)
+			assert.DeepEqual(t, row.key, primaryKey)
+			return true, nil
+		},
+	})
+	assert.NilError(t, err)
+
+	// the row is known by the indexer
+	event, err := decoder.Decode("bank", row.key, row.value, false)
+	assert.NilError(t, err)
+	assert.Equal(t, ormstream.EventUpdate, event.Type)
+	_, ok := event.Row.(*testpb.Balance)
+	assert.Assert(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -75,6 +76,7 @@ type appInputs struct {
 	depinject.In
 
 	Config         *runtimev1alpha1.Module
+	AppConfig      *appv1alpha1.Config `optional:"true"`
 	App            appWrapper
 	Modules        map[string]AppModuleWrapper
 	BaseAppOptions []BaseAppOption
@@ -88,6 +90,7 @@ func provideAppBuilder(inputs appInputs) *AppBuilder {
 	app := inputs.App
 	app.baseAppOptions = inputs.BaseAppOptions
 	app.config = inputs.Config
+	app.appConfig = inputs.AppConfig
 	app.ModuleManager = mm
 	return &AppBuilder{app: app}
 }
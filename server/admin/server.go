@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	unixSocketPrefix = "unix://"
+	unixSocketPerm   = 0o600
+)
+
+// StartServer starts the admin gRPC server operating app on the loopback TCP
+// address or the Unix socket of cfg. The level of logger is changed by the
+// service if it implements LevelLogger. Every request, and every runtime update
+// of app it results in, is logged with auditLogger, which must not be affected
+// by the level of logger. The exported genesis documents are based on
+// genesisFile.
+func StartServer(app App, logger, auditLogger log.Logger, cfg config.AdminConfig, genesisFile string) (*grpc.Server, error) {
+	listener, err := listen(cfg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	app.SetAuditLogger(auditLogger)
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(auditInterceptor(auditLogger)),
+		grpc.StreamInterceptor(auditStreamInterceptor(auditLogger)),
+	)
+	levelLogger, _ := logger.(LevelLogger)
+	RegisterServiceServer(grpcSrv, NewService(app, levelLogger, genesisFile))
+	gogoreflection.Register(grpcSrv)
+
+	errCh := make(chan error)
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			errCh <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+
+	case <-time.After(types.ServerStartTime):
+		// assume server started successfully
+		return grpcSrv, nil
+	}
+}
+
+// Dial connects to the admin gRPC server listening on address, a TCP address or
+// a Unix socket prefixed by unix://.
+func Dial(address string) (*grpc.ClientConn, error) {
+	if path := strings.TrimPrefix(address, unixSocketPrefix); path != address {
+		address = "unix:" + path
+	}
+	return grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// listen listens on a Unix socket if address is prefixed by unix://, or on a
+// TCP address otherwise, which must be a loopback one. The Unix socket is only
+// accessible to the user running the node, whatever its umask.
+func listen(address string) (net.Listener, error) {
+	if path := strings.TrimPrefix(address, unixSocketPrefix); path != address {
+		// remove the socket left over by a previous run
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		listener, err := net.Listen("unix", path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(path, unixSocketPerm); err != nil {
+			listener.Close()
+			return nil, err
+		}
+		return listener, nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("the admin gRPC server must be bound to a loopback address or a Unix socket, got %s", address)
+	}
+	return net.Listen("tcp", address)
+}
+
+// auditInterceptor logs the admin requests along with their outcome.
+func auditInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			logger.Error("admin request failed", "method", info.FullMethod, "request", fmt.Sprint(req), "err", err)
+		} else {
+			logger.Info("admin request", "method", info.FullMethod, "request", fmt.Sprint(req))
+		}
+		return res, err
+	}
+}
+
+// auditStreamInterceptor logs the admin streaming requests along with their
+// outcome.
+func auditStreamInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if err != nil {
+			logger.Error("admin request failed", "method", info.FullMethod, "err", err)
+		} else {
+			logger.Info("admin request", "method", info.FullMethod)
+		}
+		return err
+	}
+}
@@ -0,0 +1,253 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// App is the application operated by the admin service. It is implemented by
+// the applications embedding BaseApp.
+type App interface {
+	LastBlockHeight() int64
+	SnapshotManager() *snapshots.Manager
+
+	MinGasPrices() sdk.DecCoins
+	HaltHeight() uint64
+	HaltTime() uint64
+	PruningOptions() pruningtypes.PruningOptions
+
+	UpdateMinGasPrices(ctx context.Context, gasPrices sdk.DecCoins) error
+	UpdateHalt(ctx context.Context, haltHeight, haltTime uint64) error
+	UpdatePruning(ctx context.Context, opts pruningtypes.PruningOptions) error
+
+	SetAuditLogger(logger log.Logger)
+}
+
+// GenesisExporter is implemented by the applications exporting the state of a
+// committed height while running, see SimApp.ExportAppStateAndValidatorsAtHeight.
+type GenesisExporter interface {
+	ExportAppStateAndValidatorsAtHeight(height int64, forZeroHeight bool, jailAllowedAddrs []string) (servertypes.ExportedApp, error)
+}
+
+// LevelLogger is implemented by the node loggers whose level can be changed
+// while the node is running, see server.NewLeveledZeroLogWrapper.
+type LevelLogger interface {
+	GetLevel() zerolog.Level
+	SetLevel(level zerolog.Level) bool
+}
+
+// exportChunkSize is the size of the chunks of the genesis documents streamed by
+// ExportGenesis.
+const exportChunkSize = 1 << 20
+
+var _ ServiceServer = service{}
+
+// service implements the admin ServiceServer.
+type service struct {
+	app         App
+	logger      LevelLogger
+	genesisFile string
+}
+
+// NewService returns the admin ServiceServer operating app. The log level is
+// the one of logger, shared with the other loggers of the node, and the
+// exported genesis documents are based on genesisFile. logger may be nil, in
+// which case the log level can't be changed.
+func NewService(app App, logger LevelLogger, genesisFile string) ServiceServer {
+	return service{app: app, logger: logger, genesisFile: genesisFile}
+}
+
+// GetConfig implements ServiceServer.GetConfig
+func (s service) GetConfig(_ context.Context, _ *GetConfigRequest) (*GetConfigResponse, error) {
+	res := &GetConfigResponse{
+		MinGasPrices: s.app.MinGasPrices(),
+		HaltHeight:   s.app.HaltHeight(),
+		HaltTime:     s.app.HaltTime(),
+		Pruning:      pruningOptionsToProto(s.app.PruningOptions()),
+	}
+	if s.logger != nil {
+		res.LogLevel = s.logger.GetLevel().String()
+	}
+	if manager := s.app.SnapshotManager(); manager != nil {
+		res.SnapshotInterval = manager.GetInterval()
+		res.SnapshotKeepRecent = manager.GetKeepRecent()
+	}
+	return res, nil
+}
+
+// SetMinGasPrices implements ServiceServer.SetMinGasPrices
+func (s service) SetMinGasPrices(ctx context.Context, req *SetMinGasPricesRequest) (*SetMinGasPricesResponse, error) {
+	if err := s.app.UpdateMinGasPrices(ctx, req.MinGasPrices.Sort()); err != nil {
+		return nil, err
+	}
+	return &SetMinGasPricesResponse{}, nil
+}
+
+// SetHalt implements ServiceServer.SetHalt
+func (s service) SetHalt(ctx context.Context, req *SetHaltRequest) (*SetHaltResponse, error) {
+	if req.HaltHeight > 0 && req.HaltHeight <= uint64(s.app.LastBlockHeight()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"halt height %d must be greater than the latest height %d", req.HaltHeight, s.app.LastBlockHeight())
+	}
+	if err := s.app.UpdateHalt(ctx, req.HaltHeight, req.HaltTime); err != nil {
+		return nil, err
+	}
+	return &SetHaltResponse{}, nil
+}
+
+// SetPruning implements ServiceServer.SetPruning
+func (s service) SetPruning(ctx context.Context, req *SetPruningRequest) (*SetPruningResponse, error) {
+	if req.Pruning == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty pruning options")
+	}
+	opts, err := pruningOptionsFromProto(*req.Pruning)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.UpdatePruning(ctx, opts); err != nil {
+		return nil, err
+	}
+	return &SetPruningResponse{}, nil
+}
+
+// SetLogLevel implements ServiceServer.SetLogLevel
+func (s service) SetLogLevel(_ context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	level, err := zerolog.ParseLevel(req.Level)
+	if err != nil || req.Level == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid log level %q", req.Level)
+	}
+	if s.logger == nil || !s.logger.SetLevel(level) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "the level of the node logger can't be changed")
+	}
+	return &SetLogLevelResponse{}, nil
+}
+
+// CreateSnapshot implements ServiceServer.CreateSnapshot
+func (s service) CreateSnapshot(_ context.Context, _ *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	manager, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+	height := s.app.LastBlockHeight()
+	if height <= 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no block committed yet")
+	}
+	snapshot, err := manager.Create(uint64(height))
+	if err != nil {
+		return nil, err
+	}
+	return &CreateSnapshotResponse{Snapshot: snapshot}, nil
+}
+
+// ListSnapshots implements ServiceServer.ListSnapshots
+func (s service) ListSnapshots(_ context.Context, _ *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	manager, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := manager.List()
+	if err != nil {
+		return nil, err
+	}
+	return &ListSnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+// DeleteSnapshot implements ServiceServer.DeleteSnapshot
+func (s service) DeleteSnapshot(_ context.Context, req *DeleteSnapshotRequest) (*DeleteSnapshotResponse, error) {
+	manager, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+	if err := manager.Delete(req.Height, req.Format); err != nil {
+		return nil, err
+	}
+	return &DeleteSnapshotResponse{}, nil
+}
+
+// ExportGenesis implements ServiceServer.ExportGenesis
+func (s service) ExportGenesis(req *ExportGenesisRequest, stream Service_ExportGenesisServer) error {
+	exporter, ok := s.app.(GenesisExporter)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the application does not support exporting its state while running")
+	}
+	if req.Height < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "negative height %d", req.Height)
+	}
+
+	exported, err := exporter.ExportAppStateAndValidatorsAtHeight(req.Height, req.ForZeroHeight, req.JailAllowedAddrs)
+	if err != nil {
+		return err
+	}
+
+	doc, err := tmtypes.GenesisDocFromFile(s.genesisFile)
+	if err != nil {
+		return err
+	}
+	exported.UpdateGenesisDoc(doc)
+
+	// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
+	encoded, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	encoded, err = sdk.SortJSON(encoded)
+	if err != nil {
+		return err
+	}
+
+	for len(encoded) > 0 {
+		n := exportChunkSize
+		if n > len(encoded) {
+			n = len(encoded)
+		}
+		if err := stream.Send(&ExportGenesisResponse{Chunk: encoded[:n]}); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
+
+func (s service) snapshotManager() (*snapshots.Manager, error) {
+	manager := s.app.SnapshotManager()
+	if manager == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state sync snapshots are not configured")
+	}
+	return manager, nil
+}
+
+func pruningOptionsToProto(opts pruningtypes.PruningOptions) *PruningOptions {
+	var strategy string
+	switch opts.GetPruningStrategy() {
+	case pruningtypes.PruningDefault:
+		strategy = pruningtypes.PruningOptionDefault
+	case pruningtypes.PruningEverything:
+		strategy = pruningtypes.PruningOptionEverything
+	case pruningtypes.PruningNothing:
+		strategy = pruningtypes.PruningOptionNothing
+	case pruningtypes.PruningCustom:
+		strategy = pruningtypes.PruningOptionCustom
+	}
+	return &PruningOptions{Strategy: strategy, KeepRecent: opts.KeepRecent, Interval: opts.Interval}
+}
+
+func pruningOptionsFromProto(opts PruningOptions) (pruningtypes.PruningOptions, error) {
+	switch opts.Strategy {
+	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionEverything, pruningtypes.PruningOptionNothing:
+		return pruningtypes.NewPruningOptionsFromString(opts.Strategy), nil
+	case pruningtypes.PruningOptionCustom:
+		return pruningtypes.NewCustomPruningOptions(opts.KeepRecent, opts.Interval), nil
+	default:
+		return pruningtypes.PruningOptions{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pruning strategy %q", opts.Strategy)
+	}
+}
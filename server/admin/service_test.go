@@ -0,0 +1,264 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type mockApp struct {
+	height       int64
+	minGasPrices sdk.DecCoins
+	haltHeight   uint64
+	haltTime     uint64
+	pruning      pruningtypes.PruningOptions
+	auditLogger  log.Logger
+}
+
+func (app *mockApp) LastBlockHeight() int64                      { return app.height }
+func (app *mockApp) SnapshotManager() *snapshots.Manager         { return nil }
+func (app *mockApp) MinGasPrices() sdk.DecCoins                  { return app.minGasPrices }
+func (app *mockApp) HaltHeight() uint64                          { return app.haltHeight }
+func (app *mockApp) HaltTime() uint64                            { return app.haltTime }
+func (app *mockApp) PruningOptions() pruningtypes.PruningOptions { return app.pruning }
+func (app *mockApp) SetAuditLogger(logger log.Logger)            { app.auditLogger = logger }
+
+func (app *mockApp) UpdateMinGasPrices(_ context.Context, gasPrices sdk.DecCoins) error {
+	app.minGasPrices = gasPrices
+	return nil
+}
+
+func (app *mockApp) UpdateHalt(_ context.Context, haltHeight, haltTime uint64) error {
+	app.haltHeight, app.haltTime = haltHeight, haltTime
+	return nil
+}
+
+func (app *mockApp) UpdatePruning(_ context.Context, opts pruningtypes.PruningOptions) error {
+	app.pruning = opts
+	return nil
+}
+
+// recordingLogger records the messages logged at every level, along with their
+// key/value pairs.
+type recordingLogger struct {
+	mtx  sync.Mutex
+	logs []string
+}
+
+func (l *recordingLogger) record(msg string, keyVals []interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.logs = append(l.logs, fmt.Sprint(append([]interface{}{msg}, keyVals...)...))
+}
+
+func (l *recordingLogger) Debug(msg string, keyVals ...interface{}) { l.record(msg, keyVals) }
+func (l *recordingLogger) Info(msg string, keyVals ...interface{})  { l.record(msg, keyVals) }
+func (l *recordingLogger) Error(msg string, keyVals ...interface{}) { l.record(msg, keyVals) }
+func (l *recordingLogger) With(_ ...interface{}) log.Logger         { return l }
+
+func (l *recordingLogger) String() string {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return strings.Join(l.logs, "\n")
+}
+
+// exporterApp is a mockApp exporting its state while running.
+type exporterApp struct {
+	*mockApp
+	appState json.RawMessage
+}
+
+func (app exporterApp) ExportAppStateAndValidatorsAtHeight(height int64, _ bool, _ []string) (servertypes.ExportedApp, error) {
+	if height == 0 {
+		height = app.height
+	}
+	return servertypes.ExportedApp{
+		AppState: app.appState,
+		Height:   height + 1,
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block:     &tmproto.BlockParams{MaxBytes: 1000, MaxGas: 100},
+			Evidence:  &tmproto.EvidenceParams{MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour, MaxBytes: 100},
+			Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519}},
+		},
+	}, nil
+}
+
+// mockLogger is a LevelLogger.
+type mockLogger struct {
+	level zerolog.Level
+}
+
+func (l *mockLogger) GetLevel() zerolog.Level { return l.level }
+
+func (l *mockLogger) SetLevel(level zerolog.Level) bool {
+	l.level = level
+	return true
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	app := &mockApp{height: 10, pruning: pruningtypes.NewPruningOptions(pruningtypes.PruningDefault)}
+	logger := &mockLogger{level: zerolog.InfoLevel}
+	svc := NewService(app, logger, "")
+
+	_, err := svc.SetMinGasPrices(ctx, &SetMinGasPricesRequest{MinGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))})
+	require.NoError(t, err)
+
+	// the halt height must be in the future
+	_, err = svc.SetHalt(ctx, &SetHaltRequest{HaltHeight: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = svc.SetHalt(ctx, &SetHaltRequest{HaltHeight: 11, HaltTime: 100})
+	require.NoError(t, err)
+
+	_, err = svc.SetPruning(ctx, &SetPruningRequest{Pruning: &PruningOptions{Strategy: "unknown"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = svc.SetPruning(ctx, &SetPruningRequest{Pruning: &PruningOptions{Strategy: "custom", KeepRecent: 100, Interval: 10}})
+	require.NoError(t, err)
+
+	_, err = svc.SetLogLevel(ctx, &SetLogLevelRequest{Level: "verbose"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = svc.SetLogLevel(ctx, &SetLogLevelRequest{Level: "error"})
+	require.NoError(t, err)
+	require.Equal(t, zerolog.ErrorLevel, logger.level)
+
+	res, err := svc.GetConfig(ctx, &GetConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &GetConfigResponse{
+		MinGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		HaltHeight:   11,
+		HaltTime:     100,
+		Pruning:      &PruningOptions{Strategy: "custom", KeepRecent: 100, Interval: 10},
+		LogLevel:     "error",
+	}, res)
+
+	// snapshots are not configured
+	_, err = svc.CreateSnapshot(ctx, &CreateSnapshotRequest{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the level of the logger must be changeable
+	_, err = NewService(app, nil, "").SetLogLevel(ctx, &SetLogLevelRequest{Level: "error"})
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
+
+func TestListen(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:0", "localhost:0", "[::1]:0", "unix://" + filepath.Join(t.TempDir(), "admin.sock")} {
+		listener, err := listen(address)
+		if err != nil {
+			// IPv6 may not be available
+			require.Equal(t, "[::1]:0", address, err)
+			continue
+		}
+		if strings.HasPrefix(address, unixSocketPrefix) {
+			// the socket is only accessible to the user running the node
+			info, err := os.Stat(strings.TrimPrefix(address, unixSocketPrefix))
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(unixSocketPerm), info.Mode().Perm())
+		}
+		require.NoError(t, listener.Close())
+	}
+
+	_, err := listen("0.0.0.0:0")
+	require.Error(t, err)
+	_, err = listen("example.com:9092")
+	require.Error(t, err)
+}
+
+func TestAuditLogger(t *testing.T) {
+	app := &mockApp{}
+	cfg := config.AdminConfig{Enable: true, Address: "unix://" + filepath.Join(t.TempDir(), "admin.sock")}
+
+	// the requests are audited with the audit logger only
+	auditLogger := &recordingLogger{}
+	grpcSrv, err := StartServer(app, log.NewNopLogger(), auditLogger, cfg, "")
+	require.NoError(t, err)
+	defer grpcSrv.Stop()
+	require.Equal(t, auditLogger, app.auditLogger)
+
+	conn, err := Dial(cfg.Address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 1)}
+	_, err = NewServiceClient(conn).SetMinGasPrices(context.Background(), &SetMinGasPricesRequest{MinGasPrices: minGasPrices})
+	require.NoError(t, err)
+	require.Contains(t, auditLogger.String(), "admin request")
+	require.Contains(t, auditLogger.String(), "/cosmos.base.admin.v1beta1.Service/SetMinGasPrices")
+}
+
+func TestExportGenesis(t *testing.T) {
+	dir := t.TempDir()
+	genesisFile := filepath.Join(dir, "genesis.json")
+	require.NoError(t, (&tmtypes.GenesisDoc{ChainID: "test-chain"}).SaveAs(genesisFile))
+
+	// the app state is streamed in several chunks
+	appState, err := json.Marshal(map[string]string{"large": strings.Repeat("a", 2*exportChunkSize)})
+	require.NoError(t, err)
+	app := exporterApp{mockApp: &mockApp{height: 10}, appState: appState}
+
+	cfg := config.AdminConfig{Enable: true, Address: "unix://" + filepath.Join(dir, "admin.sock")}
+	grpcSrv, err := StartServer(app, log.NewNopLogger(), log.NewNopLogger(), cfg, genesisFile)
+	require.NoError(t, err)
+	defer grpcSrv.Stop()
+
+	conn, err := Dial(cfg.Address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	export := func(req *ExportGenesisRequest) (*tmtypes.GenesisDoc, int, error) {
+		stream, err := NewServiceClient(conn).ExportGenesis(context.Background(), req)
+		require.NoError(t, err)
+
+		var bz []byte
+		var chunks int
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			bz = append(bz, res.Chunk...)
+			chunks++
+		}
+
+		doc, err := tmtypes.GenesisDocFromJSON(bz)
+		require.NoError(t, err)
+		return doc, chunks, nil
+	}
+
+	doc, chunks, err := export(&ExportGenesisRequest{Height: 5})
+	require.NoError(t, err)
+	require.Equal(t, 3, chunks)
+	require.Equal(t, "test-chain", doc.ChainID)
+	require.Equal(t, int64(6), doc.InitialHeight)
+	require.Equal(t, int64(1000), doc.ConsensusParams.Block.MaxBytes)
+	require.JSONEq(t, string(appState), string(doc.AppState))
+
+	_, _, err = export(&ExportGenesisRequest{Height: -1})
+	require.ErrorContains(t, err, "negative height")
+
+	// the app must support exporting its state while running
+	err = NewService(app.mockApp, nil, genesisFile).ExportGenesis(&ExportGenesisRequest{}, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
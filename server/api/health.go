@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+// HealthStatus is the status reported by a HealthCheck.
+type HealthStatus string
+
+const (
+	// HealthStatusOK reports that the checked component is working as expected.
+	HealthStatusOK HealthStatus = "ok"
+	// HealthStatusDegraded reports that the checked component works but needs
+	// attention. It does not fail the health and readiness endpoints.
+	HealthStatusDegraded HealthStatus = "degraded"
+	// HealthStatusFailing reports that the checked component does not work. It
+	// fails the health or readiness endpoint the check is registered with.
+	HealthStatusFailing HealthStatus = "failing"
+)
+
+// severity orders the statuses from the healthiest to the least healthy one.
+func (s HealthStatus) severity() int {
+	switch s {
+	case HealthStatusOK:
+		return 0
+	case HealthStatusDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// HealthCheckResult is the result of a HealthCheck, explained by its reason.
+type HealthCheckResult struct {
+	Status HealthStatus `json:"status"`
+	Reason string       `json:"reason,omitempty"`
+}
+
+// HealthOK returns a HealthCheckResult with the ok status.
+func HealthOK(format string, args ...interface{}) HealthCheckResult {
+	return HealthCheckResult{Status: HealthStatusOK, Reason: fmt.Sprintf(format, args...)}
+}
+
+// HealthDegraded returns a HealthCheckResult with the degraded status.
+func HealthDegraded(format string, args ...interface{}) HealthCheckResult {
+	return HealthCheckResult{Status: HealthStatusDegraded, Reason: fmt.Sprintf(format, args...)}
+}
+
+// HealthFailing returns a HealthCheckResult with the failing status.
+func HealthFailing(format string, args ...interface{}) HealthCheckResult {
+	return HealthCheckResult{Status: HealthStatusFailing, Reason: fmt.Sprintf(format, args...)}
+}
+
+// HealthCheck checks a component of the node. Checks registered with
+// RegisterLivenessCheck are reported by the /health endpoint, while the
+// /ready endpoint reports both the liveness and the readiness checks.
+type HealthCheck interface {
+	// Name identifies the check in the endpoint responses.
+	Name() string
+	// Check runs the check. The context is canceled once the check timeout
+	// has elapsed.
+	Check(ctx context.Context) HealthCheckResult
+}
+
+type healthCheckFunc struct {
+	name  string
+	check func(context.Context) HealthCheckResult
+}
+
+// NewHealthCheck returns a HealthCheck named name running the check function.
+func NewHealthCheck(name string, check func(context.Context) HealthCheckResult) HealthCheck {
+	return healthCheckFunc{name: name, check: check}
+}
+
+func (c healthCheckFunc) Name() string { return c.name }
+
+func (c healthCheckFunc) Check(ctx context.Context) HealthCheckResult { return c.check(ctx) }
+
+// RegisterLivenessCheck registers a check reported by both the /health and the
+// /ready endpoints.
+func (s *Server) RegisterLivenessCheck(check HealthCheck) {
+	s.healthMtx.Lock()
+	defer s.healthMtx.Unlock()
+	s.livenessChecks = append(s.livenessChecks, check)
+}
+
+// RegisterReadinessCheck registers a check only reported by the /ready endpoint.
+func (s *Server) RegisterReadinessCheck(check HealthCheck) {
+	s.healthMtx.Lock()
+	defer s.healthMtx.Unlock()
+	s.readinessChecks = append(s.readinessChecks, check)
+}
+
+// healthCheckResponse is the response of the /health and /ready endpoints.
+type healthCheckResponse struct {
+	Status HealthStatus                 `json:"status"`
+	Checks map[string]HealthCheckResult `json:"checks"`
+}
+
+func (s *Server) registerHealthChecks(timeout time.Duration) {
+	s.Router.HandleFunc("/health", s.healthCheckHandler(timeout, false)).Methods("GET")
+	s.Router.HandleFunc("/ready", s.healthCheckHandler(timeout, true)).Methods("GET")
+}
+
+// healthCheckHandler runs the liveness checks, and the readiness ones if ready
+// is true. It responds with the 503 status code if any of them is failing.
+func (s *Server) healthCheckHandler(timeout time.Duration, ready bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.healthMtx.Lock()
+		checks := append([]HealthCheck{}, s.livenessChecks...)
+		if ready {
+			checks = append(checks, s.readinessChecks...)
+		}
+		s.healthMtx.Unlock()
+
+		res := runHealthChecks(r.Context(), checks, timeout)
+
+		status := http.StatusOK
+		if res.Status == HealthStatusFailing {
+			status = http.StatusServiceUnavailable
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(bz)
+	}
+}
+
+// runHealthChecks runs checks concurrently, each one bounded by timeout if it is
+// not zero, and aggregates their results with the least healthy status.
+func runHealthChecks(ctx context.Context, checks []HealthCheck, timeout time.Duration) healthCheckResponse {
+	results := make([]HealthCheckResult, len(checks))
+	done := make(chan struct{})
+	for i, check := range checks {
+		go func(i int, check HealthCheck) {
+			defer func() { done <- struct{}{} }()
+
+			checkCtx, cancel := ctx, context.CancelFunc(func() {})
+			if timeout > 0 {
+				checkCtx, cancel = context.WithTimeout(ctx, timeout)
+			}
+			defer cancel()
+
+			results[i] = check.Check(checkCtx)
+		}(i, check)
+	}
+	for range checks {
+		<-done
+	}
+
+	res := healthCheckResponse{Status: HealthStatusOK, Checks: make(map[string]HealthCheckResult, len(checks))}
+	for i, check := range checks {
+		if results[i].Status.severity() > res.Status.severity() {
+			res.Status = results[i].Status
+		}
+		res.Checks[check.Name()] = results[i]
+	}
+	return res
+}
+
+// NewGRPCHealthCheck returns a check failing if the node does not answer the
+// Tendermint service queries sent through clientCtx, e.g. if the gRPC server
+// is down.
+func NewGRPCHealthCheck(clientCtx client.Context) HealthCheck {
+	return NewHealthCheck("grpc", func(ctx context.Context) HealthCheckResult {
+		res, err := tmservice.NewServiceClient(clientCtx).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+		if err != nil {
+			return HealthFailing("failed to query the node info: %s", err)
+		}
+		return HealthOK("serving %s", res.GetNodeInfo().GetNetwork())
+	})
+}
+
+// NewSyncHealthCheck returns a check failing while the node is catching up
+// with the network.
+func NewSyncHealthCheck(clientCtx client.Context) HealthCheck {
+	return NewHealthCheck("sync", func(ctx context.Context) HealthCheckResult {
+		res, err := tmservice.NewServiceClient(clientCtx).GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+		if err != nil {
+			return HealthFailing("failed to query the sync status: %s", err)
+		}
+		if res.Syncing {
+			return HealthFailing("catching up with the network")
+		}
+		return HealthOK("synced")
+	})
+}
+
+// NewBlockAgeHealthCheck returns a check reporting the time elapsed since the
+// latest committed block. The check is degraded once this time exceeds
+// degradedAfter and failing once it exceeds failingAfter. Zero durations
+// disable the corresponding threshold.
+func NewBlockAgeHealthCheck(clientCtx client.Context, degradedAfter, failingAfter time.Duration) HealthCheck {
+	return NewHealthCheck("block-age", func(ctx context.Context) HealthCheckResult {
+		res, err := tmservice.NewServiceClient(clientCtx).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return HealthFailing("failed to query the latest block: %s", err)
+		}
+
+		header := res.GetBlock().GetHeader()
+		age := time.Since(header.Time).Truncate(time.Second)
+		switch {
+		case failingAfter > 0 && age > failingAfter:
+			return HealthFailing("block %d was committed %s ago, more than %s", header.Height, age, failingAfter)
+		case degradedAfter > 0 && age > degradedAfter:
+			return HealthDegraded("block %d was committed %s ago, more than %s", header.Height, age, degradedAfter)
+		default:
+			return HealthOK("block %d was committed %s ago", header.Height, age)
+		}
+	})
+}
+
+// NewSnapshotHealthCheck returns a check failing while the snapshot manager
+// restores a state sync snapshot, and degraded while it takes or prunes
+// snapshots.
+func NewSnapshotHealthCheck(manager *snapshots.Manager) HealthCheck {
+	return NewHealthCheck("snapshot", func(context.Context) HealthCheckResult {
+		switch op := manager.CurrentOperation(); op {
+		case "":
+			return HealthOK("idle")
+		case "restore":
+			return HealthFailing("restoring a snapshot")
+		default:
+			return HealthDegraded("%s operation in progress", op)
+		}
+	})
+}
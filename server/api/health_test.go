@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func staticHealthCheck(name string, res HealthCheckResult) HealthCheck {
+	return NewHealthCheck(name, func(context.Context) HealthCheckResult { return res })
+}
+
+func TestHealthCheckEndpoints(t *testing.T) {
+	s := New(client.Context{}, log.NewNopLogger())
+	s.registerHealthChecks(time.Second)
+
+	get := func(path string) (int, healthCheckResponse) {
+		rec := httptest.NewRecorder()
+		s.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var res healthCheckResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return rec.Code, res
+	}
+
+	// no checks
+	code, res := get("/health")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, HealthStatusOK, res.Status)
+	require.Empty(t, res.Checks)
+
+	s.RegisterLivenessCheck(staticHealthCheck("live", HealthOK("up")))
+	s.RegisterReadinessCheck(staticHealthCheck("degraded", HealthDegraded("slow")))
+
+	code, res = get("/health")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, healthCheckResponse{
+		Status: HealthStatusOK,
+		Checks: map[string]HealthCheckResult{"live": HealthOK("up")},
+	}, res)
+
+	// degraded checks do not fail the endpoint
+	code, res = get("/ready")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, healthCheckResponse{
+		Status: HealthStatusDegraded,
+		Checks: map[string]HealthCheckResult{"live": HealthOK("up"), "degraded": HealthDegraded("slow")},
+	}, res)
+
+	// failing readiness checks only fail the readiness endpoint
+	s.RegisterReadinessCheck(staticHealthCheck("failing", HealthFailing("down")))
+	code, _ = get("/health")
+	require.Equal(t, http.StatusOK, code)
+	code, res = get("/ready")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, HealthStatusFailing, res.Status)
+	require.Equal(t, HealthFailing("down"), res.Checks["failing"])
+}
+
+func TestHealthCheckTimeout(t *testing.T) {
+	check := NewHealthCheck("slow", func(ctx context.Context) HealthCheckResult {
+		<-ctx.Done()
+		return HealthFailing("%s", ctx.Err())
+	})
+
+	res := runHealthChecks(context.Background(), []HealthCheck{check}, 10*time.Millisecond)
+	require.Equal(t, HealthStatusFailing, res.Status)
+	require.Equal(t, HealthFailing("context deadline exceeded"), res.Checks["slow"])
+}
@@ -39,6 +39,10 @@ type Server struct {
 	// this mutex to avoid data races.
 	mtx      sync.Mutex
 	listener net.Listener
+
+	healthMtx       sync.Mutex
+	livenessChecks  []HealthCheck
+	readinessChecks []HealthCheck
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -113,6 +117,7 @@ func (s *Server) Start(cfg config.Config) error {
 		return err
 	}
 
+	s.registerHealthChecks(time.Duration(cfg.API.HealthCheckTimeout) * time.Second)
 	s.registerGRPCGatewayRoutes()
 
 	s.listener = listener
@@ -26,6 +26,9 @@ const (
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
 
+	// DefaultAdminAddress defines the default address to bind the admin gRPC server to.
+	DefaultAdminAddress = "127.0.0.1:9092"
+
 	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
 	// bytes the server can receive.
 	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
@@ -115,6 +118,18 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// HealthCheckTimeout defines the timeout of each check run by the health
+	// and readiness endpoints (in seconds)
+	HealthCheckTimeout uint `mapstructure:"health-check-timeout"`
+
+	// HealthBlockAgeDegraded defines the time since the latest block (in seconds)
+	// after which the readiness endpoint reports the node as degraded
+	HealthBlockAgeDegraded uint `mapstructure:"health-block-age-degraded"`
+
+	// HealthBlockAgeFailing defines the time since the latest block (in seconds)
+	// after which the readiness endpoint reports the node as not ready
+	HealthBlockAgeFailing uint `mapstructure:"health-block-age-failing"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -192,6 +207,16 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// AdminConfig defines the admin gRPC server configuration.
+type AdminConfig struct {
+	// Enable defines if the admin gRPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the admin gRPC server address to bind to, either a loopback
+	// TCP address or a Unix socket as unix:///path/to/socket
+	Address string `mapstructure:"address"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -203,6 +228,7 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Admin     AdminConfig      `mapstructure:"admin"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -251,12 +277,15 @@ func DefaultConfig() *Config {
 			GlobalLabels: [][]string{},
 		},
 		API: APIConfig{
-			Enable:             false,
-			Swagger:            false,
-			Address:            DefaultAPIAddress,
-			MaxOpenConnections: 1000,
-			RPCReadTimeout:     10,
-			RPCMaxBodyBytes:    1000000,
+			Enable:                 false,
+			Swagger:                false,
+			Address:                DefaultAPIAddress,
+			MaxOpenConnections:     1000,
+			RPCReadTimeout:         10,
+			RPCMaxBodyBytes:        1000000,
+			HealthCheckTimeout:     5,
+			HealthBlockAgeDegraded: 30,
+			HealthBlockAgeFailing:  120,
 		},
 		GRPC: GRPCConfig{
 			Enable:         true,
@@ -283,6 +312,10 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Admin: AdminConfig{
+			Enable:  false,
+			Address: DefaultAdminAddress,
+		},
 	}
 }
 
@@ -321,14 +354,17 @@ func GetConfig(v *viper.Viper) Config {
 			GlobalLabels:            globalLabels,
 		},
 		API: APIConfig{
-			Enable:             v.GetBool("api.enable"),
-			Swagger:            v.GetBool("api.swagger"),
-			Address:            v.GetString("api.address"),
-			MaxOpenConnections: v.GetUint("api.max-open-connections"),
-			RPCReadTimeout:     v.GetUint("api.rpc-read-timeout"),
-			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
-			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
-			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			Enable:                 v.GetBool("api.enable"),
+			Swagger:                v.GetBool("api.swagger"),
+			Address:                v.GetString("api.address"),
+			MaxOpenConnections:     v.GetUint("api.max-open-connections"),
+			RPCReadTimeout:         v.GetUint("api.rpc-read-timeout"),
+			RPCWriteTimeout:        v.GetUint("api.rpc-write-timeout"),
+			RPCMaxBodyBytes:        v.GetUint("api.rpc-max-body-bytes"),
+			EnableUnsafeCORS:       v.GetBool("api.enabled-unsafe-cors"),
+			HealthCheckTimeout:     v.GetUint("api.health-check-timeout"),
+			HealthBlockAgeDegraded: v.GetUint("api.health-block-age-degraded"),
+			HealthBlockAgeFailing:  v.GetUint("api.health-block-age-failing"),
 		},
 		Rosetta: RosettaConfig{
 			Enable:              v.GetBool("rosetta.enable"),
@@ -356,6 +392,10 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Admin: AdminConfig{
+			Enable:  v.GetBool("admin.enable"),
+			Address: v.GetString("admin.address"),
+		},
 	}
 }
 
@@ -146,6 +146,18 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# HealthCheckTimeout defines the timeout of each check run by the /health and
+# /ready endpoints (in seconds).
+health-check-timeout = {{ .API.HealthCheckTimeout }}
+
+# HealthBlockAgeDegraded defines the time since the latest block (in seconds)
+# after which the /ready endpoint reports the node as degraded. 0 disables it.
+health-block-age-degraded = {{ .API.HealthBlockAgeDegraded }}
+
+# HealthBlockAgeFailing defines the time since the latest block (in seconds)
+# after which the /ready endpoint reports the node as not ready. 0 disables it.
+health-block-age-failing = {{ .API.HealthBlockAgeFailing }}
+
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
@@ -232,6 +244,22 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                           Admin Configuration                           ###
+###############################################################################
+
+# The admin gRPC service operates the running node: it updates the minimum gas
+# prices, the halt height and time, the pruning options and the log level, and
+# manages the state sync snapshots. Changes are applied between blocks.
+[admin]
+
+# Enable defines if the admin gRPC server should be enabled.
+enable = {{ .Admin.Enable }}
+
+# Address defines the admin gRPC server address to bind to, either a loopback
+# TCP address or a Unix socket as "unix:///path/to/socket".
+address = "{{ .Admin.Address }}"
 `
 
 var configTemplate *template.Template
@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/admin"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,6 +21,7 @@ const (
 	FlagHeight           = "height"
 	FlagForZeroHeight    = "for-zero-height"
 	FlagJailAllowedAddrs = "jail-allowed-addrs"
+	FlagLive             = "live"
 )
 
 // ExportCmd dumps app state to JSON.
@@ -37,6 +40,10 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
+			if live, _ := cmd.Flags().GetBool(FlagLive); live {
+				return exportLive(cmd, serverCtx)
+			}
+
 			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				return err
@@ -76,23 +83,7 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 				return err
 			}
 
-			doc.AppState = exported.AppState
-			doc.Validators = exported.Validators
-			doc.InitialHeight = exported.Height
-			doc.ConsensusParams = &tmtypes.ConsensusParams{
-				Block: tmtypes.BlockParams{
-					MaxBytes: exported.ConsensusParams.Block.MaxBytes,
-					MaxGas:   exported.ConsensusParams.Block.MaxGas,
-				},
-				Evidence: tmtypes.EvidenceParams{
-					MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
-					MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
-					MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
-				},
-				Validator: tmtypes.ValidatorParams{
-					PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
-				},
-			}
+			exported.UpdateGenesisDoc(doc)
 
 			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
 			// (except for stuff inside AppState). Inside AppState, we're free
@@ -112,6 +103,64 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 	cmd.Flags().Int64(FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
 	cmd.Flags().Bool(FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().Bool(FlagLive, false, "Export the state of the running node through its admin gRPC server, without stopping it")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the exported genesis to the given file instead of STDOUT (only with --live)")
 
 	return cmd
 }
+
+// exportLive exports the state of the running node through the admin gRPC
+// server configured in app.toml, streaming the genesis document to the output.
+func exportLive(cmd *cobra.Command, serverCtx *Context) error {
+	if !serverCtx.Viper.GetBool("admin.enable") {
+		return fmt.Errorf("the admin gRPC server is disabled in the [admin] section of app.toml")
+	}
+
+	height, _ := cmd.Flags().GetInt64(FlagHeight)
+	if height < 0 {
+		height = 0
+	}
+	forZeroHeight, _ := cmd.Flags().GetBool(FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(FlagJailAllowedAddrs)
+
+	conn, err := admin.Dial(serverCtx.Viper.GetString("admin.address"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := admin.NewServiceClient(conn).ExportGenesis(cmd.Context(), &admin.ExportGenesisRequest{
+		Height:           height,
+		ForZeroHeight:    forZeroHeight,
+		JailAllowedAddrs: jailAllowedAddrs,
+	})
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument); outputDocument != "" {
+		file, err := os.Create(outputDocument)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error exporting state: %w", err)
+		}
+		if _, err := out.Write(res.Chunk); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintln(out)
+	return err
+}
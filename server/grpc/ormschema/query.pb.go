@@ -0,0 +1,2408 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/ormschema/v1alpha1/query.proto
+
+package ormschema
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryModuleSchemasRequest is the request type for the Query/ModuleSchemas RPC method.
+type QueryModuleSchemasRequest struct {
+}
+
+func (m *QueryModuleSchemasRequest) Reset()         { *m = QueryModuleSchemasRequest{} }
+func (m *QueryModuleSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleSchemasRequest) ProtoMessage()    {}
+func (*QueryModuleSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{0}
+}
+func (m *QueryModuleSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleSchemasRequest.Merge(m, src)
+}
+func (m *QueryModuleSchemasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleSchemasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleSchemasRequest proto.InternalMessageInfo
+
+// QueryModuleSchemasResponse is the response type for the Query/ModuleSchemas RPC method.
+type QueryModuleSchemasResponse struct {
+	// schemas are the ORM schemas of the modules, sorted by module name.
+	Schemas []*ModuleSchema `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	// file_descriptors are the serialized google.protobuf.FileDescriptorProto of
+	// the files defining the tables of the modules along with all their
+	// dependencies.
+	FileDescriptors [][]byte `protobuf:"bytes,2,rep,name=file_descriptors,json=fileDescriptors,proto3" json:"file_descriptors,omitempty"`
+}
+
+func (m *QueryModuleSchemasResponse) Reset()         { *m = QueryModuleSchemasResponse{} }
+func (m *QueryModuleSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleSchemasResponse) ProtoMessage()    {}
+func (*QueryModuleSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{1}
+}
+func (m *QueryModuleSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleSchemasResponse.Merge(m, src)
+}
+func (m *QueryModuleSchemasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleSchemasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleSchemasResponse proto.InternalMessageInfo
+
+func (m *QueryModuleSchemasResponse) GetSchemas() []*ModuleSchema {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+func (m *QueryModuleSchemasResponse) GetFileDescriptors() [][]byte {
+	if m != nil {
+		return m.FileDescriptors
+	}
+	return nil
+}
+
+// QueryModuleSchemaRequest is the request type for the Query/ModuleSchema RPC method.
+type QueryModuleSchemaRequest struct {
+	// module_name is the name of the module in the app config.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleSchemaRequest) Reset()         { *m = QueryModuleSchemaRequest{} }
+func (m *QueryModuleSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleSchemaRequest) ProtoMessage()    {}
+func (*QueryModuleSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{2}
+}
+func (m *QueryModuleSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleSchemaRequest.Merge(m, src)
+}
+func (m *QueryModuleSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryModuleSchemaRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleSchemaResponse is the response type for the Query/ModuleSchema RPC method.
+type QueryModuleSchemaResponse struct {
+	// schema is the ORM schema of the module.
+	Schema *ModuleSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// file_descriptors are the serialized google.protobuf.FileDescriptorProto of
+	// the files defining the tables of the module along with all their
+	// dependencies.
+	FileDescriptors [][]byte `protobuf:"bytes,2,rep,name=file_descriptors,json=fileDescriptors,proto3" json:"file_descriptors,omitempty"`
+}
+
+func (m *QueryModuleSchemaResponse) Reset()         { *m = QueryModuleSchemaResponse{} }
+func (m *QueryModuleSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleSchemaResponse) ProtoMessage()    {}
+func (*QueryModuleSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{3}
+}
+func (m *QueryModuleSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleSchemaResponse.Merge(m, src)
+}
+func (m *QueryModuleSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryModuleSchemaResponse) GetSchema() *ModuleSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *QueryModuleSchemaResponse) GetFileDescriptors() [][]byte {
+	if m != nil {
+		return m.FileDescriptors
+	}
+	return nil
+}
+
+// ModuleSchema describes the ORM schema of a module.
+type ModuleSchema struct {
+	// module_name is the name of the module in the app config.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// store_key is the name of the KV store key of the module.
+	StoreKey string `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// prefix is the prefix preceding all the ORM keys in the module store.
+	Prefix []byte `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// files are the ORM files of the module.
+	Files []*FileSchema `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (m *ModuleSchema) Reset()         { *m = ModuleSchema{} }
+func (m *ModuleSchema) String() string { return proto.CompactTextString(m) }
+func (*ModuleSchema) ProtoMessage()    {}
+func (*ModuleSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{4}
+}
+func (m *ModuleSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleSchema.Merge(m, src)
+}
+func (m *ModuleSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleSchema proto.InternalMessageInfo
+
+func (m *ModuleSchema) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *ModuleSchema) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *ModuleSchema) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *ModuleSchema) GetFiles() []*FileSchema {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// FileSchema describes an ORM file of a module.
+type FileSchema struct {
+	// id is the varint encoded prefix of the keys of the file's tables.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proto_file_name is the name of the .proto file defining the tables.
+	ProtoFileName string `protobuf:"bytes,2,opt,name=proto_file_name,json=protoFileName,proto3" json:"proto_file_name,omitempty"`
+	// storage_type is the name of the cosmos.orm.v1alpha1.StorageType of the
+	// file's tables.
+	StorageType string `protobuf:"bytes,3,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	// tables are the tables defined in the file.
+	Tables []*TableSchema `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (m *FileSchema) Reset()         { *m = FileSchema{} }
+func (m *FileSchema) String() string { return proto.CompactTextString(m) }
+func (*FileSchema) ProtoMessage()    {}
+func (*FileSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{5}
+}
+func (m *FileSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSchema.Merge(m, src)
+}
+func (m *FileSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSchema proto.InternalMessageInfo
+
+func (m *FileSchema) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FileSchema) GetProtoFileName() string {
+	if m != nil {
+		return m.ProtoFileName
+	}
+	return ""
+}
+
+func (m *FileSchema) GetStorageType() string {
+	if m != nil {
+		return m.StorageType
+	}
+	return ""
+}
+
+func (m *FileSchema) GetTables() []*TableSchema {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+// TableSchema describes an ORM table.
+type TableSchema struct {
+	// id is the id of the table within its file.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// message_name is the fully-qualified name of the proto message stored in
+	// the table.
+	MessageName string `protobuf:"bytes,2,opt,name=message_name,json=messageName,proto3" json:"message_name,omitempty"`
+	// singleton indicates whether the table is a singleton, storing a single
+	// row under its id.
+	Singleton bool `protobuf:"varint,3,opt,name=singleton,proto3" json:"singleton,omitempty"`
+	// primary_key_fields are the names of the primary key fields.
+	PrimaryKeyFields []string `protobuf:"bytes,4,rep,name=primary_key_fields,json=primaryKeyFields,proto3" json:"primary_key_fields,omitempty"`
+	// auto_increment indicates whether the primary key is auto-incremented.
+	AutoIncrement bool `protobuf:"varint,5,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	// indexes are the secondary indexes of the table.
+	Indexes []*IndexSchema `protobuf:"bytes,6,rep,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *TableSchema) Reset()         { *m = TableSchema{} }
+func (m *TableSchema) String() string { return proto.CompactTextString(m) }
+func (*TableSchema) ProtoMessage()    {}
+func (*TableSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{6}
+}
+func (m *TableSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableSchema.Merge(m, src)
+}
+func (m *TableSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableSchema proto.InternalMessageInfo
+
+func (m *TableSchema) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TableSchema) GetMessageName() string {
+	if m != nil {
+		return m.MessageName
+	}
+	return ""
+}
+
+func (m *TableSchema) GetSingleton() bool {
+	if m != nil {
+		return m.Singleton
+	}
+	return false
+}
+
+func (m *TableSchema) GetPrimaryKeyFields() []string {
+	if m != nil {
+		return m.PrimaryKeyFields
+	}
+	return nil
+}
+
+func (m *TableSchema) GetAutoIncrement() bool {
+	if m != nil {
+		return m.AutoIncrement
+	}
+	return false
+}
+
+func (m *TableSchema) GetIndexes() []*IndexSchema {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// IndexSchema describes a secondary index of an ORM table.
+type IndexSchema struct {
+	// id is the id of the index within its table.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// fields are the names of the indexed fields.
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// unique indicates whether the index is unique.
+	Unique bool `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+}
+
+func (m *IndexSchema) Reset()         { *m = IndexSchema{} }
+func (m *IndexSchema) String() string { return proto.CompactTextString(m) }
+func (*IndexSchema) ProtoMessage()    {}
+func (*IndexSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac056ad72410f6bc, []int{7}
+}
+func (m *IndexSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexSchema.Merge(m, src)
+}
+func (m *IndexSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexSchema proto.InternalMessageInfo
+
+func (m *IndexSchema) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IndexSchema) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *IndexSchema) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryModuleSchemasRequest)(nil), "cosmos.base.ormschema.v1alpha1.QueryModuleSchemasRequest")
+	proto.RegisterType((*QueryModuleSchemasResponse)(nil), "cosmos.base.ormschema.v1alpha1.QueryModuleSchemasResponse")
+	proto.RegisterType((*QueryModuleSchemaRequest)(nil), "cosmos.base.ormschema.v1alpha1.QueryModuleSchemaRequest")
+	proto.RegisterType((*QueryModuleSchemaResponse)(nil), "cosmos.base.ormschema.v1alpha1.QueryModuleSchemaResponse")
+	proto.RegisterType((*ModuleSchema)(nil), "cosmos.base.ormschema.v1alpha1.ModuleSchema")
+	proto.RegisterType((*FileSchema)(nil), "cosmos.base.ormschema.v1alpha1.FileSchema")
+	proto.RegisterType((*TableSchema)(nil), "cosmos.base.ormschema.v1alpha1.TableSchema")
+	proto.RegisterType((*IndexSchema)(nil), "cosmos.base.ormschema.v1alpha1.IndexSchema")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/ormschema/v1alpha1/query.proto", fileDescriptor_ac056ad72410f6bc)
+}
+
+var fileDescriptor_ac056ad72410f6bc = []byte{
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4f, 0x13, 0x4d,
+	0x18, 0xc6, 0xd9, 0xf6, 0xa3, 0xd0, 0xb7, 0x2d, 0x90, 0x39, 0x90, 0xfd, 0x80, 0xf4, 0x2b, 0x9b,
+	0x7c, 0x5a, 0x10, 0xbb, 0x01, 0x63, 0x14, 0x8d, 0x89, 0x51, 0x6c, 0x42, 0x10, 0x13, 0x57, 0x4e,
+	0x5e, 0x9a, 0x69, 0xf7, 0xed, 0x32, 0xa1, 0xbb, 0xb3, 0xec, 0xec, 0x12, 0x36, 0xc6, 0x8b, 0x07,
+	0x4f, 0x26, 0x9a, 0xf8, 0x3f, 0x78, 0xf2, 0xee, 0xdd, 0x93, 0x47, 0x12, 0x2f, 0x1e, 0x0d, 0xf8,
+	0x57, 0x78, 0x32, 0x3b, 0x3b, 0x4b, 0x8b, 0xa0, 0x05, 0x4e, 0xcd, 0x3c, 0xf3, 0x3e, 0xef, 0xfc,
+	0x9e, 0x6c, 0xdf, 0x17, 0x16, 0x3b, 0x5c, 0xb8, 0x5c, 0x98, 0x6d, 0x2a, 0xd0, 0xe4, 0x81, 0x2b,
+	0x3a, 0xdb, 0xe8, 0x52, 0x73, 0x6f, 0x99, 0xf6, 0xfc, 0x6d, 0xba, 0x6c, 0xee, 0x46, 0x18, 0xc4,
+	0x0d, 0x3f, 0xe0, 0x21, 0x27, 0xd5, 0xb4, 0xb6, 0x91, 0xd4, 0x36, 0x8e, 0x6b, 0x1b, 0x59, 0xed,
+	0xcc, 0x9c, 0xc3, 0xb9, 0xd3, 0x43, 0x93, 0xfa, 0xcc, 0xa4, 0x9e, 0xc7, 0x43, 0x1a, 0x32, 0xee,
+	0x89, 0xd4, 0x6d, 0xcc, 0xc2, 0xbf, 0x4f, 0x93, 0x66, 0x9b, 0xdc, 0x8e, 0x7a, 0xf8, 0x4c, 0x7a,
+	0x85, 0x85, 0xbb, 0x11, 0x8a, 0xd0, 0x78, 0xab, 0xc1, 0xcc, 0x59, 0xb7, 0xc2, 0xe7, 0x9e, 0x40,
+	0xd2, 0x84, 0xb1, 0xf4, 0x31, 0xa1, 0x6b, 0xb5, 0x7c, 0xbd, 0xb4, 0xb2, 0xd4, 0xf8, 0x3b, 0x4b,
+	0x63, 0xb0, 0x8f, 0x95, 0x99, 0xc9, 0x02, 0x4c, 0x75, 0x59, 0x0f, 0x5b, 0x36, 0x8a, 0x4e, 0xc0,
+	0xfc, 0x90, 0x07, 0x42, 0xcf, 0xd5, 0xf2, 0xf5, 0xb2, 0x35, 0x99, 0xe8, 0x6b, 0x7d, 0xd9, 0xb8,
+	0x0b, 0xfa, 0x29, 0x20, 0x45, 0x4b, 0xfe, 0x83, 0x92, 0x2b, 0xe5, 0x96, 0x47, 0x5d, 0xd4, 0xb5,
+	0x9a, 0x56, 0x2f, 0x5a, 0x90, 0x4a, 0x4f, 0xa8, 0x8b, 0xc6, 0x1b, 0xed, 0x8c, 0xb0, 0xc7, 0x69,
+	0xd6, 0xa0, 0x90, 0x02, 0x49, 0xe7, 0x45, 0xc3, 0x28, 0xef, 0x45, 0xb2, 0x7c, 0xd0, 0xa0, 0x3c,
+	0xd8, 0x63, 0x68, 0x00, 0x32, 0x0b, 0x45, 0x11, 0xf2, 0x00, 0x5b, 0x3b, 0x18, 0xeb, 0x39, 0x79,
+	0x3d, 0x2e, 0x85, 0x0d, 0x8c, 0xc9, 0x34, 0x14, 0xfc, 0x00, 0xbb, 0x6c, 0x5f, 0xcf, 0xd7, 0xb4,
+	0x7a, 0xd9, 0x52, 0x27, 0x72, 0x1f, 0x46, 0x93, 0x97, 0x85, 0xfe, 0x8f, 0xfc, 0x46, 0x8b, 0xc3,
+	0x62, 0x35, 0xd9, 0x71, 0xa8, 0xd4, 0x68, 0x7c, 0xd4, 0x00, 0xfa, 0x2a, 0x99, 0x80, 0x1c, 0xb3,
+	0x25, 0x5d, 0xc5, 0xca, 0x31, 0x9b, 0x5c, 0x81, 0x49, 0xf9, 0x5f, 0x6a, 0x75, 0x59, 0x86, 0x9e,
+	0xb2, 0x55, 0xa4, 0xdc, 0x64, 0x8a, 0x7e, 0x1e, 0xca, 0x09, 0x2c, 0x75, 0xb0, 0x15, 0xc6, 0x3e,
+	0x4a, 0xcc, 0xa2, 0x55, 0x52, 0xda, 0x56, 0xec, 0x23, 0x79, 0x08, 0x85, 0x90, 0xb6, 0xfb, 0xb0,
+	0xd7, 0x86, 0xc1, 0x6e, 0xd1, 0x76, 0xc6, 0x65, 0x29, 0xab, 0xf1, 0x53, 0x83, 0xd2, 0x80, 0x7e,
+	0x8a, 0x77, 0x1e, 0xca, 0x2e, 0x0a, 0x41, 0x9d, 0x13, 0xb0, 0x25, 0xa5, 0x49, 0xd4, 0x39, 0x28,
+	0x0a, 0xe6, 0x39, 0x3d, 0x0c, 0xb9, 0x27, 0x39, 0xc7, 0xad, 0xbe, 0x40, 0x96, 0x80, 0xf8, 0x01,
+	0x73, 0x69, 0x10, 0x27, 0x1f, 0xa2, 0xd5, 0x65, 0xd8, 0xb3, 0x53, 0xe2, 0xa2, 0x35, 0xa5, 0x6e,
+	0x36, 0x30, 0x6e, 0x4a, 0x9d, 0xfc, 0x0f, 0x13, 0x34, 0x0a, 0x79, 0x8b, 0x79, 0x9d, 0x00, 0x5d,
+	0xf4, 0x42, 0x7d, 0x54, 0x36, 0xac, 0x24, 0xea, 0x7a, 0x26, 0x92, 0x47, 0x30, 0xc6, 0x3c, 0x1b,
+	0xf7, 0x51, 0xe8, 0x85, 0xf3, 0x65, 0x5f, 0x4f, 0xca, 0xb3, 0x59, 0x52, 0x5e, 0x63, 0x13, 0x4a,
+	0x03, 0xfa, 0xa9, 0xec, 0xd3, 0x50, 0x50, 0xb8, 0x39, 0x89, 0xab, 0x4e, 0x89, 0x1e, 0x79, 0x6c,
+	0x37, 0x42, 0x95, 0x56, 0x9d, 0x56, 0x5e, 0xe7, 0x61, 0x54, 0x8e, 0x0c, 0xf9, 0xa4, 0x41, 0xe5,
+	0xc4, 0x1a, 0x20, 0xab, 0xc3, 0x00, 0xff, 0xb8, 0x58, 0x66, 0xee, 0x5c, 0xc6, 0x9a, 0xce, 0xa9,
+	0x61, 0xbe, 0xfa, 0xfa, 0xe3, 0x7d, 0x6e, 0x81, 0x5c, 0x35, 0x87, 0x2c, 0xc9, 0x6c, 0xbd, 0x7c,
+	0xfe, 0x7d, 0xce, 0x6e, 0x5f, 0xf8, 0xf5, 0x8c, 0x7b, 0xf5, 0x12, 0x4e, 0x85, 0x7d, 0x4f, 0x62,
+	0xdf, 0x22, 0x37, 0xcf, 0x89, 0x6d, 0xbe, 0x18, 0xd8, 0x05, 0x2f, 0x1f, 0x3c, 0xfe, 0x72, 0x58,
+	0xd5, 0x0e, 0x0e, 0xab, 0xda, 0xf7, 0xc3, 0xaa, 0xf6, 0xee, 0xa8, 0x3a, 0x72, 0x70, 0x54, 0x1d,
+	0xf9, 0x76, 0x54, 0x1d, 0x79, 0xbe, 0xe2, 0xb0, 0x70, 0x3b, 0x6a, 0x37, 0x3a, 0xdc, 0xcd, 0x5a,
+	0xa7, 0x3f, 0xd7, 0x85, 0xbd, 0x63, 0x0a, 0x0c, 0xf6, 0x30, 0x30, 0x9d, 0xc0, 0xef, 0xf4, 0x1f,
+	0x6b, 0x17, 0xe4, 0x64, 0xde, 0xf8, 0x35, 0x00, 0xee, 0x8a, 0x87, 0xe4, 0x68, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleSchemas returns the ORM schemas of all the modules of the app.
+	ModuleSchemas(ctx context.Context, in *QueryModuleSchemasRequest, opts ...grpc.CallOption) (*QueryModuleSchemasResponse, error)
+	// ModuleSchema returns the ORM schema of a module.
+	ModuleSchema(ctx context.Context, in *QueryModuleSchemaRequest, opts ...grpc.CallOption) (*QueryModuleSchemaResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleSchemas(ctx context.Context, in *QueryModuleSchemasRequest, opts ...grpc.CallOption) (*QueryModuleSchemasResponse, error) {
+	out := new(QueryModuleSchemasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleSchema(ctx context.Context, in *QueryModuleSchemaRequest, opts ...grpc.CallOption) (*QueryModuleSchemaResponse, error) {
+	out := new(QueryModuleSchemaResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ModuleSchemas returns the ORM schemas of all the modules of the app.
+	ModuleSchemas(context.Context, *QueryModuleSchemasRequest) (*QueryModuleSchemasResponse, error)
+	// ModuleSchema returns the ORM schema of a module.
+	ModuleSchema(context.Context, *QueryModuleSchemaRequest) (*QueryModuleSchemaResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ModuleSchemas(ctx context.Context, req *QueryModuleSchemasRequest) (*QueryModuleSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleSchemas not implemented")
+}
+func (*UnimplementedQueryServer) ModuleSchema(ctx context.Context, req *QueryModuleSchemaRequest) (*QueryModuleSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleSchema not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ModuleSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleSchemas(ctx, req.(*QueryModuleSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.ormschema.v1alpha1.Query/ModuleSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleSchema(ctx, req.(*QueryModuleSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.ormschema.v1alpha1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleSchemas",
+			Handler:    _Query_ModuleSchemas_Handler,
+		},
+		{
+			MethodName: "ModuleSchema",
+			Handler:    _Query_ModuleSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/ormschema/v1alpha1/query.proto",
+}
+
+func (m *QueryModuleSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileDescriptors) > 0 {
+		for iNdEx := len(m.FileDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileDescriptors[iNdEx])
+			copy(dAtA[i:], m.FileDescriptors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FileDescriptors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileDescriptors) > 0 {
+		for iNdEx := len(m.FileDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileDescriptors[iNdEx])
+			copy(dAtA[i:], m.FileDescriptors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FileDescriptors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StorageType) > 0 {
+		i -= len(m.StorageType)
+		copy(dAtA[i:], m.StorageType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProtoFileName) > 0 {
+		i -= len(m.ProtoFileName)
+		copy(dAtA[i:], m.ProtoFileName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtoFileName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.AutoIncrement {
+		i--
+		if m.AutoIncrement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PrimaryKeyFields) > 0 {
+		for iNdEx := len(m.PrimaryKeyFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrimaryKeyFields[iNdEx])
+			copy(dAtA[i:], m.PrimaryKeyFields[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimaryKeyFields[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Singleton {
+		i--
+		if m.Singleton {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MessageName) > 0 {
+		i -= len(m.MessageName)
+		copy(dAtA[i:], m.MessageName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryModuleSchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleSchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FileDescriptors) > 0 {
+		for _, b := range m.FileDescriptors {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FileDescriptors) > 0 {
+		for _, b := range m.FileDescriptors {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FileSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.ProtoFileName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StorageType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TableSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.MessageName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Singleton {
+		n += 2
+	}
+	if len(m.PrimaryKeyFields) > 0 {
+		for _, s := range m.PrimaryKeyFields {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AutoIncrement {
+		n += 2
+	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Unique {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryModuleSchemasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleSchemasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleSchemasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleSchemasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, &ModuleSchema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileDescriptors = append(m.FileDescriptors, make([]byte, postIndex-iNdEx))
+			copy(m.FileDescriptors[len(m.FileDescriptors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ModuleSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileDescriptors = append(m.FileDescriptors, make([]byte, postIndex-iNdEx))
+			copy(m.FileDescriptors[len(m.FileDescriptors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &FileSchema{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoFileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoFileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &TableSchema{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Singleton", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Singleton = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeyFields = append(m.PrimaryKeyFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncrement", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoIncrement = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, &IndexSchema{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
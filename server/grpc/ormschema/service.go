@@ -0,0 +1,261 @@
+package ormschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	schemas map[string]*QueryModuleSchemaResponse
+	all     *QueryModuleSchemasResponse
+}
+
+// ModuleSchemas returns the ORM schemas declared by the config messages of the
+// modules of appConfig with the cosmos.orm.v1alpha1.module_schema option, by
+// module name. The config messages are resolved with protoregistry.GlobalTypes.
+func ModuleSchemas(appConfig *appv1alpha1.Config) (map[string]*ormv1alpha1.ModuleSchemaDescriptor, error) {
+	schemas := map[string]*ormv1alpha1.ModuleSchemaDescriptor{}
+	for _, module := range appConfig.Modules {
+		if module.Config == nil {
+			continue
+		}
+
+		msgType, err := protoregistry.GlobalTypes.FindMessageByURL(module.Config.TypeUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		opts := msgType.Descriptor().Options()
+		if opts == nil || !proto.HasExtension(opts, ormv1alpha1.E_ModuleSchema) {
+			continue
+		}
+
+		schemas[module.Name] = proto.GetExtension(opts, ormv1alpha1.E_ModuleSchema).(*ormv1alpha1.ModuleSchemaDescriptor)
+	}
+
+	return schemas, nil
+}
+
+// NewQueryServer returns the QueryServer describing the ORM schemas of the
+// modules, by module name. The store key names of the modules are looked up in
+// storeKeys and default to the module names. The files defining the tables are
+// resolved with files, or protoregistry.GlobalFiles if it is nil.
+func NewQueryServer(schemas map[string]*ormv1alpha1.ModuleSchemaDescriptor, storeKeys map[string]string, files protodesc.Resolver) (QueryServer, error) {
+	if files == nil {
+		files = protoregistry.GlobalFiles
+	}
+
+	moduleNames := make([]string, 0, len(schemas))
+	for name := range schemas {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	server := queryServer{
+		schemas: map[string]*QueryModuleSchemaResponse{},
+		all:     &QueryModuleSchemasResponse{},
+	}
+	allFiles := newFileSet()
+	for _, name := range moduleNames {
+		storeKey, ok := storeKeys[name]
+		if !ok {
+			storeKey = name
+		}
+
+		moduleFiles := newFileSet()
+		schema, err := buildModuleSchema(name, storeKey, schemas[name], files, moduleFiles)
+		if err != nil {
+			return nil, err
+		}
+
+		server.schemas[name] = &QueryModuleSchemaResponse{Schema: schema, FileDescriptors: moduleFiles.descriptors}
+		server.all.Schemas = append(server.all.Schemas, schema)
+		for _, file := range moduleFiles.files {
+			if err := allFiles.add(file); err != nil {
+				return nil, err
+			}
+		}
+	}
+	server.all.FileDescriptors = allFiles.descriptors
+
+	return server, nil
+}
+
+// RegisterQueryService registers the ORM schema discovery service of the
+// modules of appConfig on server. storeKeys maps the module names to the names
+// of their store keys when they differ.
+func RegisterQueryService(server gogogrpc.Server, appConfig *appv1alpha1.Config, storeKeys map[string]string) error {
+	schemas, err := ModuleSchemas(appConfig)
+	if err != nil {
+		return err
+	}
+
+	queryServer, err := NewQueryServer(schemas, storeKeys, nil)
+	if err != nil {
+		return err
+	}
+
+	RegisterQueryServer(server, queryServer)
+	return nil
+}
+
+// RegisterGRPCGatewayRoutes mounts the ORM schema discovery service's
+// GRPC-gateway routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+// ModuleSchemas implements QueryServer.ModuleSchemas
+func (s queryServer) ModuleSchemas(_ context.Context, _ *QueryModuleSchemasRequest) (*QueryModuleSchemasResponse, error) {
+	return s.all, nil
+}
+
+// ModuleSchema implements QueryServer.ModuleSchema
+func (s queryServer) ModuleSchema(_ context.Context, req *QueryModuleSchemaRequest) (*QueryModuleSchemaResponse, error) {
+	if req == nil || req.ModuleName == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty module name")
+	}
+
+	res, ok := s.schemas[req.ModuleName]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no ORM schema for module %s", req.ModuleName)
+	}
+
+	return res, nil
+}
+
+func buildModuleSchema(
+	name, storeKey string, descriptor *ormv1alpha1.ModuleSchemaDescriptor, files protodesc.Resolver, fileSet *fileSet,
+) (*ModuleSchema, error) {
+	schema := &ModuleSchema{
+		ModuleName: name,
+		StoreKey:   storeKey,
+		Prefix:     descriptor.Prefix,
+	}
+
+	for _, entry := range descriptor.SchemaFile {
+		file, err := files.FindFileByPath(entry.ProtoFileName)
+		if err != nil {
+			return nil, fmt.Errorf("ORM schema of module %s: %w", name, err)
+		}
+
+		if err := fileSet.add(file); err != nil {
+			return nil, err
+		}
+
+		fileSchema := &FileSchema{
+			Id:            entry.Id,
+			ProtoFileName: entry.ProtoFileName,
+			StorageType:   entry.StorageType.String(),
+		}
+
+		messages := file.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			if table := buildTableSchema(messages.Get(i)); table != nil {
+				fileSchema.Tables = append(fileSchema.Tables, table)
+			}
+		}
+
+		schema.Files = append(schema.Files, fileSchema)
+	}
+
+	return schema, nil
+}
+
+// buildTableSchema returns the schema of the table stored in messages of type
+// descriptor or nil if it isn't a table.
+func buildTableSchema(descriptor protoreflect.MessageDescriptor) *TableSchema {
+	opts := descriptor.Options()
+	if opts == nil {
+		return nil
+	}
+
+	if singleton, ok := proto.GetExtension(opts, ormv1.E_Singleton).(*ormv1.SingletonDescriptor); ok && singleton != nil {
+		return &TableSchema{
+			Id:          singleton.Id,
+			MessageName: string(descriptor.FullName()),
+			Singleton:   true,
+		}
+	}
+
+	table, ok := proto.GetExtension(opts, ormv1.E_Table).(*ormv1.TableDescriptor)
+	if !ok || table == nil {
+		return nil
+	}
+
+	schema := &TableSchema{
+		Id:               table.Id,
+		MessageName:      string(descriptor.FullName()),
+		PrimaryKeyFields: splitFields(table.PrimaryKey.GetFields()),
+		AutoIncrement:    table.PrimaryKey.GetAutoIncrement(),
+	}
+	for _, index := range table.Index {
+		schema.Indexes = append(schema.Indexes, &IndexSchema{
+			Id:     index.Id,
+			Fields: splitFields(index.Fields),
+			Unique: index.Unique,
+		})
+	}
+
+	return schema
+}
+
+func splitFields(fields string) []string {
+	if fields == "" {
+		return nil
+	}
+
+	return strings.Split(fields, ",")
+}
+
+// fileSet collects the serialized descriptors of files along with all their
+// dependencies, each dependency preceding the files importing it.
+type fileSet struct {
+	files       []protoreflect.FileDescriptor
+	seen        map[string]bool
+	descriptors [][]byte
+}
+
+func newFileSet() *fileSet {
+	return &fileSet{seen: map[string]bool{}}
+}
+
+func (s *fileSet) add(file protoreflect.FileDescriptor) error {
+	if s.seen[file.Path()] {
+		return nil
+	}
+	s.seen[file.Path()] = true
+
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err := s.add(imports.Get(i).FileDescriptor); err != nil {
+			return err
+		}
+	}
+
+	bz, err := proto.Marshal(protodesc.ToFileDescriptorProto(file))
+	if err != nil {
+		return err
+	}
+
+	s.files = append(s.files, file)
+	s.descriptors = append(s.descriptors, bz)
+	return nil
+}
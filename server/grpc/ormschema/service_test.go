@@ -0,0 +1,165 @@
+package ormschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func messageOptions(table *ormv1.TableDescriptor, singleton *ormv1.SingletonDescriptor) *descriptorpb.MessageOptions {
+	opts := &descriptorpb.MessageOptions{}
+	if table != nil {
+		proto.SetExtension(opts, ormv1.E_Table, table)
+	}
+	if singleton != nil {
+		proto.SetExtension(opts, ormv1.E_Singleton, singleton)
+	}
+	return opts
+}
+
+// testFiles returns the files resolver of an ORM file defining a table, a
+// singleton and a regular message.
+func testFiles(t *testing.T) *protoregistry.Files {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("testpb/state.proto"),
+		Package:    proto.String("testpb"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"cosmos/orm/v1/orm.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Balance"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("address", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("denom", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("amount", 3, descriptorpb.FieldDescriptorProto_TYPE_UINT64),
+				},
+				Options: messageOptions(&ormv1.TableDescriptor{
+					Id:         1,
+					PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "address,denom"},
+					Index:      []*ormv1.SecondaryIndexDescriptor{{Id: 1, Fields: "denom"}},
+				}, nil),
+			},
+			{
+				Name:    proto.String("Params"),
+				Field:   []*descriptorpb.FieldDescriptorProto{field("enabled", 1, descriptorpb.FieldDescriptorProto_TYPE_BOOL)},
+				Options: messageOptions(nil, &ormv1.SingletonDescriptor{Id: 2}),
+			},
+			{
+				Name:  proto.String("Event"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_UINT64)},
+			},
+		},
+	}
+
+	file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	files := &protoregistry.Files{}
+	require.NoError(t, files.RegisterFile(file))
+	return files
+}
+
+func TestQueryServer(t *testing.T) {
+	ctx := context.Background()
+	schemas := map[string]*ormv1alpha1.ModuleSchemaDescriptor{
+		"test": {
+			SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{{
+				Id:            1,
+				ProtoFileName: "testpb/state.proto",
+				StorageType:   ormv1alpha1.StorageType_STORAGE_TYPE_COMMITMENT,
+			}},
+			Prefix: []byte{0xa},
+		},
+	}
+
+	server, err := NewQueryServer(schemas, map[string]string{"test": "test_store"}, testFiles(t))
+	require.NoError(t, err)
+
+	res, err := server.ModuleSchema(ctx, &QueryModuleSchemaRequest{ModuleName: "test"})
+	require.NoError(t, err)
+	require.Equal(t, &ModuleSchema{
+		ModuleName: "test",
+		StoreKey:   "test_store",
+		Prefix:     []byte{0xa},
+		Files: []*FileSchema{{
+			Id:            1,
+			ProtoFileName: "testpb/state.proto",
+			StorageType:   "STORAGE_TYPE_COMMITMENT",
+			Tables: []*TableSchema{
+				{
+					Id:               1,
+					MessageName:      "testpb.Balance",
+					PrimaryKeyFields: []string{"address", "denom"},
+					Indexes:          []*IndexSchema{{Id: 1, Fields: []string{"denom"}}},
+				},
+				{Id: 2, MessageName: "testpb.Params", Singleton: true},
+			},
+		}},
+	}, res.Schema)
+
+	// the dependencies precede the files importing them
+	var fileNames []string
+	for _, bz := range res.FileDescriptors {
+		var fdp descriptorpb.FileDescriptorProto
+		require.NoError(t, proto.Unmarshal(bz, &fdp))
+		fileNames = append(fileNames, fdp.GetName())
+	}
+	require.Equal(t, []string{"google/protobuf/descriptor.proto", "cosmos/orm/v1/orm.proto", "testpb/state.proto"}, fileNames)
+
+	all, err := server.ModuleSchemas(ctx, &QueryModuleSchemasRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*ModuleSchema{res.Schema}, all.Schemas)
+	require.Equal(t, res.FileDescriptors, all.FileDescriptors)
+
+	_, err = server.ModuleSchema(ctx, &QueryModuleSchemaRequest{ModuleName: "bank"})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, err = server.ModuleSchema(ctx, &QueryModuleSchemaRequest{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the files of the schemas must be resolvable
+	schemas["other"] = &ormv1alpha1.ModuleSchemaDescriptor{
+		SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{{Id: 1, ProtoFileName: "testpb/unknown.proto"}},
+	}
+	_, err = NewQueryServer(schemas, nil, testFiles(t))
+	require.Error(t, err)
+}
+
+func TestModuleSchemas(t *testing.T) {
+	config, err := anypb.New(&runtimev1alpha1.Module{AppName: "test"})
+	require.NoError(t, err)
+
+	// the runtime module doesn't declare an ORM schema
+	schemas, err := ModuleSchemas(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{{Name: "runtime", Config: config}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, schemas)
+
+	_, err = ModuleSchemas(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{{Name: "unknown", Config: &anypb.Any{TypeUrl: "/unknown.Module"}}},
+	})
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"sync/atomic"
+
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 )
@@ -11,12 +13,58 @@ var _ tmlog.Logger = (*ZeroLogWrapper)(nil)
 // Tendermint's Logger interface.
 type ZeroLogWrapper struct {
 	zerolog.Logger
+
+	// level is the level shared by the logger and the loggers derived from it
+	// with With, which can be changed at runtime with SetLevel. It is nil if the
+	// level is only the one of the underlying zerolog.Logger.
+	level *int32
+}
+
+// NewLeveledZeroLogWrapper returns a ZeroLogWrapper around logger logging at
+// level. The level is shared with the loggers derived from it with With, and
+// can be changed while they are used with SetLevel.
+func NewLeveledZeroLogWrapper(logger zerolog.Logger, level zerolog.Level) ZeroLogWrapper {
+	lvl := int32(level)
+	return ZeroLogWrapper{Logger: logger, level: &lvl}
+}
+
+// GetLevel returns the current level of the logger.
+func (z ZeroLogWrapper) GetLevel() zerolog.Level {
+	if z.level == nil {
+		return z.Logger.GetLevel()
+	}
+	return zerolog.Level(atomic.LoadInt32(z.level))
+}
+
+// SetLevel changes the level of the logger and of the loggers sharing its
+// level. It returns false if the logger was not created by
+// NewLeveledZeroLogWrapper, in which case its level can't be changed.
+func (z ZeroLogWrapper) SetLevel(level zerolog.Level) bool {
+	if z.level == nil {
+		return false
+	}
+	atomic.StoreInt32(z.level, int32(level))
+	return true
+}
+
+// Unleveled returns a ZeroLogWrapper around the same zerolog.Logger which logs
+// regardless of the level set with SetLevel. It is used for the audit logs,
+// which must not be silenced by a change of the log level of the node.
+func (z ZeroLogWrapper) Unleveled() ZeroLogWrapper {
+	return ZeroLogWrapper{Logger: z.Logger}
+}
+
+func (z ZeroLogWrapper) enabled(level zerolog.Level) bool {
+	return z.level == nil || level >= zerolog.Level(atomic.LoadInt32(z.level))
 }
 
 // Info implements Tendermint's Logger interface and logs with level INFO. A set
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.InfoLevel) {
+		return
+	}
 	z.Logger.Info().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -24,6 +72,9 @@ func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.ErrorLevel) {
+		return
+	}
 	z.Logger.Error().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -31,6 +82,9 @@ func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.DebugLevel) {
+		return
+	}
 	z.Logger.Debug().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -38,7 +92,7 @@ func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
 // of key/value tuples. The number of tuples must be even and the key of the
 // tuple must be a string.
 func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
-	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	return ZeroLogWrapper{Logger: z.Logger.With().Fields(getLogFields(keyVals...)).Logger(), level: z.level}
 }
 
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
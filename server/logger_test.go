@@ -0,0 +1,48 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeveledZeroLogWrapper(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := NewLeveledZeroLogWrapper(zerolog.New(buf), zerolog.InfoLevel)
+	moduleLogger := logger.With("module", "test")
+
+	moduleLogger.Debug("debug")
+	moduleLogger.Info("info")
+	require.NotContains(t, buf.String(), `"message":"debug"`)
+	require.Contains(t, buf.String(), `"message":"info"`)
+
+	// the level is shared with the derived loggers
+	require.True(t, logger.SetLevel(zerolog.DebugLevel))
+	require.Equal(t, zerolog.DebugLevel, moduleLogger.(ZeroLogWrapper).GetLevel())
+	moduleLogger.Debug("debug")
+	require.Contains(t, buf.String(), `"message":"debug"`)
+
+	buf.Reset()
+	require.True(t, logger.SetLevel(zerolog.ErrorLevel))
+	moduleLogger.Info("info")
+	moduleLogger.Error("error")
+	require.NotContains(t, buf.String(), `"message":"info"`)
+	require.Contains(t, buf.String(), `"message":"error"`)
+
+	// the global level is left untouched
+	require.Equal(t, zerolog.TraceLevel, zerolog.GlobalLevel())
+
+	// the level of a logger not created by NewLeveledZeroLogWrapper can't be changed
+	require.False(t, ZeroLogWrapper{Logger: zerolog.New(buf)}.SetLevel(zerolog.DebugLevel))
+
+	// the unleveled loggers are not affected by the level changes
+	buf.Reset()
+	auditLogger := moduleLogger.(ZeroLogWrapper).Unleveled()
+	require.False(t, auditLogger.SetLevel(zerolog.DebugLevel))
+	auditLogger.Info("audit")
+	auditLogger.With("module", "audit").Info("audit")
+	require.Equal(t, 2, strings.Count(buf.String(), `"message":"audit"`))
+}
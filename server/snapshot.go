@@ -0,0 +1,356 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/light"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	FlagSnapshotDir = "snapshot-dir"
+	FlagScratchDir  = "scratch-dir"
+	FlagInMemory    = "in-memory"
+	FlagAppHash     = "app-hash"
+)
+
+// SnapshotsCmd returns the command handling the state sync snapshots of the node.
+func SnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "State sync snapshots subcommands",
+	}
+
+	cmd.AddCommand(VerifySnapshotCmd(appCreator, defaultNodeHome))
+
+	return cmd
+}
+
+// VerifySnapshotCmd returns the command restoring a snapshot into a scratch
+// application to check its app hash against a trusted one.
+func VerifySnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [height] [format]",
+		Short: "Verify a state sync snapshot against a trusted app hash",
+		Long: `Restore a state sync snapshot into a scratch application, including the state of
+the extension snapshotters, and compare the app hash of the restored state with a
+trusted app hash, before restoring the snapshot into the node.
+
+The snapshot is read from the snapshot store of the node, or from the directory
+given with --snapshot-dir, and is restored in a temporary directory which is removed
+afterwards, or in memory with --in-memory. The format defaults to the current
+snapshot format.
+
+The trusted app hash, committed in the header of the block following the snapshot
+height, is either given with --app-hash, or fetched from a light client configured
+with the rpc-servers, trust-height, trust-hash and trust-period of the [statesync]
+section of the Tendermint config. In the latter case the hash of each store is also
+checked against the light client, in order to report the mismatching stores.
+
+The node must not be running.
+`,
+		Example: fmt.Sprintf("%s snapshots verify 1000 --app-hash 8E1F...", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format := snapshottypes.CurrentFormat
+			if len(args) > 1 {
+				f, err := strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+				format = uint32(f)
+			}
+
+			trusted, err := getTrustedAppHash(cmd, serverCtx, int64(height))
+			if err != nil {
+				return err
+			}
+
+			snapshotDir, _ := cmd.Flags().GetString(FlagSnapshotDir)
+			if snapshotDir == "" {
+				snapshotDir = filepath.Join(config.RootDir, "data", "snapshots")
+			}
+			snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(serverCtx.Viper), snapshotDir)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+			if err != nil {
+				return err
+			}
+
+			commitInfo, err := restoreScratchSnapshot(cmd, appCreator, serverCtx, snapshotStore, height, format)
+			if err != nil {
+				return err
+			}
+
+			appHash := commitInfo.Hash()
+			cmd.Printf("Restored snapshot at height %d with format %d, app hash %X\n", height, format, appHash)
+			if bytes.Equal(appHash, trusted.appHash) {
+				cmd.Printf("The app hash matches the trusted app hash %X\n", trusted.appHash)
+				return nil
+			}
+
+			mismatches, err := trusted.mismatchingStores(cmd.Context(), commitInfo)
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("the app hash doesn't match the trusted app hash %X:\n%s", trusted.appHash, strings.Join(mismatches, "\n"))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagSnapshotDir, "", "The directory of the snapshot store, defaults to the snapshot store of the node")
+	cmd.Flags().String(FlagScratchDir, "", "The directory where the snapshot is restored, defaults to a temporary directory")
+	cmd.Flags().Bool(FlagInMemory, false, "Restore the state of the snapshot in memory")
+	cmd.Flags().String(FlagAppHash, "", "The trusted app hash in hex, fetched from a light client if empty")
+
+	return cmd
+}
+
+// trustedAppHash is the trusted app hash of a snapshot height.
+type trustedAppHash struct {
+	appHash []byte
+	height  int64
+	// node is the RPC client querying the proofs of the store hashes, nil if
+	// the app hash was supplied by the user.
+	node rpcclient.ABCIClient
+}
+
+// getTrustedAppHash returns the app hash supplied by the user, or fetches it
+// with a light client configured by the state sync config of the node.
+func getTrustedAppHash(cmd *cobra.Command, serverCtx *Context, height int64) (trustedAppHash, error) {
+	appHashStr, _ := cmd.Flags().GetString(FlagAppHash)
+	if appHashStr != "" {
+		appHash, err := hex.DecodeString(appHashStr)
+		if err != nil {
+			return trustedAppHash{}, fmt.Errorf("invalid app hash: %w", err)
+		}
+		return trustedAppHash{appHash: appHash, height: height}, nil
+	}
+
+	cfg := serverCtx.Config.StateSync
+	if len(cfg.RPCServers) < 2 {
+		return trustedAppHash{}, fmt.Errorf("the trusted app hash must be given with --%s, or at least two rpc-servers must be set in the [statesync] config to fetch it with a light client", FlagAppHash)
+	}
+	trustHash, err := hex.DecodeString(cfg.TrustHash)
+	if err != nil {
+		return trustedAppHash{}, fmt.Errorf("invalid trust-hash: %w", err)
+	}
+	genDoc, err := tmtypes.GenesisDocFromFile(serverCtx.Config.GenesisFile())
+	if err != nil {
+		return trustedAppHash{}, err
+	}
+
+	ctx := cmd.Context()
+	lc, err := light.NewHTTPClient(ctx, genDoc.ChainID, light.TrustOptions{
+		Period: cfg.TrustPeriod,
+		Height: cfg.TrustHeight,
+		Hash:   trustHash,
+	}, cfg.RPCServers[0], cfg.RPCServers[1:], lightdb.New(dbm.NewMemDB()), light.Logger(serverCtx.Logger))
+	if err != nil {
+		return trustedAppHash{}, fmt.Errorf("failed to create the light client: %w", err)
+	}
+
+	// the app hash of a height is committed in the header of the next one
+	block, err := lc.VerifyLightBlockAtHeight(ctx, height+1, time.Now())
+	if err != nil {
+		return trustedAppHash{}, fmt.Errorf("failed to verify the header at height %d: %w", height+1, err)
+	}
+
+	node, err := rpchttp.New(cfg.RPCServers[0])
+	if err != nil {
+		return trustedAppHash{}, err
+	}
+
+	return trustedAppHash{appHash: block.AppHash, height: height, node: node}, nil
+}
+
+// mismatchingStores returns the stores of commitInfo whose hashes don't match
+// the ones proven against the trusted app hash, or all the store hashes of
+// commitInfo if the trusted node is unknown.
+func (t trustedAppHash) mismatchingStores(ctx context.Context, commitInfo *storetypes.CommitInfo) ([]string, error) {
+	var mismatches []string
+	for _, info := range commitInfo.StoreInfos {
+		if t.node == nil {
+			mismatches = append(mismatches, fmt.Sprintf("%s: restored %X", info.Name, info.CommitId.Hash))
+			continue
+		}
+
+		hash, err := t.storeHash(ctx, info.Name)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case hash == nil:
+			mismatches = append(mismatches, fmt.Sprintf("%s: restored %X, not found in the trusted state", info.Name, info.CommitId.Hash))
+		case !bytes.Equal(hash, info.CommitId.Hash):
+			mismatches = append(mismatches, fmt.Sprintf("%s: restored %X, trusted %X", info.Name, info.CommitId.Hash, hash))
+		}
+	}
+
+	if len(mismatches) == 0 {
+		// the restored and trusted states have different sets of stores
+		mismatches = append(mismatches, "the trusted state has stores which are not in the snapshot")
+	}
+
+	return mismatches, nil
+}
+
+// storeHash queries the hash of a store at the trusted height, proven against
+// the trusted app hash. It returns nil if the store doesn't exist.
+func (t trustedAppHash) storeHash(ctx context.Context, storeName string) ([]byte, error) {
+	// any key returns the proof of the store hash in the multistore
+	res, err := t.node.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", storeName), []byte{0},
+		rpcclient.ABCIQueryOptions{Height: t.height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() || res.Response.ProofOps == nil || len(res.Response.ProofOps.Ops) == 0 {
+		return nil, nil
+	}
+
+	ops := res.Response.ProofOps.Ops
+	op, err := storetypes.CommitmentOpDecoder(ops[len(ops)-1])
+	if err != nil {
+		return nil, err
+	}
+	commitmentOp, ok := op.(storetypes.CommitmentOp)
+	if !ok || commitmentOp.Proof.GetExist() == nil || string(commitmentOp.Key) != storeName {
+		return nil, fmt.Errorf("invalid proof of store %s", storeName)
+	}
+
+	hash := commitmentOp.Proof.GetExist().Value
+	root, err := commitmentOp.Run([][]byte{hash})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[0], t.appHash) {
+		return nil, fmt.Errorf("the proof of store %s doesn't match the trusted app hash", storeName)
+	}
+
+	return hash, nil
+}
+
+// restoreScratchSnapshot restores a snapshot of store into a scratch
+// application created by appCreator, and returns the commit info of the
+// restored multistore.
+func restoreScratchSnapshot(
+	cmd *cobra.Command, appCreator types.AppCreator, serverCtx *Context, store *snapshots.Store, height uint64, format uint32,
+) (*storetypes.CommitInfo, error) {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot at height %d with format %d not found", height, format)
+	}
+	defer func() {
+		// consume the chunks left after a failure
+		for chunk := range chunks {
+			chunk.Close()
+		}
+	}()
+
+	scratchDir, _ := cmd.Flags().GetString(FlagScratchDir)
+	if scratchDir == "" {
+		scratchDir, err = os.MkdirTemp("", "snapshot-verify")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(scratchDir)
+	}
+
+	var db dbm.DB
+	if inMemory, _ := cmd.Flags().GetBool(FlagInMemory); inMemory {
+		db = dbm.NewMemDB()
+	} else {
+		db, err = openDB(scratchDir, GetAppDBBackend(serverCtx.Viper))
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer db.Close()
+
+	app := appCreator(serverCtx.Logger, db, nil, scratchAppOptions{AppOptions: serverCtx.Viper, home: scratchDir})
+	snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager })
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil, fmt.Errorf("the application doesn't support state sync snapshots")
+	}
+	manager := snapshotApp.SnapshotManager()
+
+	if err := manager.Restore(*snapshot); err != nil {
+		return nil, err
+	}
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return nil, err
+		}
+		done, err := manager.RestoreChunk(bz)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+	}
+
+	return rootmulti.NewStore(db, serverCtx.Logger).GetCommitInfo(int64(height))
+}
+
+// scratchAppOptions are the options of the scratch application a snapshot is
+// restored into. The application uses home as its home directory, and always
+// sets up a snapshot manager.
+type scratchAppOptions struct {
+	types.AppOptions
+	home string
+}
+
+// Get implements AppOptions
+func (o scratchAppOptions) Get(key string) interface{} {
+	switch key {
+	case flags.FlagHome:
+		return o.home
+	case FlagStateSyncSnapshotInterval:
+		// the snapshot manager is only set up with a snapshot interval
+		return uint64(1)
+	default:
+		return o.AppOptions.Get(key)
+	}
+}
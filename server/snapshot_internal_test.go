@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestTrustedAppHashMismatchingStores(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp("test", log.NewNopLogger(), db, nil)
+	keyA, keyB := storetypes.NewKVStoreKey("a"), storetypes.NewKVStoreKey("b")
+	app.MountStores(keyA, keyB)
+	require.NoError(t, app.LoadLatestVersion())
+
+	// proofs can only be queried after the second block
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.Commit()
+	}
+
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger()).GetCommitInfo(2)
+	require.NoError(t, err)
+	require.Len(t, commitInfo.StoreInfos, 2)
+
+	trusted := trustedAppHash{
+		appHash: commitInfo.Hash(),
+		height:  2,
+		node:    mock.ABCIApp{App: app},
+	}
+
+	hashA, err := trusted.storeHash(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, commitInfo.StoreInfos[0].CommitId.Hash, hashA)
+	hashC, err := trusted.storeHash(context.Background(), "c")
+	require.NoError(t, err)
+	require.Nil(t, hashC)
+
+	restored := &storetypes.CommitInfo{
+		Version: 2,
+		StoreInfos: []storetypes.StoreInfo{
+			commitInfo.StoreInfos[0],
+			{Name: "b", CommitId: storetypes.CommitID{Version: 2, Hash: []byte("hash")}},
+			{Name: "c", CommitId: storetypes.CommitID{Version: 2, Hash: []byte("hash")}},
+		},
+	}
+	mismatches, err := trusted.mismatchingStores(context.Background(), restored)
+	require.NoError(t, err)
+	require.Len(t, mismatches, 2)
+	require.Contains(t, mismatches[0], "b: restored 68617368, trusted")
+	require.Contains(t, mismatches[1], "c: restored 68617368, not found in the trusted state")
+
+	// the proofs are checked against the trusted app hash
+	trusted.appHash = []byte("app hash")
+	_, err = trusted.mismatchingStores(context.Background(), restored)
+	require.ErrorContains(t, err, "the proof of store a doesn't match the trusted app hash")
+}
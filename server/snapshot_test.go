@@ -0,0 +1,163 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestVerifySnapshotCmd(t *testing.T) {
+	homeDir := t.TempDir()
+	require.NoError(t, createConfigFolder(homeDir))
+	height, appHash := setupSnapshot(t, homeDir)
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+		expOut string
+	}{
+		{
+			"matching app hash",
+			[]string{fmt.Sprintf("%d", height), fmt.Sprintf("--%s=%X", server.FlagAppHash, appHash)},
+			"",
+			fmt.Sprintf("The app hash matches the trusted app hash %X", appHash),
+		},
+		{
+			"matching app hash restored in memory",
+			[]string{
+				fmt.Sprintf("%d", height), fmt.Sprintf("%d", snapshottypes.CurrentFormat),
+				fmt.Sprintf("--%s=%X", server.FlagAppHash, appHash), fmt.Sprintf("--%s", server.FlagInMemory),
+			},
+			"",
+			fmt.Sprintf("The app hash matches the trusted app hash %X", appHash),
+		},
+		{
+			"mismatching app hash",
+			[]string{fmt.Sprintf("%d", height), fmt.Sprintf("--%s=%X", server.FlagAppHash, []byte("app hash"))},
+			"the app hash doesn't match the trusted app hash",
+			fmt.Sprintf("Restored snapshot at height %d with format %d, app hash %X", height, snapshottypes.CurrentFormat, appHash),
+		},
+		{
+			"unknown snapshot",
+			[]string{fmt.Sprintf("%d", height+1), fmt.Sprintf("--%s=%X", server.FlagAppHash, appHash)},
+			fmt.Sprintf("snapshot at height %d with format %d not found", height+1, snapshottypes.CurrentFormat),
+			"",
+		},
+		{
+			"no trusted app hash",
+			[]string{fmt.Sprintf("%d", height)},
+			"the trusted app hash must be given",
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serverCtx := server.NewDefaultContext()
+			serverCtx.Config.RootDir = homeDir
+			ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+			cmd := server.VerifySnapshotCmd(newSnapshotApp, homeDir)
+			output := &bytes.Buffer{}
+			cmd.SetOut(output)
+			cmd.SetArgs(append(tc.args, fmt.Sprintf("--%s=%s", flags.FlagHome, homeDir)))
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Contains(t, output.String(), tc.expOut)
+		})
+	}
+
+	t.Run("mismatching stores are reported", func(t *testing.T) {
+		serverCtx := server.NewDefaultContext()
+		serverCtx.Config.RootDir = homeDir
+		ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+		cmd := server.VerifySnapshotCmd(newSnapshotApp, homeDir)
+		cmd.SetOut(io.Discard)
+		cmd.SetArgs([]string{
+			fmt.Sprintf("%d", height), fmt.Sprintf("--%s=%X", server.FlagAppHash, []byte("app hash")),
+			fmt.Sprintf("--%s=%s", flags.FlagHome, homeDir),
+		})
+
+		err := cmd.ExecuteContext(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "acc: restored")
+		require.Contains(t, err.Error(), "bank: restored")
+	})
+}
+
+// setupSnapshot commits the genesis of a simapp into homeDir and takes a
+// snapshot of it, returning its height and app hash.
+func setupSnapshot(t *testing.T, homeDir string) (uint64, []byte) {
+	t.Helper()
+
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	snapshotDB, err := dbm.NewDB("metadata", dbm.GoLevelDBBackend, snapshotDir)
+	require.NoError(t, err)
+	defer snapshotDB.Close()
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err)
+
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0,
+		simapp.MakeTestEncodingConfig(), simapp.EmptyAppOptions{},
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(1000, 2)),
+	)
+	genesisState := simapp.GenesisStateWithSingleValidator(t, app)
+	stateBytes, err := tmjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	app.Commit()
+
+	snapshot, err := app.SnapshotManager().Create(uint64(app.LastBlockHeight()))
+	require.NoError(t, err)
+
+	return snapshot.Height, app.LastCommitID().Hash
+}
+
+// newSnapshotApp creates a simapp with a snapshot manager, like the simd app
+// creator.
+func newSnapshotApp(logger log.Logger, db dbm.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), filepath.Join(homeDir, "data", "snapshots"))
+	if err != nil {
+		panic(err)
+	}
+
+	return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, homeDir, 0,
+		simapp.MakeTestEncodingConfig(), appOpts,
+		baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)), 2)),
+	)
+}
@@ -26,12 +26,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server/admin"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -364,6 +366,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		registerHealthChecks(apiSrv, app, config)
 		app.RegisterAPIRoutes(apiSrv, config.API)
 		errCh := make(chan error)
 
@@ -408,6 +411,27 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return WaitForQuitSignals()
 	}
 
+	var adminSrv *grpc.Server
+	if config.Admin.Enable {
+		adminApp, ok := app.(admin.App)
+		if !ok {
+			return fmt.Errorf("the application does not support the admin gRPC service")
+		}
+
+		// the admin requests are audited regardless of the log level
+		auditLogger := ctx.Logger
+		if logger, ok := ctx.Logger.(ZeroLogWrapper); ok {
+			auditLogger = logger.Unleveled()
+		}
+
+		adminSrv, err = admin.StartServer(
+			adminApp, ctx.Logger.With("module", "admin"), auditLogger.With("module", "admin"), config.Admin, cfg.GenesisFile(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	var rosettaSrv crgserver.Server
 	if config.Rosetta.Enable {
 		offlineMode := config.Rosetta.Offline
@@ -472,6 +496,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = apiSrv.Close()
 		}
 
+		if adminSrv != nil {
+			adminSrv.Stop()
+		}
+
 		if grpcSrv != nil {
 			grpcSrv.Stop()
 			if grpcWebSrv != nil {
@@ -487,3 +515,22 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// wait for signal capture and gracefully return
 	return WaitForQuitSignals()
 }
+
+// registerHealthChecks registers the default checks of the health and readiness
+// endpoints of the API server.
+func registerHealthChecks(apiSrv *api.Server, app types.Application, config serverconfig.Config) {
+	if config.GRPC.Enable {
+		apiSrv.RegisterLivenessCheck(api.NewGRPCHealthCheck(apiSrv.ClientCtx))
+	}
+
+	apiSrv.RegisterReadinessCheck(api.NewSyncHealthCheck(apiSrv.ClientCtx))
+	apiSrv.RegisterReadinessCheck(api.NewBlockAgeHealthCheck(
+		apiSrv.ClientCtx,
+		time.Duration(config.API.HealthBlockAgeDegraded)*time.Second,
+		time.Duration(config.API.HealthBlockAgeFailing)*time.Second,
+	))
+
+	if snapshotApp, ok := app.(interface{ SnapshotManager() *snapshots.Manager }); ok && snapshotApp.SnapshotManager() != nil {
+		apiSrv.RegisterReadinessCheck(api.NewSnapshotHealthCheck(snapshotApp.SnapshotManager()))
+	}
+}
@@ -78,3 +78,25 @@ type (
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions) (ExportedApp, error)
 )
+
+// UpdateGenesisDoc sets the app state, validators, initial height and
+// consensus params of doc to the exported ones.
+func (e ExportedApp) UpdateGenesisDoc(doc *tmtypes.GenesisDoc) {
+	doc.AppState = e.AppState
+	doc.Validators = e.Validators
+	doc.InitialHeight = e.Height
+	doc.ConsensusParams = &tmtypes.ConsensusParams{
+		Block: tmtypes.BlockParams{
+			MaxBytes: e.ConsensusParams.Block.MaxBytes,
+			MaxGas:   e.ConsensusParams.Block.MaxGas,
+		},
+		Evidence: tmtypes.EvidenceParams{
+			MaxAgeNumBlocks: e.ConsensusParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  e.ConsensusParams.Evidence.MaxAgeDuration,
+			MaxBytes:        e.ConsensusParams.Evidence.MaxBytes,
+		},
+		Validator: tmtypes.ValidatorParams{
+			PubKeyTypes: e.ConsensusParams.Validator.PubKeyTypes,
+		},
+	}
+}
@@ -58,7 +58,7 @@ func NewDefaultContext() *Context {
 	return NewContext(
 		viper.New(),
 		tmcfg.DefaultConfig(),
-		ZeroLogWrapper{log.Logger},
+		ZeroLogWrapper{Logger: log.Logger},
 	)
 }
 
@@ -158,7 +158,9 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
 	}
 
-	serverCtx.Logger = ZeroLogWrapper{zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	// the level is shared by the loggers derived from the server logger, so that
+	// the admin service can change it at runtime
+	serverCtx.Logger = NewLeveledZeroLogWrapper(zerolog.New(logWriter).With().Timestamp().Logger(), logLvl)
 
 	return SetCmdServerContext(cmd, serverCtx)
 }
@@ -295,6 +297,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(defaultNodeHome),
+		SnapshotsCmd(appCreator, defaultNodeHome),
 	)
 }
 
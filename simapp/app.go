@@ -133,7 +133,7 @@ var (
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
+		distrtypes.ModuleName:          {authtypes.Burner},
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
@@ -251,14 +251,14 @@ func NewSimApp(
 
 	initParamsKeeper(app.ParamsKeeper)
 
-	app.MintKeeper = mintkeeper.NewKeeper(
-		app.appCodec, app.keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.StakingKeeper,
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		app.appCodec, app.keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		app.StakingKeeper, authtypes.FeeCollectorName,
 	)
+	app.MintKeeper = mintkeeper.NewKeeper(
+		app.appCodec, app.keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.StakingKeeper,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName,
+	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
@@ -295,12 +295,26 @@ func NewSimApp(
 		// register the governance hooks
 		),
 	)
+	// lock the tokens of the conviction votes in the bank module
+	app.BankKeeper.AddLockedCoinsFn(app.GovKeeper.ConvictionLockedCoins)
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, app.keys[upgradetypes.StoreKey], app.appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// let the validators skip an emergency halt the chain can't be resumed from
+	skipHaltHeights := make(map[int64]bool)
+	for _, h := range cast.ToIntSlice(appOpts.Get(upgrade.FlagUnsafeSkipHalts)) {
+		skipHaltHeights[int64(h)] = true
+	}
+	app.UpgradeKeeper.SetSkipHaltHeights(skipHaltHeights)
+	// allow the security council configured in app.toml, usually a group policy,
+	// to schedule emergency halts
+	if council := cast.ToString(appOpts.Get(upgrade.AppConfigSecurityCouncil)); council != "" {
+		app.UpgradeKeeper.SetSecurityCouncil(council)
+	}
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
-		app.appCodec, app.keys[evidencetypes.StoreKey], app.StakingKeeper, app.SlashingKeeper,
+		app.appCodec, app.keys[evidencetypes.StoreKey], app.GetSubspace(evidencetypes.ModuleName),
+		app.StakingKeeper, app.SlashingKeeper,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -381,6 +395,9 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// persist the progress of the incremental module migrations in the upgrade module
+	app.ModuleManager.SetMigrationStore(app.Configurator(), app.UpgradeKeeper)
+
 	return app
 }
 
@@ -479,6 +496,9 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
 
+	// report the upgrade halt in the readiness endpoint
+	apiSvr.RegisterReadinessCheck(upgradeclient.NewHaltHealthCheck(apiSvr.ClientCtx))
+
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router)
@@ -511,4 +531,5 @@ func initParamsKeeper(paramsKeeper paramskeeper.Keeper) {
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govv1.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(evidencetypes.ModuleName)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -14,8 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -66,6 +69,34 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppExportAtHeight(t *testing.T) {
+	app := Setup(t, false)
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	latest, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest.Height)
+
+	exported, err := app.ExportAppStateAndValidatorsAtHeight(0, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, latest, exported)
+
+	exported, err = app.ExportAppStateAndValidatorsAtHeight(1, true, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), exported.Height)
+
+	// the preparation for height zero doesn't change the state of the app
+	exported, err = app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	require.Equal(t, latest, exported)
+
+	_, err = app.ExportAppStateAndValidatorsAtHeight(3, false, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	_, err = app.ExportAppStateAndValidatorsAtHeight(0, true, []string{"invalid"})
+	require.Error(t, err)
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -83,6 +114,8 @@ func TestRunMigrations(t *testing.T) {
 	bApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
 	app.BaseApp = bApp
 	configurator := module.NewConfigurator(app.appCodec, bApp.MsgServiceRouter(), app.GRPCQueryRouter())
+	// The new baseapp has no store mounted to track incremental migrations.
+	app.ModuleManager.SetMigrationStore(configurator, nil)
 
 	// We register all modules on the Configurator, except x/bank. x/bank will
 	// serve as the test subject on which we run the migration tests.
@@ -200,6 +233,20 @@ func TestRunMigrations(t *testing.T) {
 	}
 }
 
+func TestSecurityCouncilFromAppConfig(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	council := authtypes.NewModuleAddress("council").String()
+
+	appOpts := viper.New()
+	appOpts.Set(upgrade.AppConfigSecurityCouncil, council)
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, appOpts)
+	require.Equal(t, council, app.UpgradeKeeper.SecurityCouncil())
+
+	// no security council is set by default
+	app = NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	require.Empty(t, app.UpgradeKeeper.SecurityCouncil())
+}
+
 func TestInitGenesisOnMigration(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCfg := MakeTestEncodingConfig()
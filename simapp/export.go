@@ -21,10 +21,43 @@ func (app *SimApp) ExportAppStateAndValidators(
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return app.exportAppStateAndValidators(ctx, forZeroHeight, jailAllowedAddrs)
+}
+
+// ExportAppStateAndValidatorsAtHeight exports the state of a committed height of
+// the running application for a genesis file. The state is read from an
+// immutable view of the height, so that the changes made to prepare a zero
+// height genesis are discarded.
+func (app *SimApp) ExportAppStateAndValidatorsAtHeight(
+	height int64, forZeroHeight bool, jailAllowedAddrs []string,
+) (exported servertypes.ExportedApp, err error) {
+	for _, addr := range jailAllowedAddrs {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
+	ctx, err := app.NewContextAtHeight(height)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	// the node must keep running if the state can't be prepared for height zero
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to export the state of height %d: %v", ctx.BlockHeight(), r)
+		}
+	}()
 
+	return app.exportAppStateAndValidators(ctx, forZeroHeight, jailAllowedAddrs)
+}
+
+func (app *SimApp) exportAppStateAndValidators(
+	ctx sdk.Context, forZeroHeight bool, jailAllowedAddrs []string,
+) (servertypes.ExportedApp, error) {
 	// We export at last height + 1, because that's the height at which
 	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
+	height := ctx.BlockHeight() + 1
 	if forZeroHeight {
 		height = 0
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
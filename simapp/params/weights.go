@@ -18,6 +18,7 @@ const (
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
 	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgTransferDelegation          int = 50
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
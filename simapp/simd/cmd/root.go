@@ -34,6 +34,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -110,10 +111,18 @@ func initAppConfig() (string, interface{}) {
 		LruSize uint64 `mapstructure:"lru_size"`
 	}
 
+	// UpgradeConfig defines configuration for the upgrade module.
+	type UpgradeConfig struct {
+		// SecurityCouncil is the address allowed to schedule emergency halts
+		// along with governance. It must be the same on every node.
+		SecurityCouncil string `mapstructure:"security_council"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
 
-		WASM WASMConfig `mapstructure:"wasm"`
+		WASM    WASMConfig    `mapstructure:"wasm"`
+		Upgrade UpgradeConfig `mapstructure:"upgrade"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -147,7 +156,12 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0
+
+[upgrade]
+# The address, usually a group policy, allowed to schedule emergency halts along with governance.
+# It must be the same on every node, as the halts it schedules are rejected by the nodes without it.
+security_council = "{{ .Upgrade.SecurityCouncil }}"`
 
 	return customAppTemplate, customAppConfig
 }
@@ -163,6 +177,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.GenTxCmd(simapp.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(simapp.ModuleBasics),
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
+		genesisCommand(),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -186,6 +201,23 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	upgrade.AddModuleInitFlags(startCmd)
+}
+
+func genesisCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Genesis-related subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		genutilcli.CeremonyCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
+	)
+
+	return cmd
 }
 
 func queryCommand() *cobra.Command {
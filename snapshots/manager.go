@@ -135,6 +135,14 @@ func (m *Manager) endLocked() {
 	m.restoreChunkIndex = 0
 }
 
+// CurrentOperation returns the name of the operation in progress (snapshot, prune or restore),
+// or an empty string if the manager is idle.
+func (m *Manager) CurrentOperation() string {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return string(m.operation)
+}
+
 // GetInterval returns snapshot interval represented in heights.
 func (m *Manager) GetInterval() uint64 {
 	return m.opts.Interval
@@ -252,6 +260,24 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 	return m.store.Prune(retain)
 }
 
+// Delete deletes a snapshot, if no other operations are in progress.
+func (m *Manager) Delete(height uint64, format uint32) error {
+	err := m.begin(opPrune)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no snapshot for height %v format %v", height, format)
+	}
+	return m.store.Delete(height, format)
+}
+
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -128,6 +129,26 @@ func TestManager_Prune(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_Delete(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, opts, &mockSnapshotter{}, nil, log.NewNopLogger())
+
+	require.NoError(t, manager.Delete(2, 1))
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	// deleting a missing snapshot should error
+	err = manager.Delete(2, 1)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// Delete should error while a snapshot is being taken
+	manager = setupBusyManager(t)
+	err = manager.Delete(2, 1)
+	require.Error(t, err)
+}
+
 func TestManager_Restore(t *testing.T) {
 	store := setupStore(t)
 	target := &mockSnapshotter{
@@ -177,6 +198,7 @@ func TestManager_Restore(t *testing.T) {
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
+	require.Equal(t, "restore", manager.CurrentOperation())
 
 	// While the restore is in progress, any other operations fail
 	_, err = manager.Create(4)
@@ -204,6 +226,7 @@ func TestManager_Restore(t *testing.T) {
 	}
 
 	assert.Equal(t, expectItems, target.items)
+	require.Empty(t, manager.CurrentOperation())
 
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
@@ -67,7 +67,33 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+
+	var fileOpts []file.Option
+	if maxSize := cast.ToInt64(opts.Get("streamers.file.max_file_size")); maxSize > 0 {
+		fileOpts = append(fileOpts, file.WithMaxFileSize(maxSize))
+	}
+	if maxAge := cast.ToDuration(opts.Get("streamers.file.max_file_age")); maxAge > 0 {
+		fileOpts = append(fileOpts, file.WithMaxFileAge(maxAge))
+	}
+	switch compression := cast.ToString(opts.Get("streamers.file.compression")); compression {
+	case "", "none":
+	case "zstd":
+		fileOpts = append(fileOpts, file.WithCompression())
+	default:
+		return nil, fmt.Errorf("unsupported file streaming compression %s", compression)
+	}
+	filter, err := file.ParseKeyFilter(
+		cast.ToStringSlice(opts.Get("streamers.file.include")),
+		cast.ToStringSlice(opts.Get("streamers.file.exclude")),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !filter.Empty() {
+		fileOpts = append(fileOpts, file.WithKeyFilter(filter))
+	}
+
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, fileOpts...)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
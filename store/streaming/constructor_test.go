@@ -57,6 +57,7 @@ func TestLoadStreamingServices(t *testing.T) {
 	testCases := map[string]struct {
 		appOpts            serverTypes.AppOptions
 		activeStreamersLen int
+		expErr             bool
 	}{
 		"empty app options": {
 			appOpts: simapp.EmptyAppOptions{},
@@ -72,11 +73,37 @@ func TestLoadStreamingServices(t *testing.T) {
 		"not exposing anything": {
 			appOpts: streamingAppOptions{keys: []string{"mockKey3"}},
 		},
+		"file options": {
+			appOpts: streamingAppOptions{keys: []string{"*"}, fileOpts: map[string]interface{}{
+				"streamers.file.max_file_size": 1 << 20,
+				"streamers.file.max_file_age":  "1h",
+				"streamers.file.compression":   "zstd",
+				"streamers.file.include":       []string{"mockKey1", "mockKey2/01"},
+				"streamers.file.exclude":       []string{"mockKey1/0102"},
+			}},
+			activeStreamersLen: 1,
+		},
+		"unsupported compression": {
+			appOpts: streamingAppOptions{keys: []string{"*"}, fileOpts: map[string]interface{}{
+				"streamers.file.compression": "gzip",
+			}},
+			expErr: true,
+		},
+		"invalid key filter": {
+			appOpts: streamingAppOptions{keys: []string{"*"}, fileOpts: map[string]interface{}{
+				"streamers.file.include": []string{"mockKey1/xyz"},
+			}},
+			expErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			activeStreamers, _, err := streaming.LoadStreamingServices(bApp, tc.appOpts, encCdc.Codec, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 			require.Equal(t, tc.activeStreamersLen, len(activeStreamers))
 		})
@@ -84,7 +111,8 @@ func TestLoadStreamingServices(t *testing.T) {
 }
 
 type streamingAppOptions struct {
-	keys []string
+	keys     []string
+	fileOpts map[string]interface{}
 }
 
 func (ao streamingAppOptions) Get(o string) interface{} {
@@ -94,6 +122,6 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 	case "streamers.file.keys":
 		return ao.keys
 	default:
-		return nil
+		return ao.fileOpts[o]
 	}
 }
@@ -0,0 +1,135 @@
+package file
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Option configures a StreamingService
+type Option func(*StreamingService)
+
+// WithMaxFileSize batches the output of several blocks into a single file, which is rotated once
+// at least size bytes were written to it. Files are only rotated at the end of a block.
+func WithMaxFileSize(size int64) Option {
+	return func(fss *StreamingService) {
+		fss.maxFileSize = size
+	}
+}
+
+// WithMaxFileAge batches the output of several blocks into a single file, which is rotated once
+// the block time advanced by at least age since the first block written to it. Files are only
+// rotated at the end of a block.
+func WithMaxFileAge(age time.Duration) Option {
+	return func(fss *StreamingService) {
+		fss.maxFileAge = age
+	}
+}
+
+// WithCompression compresses the output files with zstd
+func WithCompression() Option {
+	return func(fss *StreamingService) {
+		fss.compress = true
+	}
+}
+
+// WithKeyFilter only writes out the state changes allowed by filter
+func WithKeyFilter(filter KeyFilter) Option {
+	return func(fss *StreamingService) {
+		fss.filter = filter
+	}
+}
+
+// KeyPrefix matches the keys of a KVStore starting with Prefix, or all of its keys if Prefix is empty
+type KeyPrefix struct {
+	StoreKey string
+	Prefix   []byte
+}
+
+// ParseKeyPrefix parses a KeyPrefix from its string representation, "{store key}" or
+// "{store key}/{hex encoded key prefix}"
+func ParseKeyPrefix(s string) (KeyPrefix, error) {
+	storeKey, hexPrefix, _ := strings.Cut(s, "/")
+	if storeKey == "" {
+		return KeyPrefix{}, fmt.Errorf("invalid key prefix %q: empty store key", s)
+	}
+	prefix, err := hex.DecodeString(hexPrefix)
+	if err != nil {
+		return KeyPrefix{}, fmt.Errorf("invalid key prefix %q: %w", s, err)
+	}
+	return KeyPrefix{StoreKey: storeKey, Prefix: prefix}, nil
+}
+
+// Matches returns true if key of the KVStore named storeKey starts with the prefix
+func (kp KeyPrefix) Matches(storeKey string, key []byte) bool {
+	return kp.StoreKey == storeKey && bytes.HasPrefix(key, kp.Prefix)
+}
+
+// KeyFilter selects the state changes written out by the StreamingService.
+// A state change is written out if it matches any of the Include prefixes, or if Include is empty,
+// and none of the Exclude prefixes.
+type KeyFilter struct {
+	Include []KeyPrefix
+	Exclude []KeyPrefix
+}
+
+// ParseKeyFilter parses the include and exclude KeyPrefixes of a KeyFilter
+func ParseKeyFilter(include, exclude []string) (KeyFilter, error) {
+	var filter KeyFilter
+	for _, s := range include {
+		kp, err := ParseKeyPrefix(s)
+		if err != nil {
+			return KeyFilter{}, err
+		}
+		filter.Include = append(filter.Include, kp)
+	}
+	for _, s := range exclude {
+		kp, err := ParseKeyPrefix(s)
+		if err != nil {
+			return KeyFilter{}, err
+		}
+		filter.Exclude = append(filter.Exclude, kp)
+	}
+	return filter, nil
+}
+
+// Empty returns true if the filter allows all the state changes
+func (f KeyFilter) Empty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Allow returns true if the state change of key in the KVStore named storeKey should be written out
+func (f KeyFilter) Allow(storeKey string, key []byte) bool {
+	for _, kp := range f.Exclude {
+		if kp.Matches(storeKey, key) {
+			return false
+		}
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, kp := range f.Include {
+		if kp.Matches(storeKey, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterListener is a WriteListener only forwarding the writes allowed by a KeyFilter
+type filterListener struct {
+	types.WriteListener
+	filter KeyFilter
+}
+
+// OnWrite satisfies the WriteListener interface
+func (fl filterListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	if !fl.filter.Allow(storeKey.Name(), key) {
+		return nil
+	}
+	return fl.WriteListener.OnWrite(storeKey, key, value, delete)
+}
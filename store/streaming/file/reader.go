@@ -0,0 +1,269 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	// the names of the files containing a single block phase: block-{N}-begin, block-{N}-tx-{M} and block-{N}-end
+	phaseFileRegexp = regexp.MustCompile(`(?:^|-)block-(\d+)-(begin|end|tx-(\d+))$`)
+	// the names of the files batching several blocks: blocks-{N}
+	batchFileRegexp = regexp.MustCompile(`(?:^|-)blocks-(\d+)$`)
+)
+
+// Record is the ABCI request and response of a block phase along with the state changes that occurred due to the request
+type Record struct {
+	Height       int64
+	TxIndex      int64                // the index of the tx in the block for DeliverTx records
+	Request      codec.ProtoMarshaler // *abci.RequestBeginBlock, *abci.RequestDeliverTx or *abci.RequestEndBlock
+	Response     codec.ProtoMarshaler // *abci.ResponseBeginBlock, *abci.ResponseDeliverTx or *abci.ResponseEndBlock
+	StateChanges []types.StoreKVPair
+}
+
+// Reader iterates over the Records of the files written out by the StreamingService
+type Reader struct {
+	r      *bufio.Reader
+	closer func() error
+	codec  codec.BinaryCodec
+
+	// size of the file if it is known, bounding the size of its messages, 0 otherwise
+	size uint64
+
+	// phase of the file if it only contains a single block phase, 0 if it batches several blocks
+	phase   byte
+	done    bool
+	height  int64
+	txIndex int64
+}
+
+// NewReader creates a Reader over the Records of a batched file read from r, after its decompression
+func NewReader(r io.Reader, c codec.BinaryCodec) *Reader {
+	return &Reader{
+		r:      bufio.NewReader(r),
+		closer: func() error { return nil },
+		codec:  c,
+	}
+}
+
+// OpenFile opens a file written out by the StreamingService, either batched or containing a single
+// block phase, and creates a Reader over its Records. The file is decompressed if it was compressed.
+func OpenFile(name string, c codec.BinaryCodec) (*Reader, error) {
+	baseName := filepath.Base(name)
+	compressed := strings.HasSuffix(baseName, compressedFileSuffix)
+	baseName = strings.TrimSuffix(baseName, compressedFileSuffix)
+
+	var (
+		phase   byte
+		height  int64
+		txIndex int64
+		err     error
+	)
+	if m := phaseFileRegexp.FindStringSubmatch(baseName); m != nil {
+		if height, err = strconv.ParseInt(m[1], 10, 64); err != nil {
+			return nil, err
+		}
+		switch {
+		case m[2] == "begin":
+			phase = phaseBeginBlock
+		case m[2] == "end":
+			phase = phaseEndBlock
+		default:
+			phase = phaseDeliverTx
+			if txIndex, err = strconv.ParseInt(m[3], 10, 64); err != nil {
+				return nil, err
+			}
+		}
+	} else if !batchFileRegexp.MatchString(baseName) {
+		return nil, fmt.Errorf("%s is not a file written by the file streaming service", name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	var size uint64
+	if !compressed {
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		size = uint64(info.Size())
+	}
+	var src io.Reader = f
+	closer := f.Close
+	if compressed {
+		dec, err := zstd.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		src = dec
+		closer = func() error {
+			dec.Close()
+			return f.Close()
+		}
+	}
+
+	reader := NewReader(src, c)
+	reader.closer = closer
+	reader.size = size
+	reader.phase = phase
+	reader.height = height
+	reader.txIndex = txIndex
+	return reader, nil
+}
+
+// Next returns the next Record, or io.EOF once all the Records were read
+func (r *Reader) Next() (*Record, error) {
+	if r.phase != 0 {
+		return r.nextPhaseRecord()
+	}
+
+	phase, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	count, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	reqBz, err := r.readMessage()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	// the count is not trusted to preallocate the state changes, the reads failing
+	// at the end of a corrupted file instead
+	var stateChanges [][]byte
+	for i := uint64(0); i < count; i++ {
+		bz, err := r.readMessage()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		stateChanges = append(stateChanges, bz)
+	}
+	resBz, err := r.readMessage()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return r.decodeRecord(phase, reqBz, stateChanges, resBz)
+}
+
+// nextPhaseRecord returns the single Record of a file containing a single block phase, where the
+// first message is the request, the last one the response and every message in between a StoreKVPair
+func (r *Reader) nextPhaseRecord() (*Record, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	r.done = true
+
+	var messages [][]byte
+	for {
+		bz, err := r.readMessage()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		messages = append(messages, bz)
+	}
+	if len(messages) < 2 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return r.decodeRecord(r.phase, messages[0], messages[1:len(messages)-1], messages[len(messages)-1])
+}
+
+// readMessage reads a length-prefixed message. The size of the message is bounded by the size of the
+// file when it is known, and the message is read without allocating its size upfront otherwise, so that
+// a corrupted size can't exhaust the memory.
+func (r *Reader) readMessage() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if r.size > 0 && size > r.size {
+		return nil, fmt.Errorf("message size %d exceeds the file size %d", size, r.size)
+	}
+	if size > math.MaxInt64 {
+		return nil, fmt.Errorf("message size %d is too large", size)
+	}
+	bz, err := io.ReadAll(io.LimitReader(r.r, int64(size)))
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if uint64(len(bz)) < size {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return bz, nil
+}
+
+func (r *Reader) decodeRecord(phase byte, reqBz []byte, stateChanges [][]byte, resBz []byte) (*Record, error) {
+	record := &Record{StateChanges: make([]types.StoreKVPair, len(stateChanges))}
+	switch phase {
+	case phaseBeginBlock:
+		req := new(abci.RequestBeginBlock)
+		if err := r.codec.Unmarshal(reqBz, req); err != nil {
+			return nil, err
+		}
+		r.height = req.GetHeader().Height
+		r.txIndex = 0
+		record.Request, record.Response = req, new(abci.ResponseBeginBlock)
+	case phaseDeliverTx:
+		req := new(abci.RequestDeliverTx)
+		if err := r.codec.Unmarshal(reqBz, req); err != nil {
+			return nil, err
+		}
+		record.TxIndex = r.txIndex
+		r.txIndex++
+		record.Request, record.Response = req, new(abci.ResponseDeliverTx)
+	case phaseEndBlock:
+		req := new(abci.RequestEndBlock)
+		if err := r.codec.Unmarshal(reqBz, req); err != nil {
+			return nil, err
+		}
+		r.height = req.Height
+		record.Request, record.Response = req, new(abci.ResponseEndBlock)
+	default:
+		return nil, fmt.Errorf("unknown block phase %d", phase)
+	}
+	record.Height = r.height
+	if err := r.codec.Unmarshal(resBz, record.Response); err != nil {
+		return nil, err
+	}
+	for i, bz := range stateChanges {
+		if err := r.codec.Unmarshal(bz, &record.StateChanges[i]); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+// Close closes the underlying file of a Reader created with OpenFile
+func (r *Reader) Close() error {
+	return r.closer()
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF, for files ending in the middle of a Record
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
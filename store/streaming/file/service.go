@@ -1,14 +1,18 @@
 package file
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
 	"sync"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -30,7 +34,64 @@ type StreamingService struct {
 	stateCacheLock     *sync.Mutex                              // mutex for the state cache
 	currentBlockNumber int64                                    // the current block number
 	currentTxIndex     int64                                    // the index of the current tx
+	currentBlockTime   time.Time                                // the time of the current block
 	quitChan           chan struct{}                            // channel to synchronize closure
+	doneChan           chan struct{}                            // channel closed once the Stream goroutine returned
+
+	maxFileSize int64         // rotate batched files once they reach this size, if > 0
+	maxFileAge  time.Duration // rotate batched files once the block time advanced by this duration, if > 0
+	compress    bool          // compress the files with zstd
+	filter      KeyFilter     // filter of the state changes to write out
+	batchFile   *batchFile    // the current file when batching several blocks per file
+}
+
+// phase types identifying the ABCI messages of the records of batched files
+const (
+	phaseBeginBlock byte = iota + 1
+	phaseDeliverTx
+	phaseEndBlock
+)
+
+// compressedFileSuffix is the suffix of the files compressed with zstd
+const compressedFileSuffix = ".zst"
+
+// outputFile is a (possibly compressed) file the StreamingService writes to
+type outputFile struct {
+	file    *os.File
+	encoder *zstd.Encoder // nil if the file is not compressed
+	size    int64         // the number of bytes written to the file
+}
+
+// Write satisfies io.Writer
+func (of *outputFile) Write(b []byte) (int, error) {
+	var (
+		n   int
+		err error
+	)
+	if of.encoder != nil {
+		n, err = of.encoder.Write(b)
+	} else {
+		n, err = of.file.Write(b)
+	}
+	of.size += int64(n)
+	return n, err
+}
+
+// Close satisfies io.Closer, flushing the compressed data before closing the file
+func (of *outputFile) Close() error {
+	if of.encoder != nil {
+		if err := of.encoder.Close(); err != nil {
+			of.file.Close()
+			return err
+		}
+	}
+	return of.file.Close()
+}
+
+// batchFile is a file containing the output of several blocks
+type batchFile struct {
+	*outputFile
+	startTime time.Time // the time of the first block written to the file
 }
 
 // IntermediateWriter is used so that we do not need to update the underlying io.Writer
@@ -53,29 +114,35 @@ func (iw *IntermediateWriter) Write(b []byte) (int, error) {
 }
 
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
-func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec, opts ...Option) (*StreamingService, error) {
 	listenChan := make(chan []byte)
+	fss := &StreamingService{
+		srcChan:        listenChan,
+		filePrefix:     filePrefix,
+		writeDir:       writeDir,
+		codec:          c,
+		stateCache:     make([][]byte, 0),
+		stateCacheLock: new(sync.Mutex),
+	}
+	for _, opt := range opts {
+		opt(fss)
+	}
 	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
-	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	var listener types.WriteListener = types.NewStoreKVPairWriteListener(iw, c)
+	if !fss.filter.Empty() {
+		listener = filterListener{WriteListener: listener, filter: fss.filter}
+	}
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
 	// in this case, we are using the same listener for each Store
 	for _, key := range storeKeys {
-		listeners[key] = append(listeners[key], listener)
+		fss.listeners[key] = append(fss.listeners[key], listener)
 	}
 	// check that the writeDir exists and is writable so that we can catch the error here at initialization if it is not
 	// we don't open a dstFile until we receive our first ABCI message
 	if err := isDirWriteable(writeDir); err != nil {
 		return nil, err
 	}
-	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
-		filePrefix:     filePrefix,
-		writeDir:       writeDir,
-		codec:          c,
-		stateCache:     make([][]byte, 0),
-		stateCacheLock: new(sync.Mutex),
-	}, nil
+	return fss, nil
 }
 
 // Listeners satisfies the baseapp.StreamingService interface
@@ -89,6 +156,12 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 // It writes the received BeginBlock request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	if fss.batching() {
+		fss.currentBlockNumber = req.GetHeader().Height
+		fss.currentTxIndex = 0
+		fss.currentBlockTime = req.GetHeader().Time
+		return fss.writeBatchRecord(phaseBeginBlock, &req, &res)
+	}
 	// generate the new file
 	dstFile, err := fss.openBeginBlockFile(req)
 	if err != nil {
@@ -126,20 +199,20 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 	return dstFile.Close()
 }
 
-func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os.File, error) {
+func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*outputFile, error) {
 	fss.currentBlockNumber = req.GetHeader().Height
 	fss.currentTxIndex = 0
-	fileName := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	fss.currentBlockTime = req.GetHeader().Time
+	return fss.openFile(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber))
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
 // It writes the received DeliverTx request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if fss.batching() {
+		return fss.writeBatchRecord(phaseDeliverTx, &req, &res)
+	}
 	// generate the new file
 	dstFile, err := fss.openDeliverTxFile()
 	if err != nil {
@@ -177,19 +250,26 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 	return dstFile.Close()
 }
 
-func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
+func (fss *StreamingService) openDeliverTxFile() (*outputFile, error) {
 	fileName := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
 	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return fss.openFile(fileName)
 }
 
 // ListenEndBlock satisfies the baseapp.ABCIListener interface
 // It writes the received EndBlock request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if fss.batching() {
+		if err := fss.writeBatchRecord(phaseEndBlock, &req, &res); err != nil {
+			return err
+		}
+		// files are only rotated between blocks
+		if fss.shouldRotate() {
+			return fss.closeBatchFile()
+		}
+		return nil
+	}
 	// generate the new file
 	dstFile, err := fss.openEndBlockFile()
 	if err != nil {
@@ -227,12 +307,104 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 	return dstFile.Close()
 }
 
-func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
+func (fss *StreamingService) openEndBlockFile() (*outputFile, error) {
+	return fss.openFile(fmt.Sprintf("block-%d-end", fss.currentBlockNumber))
+}
+
+// openFile creates the file named fileName, or fileName with the compressed file suffix if the
+// output is compressed, in the write directory
+func (fss *StreamingService) openFile(fileName string) (*outputFile, error) {
 	if fss.filePrefix != "" {
 		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	if fss.compress {
+		fileName += compressedFileSuffix
+	}
+	f, err := os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	of := &outputFile{file: f}
+	if fss.compress {
+		if of.encoder, err = zstd.NewWriter(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return of, nil
+}
+
+// batching returns true if the output of several blocks is batched into a single file
+func (fss *StreamingService) batching() bool {
+	return fss.maxFileSize > 0 || fss.maxFileAge > 0
+}
+
+// writeBatchRecord writes the ABCI request and response of phase, and the state changes cached
+// for it, out to the current batched file, which is created if needed.
+// Each record is made of the phase type, the number of state changes as an uvarint, and the
+// length-prefixed protobuf encoded request, state changes and response.
+func (fss *StreamingService) writeBatchRecord(phase byte, req, res codec.ProtoMarshaler) error {
+	if fss.batchFile == nil {
+		of, err := fss.openFile(fmt.Sprintf("blocks-%d", fss.currentBlockNumber))
+		if err != nil {
+			return err
+		}
+		fss.batchFile = &batchFile{outputFile: of, startTime: fss.currentBlockTime}
+	}
+	fss.stateCacheLock.Lock()
+	stateChanges := fss.stateCache
+	fss.stateCache = nil
+	fss.stateCacheLock.Unlock()
+	header := make([]byte, 1+binary.MaxVarintLen64)
+	header[0] = phase
+	n := binary.PutUvarint(header[1:], uint64(len(stateChanges)))
+	if _, err := fss.batchFile.Write(header[:1+n]); err != nil {
+		return err
+	}
+	return fss.writeMessages(fss.batchFile, req, stateChanges, res)
+}
+
+// shouldRotate returns true if the current batched file reached its maximum size or age
+func (fss *StreamingService) shouldRotate() bool {
+	if fss.batchFile == nil {
+		return false
+	}
+	if fss.maxFileSize > 0 && fss.batchFile.size >= fss.maxFileSize {
+		return true
+	}
+	return fss.maxFileAge > 0 && fss.currentBlockTime.Sub(fss.batchFile.startTime) >= fss.maxFileAge
+}
+
+// closeBatchFile closes the current batched file, if any
+func (fss *StreamingService) closeBatchFile() error {
+	if fss.batchFile == nil {
+		return nil
+	}
+	err := fss.batchFile.Close()
+	fss.batchFile = nil
+	return err
+}
+
+// writeMessages writes the length-prefixed protobuf encoded req, the state changes and res out to w
+func (fss *StreamingService) writeMessages(w io.Writer, req codec.ProtoMarshaler, stateChanges [][]byte, res codec.ProtoMarshaler) error {
+	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(req)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(lengthPrefixedReqBytes); err != nil {
+		return err
+	}
+	for _, stateChange := range stateChanges {
+		if _, err = w.Write(stateChange); err != nil {
+			return err
+		}
+	}
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(res)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(lengthPrefixedResBytes)
+	return err
 }
 
 // Stream satisfies the baseapp.StreamingService interface
@@ -243,14 +415,15 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 	if fss.quitChan != nil {
 		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
 	}
-	fss.quitChan = make(chan struct{})
+	quitChan, doneChan := make(chan struct{}), make(chan struct{})
+	fss.quitChan, fss.doneChan = quitChan, doneChan
 	wg.Add(1)
 	go func() {
+		defer close(doneChan)
 		defer wg.Done()
 		for {
 			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
+			case <-quitChan:
 				return
 			case by := <-fss.srcChan:
 				fss.stateCacheLock.Lock()
@@ -263,9 +436,15 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 }
 
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the goroutine spun up by Stream and waits for it to return before
+// closing the current batched file, if any
 func (fss *StreamingService) Close() error {
-	close(fss.quitChan)
-	return nil
+	if fss.quitChan != nil {
+		close(fss.quitChan)
+		<-fss.doneChan
+		fss.quitChan, fss.doneChan = nil, nil
+	}
+	return fss.closeBatchFile()
 }
 
 // isDirWriteable checks if dir is writable by writing and removing a file
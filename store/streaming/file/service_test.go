@@ -1,13 +1,17 @@
 package file
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -399,3 +403,202 @@ func getHeadSegment(bz []byte) ([]byte, []byte, error) {
 	}
 	return bz[prefixSize:(uint64(prefixSize) + size)], bz[uint64(prefixSize)+size:], nil
 }
+
+func TestFileStreamingServiceBatching(t *testing.T) {
+	dir := t.TempDir()
+	filter, err := ParseKeyFilter([]string{mockStoreKey1.Name(), mockStoreKey2.Name() + "/02"}, []string{mockStoreKey1.Name() + "/0304"})
+	require.NoError(t, err)
+
+	fss, err := NewStreamingService(dir, testPrefix, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller,
+		WithMaxFileAge(time.Hour), WithCompression(), WithKeyFilter(filter))
+	require.NoError(t, err)
+	listener1 := fss.listeners[mockStoreKey1][0]
+	listener2 := fss.listeners[mockStoreKey2][0]
+	wg := new(sync.WaitGroup)
+	require.NoError(t, fss.Stream(wg))
+
+	// blocks 1 and 2 are batched in a file, block 3 starts a new one an hour later
+	start := time.Unix(1000, 0).UTC()
+	blockTimes := []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour)}
+	for i, blockTime := range blockTimes {
+		height := int64(i + 1)
+		beginReq := abci.RequestBeginBlock{Header: types1.Header{Height: height, Time: blockTime}}
+
+		require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+		require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false))
+		waitForStateChanges(t, fss, 2)
+		require.NoError(t, fss.ListenBeginBlock(emptyContext, beginReq, testBeginBlockRes))
+
+		// filtered out
+		require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey3, mockValue3, false))
+		require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey3, mockValue3, false))
+		require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey2, nil, true))
+		waitForStateChanges(t, fss, 1)
+		require.NoError(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1))
+		require.NoError(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq2, testDeliverTxRes2))
+
+		require.NoError(t, fss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, testEndBlockRes))
+	}
+	require.NoError(t, fss.Close())
+	wg.Wait()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, testPrefix+"-blocks-1.zst"),
+		filepath.Join(dir, testPrefix+"-blocks-3.zst"),
+	}, files)
+
+	var records []*Record
+	for _, name := range files {
+		reader, err := OpenFile(name, testMarshaller)
+		require.NoError(t, err)
+		for {
+			record, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			records = append(records, record)
+		}
+		require.NoError(t, reader.Close())
+	}
+
+	require.Len(t, records, 12)
+	for i, record := range records {
+		require.Equal(t, int64(i/4+1), record.Height)
+		switch i % 4 {
+		case 0:
+			require.Equal(t, blockTimes[i/4], record.Request.(*abci.RequestBeginBlock).Header.Time)
+			require.Equal(t, &testBeginBlockRes, record.Response)
+			require.Equal(t, []types.StoreKVPair{
+				{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+				{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+			}, record.StateChanges)
+		case 1:
+			require.Equal(t, int64(0), record.TxIndex)
+			require.Equal(t, &testDeliverTxReq1, record.Request)
+			require.Equal(t, []types.StoreKVPair{
+				{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Delete: true},
+			}, record.StateChanges)
+		case 2:
+			require.Equal(t, int64(1), record.TxIndex)
+			require.Equal(t, &testDeliverTxReq2, record.Request)
+			require.Empty(t, record.StateChanges)
+		case 3:
+			require.Equal(t, &abci.RequestEndBlock{Height: int64(i/4 + 1)}, record.Request)
+			require.Empty(t, record.StateChanges)
+		}
+	}
+}
+
+func TestFileStreamingServiceClose(t *testing.T) {
+	fss, err := NewStreamingService(t.TempDir(), testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller,
+		WithMaxFileAge(time.Hour))
+	require.NoError(t, err)
+
+	// closing a service which isn't streaming only closes its files
+	require.NoError(t, fss.Close())
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, fss.Stream(wg))
+	require.Error(t, fss.Stream(wg))
+	require.NoError(t, fss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+
+	// the goroutine has returned once Close returns, and the stream can be started again
+	require.NoError(t, fss.Close())
+	require.Nil(t, fss.batchFile)
+	require.Nil(t, fss.quitChan)
+	require.NoError(t, fss.Stream(wg))
+	require.NoError(t, fss.Close())
+	wg.Wait()
+}
+
+func TestOpenFile(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, "", []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.NoError(t, err)
+	listener := fss.listeners[mockStoreKey1][0]
+	wg := new(sync.WaitGroup)
+	require.NoError(t, fss.Stream(wg))
+
+	require.NoError(t, fss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, listener.OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+	waitForStateChanges(t, fss, 1)
+	require.NoError(t, fss.ListenDeliverTx(emptyContext, testDeliverTxReq1, testDeliverTxRes1))
+	require.NoError(t, fss.Close())
+	wg.Wait()
+
+	reader, err := OpenFile(filepath.Join(dir, "block-1-tx-0"), testMarshaller)
+	require.NoError(t, err)
+	record, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), record.Height)
+	require.Equal(t, int64(0), record.TxIndex)
+	require.Equal(t, &testDeliverTxReq1, record.Request)
+	require.Equal(t, testDeliverTxRes1.Data, record.Response.(*abci.ResponseDeliverTx).Data)
+	require.Equal(t, []types.StoreKVPair{{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1}}, record.StateChanges)
+	_, err = reader.Next()
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, reader.Close())
+
+	_, err = OpenFile(filepath.Join(dir, "unknown"), testMarshaller)
+	require.Error(t, err)
+}
+
+func TestReaderCorruptedSizes(t *testing.T) {
+	uvarint := func(x uint64) []byte {
+		buf := make([]byte, binary.MaxVarintLen64)
+		return buf[:binary.PutUvarint(buf, x)]
+	}
+	hugeSize := uvarint(math.MaxUint64)
+
+	// the message sizes are bounded by the size of the file
+	name := filepath.Join(t.TempDir(), "block-1-begin")
+	require.NoError(t, os.WriteFile(name, hugeSize, 0o600))
+	reader, err := OpenFile(name, testMarshaller)
+	require.NoError(t, err)
+	_, err = reader.Next()
+	require.ErrorContains(t, err, "exceeds the file size")
+	require.NoError(t, reader.Close())
+
+	// without the size of the file, the messages can't be longer than the data
+	_, err = NewReader(bytes.NewReader(uvarint(1<<40)), testMarshaller).Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// nor the state changes more numerous
+	batch := append([]byte{phaseBeginBlock}, hugeSize...)
+	batch = append(batch, 0)
+	_, err = NewReader(bytes.NewReader(batch), testMarshaller).Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestKeyFilter(t *testing.T) {
+	_, err := ParseKeyFilter([]string{"/01"}, nil)
+	require.Error(t, err)
+	_, err = ParseKeyFilter(nil, []string{"bank/zz"})
+	require.Error(t, err)
+
+	filter, err := ParseKeyFilter(nil, nil)
+	require.NoError(t, err)
+	require.True(t, filter.Empty())
+	require.True(t, filter.Allow("bank", []byte{1}))
+
+	filter, err = ParseKeyFilter([]string{"bank/02", "staking"}, []string{"staking/21"})
+	require.NoError(t, err)
+	require.False(t, filter.Empty())
+	require.True(t, filter.Allow("bank", []byte{2, 1}))
+	require.False(t, filter.Allow("bank", []byte{1, 2}))
+	require.True(t, filter.Allow("staking", []byte{0x22}))
+	require.False(t, filter.Allow("staking", []byte{0x21, 1}))
+	require.False(t, filter.Allow("acc", []byte{2}))
+}
+
+// waitForStateChanges waits for the StreamingService to cache n state changes
+func waitForStateChanges(t *testing.T, fss *StreamingService, n int) {
+	require.Eventually(t, func() bool {
+		fss.stateCacheLock.Lock()
+		defer fss.stateCacheLock.Unlock()
+		return len(fss.stateCache) == n
+	}, time.Second, time.Millisecond)
+}
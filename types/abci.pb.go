@@ -272,6 +272,11 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// GasProfile is the breakdown of the consumed gas. It is only populated when
+	// simulating a transaction.
+	//
+	// Since: cosmos-sdk 0.47
+	GasProfile *GasProfile `protobuf:"bytes,3,opt,name=gas_profile,json=gasProfile,proto3" json:"gas_profile,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -320,6 +325,157 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasProfile() *GasProfile {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
+}
+
+// GasProfile defines a breakdown of the gas consumed by a transaction.
+//
+// Since: cosmos-sdk 0.47
+type GasProfile struct {
+	// entries are the gas consumptions aggregated by handler, store, operation
+	// and descriptor, in order of first occurrence.
+	Entries []GasProfileEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *GasProfile) Reset()      { *m = GasProfile{} }
+func (*GasProfile) ProtoMessage() {}
+func (*GasProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *GasProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfile.Merge(m, src)
+}
+func (m *GasProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfile proto.InternalMessageInfo
+
+func (m *GasProfile) GetEntries() []GasProfileEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// GasProfileEntry defines the gas consumed for a given handler, store,
+// operation and gas descriptor.
+//
+// Since: cosmos-sdk 0.47
+type GasProfileEntry struct {
+	// handler is the part of the transaction execution which consumed the gas:
+	// "ante", "post" or the type URL of the executed message.
+	Handler string `protobuf:"bytes,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	// store is the name of the KVStore the gas was consumed for, empty if the gas
+	// was not consumed by a store operation.
+	Store string `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty"`
+	// operation is the type of the store operation (read, write, has, delete or
+	// iterate), "other" if the gas was not consumed by a store operation.
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	// gas_descriptor is the descriptor passed to the gas meter.
+	GasDescriptor string `protobuf:"bytes,4,opt,name=gas_descriptor,json=gasDescriptor,proto3" json:"gas_descriptor,omitempty"`
+	// gas is the total amount of gas consumed.
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// count is the number of times gas was consumed.
+	Count uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasProfileEntry) Reset()      { *m = GasProfileEntry{} }
+func (*GasProfileEntry) ProtoMessage() {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+}
+func (m *GasProfileEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileEntry.Merge(m, src)
+}
+func (m *GasProfileEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileEntry proto.InternalMessageInfo
+
+func (m *GasProfileEntry) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGasDescriptor() string {
+	if m != nil {
+		return m.GasDescriptor
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *GasProfileEntry) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -341,7 +497,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +536,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +584,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +641,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +704,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,6 +781,8 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*GasProfile)(nil), "cosmos.base.abci.v1beta1.GasProfile")
+	proto.RegisterType((*GasProfileEntry)(nil), "cosmos.base.abci.v1beta1.GasProfileEntry")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -637,64 +795,73 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0xdb, 0x75, 0x3c, 0x8e, 0x29, 0x1a, 0x45, 0xe9, 0xa4, 0x80, 0x6d, 0xdc, 0x22,
-	0x59, 0x48, 0xac, 0xd5, 0xb4, 0x42, 0xb4, 0xa7, 0xd6, 0xe1, 0x2b, 0x52, 0xcb, 0x61, 0xe3, 0x0a,
-	0x89, 0x8b, 0x35, 0xb6, 0xa7, 0xe3, 0x55, 0xbd, 0x3b, 0xd6, 0xce, 0x6c, 0xb2, 0xb9, 0x71, 0x83,
-	0x23, 0x27, 0xce, 0x5c, 0xe1, 0x2f, 0xe9, 0x81, 0x43, 0x8e, 0x3d, 0x54, 0x01, 0x92, 0x1b, 0x7f,
-	0x05, 0x7a, 0x6f, 0xc6, 0x1f, 0x25, 0x75, 0xd5, 0x93, 0xdf, 0xfc, 0xde, 0x87, 0xdf, 0xfb, 0xbd,
-	0xdf, 0xce, 0x90, 0x5b, 0x63, 0xa5, 0x13, 0xa5, 0x7b, 0x23, 0xae, 0x45, 0x8f, 0x8f, 0xc6, 0x71,
-	0xef, 0xf8, 0xce, 0x48, 0x18, 0x7e, 0x07, 0x0f, 0xe1, 0x3c, 0x53, 0x46, 0x51, 0x66, 0x83, 0x42,
-	0x08, 0x0a, 0x11, 0x77, 0x41, 0x37, 0x77, 0xa4, 0x92, 0x0a, 0x83, 0x7a, 0x60, 0xd9, 0xf8, 0x9b,
-	0x1f, 0x18, 0x91, 0x4e, 0x44, 0x96, 0xc4, 0xa9, 0xb1, 0x35, 0xcd, 0xe9, 0x5c, 0x68, 0xe7, 0xdc,
-	0x93, 0x4a, 0xc9, 0x99, 0xe8, 0xe1, 0x69, 0x94, 0x3f, 0xeb, 0xf1, 0xf4, 0xd4, 0xba, 0x3a, 0x7f,
-	0x56, 0x08, 0x19, 0x14, 0x91, 0xd0, 0x73, 0x95, 0x6a, 0x41, 0x77, 0x49, 0x30, 0x15, 0xb1, 0x9c,
-	0x1a, 0xe6, 0xb5, 0xbd, 0x6e, 0x25, 0x72, 0x27, 0xda, 0x21, 0x81, 0x29, 0xa6, 0x5c, 0x4f, 0x59,
-	0xb9, 0xed, 0x75, 0x6b, 0x7d, 0x72, 0x71, 0xde, 0x0a, 0x06, 0xc5, 0xb7, 0x5c, 0x4f, 0x23, 0xe7,
-	0xa1, 0x1f, 0x92, 0xda, 0x58, 0x4d, 0x84, 0x9e, 0xf3, 0xb1, 0x60, 0x15, 0x08, 0x8b, 0x56, 0x00,
-	0xa5, 0xc4, 0x87, 0x03, 0xf3, 0xdb, 0x5e, 0xb7, 0x11, 0xa1, 0x0d, 0xd8, 0x84, 0x1b, 0xce, 0xae,
-	0x61, 0x30, 0xda, 0xf4, 0x06, 0xa9, 0x66, 0xfc, 0x64, 0x38, 0x53, 0x92, 0x05, 0x08, 0x07, 0x19,
-	0x3f, 0x79, 0xac, 0x24, 0x7d, 0x4a, 0xfc, 0x99, 0x92, 0x9a, 0x55, 0xdb, 0x95, 0x6e, 0x7d, 0xbf,
-	0x1b, 0x6e, 0x22, 0x28, 0x7c, 0xd4, 0x3f, 0x38, 0x7c, 0x22, 0xb4, 0xe6, 0x52, 0x3c, 0x56, 0xb2,
-	0x7f, 0xe3, 0xc5, 0x79, 0xab, 0xf4, 0xc7, 0x5f, 0xad, 0xeb, 0xaf, 0xe3, 0x3a, 0xc2, 0x72, 0xd0,
-	0x43, 0x9c, 0x3e, 0x53, 0x6c, 0xcb, 0xf6, 0x00, 0x36, 0xfd, 0x88, 0x10, 0xc9, 0xf5, 0xf0, 0x84,
-	0xa7, 0x46, 0x4c, 0x58, 0x0d, 0x99, 0xa8, 0x49, 0xae, 0xbf, 0x47, 0x80, 0xee, 0x91, 0x2d, 0x70,
-	0xe7, 0x5a, 0x4c, 0x18, 0x41, 0x67, 0x55, 0x72, 0xfd, 0x54, 0x8b, 0x09, 0xbd, 0x4d, 0xca, 0xa6,
-	0x60, 0xf5, 0xb6, 0xd7, 0xad, 0xef, 0xef, 0x84, 0x96, 0xf6, 0x70, 0x41, 0x7b, 0xf8, 0x28, 0x3d,
-	0x8d, 0xca, 0xa6, 0x00, 0xa6, 0x4c, 0x9c, 0x08, 0x6d, 0x78, 0x32, 0x67, 0xdb, 0x96, 0xa9, 0x25,
-	0x40, 0xef, 0x91, 0x40, 0x1c, 0x8b, 0xd4, 0x68, 0xd6, 0xc0, 0x51, 0x77, 0xc3, 0xd5, 0x6e, 0xed,
-	0xa4, 0x5f, 0x81, 0xbb, 0xef, 0xc3, 0x60, 0x91, 0x8b, 0x7d, 0xe0, 0xff, 0xfc, 0x5b, 0xab, 0xd4,
-	0xf9, 0xdd, 0x23, 0xef, 0xbd, 0x3e, 0x27, 0xfd, 0x94, 0xd4, 0x12, 0x2d, 0x87, 0x71, 0x3a, 0x11,
-	0x05, 0x6e, 0xb5, 0xd1, 0x6f, 0xfc, 0x7b, 0xde, 0x5a, 0x81, 0xd1, 0x56, 0xa2, 0xe5, 0x21, 0x58,
-	0xf4, 0x7d, 0x52, 0x01, 0xe2, 0x71, 0xc7, 0x11, 0x98, 0xf4, 0x68, 0xd9, 0x4c, 0x05, 0x9b, 0xf9,
-	0x64, 0x33, 0xef, 0x47, 0x26, 0x8b, 0x53, 0x69, 0x7b, 0xdb, 0x71, 0xa4, 0x6f, 0xaf, 0x81, 0x7a,
-	0xd5, 0xeb, 0x8f, 0xaf, 0xda, 0x5e, 0x27, 0x23, 0xf5, 0x35, 0x2f, 0x2c, 0x02, 0x34, 0x8b, 0x2d,
-	0xd6, 0x22, 0xb4, 0xe9, 0x21, 0x21, 0xdc, 0x98, 0x2c, 0x1e, 0xe5, 0x46, 0x68, 0x56, 0xc6, 0x0e,
-	0x6e, 0xbd, 0x65, 0xf3, 0x8b, 0x58, 0xc7, 0xcd, 0x5a, 0xb2, 0xfb, 0xcf, 0xbb, 0xa4, 0xb6, 0x0c,
-	0x82, 0x69, 0x9f, 0x8b, 0x53, 0xf7, 0x87, 0x60, 0xd2, 0x1d, 0x72, 0xed, 0x98, 0xcf, 0x72, 0xe1,
-	0x18, 0xb0, 0x87, 0xce, 0x01, 0xa9, 0x7e, 0xc3, 0xf5, 0xe1, 0x55, 0x65, 0x40, 0xa6, 0xbf, 0x49,
-	0x19, 0x65, 0x74, 0x2e, 0x94, 0x01, 0x9b, 0x09, 0x22, 0xa1, 0xf3, 0x99, 0xa1, 0xbb, 0x4e, 0xf6,
-	0x90, 0xbe, 0xdd, 0x2f, 0x33, 0xcf, 0x49, 0xff, 0x2a, 0xfb, 0xf7, 0xfe, 0xc7, 0xfe, 0x3b, 0x49,
-	0x81, 0xde, 0x27, 0x0d, 0x58, 0x6e, 0xe6, 0x3e, 0x6a, 0xcd, 0x7c, 0x4c, 0x7e, 0xb3, 0x1e, 0xb7,
-	0x13, 0x2d, 0x17, 0x9f, 0xff, 0x42, 0x45, 0xbf, 0x7a, 0x84, 0x1e, 0xc5, 0x49, 0x3e, 0xe3, 0x26,
-	0x56, 0xe9, 0xf2, 0x72, 0xf8, 0xda, 0x4e, 0x87, 0x9f, 0x8b, 0x87, 0x12, 0xff, 0x78, 0xf3, 0x2e,
-	0x1c, 0x63, 0xfd, 0x2d, 0x68, 0xed, 0xec, 0xbc, 0xe5, 0x21, 0x15, 0x48, 0xe2, 0x17, 0x24, 0xc8,
-	0x90, 0x09, 0x1c, 0xb5, 0xbe, 0xdf, 0xde, 0x5c, 0xc5, 0x32, 0x16, 0xb9, 0xf8, 0xce, 0x43, 0x52,
-	0x7d, 0xa2, 0xe5, 0x97, 0x40, 0xd6, 0x1e, 0x01, 0xd9, 0x0e, 0xd7, 0x24, 0x53, 0x4d, 0xb4, 0x1c,
-	0x80, 0x6a, 0x16, 0xd7, 0x0a, 0x54, 0xdf, 0xb6, 0xdc, 0x3e, 0x08, 0x60, 0xfd, 0xcc, 0xeb, 0xfc,
-	0xe4, 0x91, 0xda, 0xa0, 0x58, 0x14, 0xb9, 0xbf, 0xdc, 0x44, 0xe5, 0xed, 0xd3, 0xb8, 0x84, 0xb5,
-	0x65, 0x5d, 0x21, 0xb9, 0xfc, 0xee, 0x24, 0xa3, 0x14, 0x5f, 0x79, 0xe4, 0xfa, 0x91, 0xe0, 0xd9,
-	0x78, 0x3a, 0x28, 0xb4, 0x53, 0x46, 0x8b, 0xd4, 0x8d, 0x32, 0x7c, 0x36, 0x1c, 0xab, 0x3c, 0x35,
-	0x4e, 0x5f, 0x04, 0xa1, 0x03, 0x40, 0x40, 0xa0, 0xd6, 0x65, 0xd5, 0x65, 0x0f, 0x90, 0x36, 0xe7,
-	0x52, 0x0c, 0xd3, 0x3c, 0x19, 0x89, 0x0c, 0xef, 0x5e, 0x3f, 0x22, 0x00, 0x7d, 0x87, 0x08, 0xc8,
-	0x16, 0x03, 0xb0, 0x12, 0x5e, 0xc1, 0x7e, 0x54, 0x03, 0x64, 0x00, 0x00, 0x54, 0x9d, 0xc5, 0x49,
-	0x6c, 0xf0, 0x22, 0xf6, 0x23, 0x7b, 0xa0, 0x9f, 0x93, 0x8a, 0x29, 0x34, 0x0b, 0x70, 0xae, 0xdb,
-	0x9b, 0xb9, 0x59, 0x3d, 0x1f, 0x11, 0x24, 0xd8, 0xf1, 0xfa, 0x0f, 0x5f, 0xfe, 0xd3, 0x2c, 0xbd,
-	0xb8, 0x68, 0x7a, 0x67, 0x17, 0x4d, 0xef, 0xef, 0x8b, 0xa6, 0xf7, 0xcb, 0x65, 0xb3, 0x74, 0x76,
-	0xd9, 0x2c, 0xbd, 0xbc, 0x6c, 0x96, 0x7e, 0xe8, 0xc8, 0xd8, 0x4c, 0xf3, 0x51, 0x38, 0x56, 0x49,
-	0xcf, 0x3d, 0x87, 0xf6, 0xe7, 0x33, 0x3d, 0x79, 0x6e, 0xdf, 0xae, 0x51, 0x80, 0x14, 0xde, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xf1, 0x8e, 0x98, 0x30, 0x07, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x3f, 0x6f, 0x1b, 0xc7,
+	0x13, 0xe5, 0x91, 0xe7, 0xa3, 0x38, 0x14, 0xad, 0x1f, 0x16, 0x82, 0xbc, 0xf2, 0x2f, 0x21, 0x19,
+	0xda, 0x06, 0x98, 0x00, 0x21, 0x61, 0xd9, 0x08, 0x62, 0x57, 0x36, 0x6d, 0xc5, 0x21, 0x60, 0x07,
+	0xc1, 0x89, 0x86, 0x81, 0x34, 0xc4, 0x92, 0x5c, 0x2d, 0x0f, 0xe6, 0xdd, 0x12, 0xb7, 0x4b, 0x89,
+	0xec, 0xd2, 0x25, 0x48, 0x95, 0x2a, 0x75, 0xda, 0xa4, 0xc8, 0xe7, 0x70, 0x91, 0x42, 0xa5, 0x0b,
+	0x43, 0x49, 0xa4, 0x2e, 0x9f, 0x22, 0x98, 0xdd, 0xe5, 0x1f, 0x5b, 0xa0, 0xa0, 0x4a, 0xb3, 0x6f,
+	0x66, 0xf7, 0x66, 0xde, 0xbc, 0x19, 0x11, 0x6e, 0xf5, 0xa5, 0x8a, 0xa5, 0x6a, 0xf6, 0x98, 0xe2,
+	0x4d, 0xd6, 0xeb, 0x47, 0xcd, 0xa3, 0xbb, 0x3d, 0xae, 0xd9, 0x5d, 0x73, 0x68, 0x8c, 0x53, 0xa9,
+	0x25, 0xa1, 0x36, 0xa8, 0x81, 0x41, 0x0d, 0x83, 0xbb, 0xa0, 0x9b, 0xdb, 0x42, 0x0a, 0x69, 0x82,
+	0x9a, 0x68, 0xd9, 0xf8, 0x9b, 0xff, 0xd7, 0x3c, 0x19, 0xf0, 0x34, 0x8e, 0x12, 0x6d, 0xdf, 0xd4,
+	0xb3, 0x31, 0x57, 0xce, 0xb9, 0x2b, 0xa4, 0x14, 0x23, 0xde, 0x34, 0xa7, 0xde, 0xe4, 0xb0, 0xc9,
+	0x92, 0x99, 0x75, 0xd5, 0xfe, 0xcc, 0x01, 0x74, 0xa6, 0x21, 0x57, 0x63, 0x99, 0x28, 0x4e, 0x76,
+	0x20, 0x18, 0xf2, 0x48, 0x0c, 0x35, 0xf5, 0xaa, 0x5e, 0x3d, 0x17, 0xba, 0x13, 0xa9, 0x41, 0xa0,
+	0xa7, 0x43, 0xa6, 0x86, 0x34, 0x5b, 0xf5, 0xea, 0x85, 0x16, 0x9c, 0x9d, 0x56, 0x82, 0xce, 0xf4,
+	0x6b, 0xa6, 0x86, 0xa1, 0xf3, 0x90, 0x8f, 0xa0, 0xd0, 0x97, 0x03, 0xae, 0xc6, 0xac, 0xcf, 0x69,
+	0x0e, 0xc3, 0xc2, 0x25, 0x40, 0x08, 0xf8, 0x78, 0xa0, 0x7e, 0xd5, 0xab, 0x97, 0x42, 0x63, 0x23,
+	0x36, 0x60, 0x9a, 0xd1, 0x6b, 0x26, 0xd8, 0xd8, 0xe4, 0x06, 0xe4, 0x53, 0x76, 0xdc, 0x1d, 0x49,
+	0x41, 0x03, 0x03, 0x07, 0x29, 0x3b, 0x7e, 0x2e, 0x05, 0x79, 0x09, 0xfe, 0x48, 0x0a, 0x45, 0xf3,
+	0xd5, 0x5c, 0xbd, 0xb8, 0x57, 0x6f, 0xac, 0x23, 0xa8, 0xf1, 0xb8, 0xf5, 0xa4, 0xfd, 0x82, 0x2b,
+	0xc5, 0x04, 0x7f, 0x2e, 0x45, 0xeb, 0xc6, 0x9b, 0xd3, 0x4a, 0xe6, 0xf7, 0xbf, 0x2a, 0x5b, 0xef,
+	0xe3, 0x2a, 0x34, 0xcf, 0x61, 0x0e, 0x51, 0x72, 0x28, 0xe9, 0x86, 0xcd, 0x01, 0x6d, 0xf2, 0x31,
+	0x80, 0x60, 0xaa, 0x7b, 0xcc, 0x12, 0xcd, 0x07, 0xb4, 0x60, 0x98, 0x28, 0x08, 0xa6, 0x5e, 0x19,
+	0x80, 0xec, 0xc2, 0x06, 0xba, 0x27, 0x8a, 0x0f, 0x28, 0x18, 0x67, 0x5e, 0x30, 0xf5, 0x52, 0xf1,
+	0x01, 0xb9, 0x0d, 0x59, 0x3d, 0xa5, 0xc5, 0xaa, 0x57, 0x2f, 0xee, 0x6d, 0x37, 0x2c, 0xed, 0x8d,
+	0x39, 0xed, 0x8d, 0xc7, 0xc9, 0x2c, 0xcc, 0xea, 0x29, 0x32, 0xa5, 0xa3, 0x98, 0x2b, 0xcd, 0xe2,
+	0x31, 0xdd, 0xb4, 0x4c, 0x2d, 0x00, 0x72, 0x1f, 0x02, 0x7e, 0xc4, 0x13, 0xad, 0x68, 0xc9, 0x94,
+	0xba, 0xd3, 0x58, 0xf6, 0xd6, 0x56, 0xba, 0x8f, 0xee, 0x96, 0x8f, 0x85, 0x85, 0x2e, 0xf6, 0xa1,
+	0xff, 0xe3, 0xaf, 0x95, 0x4c, 0xed, 0x37, 0x0f, 0xae, 0xbf, 0x5f, 0x27, 0xf9, 0x0c, 0x0a, 0xb1,
+	0x12, 0xdd, 0x28, 0x19, 0xf0, 0xa9, 0xe9, 0x6a, 0xa9, 0x55, 0xfa, 0xf7, 0xb4, 0xb2, 0x04, 0xc3,
+	0x8d, 0x58, 0x89, 0x36, 0x5a, 0xe4, 0x7f, 0x90, 0x43, 0xe2, 0x4d, 0x8f, 0x43, 0x34, 0xc9, 0xc1,
+	0x22, 0x99, 0x9c, 0x49, 0xe6, 0xce, 0x7a, 0xde, 0x0f, 0x74, 0x1a, 0x25, 0xc2, 0xe6, 0xb6, 0xed,
+	0x48, 0xdf, 0x5c, 0x01, 0xd5, 0x32, 0xd7, 0xef, 0xdf, 0x55, 0xbd, 0x5a, 0x0a, 0xc5, 0x15, 0x2f,
+	0x36, 0x02, 0x35, 0x6b, 0x52, 0x2c, 0x84, 0xc6, 0x26, 0x6d, 0x00, 0xa6, 0x75, 0x1a, 0xf5, 0x26,
+	0x9a, 0x2b, 0x9a, 0x35, 0x19, 0xdc, 0xba, 0xa4, 0xf3, 0xf3, 0x58, 0xc7, 0xcd, 0xca, 0x65, 0xf7,
+	0xcd, 0x7b, 0x50, 0x58, 0x04, 0x61, 0xb5, 0xaf, 0xf9, 0xcc, 0x7d, 0x10, 0x4d, 0xb2, 0x0d, 0xd7,
+	0x8e, 0xd8, 0x68, 0xc2, 0x1d, 0x03, 0xf6, 0x50, 0xfb, 0xc9, 0x83, 0xfc, 0x33, 0xa6, 0xda, 0x17,
+	0xa5, 0x81, 0x57, 0xfd, 0x75, 0xd2, 0xc8, 0x1a, 0xe7, 0x42, 0x1a, 0xfb, 0x50, 0x44, 0xd7, 0x38,
+	0x95, 0x87, 0xd1, 0xc8, 0x0e, 0x48, 0x71, 0xef, 0xf6, 0xfa, 0x62, 0x9e, 0x31, 0xf5, 0xad, 0x8d,
+	0x0d, 0x41, 0x2c, 0xec, 0xda, 0x2b, 0x80, 0xa5, 0x87, 0xb4, 0x21, 0xcf, 0x13, 0x9d, 0x46, 0x5c,
+	0x51, 0xcf, 0xb0, 0xf3, 0xe9, 0x55, 0x1e, 0xdc, 0x4f, 0x74, 0x3a, 0x73, 0x1c, 0xcd, 0xef, 0xd7,
+	0xfe, 0xf0, 0x60, 0xeb, 0x83, 0x10, 0x42, 0x21, 0x3f, 0x64, 0xc9, 0x60, 0xc4, 0x53, 0xc7, 0xd2,
+	0xfc, 0x88, 0x4c, 0x29, 0x2d, 0xd3, 0x05, 0x53, 0xe6, 0x80, 0xc2, 0x96, 0x63, 0x9e, 0x32, 0x1d,
+	0xc9, 0x64, 0xbe, 0x02, 0x16, 0x00, 0xb9, 0x03, 0xd7, 0x91, 0x81, 0x01, 0x57, 0xfd, 0x34, 0x1a,
+	0x6b, 0x99, 0x9a, 0x65, 0x50, 0x08, 0x4b, 0x82, 0xa9, 0xa7, 0x0b, 0x10, 0xdb, 0x22, 0x98, 0x32,
+	0x4b, 0xc1, 0x0f, 0xd1, 0xc4, 0x8f, 0xf5, 0xe5, 0x24, 0xd1, 0x66, 0x23, 0xf8, 0xa1, 0x3d, 0xa0,
+	0xd6, 0x83, 0x90, 0xab, 0xc9, 0x48, 0x93, 0x1d, 0xb7, 0x48, 0x30, 0xc9, 0xcd, 0x56, 0x96, 0x7a,
+	0x6e, 0x99, 0x5c, 0xd4, 0xf3, 0xfd, 0x0f, 0xf4, 0x7c, 0xa5, 0xe1, 0x22, 0x0f, 0xa0, 0x84, 0xe3,
+	0x92, 0xba, 0x35, 0xa9, 0xa8, 0x5f, 0xcd, 0xad, 0x9d, 0xf0, 0xcd, 0x58, 0x89, 0xf9, 0x42, 0x9d,
+	0xcf, 0xe5, 0x2f, 0x1e, 0x90, 0x83, 0x28, 0x9e, 0x8c, 0x0c, 0x13, 0x73, 0x2f, 0xf9, 0xca, 0xca,
+	0xc5, 0x2c, 0x20, 0xcf, 0x08, 0xe2, 0x93, 0x4b, 0xfb, 0x87, 0x12, 0x6c, 0x6d, 0x60, 0x6a, 0x27,
+	0xa7, 0x15, 0xcf, 0x68, 0x0b, 0x21, 0xf2, 0x25, 0x04, 0xa9, 0x61, 0xc2, 0x94, 0x5a, 0xdc, 0xab,
+	0xae, 0x7f, 0xc5, 0x32, 0x16, 0xba, 0xf8, 0xda, 0x23, 0xc8, 0xbf, 0x50, 0xe2, 0x29, 0x92, 0xb5,
+	0x0b, 0xb8, 0x08, 0xba, 0x2b, 0x43, 0x98, 0x8f, 0x95, 0xe8, 0xcc, 0xc6, 0xcb, 0x45, 0x8d, 0xaf,
+	0x6f, 0x5a, 0x6e, 0x1f, 0x06, 0x38, 0x50, 0xd4, 0xab, 0xfd, 0xe0, 0x41, 0xa1, 0x33, 0x9d, 0x3f,
+	0xf2, 0x60, 0xd1, 0x89, 0xdc, 0xe5, 0xd5, 0xb8, 0x0b, 0x2b, 0xcd, 0xba, 0x40, 0x72, 0xf6, 0xea,
+	0x24, 0x9b, 0xe1, 0x7e, 0xe7, 0xc1, 0xd6, 0x01, 0x67, 0x69, 0x7f, 0xd8, 0x99, 0x2a, 0xa7, 0x8c,
+	0x0a, 0x14, 0xb5, 0xd4, 0x6c, 0xd4, 0xb5, 0x02, 0xb2, 0x03, 0x0b, 0x06, 0x7a, 0x82, 0xc8, 0x52,
+	0x5b, 0xd9, 0x15, 0x6d, 0xe1, 0xb5, 0x31, 0x13, 0xbc, 0x9b, 0x4c, 0xe2, 0x1e, 0x4f, 0x8d, 0x94,
+	0xfd, 0x10, 0x10, 0xfa, 0xc6, 0x20, 0xb8, 0x07, 0x4c, 0x80, 0x79, 0xc9, 0xe8, 0xd8, 0x0f, 0x0b,
+	0x88, 0x74, 0x10, 0xc0, 0x57, 0x47, 0x51, 0x1c, 0x69, 0xa7, 0x62, 0x7b, 0x20, 0x5f, 0x40, 0x4e,
+	0x4f, 0x15, 0x0d, 0xaa, 0xb9, 0xcb, 0x47, 0x7f, 0xf9, 0x0f, 0x39, 0xc4, 0x0b, 0xb6, 0xbc, 0xd6,
+	0xa3, 0xb7, 0xff, 0x94, 0x33, 0x6f, 0xce, 0xca, 0xde, 0xc9, 0x59, 0xd9, 0xfb, 0xfb, 0xac, 0xec,
+	0xfd, 0x7c, 0x5e, 0xce, 0x9c, 0x9c, 0x97, 0x33, 0x6f, 0xcf, 0xcb, 0x99, 0xef, 0x6a, 0x22, 0xd2,
+	0xc3, 0x49, 0xaf, 0xd1, 0x97, 0x71, 0xd3, 0xfd, 0xc0, 0xb0, 0x7f, 0x3e, 0x57, 0x83, 0xd7, 0xf6,
+	0xd7, 0x40, 0x2f, 0x30, 0x14, 0xde, 0xfb, 0x6f, 0x00, 0xb5, 0x84, 0x12, 0x9b, 0x82, 0x08, 0x00,
+	0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -972,6 +1139,18 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasProfile != nil {
+		{
+			size, err := m.GasProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAbci(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -985,6 +1164,104 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasProfileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Gas != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GasDescriptor) > 0 {
+		i -= len(m.GasDescriptor)
+		copy(dAtA[i:], m.GasDescriptor)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.GasDescriptor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Handler) > 0 {
+		i -= len(m.Handler)
+		copy(dAtA[i:], m.Handler)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Handler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1387,6 +1664,56 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if m.GasProfile != nil {
+		l = m.GasProfile.Size()
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	return n
+}
+
+func (m *GasProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasProfileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.GasDescriptor)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovAbci(uint64(m.Gas))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAbci(uint64(m.Count))
+	}
 	return n
 }
 
@@ -2455,6 +2782,342 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasProfile == nil {
+				m.GasProfile = &GasProfile{}
+			}
+			if err := m.GasProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, GasProfileEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDescriptor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDescriptor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
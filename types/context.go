@@ -39,6 +39,8 @@ type Context struct {
 	consParams    *tmproto.ConsensusParams
 	eventManager  *EventManager
 	priority      int64 // The tx priority, only relevant in CheckTx
+	gasProfiler   *GasProfiler
+	gasHandler    string // The handler gas is recorded under, only relevant with a gasProfiler
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -55,6 +57,7 @@ func (c Context) Logger() log.Logger          { return c.logger }
 func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
 func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
+func (c Context) GasProfiler() *GasProfiler   { return c.gasProfiler }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
@@ -182,12 +185,25 @@ func (c Context) WithVoteInfos(voteInfo []abci.VoteInfo) Context {
 	return c
 }
 
-// WithGasMeter returns a Context with an updated transaction GasMeter.
+// WithGasMeter returns a Context with an updated transaction GasMeter. If the
+// Context has a GasProfiler, the GasMeter records into it.
 func (c Context) WithGasMeter(meter GasMeter) Context {
 	c.gasMeter = meter
+	if c.gasProfiler != nil {
+		c.gasMeter = c.gasProfiler.GasMeter(meter, c.gasHandler, "")
+	}
 	return c
 }
 
+// WithGasProfiler returns a Context whose transaction GasMeter, and the
+// GasMeters of its KVStores, record the consumed gas in the given GasProfiler
+// under the given handler. A nil GasProfiler disables gas profiling.
+func (c Context) WithGasProfiler(profiler *GasProfiler, handler string) Context {
+	c.gasProfiler = profiler
+	c.gasHandler = handler
+	return c.WithGasMeter(c.gasMeter)
+}
+
 // WithBlockGasMeter returns a Context with an updated block GasMeter
 func (c Context) WithBlockGasMeter(meter GasMeter) Context {
 	c.blockGasMeter = meter
@@ -258,12 +274,22 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), storetypes.KVGasConfig())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), storetypes.TransientGasConfig())
+}
+
+// storeGasMeter returns the GasMeter used by the store with the given key, which
+// records the store name when gas profiling is enabled.
+func (c Context) storeGasMeter(key storetypes.StoreKey) GasMeter {
+	if c.gasProfiler == nil {
+		return c.GasMeter()
+	}
+
+	return c.gasProfiler.GasMeter(c.gasMeter, c.gasHandler, key.Name())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -0,0 +1,290 @@
+package types
+
+import (
+	"compress/gzip"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Gas profile handlers for gas consumed outside of message handlers.
+const (
+	GasProfileHandlerAnte = "ante"
+	GasProfileHandlerPost = "post"
+)
+
+// Gas profile operations, derived from the gas descriptors used by the KVStores.
+const (
+	GasProfileOperationRead    = "read"
+	GasProfileOperationWrite   = "write"
+	GasProfileOperationHas     = "has"
+	GasProfileOperationDelete  = "delete"
+	GasProfileOperationIterate = "iterate"
+	GasProfileOperationOther   = "other"
+)
+
+var gasProfileOperations = map[string]string{
+	storetypes.GasReadCostFlatDesc:     GasProfileOperationRead,
+	storetypes.GasReadPerByteDesc:      GasProfileOperationRead,
+	storetypes.GasWriteCostFlatDesc:    GasProfileOperationWrite,
+	storetypes.GasWritePerByteDesc:     GasProfileOperationWrite,
+	storetypes.GasHasDesc:              GasProfileOperationHas,
+	storetypes.GasDeleteDesc:           GasProfileOperationDelete,
+	storetypes.GasIterNextCostFlatDesc: GasProfileOperationIterate,
+	storetypes.GasValuePerByteDesc:     GasProfileOperationIterate,
+}
+
+func (p GasProfile) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &p)
+	return string(bz)
+}
+
+func (e GasProfileEntry) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &e)
+	return string(bz)
+}
+
+// TotalGas returns the sum of the gas consumed by all the entries.
+func (p GasProfile) TotalGas() Gas {
+	var total Gas
+	for _, e := range p.Entries {
+		total += e.Gas
+	}
+	return total
+}
+
+type gasProfileKey struct {
+	handler, store, descriptor string
+}
+
+// GasProfiler records the gas consumed through the gas meters it wraps,
+// aggregated by handler, store, operation and descriptor. A GasProfiler is
+// attached to a Context with WithGasProfiler, after which the Context's gas
+// meter and the gas meters of its KVStores record into it.
+//
+// NOTE: A GasProfiler is not safe for concurrent use.
+type GasProfiler struct {
+	index   map[gasProfileKey]int
+	entries []GasProfileEntry
+}
+
+// NewGasProfiler returns a new, empty GasProfiler.
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{index: make(map[gasProfileKey]int)}
+}
+
+// Profile returns the gas consumptions recorded so far.
+func (p *GasProfiler) Profile() *GasProfile {
+	entries := make([]GasProfileEntry, len(p.entries))
+	copy(entries, p.entries)
+	return &GasProfile{Entries: entries}
+}
+
+// GasMeter returns a GasMeter which consumes gas from meter and records it
+// under the given handler and store.
+func (p *GasProfiler) GasMeter(meter GasMeter, handler, store string) GasMeter {
+	if pm, ok := meter.(*profilingGasMeter); ok {
+		meter = pm.GasMeter
+	}
+
+	return &profilingGasMeter{GasMeter: meter, profiler: p, handler: handler, store: store}
+}
+
+func (p *GasProfiler) entry(handler, store, descriptor string) *GasProfileEntry {
+	key := gasProfileKey{handler: handler, store: store, descriptor: descriptor}
+	if i, ok := p.index[key]; ok {
+		return &p.entries[i]
+	}
+
+	operation, ok := gasProfileOperations[descriptor]
+	if !ok || store == "" {
+		operation = GasProfileOperationOther
+	}
+
+	p.index[key] = len(p.entries)
+	p.entries = append(p.entries, GasProfileEntry{
+		Handler:       handler,
+		Store:         store,
+		Operation:     operation,
+		GasDescriptor: descriptor,
+	})
+
+	return &p.entries[len(p.entries)-1]
+}
+
+func (p *GasProfiler) consume(handler, store, descriptor string, amount Gas) {
+	e := p.entry(handler, store, descriptor)
+	e.Gas += amount
+	e.Count++
+}
+
+func (p *GasProfiler) refund(handler, store, descriptor string, amount Gas) {
+	e := p.entry(handler, store, descriptor)
+	if amount > e.Gas {
+		amount = e.Gas
+	}
+	e.Gas -= amount
+}
+
+// profilingGasMeter is a GasMeter which records every gas consumption and
+// refund in a GasProfiler before forwarding it to the wrapped GasMeter.
+type profilingGasMeter struct {
+	GasMeter
+
+	profiler *GasProfiler
+	handler  string
+	store    string
+}
+
+// ConsumeGas records the consumed gas and consumes it from the wrapped meter.
+func (m *profilingGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	m.profiler.consume(m.handler, m.store, descriptor, amount)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// RefundGas records the refunded gas and refunds it to the wrapped meter.
+func (m *profilingGasMeter) RefundGas(amount Gas, descriptor string) {
+	m.GasMeter.RefundGas(amount, descriptor)
+	m.profiler.refund(m.handler, m.store, descriptor, amount)
+}
+
+// WritePprof writes the gas profile to w as a gzipped pprof profile, which can
+// be inspected with `go tool pprof`. Every entry is a sample whose stack is
+// made of the handler, the store (if any) and the descriptor, with the gas
+// consumed and the number of consumptions as values.
+func (p GasProfile) WritePprof(w io.Writer) error {
+	b := newPprofBuilder()
+
+	gasType, countType := b.valueType("gas", "gas"), b.valueType("count", "count")
+	b.buf = protowire.AppendTag(b.buf, 1, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, gasType)
+	b.buf = protowire.AppendTag(b.buf, 1, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, countType)
+
+	for _, e := range p.Entries {
+		// pprof stacks are ordered from the leaf to the root
+		stack := []uint64{b.function(e.GasDescriptor)}
+		if e.Store != "" {
+			stack = append(stack, b.function("store/"+e.Store))
+		}
+		stack = append(stack, b.function(e.Handler))
+
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.BytesType)
+		sample = protowire.AppendBytes(sample, appendPackedVarints(nil, stack...))
+		sample = protowire.AppendTag(sample, 2, protowire.BytesType)
+		sample = protowire.AppendBytes(sample, appendPackedVarints(nil, e.Gas, e.Count))
+
+		b.buf = protowire.AppendTag(b.buf, 2, protowire.BytesType)
+		b.buf = protowire.AppendBytes(b.buf, sample)
+	}
+
+	bz := b.finish()
+
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write(bz); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// pprofBuilder encodes a pprof profile, as defined in
+// https://github.com/google/pprof/blob/main/proto/profile.proto, without
+// depending on its generated code.
+type pprofBuilder struct {
+	buf       []byte
+	strings   []string
+	stringIDs map[string]int64
+	functions map[string]uint64
+	locations []byte
+}
+
+func newPprofBuilder() *pprofBuilder {
+	return &pprofBuilder{
+		strings:   []string{""},
+		stringIDs: map[string]int64{"": 0},
+		functions: make(map[string]uint64),
+	}
+}
+
+func (b *pprofBuilder) str(s string) int64 {
+	if id, ok := b.stringIDs[s]; ok {
+		return id
+	}
+
+	id := int64(len(b.strings))
+	b.strings = append(b.strings, s)
+	b.stringIDs[s] = id
+
+	return id
+}
+
+func (b *pprofBuilder) valueType(typ, unit string) []byte {
+	var vt []byte
+	vt = protowire.AppendTag(vt, 1, protowire.VarintType)
+	vt = protowire.AppendVarint(vt, uint64(b.str(typ)))
+	vt = protowire.AppendTag(vt, 2, protowire.VarintType)
+	vt = protowire.AppendVarint(vt, uint64(b.str(unit)))
+	return vt
+}
+
+// function returns the ID of the location holding the function with the given
+// name, registering both if needed. Functions and locations share their IDs.
+func (b *pprofBuilder) function(name string) uint64 {
+	if id, ok := b.functions[name]; ok {
+		return id
+	}
+
+	id := uint64(len(b.functions) + 1)
+	b.functions[name] = id
+
+	var fn []byte
+	fn = protowire.AppendTag(fn, 1, protowire.VarintType)
+	fn = protowire.AppendVarint(fn, id)
+	fn = protowire.AppendTag(fn, 2, protowire.VarintType)
+	fn = protowire.AppendVarint(fn, uint64(b.str(name)))
+	fn = protowire.AppendTag(fn, 3, protowire.VarintType)
+	fn = protowire.AppendVarint(fn, uint64(b.str(name)))
+	b.buf = protowire.AppendTag(b.buf, 5, protowire.BytesType)
+	b.buf = protowire.AppendBytes(b.buf, fn)
+
+	var line []byte
+	line = protowire.AppendTag(line, 1, protowire.VarintType)
+	line = protowire.AppendVarint(line, id)
+
+	var loc []byte
+	loc = protowire.AppendTag(loc, 1, protowire.VarintType)
+	loc = protowire.AppendVarint(loc, id)
+	loc = protowire.AppendTag(loc, 4, protowire.BytesType)
+	loc = protowire.AppendBytes(loc, line)
+	b.locations = protowire.AppendTag(b.locations, 4, protowire.BytesType)
+	b.locations = protowire.AppendBytes(b.locations, loc)
+
+	return id
+}
+
+func (b *pprofBuilder) finish() []byte {
+	bz := append(b.buf, b.locations...)
+
+	// the gas is the default sample type
+	bz = protowire.AppendTag(bz, 14, protowire.VarintType)
+	bz = protowire.AppendVarint(bz, uint64(b.str("gas")))
+
+	for _, s := range b.strings {
+		bz = protowire.AppendTag(bz, 6, protowire.BytesType)
+		bz = protowire.AppendString(bz, s)
+	}
+
+	return bz
+}
+
+func appendPackedVarints(b []byte, vs ...uint64) []byte {
+	for _, v := range vs {
+		b = protowire.AppendVarint(b, v)
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasProfiler(t *testing.T) {
+	key := types.NewKVStoreKey("profiled")
+	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_profiled"))
+	ctx = ctx.WithGasMeter(types.NewGasMeter(100000))
+
+	profiler := types.NewGasProfiler()
+	ctx = ctx.WithGasProfiler(profiler, types.GasProfileHandlerAnte)
+	ctx.GasMeter().ConsumeGas(10, "txSize")
+
+	// replacing the gas meter keeps recording
+	ctx = ctx.WithGasMeter(types.NewGasMeter(100000))
+	ctx.GasMeter().ConsumeGas(5, "txSize")
+
+	msgCtx := ctx.WithGasProfiler(profiler, "/test.MsgTest")
+	store := msgCtx.KVStore(key)
+	store.Set([]byte("key"), []byte("value"))
+	store.Get([]byte("key"))
+	store.Get([]byte("key"))
+
+	// wrapping an already wrapped meter does not record twice
+	msgCtx = msgCtx.WithGasMeter(msgCtx.GasMeter())
+	msgCtx.GasMeter().ConsumeGas(1, "custom")
+
+	profile := profiler.Profile()
+	require.Equal(t, 10+ctx.GasMeter().GasConsumed(), profile.TotalGas())
+
+	byDescriptor := make(map[string]types.GasProfileEntry)
+	for _, e := range profile.Entries {
+		byDescriptor[e.Handler+"/"+e.GasDescriptor] = e
+	}
+
+	require.Equal(t, types.GasProfileEntry{
+		Handler: types.GasProfileHandlerAnte, Operation: types.GasProfileOperationOther,
+		GasDescriptor: "txSize", Gas: 15, Count: 2,
+	}, byDescriptor["ante/txSize"])
+
+	readFlat := byDescriptor["/test.MsgTest/ReadFlat"]
+	require.Equal(t, "profiled", readFlat.Store)
+	require.Equal(t, types.GasProfileOperationRead, readFlat.Operation)
+	require.Equal(t, uint64(2), readFlat.Count)
+	require.Equal(t, 2*storetypes.KVGasConfig().ReadCostFlat, readFlat.Gas)
+
+	writeFlat := byDescriptor["/test.MsgTest/WriteFlat"]
+	require.Equal(t, types.GasProfileOperationWrite, writeFlat.Operation)
+	require.Equal(t, uint64(1), writeFlat.Count)
+
+	require.Equal(t, uint64(1), byDescriptor["/test.MsgTest/custom"].Gas)
+
+	var buf bytes.Buffer
+	require.NoError(t, profile.WritePprof(&buf))
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	bz, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(bz, []byte("store/profiled")))
+	require.True(t, bytes.Contains(bz, []byte("/test.MsgTest")))
+}
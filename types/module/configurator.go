@@ -34,6 +34,17 @@ type Configurator interface {
 	// will panic. If the ConsensusVersion bump does not introduce any store
 	// changes, then a no-op function must be registered here.
 	RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error
+
+	// RegisterIncrementalMigration registers an incremental store migration for
+	// a module from version `fromVersion` to version `fromVersion+1`. Unlike the
+	// migrations registered with RegisterMigration, which all run inside the
+	// upgrade block, the handler is called once per block after the upgrade
+	// with the cursor it returned in the previous block, until it returns a nil
+	// cursor. See IncrementalMigrationHandler.
+	//
+	// Only one migration, in-place or incremental, can be registered for each
+	// module version.
+	RegisterIncrementalMigration(moduleName string, fromVersion uint64, handler IncrementalMigrationHandler) error
 }
 
 type configurator struct {
@@ -43,6 +54,10 @@ type configurator struct {
 
 	// migrations is a map of moduleName -> fromVersion -> migration script handler
 	migrations map[string]map[uint64]MigrationHandler
+
+	// incrementalMigrations is a map of moduleName -> fromVersion -> incremental
+	// migration handler
+	incrementalMigrations map[string]map[uint64]IncrementalMigrationHandler
 }
 
 // NewConfigurator returns a new Configurator instance
@@ -52,6 +67,8 @@ func NewConfigurator(cdc codec.Codec, msgServer grpc.Server, queryServer grpc.Se
 		msgServer:   msgServer,
 		queryServer: queryServer,
 		migrations:  map[string]map[uint64]MigrationHandler{},
+
+		incrementalMigrations: map[string]map[uint64]IncrementalMigrationHandler{},
 	}
 }
 
@@ -73,45 +90,103 @@ func (c configurator) RegisterMigration(moduleName string, fromVersion uint64, h
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
 	}
 
+	if c.hasMigration(moduleName, fromVersion) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "another migration for module %s and version %d already exists", moduleName, fromVersion)
+	}
+
 	if c.migrations[moduleName] == nil {
 		c.migrations[moduleName] = map[uint64]MigrationHandler{}
 	}
 
-	if c.migrations[moduleName][fromVersion] != nil {
+	c.migrations[moduleName][fromVersion] = handler
+
+	return nil
+}
+
+// RegisterIncrementalMigration implements the Configurator.RegisterIncrementalMigration method
+func (c configurator) RegisterIncrementalMigration(moduleName string, fromVersion uint64, handler IncrementalMigrationHandler) error {
+	if fromVersion == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
+	}
+
+	if c.hasMigration(moduleName, fromVersion) {
 		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "another migration for module %s and version %d already exists", moduleName, fromVersion)
 	}
 
-	c.migrations[moduleName][fromVersion] = handler
+	if c.incrementalMigrations[moduleName] == nil {
+		c.incrementalMigrations[moduleName] = map[uint64]IncrementalMigrationHandler{}
+	}
+
+	c.incrementalMigrations[moduleName][fromVersion] = handler
 
 	return nil
 }
 
+// hasMigration returns whether an in-place or an incremental migration is
+// registered for a module version.
+func (c configurator) hasMigration(moduleName string, fromVersion uint64) bool {
+	return c.migrations[moduleName][fromVersion] != nil || c.incrementalMigrations[moduleName][fromVersion] != nil
+}
+
 // runModuleMigrations runs all in-place store migrations for one given module from a
-// version to another version.
-func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+// version to another version. It stops at the first incremental migration, which
+// is left to runIncrementalMigration along with the following migrations, and
+// returns the version the module was migrated to.
+func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) (uint64, error) {
 	// No-op if toVersion is the initial version or if the version is unchanged.
 	if toVersion <= 1 || fromVersion == toVersion {
-		return nil
+		return toVersion, nil
 	}
 
-	moduleMigrationsMap, found := c.migrations[moduleName]
-	if !found {
-		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migrations found for module %s", moduleName)
+	_, found := c.migrations[moduleName]
+	_, foundIncremental := c.incrementalMigrations[moduleName]
+	if !found && !foundIncremental {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migrations found for module %s", moduleName)
 	}
 
 	// Run in-place migrations for the module sequentially until toVersion.
 	for i := fromVersion; i < toVersion; i++ {
-		migrateFn, found := moduleMigrationsMap[i]
+		if _, found := c.incrementalMigrations[moduleName][i]; found {
+			return i, nil
+		}
+
+		migrateFn, found := c.migrations[moduleName][i]
 		if !found {
-			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", moduleName, i, i+1)
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", moduleName, i, i+1)
 		}
 		ctx.Logger().Info(fmt.Sprintf("migrating module %s from version %d to version %d", moduleName, i, i+1))
 
 		err := migrateFn(ctx)
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
-	return nil
+	return toVersion, nil
+}
+
+// runIncrementalMigration runs one batch of the incremental migration of a
+// module from the cursor of its progress, followed by the in-place migrations
+// registered up to its next incremental migration once it is done, and returns
+// the updated progress.
+func (c configurator) runIncrementalMigration(ctx sdk.Context, progress MigrationProgress) (MigrationProgress, error) {
+	migrateFn, found := c.incrementalMigrations[progress.ModuleName][progress.Version]
+	if !found {
+		return progress, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no incremental migration found for module %s from version %d to version %d", progress.ModuleName, progress.Version, progress.Version+1)
+	}
+
+	cursor, err := migrateFn(ctx, progress.Cursor)
+	if err != nil {
+		return progress, err
+	}
+
+	progress.Cursor = cursor
+	if cursor != nil {
+		return progress, nil
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("migrated module %s from version %d to version %d", progress.ModuleName, progress.Version, progress.Version+1))
+	progress.Version, err = c.runModuleMigrations(ctx, progress.ModuleName, progress.Version+1, progress.ToVersion)
+
+	return progress, err
 }
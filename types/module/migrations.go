@@ -0,0 +1,131 @@
+package module
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/grpc"
+	gogogrpc "google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IncrementalMigrationHandler is the function a module registers to migrate its
+// store over several blocks. Each call performs a bounded amount of work
+// starting from cursor, which is nil on the first call, and returns the cursor
+// to resume from in the next block, or a nil cursor once the migration is done.
+type IncrementalMigrationHandler func(ctx sdk.Context, cursor []byte) ([]byte, error)
+
+// MigrationProgress is the progress of the incremental migration of a module.
+type MigrationProgress struct {
+	// ModuleName is the name of the migrating module.
+	ModuleName string
+	// Version is the version the module is being migrated from.
+	Version uint64
+	// ToVersion is the consensus version the module is migrated to.
+	ToVersion uint64
+	// Cursor is the cursor returned by the incremental migration handler in
+	// the previous block, nil if the migration has not started yet.
+	Cursor []byte
+}
+
+// MigrationStore persists the progress of the incremental migrations between
+// blocks. It is implemented by the x/upgrade keeper.
+type MigrationStore interface {
+	// GetMigrationProgress returns the progress of the incremental migration
+	// of a module, if it is migrating.
+	GetMigrationProgress(ctx sdk.Context, moduleName string) (MigrationProgress, bool)
+	// SetMigrationProgress sets the progress of the incremental migration of a
+	// module.
+	SetMigrationProgress(ctx sdk.Context, progress MigrationProgress)
+	// DeleteMigrationProgress deletes the progress of the incremental migration
+	// of a module once it is done.
+	DeleteMigrationProgress(ctx sdk.Context, moduleName string)
+}
+
+// HasMigrationCompatibility is the interface for modules whose msg handlers keep
+// working while they run incremental migrations, e.g. by reading both the old
+// and the new layouts of their store. The msgs of the other modules are
+// rejected until their incremental migrations are done.
+type HasMigrationCompatibility interface {
+	MigrationCompatible() bool
+}
+
+// events emitted by the incremental migrations
+const (
+	EventTypeModuleMigration = "module_migration"
+
+	AttributeKeyModule    = "module"
+	AttributeKeyVersion   = "version"
+	AttributeKeyToVersion = "to_version"
+	AttributeKeyStatus    = "status"
+
+	AttributeValueMigrationStarted    = "started"
+	AttributeValueMigrationInProgress = "in_progress"
+	AttributeValueMigrationDone       = "done"
+)
+
+// emitMigrationEvent emits an event reporting the progress of the incremental
+// migration of a module.
+func emitMigrationEvent(ctx sdk.Context, progress MigrationProgress, status string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeModuleMigration,
+			sdk.NewAttribute(AttributeKeyModule, progress.ModuleName),
+			sdk.NewAttribute(AttributeKeyVersion, fmt.Sprintf("%d", progress.Version)),
+			sdk.NewAttribute(AttributeKeyToVersion, fmt.Sprintf("%d", progress.ToVersion)),
+			sdk.NewAttribute(AttributeKeyStatus, status),
+		),
+	)
+}
+
+// migratingConfigurator is the Configurator passed to a module in
+// RegisterServices, registering its msg services so that they reject the msgs
+// while the module runs incremental migrations.
+type migratingConfigurator struct {
+	Configurator
+	msgServer migratingMsgServer
+}
+
+// MsgServer implements the Configurator.MsgServer method
+func (c migratingConfigurator) MsgServer() grpc.Server {
+	return c.msgServer
+}
+
+type migratingMsgServer struct {
+	grpc.Server
+	manager    *Manager
+	moduleName string
+}
+
+// RegisterService implements the grpc.Server.RegisterService method, wrapping
+// every method handler of the service.
+func (s migratingMsgServer) RegisterService(sd *gogogrpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]gogogrpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		method.Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor gogogrpc.UnaryServerInterceptor) (interface{}, error) {
+			return handler(srv, ctx, dec, func(goCtx context.Context, req interface{}, info *gogogrpc.UnaryServerInfo, handler gogogrpc.UnaryHandler) (interface{}, error) {
+				// the handlers are also called without sdk.Context when registered,
+				// and the msg senders aren't charged gas for the check, which is
+				// left out of the gas profiles too
+				if ctx, ok := goCtx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+					ctx = ctx.WithGasProfiler(nil, "").WithGasMeter(sdk.NewInfiniteGasMeter())
+					if progress, migrating := s.manager.GetMigrationProgress(ctx, s.moduleName); migrating {
+						return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict, "module %s is migrating from version %d to version %d", s.moduleName, progress.Version, progress.ToVersion)
+					}
+				}
+
+				if interceptor == nil {
+					return handler(goCtx, req)
+				}
+				return interceptor(goCtx, req, info, handler)
+			})
+		}
+		desc.Methods[i] = method
+	}
+
+	s.Server.RegisterService(&desc, ss)
+}
@@ -222,6 +222,9 @@ type Manager struct {
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
 	OrderMigrations    []string
+
+	configurator   configurator
+	migrationStore MigrationStore
 }
 
 // NewManager creates a new Manager object
@@ -292,11 +295,45 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter,
 	}
 }
 
-// RegisterServices registers all module services
+// RegisterServices registers all module services. The msg services of the
+// modules not implementing HasMigrationCompatibility reject the msgs while the
+// module runs incremental migrations.
 func (m *Manager) RegisterServices(cfg Configurator) {
-	for _, module := range m.Modules {
-		module.RegisterServices(cfg)
+	for name, module := range m.Modules {
+		if compat, ok := module.(HasMigrationCompatibility); ok && compat.MigrationCompatible() {
+			module.RegisterServices(cfg)
+			continue
+		}
+
+		module.RegisterServices(migratingConfigurator{
+			Configurator: cfg,
+			msgServer:    migratingMsgServer{Server: cfg.MsgServer(), manager: m, moduleName: name},
+		})
+	}
+}
+
+// SetMigrationStore sets the store persisting the progress of the incremental
+// migrations registered with cfg. It must be set for RunMigrations to schedule
+// the incremental migrations, which are then run by BeginBlock, one batch per
+// module and per block.
+func (m *Manager) SetMigrationStore(cfg Configurator, store MigrationStore) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		panic(fmt.Sprintf("expected %T, got %T", configurator{}, cfg))
+	}
+
+	m.configurator = c
+	m.migrationStore = store
+}
+
+// GetMigrationProgress returns the progress of the incremental migration of a
+// module, if it is migrating.
+func (m *Manager) GetMigrationProgress(ctx sdk.Context, moduleName string) (MigrationProgress, bool) {
+	if m.migrationStore == nil {
+		return MigrationProgress{}, false
 	}
+
+	return m.migrationStore.GetMigrationProgress(ctx, moduleName)
 }
 
 // InitGenesis performs init genesis functionality for modules. Exactly one
@@ -395,6 +432,13 @@ type VersionMap map[string]uint64
 // Migrations are run in an order defined by `Manager.OrderMigrations` or (if not set) defined by
 // `DefaultMigrationsOrder` function.
 //
+// The in-place migrations of a module stop at its first incremental migration
+// (see Configurator.RegisterIncrementalMigration), which is scheduled in the
+// store set with SetMigrationStore. BeginBlock then runs it one batch per block,
+// followed by the remaining migrations of the module, and the msgs of the
+// module are rejected in the meantime. The returned VersionMap holds the target
+// versions of all modules, including the migrating ones.
+//
 // As an app developer, if you wish to skip running InitGenesis for your new
 // module "foo", you need to manually pass a `fromVM` argument to this function
 // foo's module version set to its latest ConsensusVersion. That way, the diff
@@ -441,10 +485,24 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
 		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
 		if exists {
-			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+			if progress, migrating := m.GetMigrationProgress(ctx, moduleName); migrating && progress.ToVersion != toVersion {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict, "module %s is still migrating from version %d to version %d", moduleName, progress.Version, progress.ToVersion)
+			}
+
+			version, err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
 			if err != nil {
 				return nil, err
 			}
+
+			if version < toVersion {
+				if m.migrationStore == nil {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "no migration store set to run the incremental migration of module %s from version %d", moduleName, version)
+				}
+
+				progress := MigrationProgress{ModuleName: moduleName, Version: version, ToVersion: toVersion}
+				m.migrationStore.SetMigrationProgress(ctx, progress)
+				emitMigrationEvent(ctx, progress, AttributeValueMigrationStarted)
+			}
 		} else {
 			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
 			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
@@ -467,6 +525,10 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+	if err := m.runIncrementalMigrations(ctx); err != nil {
+		panic(err)
+	}
+
 	for _, moduleName := range m.OrderBeginBlockers {
 		m.Modules[moduleName].BeginBlock(ctx, req)
 	}
@@ -476,6 +538,42 @@ func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	}
 }
 
+// runIncrementalMigrations runs one batch of the incremental migration of each
+// migrating module.
+func (m *Manager) runIncrementalMigrations(ctx sdk.Context) error {
+	if m.migrationStore == nil {
+		return nil
+	}
+
+	modules := m.OrderMigrations
+	if modules == nil {
+		modules = DefaultMigrationsOrder(m.ModuleNames())
+	}
+
+	for _, moduleName := range modules {
+		progress, migrating := m.migrationStore.GetMigrationProgress(ctx, moduleName)
+		if !migrating {
+			continue
+		}
+
+		progress, err := m.configurator.runIncrementalMigration(ctx, progress)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "incremental migration of module %s", moduleName)
+		}
+
+		if progress.Version == progress.ToVersion {
+			m.migrationStore.DeleteMigrationProgress(ctx, moduleName)
+			emitMigrationEvent(ctx, progress, AttributeValueMigrationDone)
+			continue
+		}
+
+		m.migrationStore.SetMigrationProgress(ctx, progress)
+		emitMigrationEvent(ctx, progress, AttributeValueMigrationInProgress)
+	}
+
+	return nil
+}
+
 // EndBlock performs end block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
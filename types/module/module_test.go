@@ -1,8 +1,10 @@
 package module_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -11,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -179,12 +182,177 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 	cfg := module.NewConfigurator(cdc, msgRouter, queryRouter)
-	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
-	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
+	mockAppModule1.EXPECT().RegisterServices(gomock.Any()).Times(1)
+	mockAppModule2.EXPECT().RegisterServices(gomock.Any()).Times(1)
 
 	mm.RegisterServices(cfg)
 }
 
+// migrationStore is an in-memory module.MigrationStore
+type migrationStore map[string]module.MigrationProgress
+
+func (s migrationStore) GetMigrationProgress(_ sdk.Context, moduleName string) (module.MigrationProgress, bool) {
+	progress, found := s[moduleName]
+	return progress, found
+}
+
+func (s migrationStore) SetMigrationProgress(_ sdk.Context, progress module.MigrationProgress) {
+	s[progress.ModuleName] = progress
+}
+
+func (s migrationStore) DeleteMigrationProgress(_ sdk.Context, moduleName string) {
+	delete(s, moduleName)
+}
+
+func TestConfigurator_RegisterIncrementalMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.NewProtoCodec(types.NewInterfaceRegistry()), nil, nil)
+	handler := func(sdk.Context, []byte) ([]byte, error) { return nil, nil }
+
+	require.EqualError(t, cfg.RegisterIncrementalMigration("module1", 0, handler), "module migration versions should start at 1: invalid version")
+	require.NoError(t, cfg.RegisterMigration("module1", 1, func(sdk.Context) error { return nil }))
+	require.EqualError(t, cfg.RegisterIncrementalMigration("module1", 1, handler), "another migration for module module1 and version 1 already exists: internal logic error")
+	require.NoError(t, cfg.RegisterIncrementalMigration("module1", 2, handler))
+	require.EqualError(t, cfg.RegisterMigration("module1", 2, func(sdk.Context) error { return nil }), "another migration for module module1 and version 2 already exists: internal logic error")
+}
+
+func TestManager_RunIncrementalMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	version := uint64(4)
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().DoAndReturn(func() uint64 { return version })
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any(), gomock.Any()).AnyTimes()
+	mockAppModule2.EXPECT().BeginBlock(gomock.Any(), gomock.Any()).AnyTimes()
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	cfg := module.NewConfigurator(codec.NewProtoCodec(types.NewInterfaceRegistry()), nil, nil)
+
+	// module1 migrates 1 -> 2 in place, 2 -> 3 incrementally over 5 items, 2
+	// items per block, and 3 -> 4 in place
+	var migrated []string
+	require.NoError(t, cfg.RegisterMigration("module1", 1, func(sdk.Context) error {
+		migrated = append(migrated, "1")
+		return nil
+	}))
+	require.NoError(t, cfg.RegisterIncrementalMigration("module1", 2, func(_ sdk.Context, cursor []byte) ([]byte, error) {
+		start := byte(0)
+		if cursor != nil {
+			start = cursor[0]
+		}
+		for i := start; i < start+2 && i < 5; i++ {
+			migrated = append(migrated, fmt.Sprintf("2.%d", i))
+		}
+		if start+2 >= 5 {
+			return nil, nil
+		}
+		return []byte{start + 2}, nil
+	}))
+	require.NoError(t, cfg.RegisterMigration("module1", 3, func(sdk.Context) error {
+		migrated = append(migrated, "3")
+		return nil
+	}))
+
+	fromVM := module.VersionMap{"module1": 1, "module2": 1}
+	_, err := mm.RunMigrations(ctx, cfg, fromVM)
+	require.EqualError(t, err, "no migration store set to run the incremental migration of module module1 from version 2: internal logic error")
+
+	store := migrationStore{}
+	mm.SetMigrationStore(cfg, store)
+	migrated = nil
+	vm, err := mm.RunMigrations(ctx, cfg, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 4, "module2": 1}, vm)
+	require.Equal(t, []string{"1"}, migrated)
+	require.Equal(t, migrationStore{"module1": {ModuleName: "module1", Version: 2, ToVersion: 4}}, store)
+
+	progress, migrating := mm.GetMigrationProgress(ctx, "module1")
+	require.True(t, migrating)
+	require.Equal(t, uint64(2), progress.Version)
+	_, migrating = mm.GetMigrationProgress(ctx, "module2")
+	require.False(t, migrating)
+
+	// a new upgrade can't be run while migrating
+	version = 5
+	_, err = mm.RunMigrations(ctx, cfg, vm)
+	require.EqualError(t, err, "module module1 is still migrating from version 2 to version 4: conflict")
+	version = 4
+
+	res := mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"1", "2.0", "2.1"}, migrated)
+	require.Equal(t, []byte{2}, store["module1"].Cursor)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, module.EventTypeModuleMigration, res.Events[0].Type)
+
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"1", "2.0", "2.1", "2.2", "2.3"}, migrated)
+
+	res = mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"1", "2.0", "2.1", "2.2", "2.3", "2.4", "3"}, migrated)
+	require.Empty(t, store)
+	require.Equal(t, module.AttributeValueMigrationDone, string(res.Events[0].Attributes[3].Value))
+
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Len(t, migrated, 7)
+}
+
+func TestManager_RegisterServicesWhileMigrating(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mm := module.NewManager(mockAppModule1)
+
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Msg",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Do",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				handler := func(context.Context, interface{}) (interface{}, error) { return "done", nil }
+				if interceptor == nil {
+					return handler(ctx, nil)
+				}
+				return interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			},
+		}},
+	}
+
+	var registered *grpc.ServiceDesc
+	msgRouter := mocks.NewMockServer(mockCtrl)
+	msgRouter.EXPECT().RegisterService(gomock.Any(), gomock.Any()).Times(1).Do(func(sd *grpc.ServiceDesc, _ interface{}) {
+		registered = sd
+	})
+	cfg := module.NewConfigurator(codec.NewProtoCodec(types.NewInterfaceRegistry()), msgRouter, nil)
+	mockAppModule1.EXPECT().RegisterServices(gomock.Any()).Times(1).Do(func(cfg module.Configurator) {
+		cfg.MsgServer().RegisterService(desc, nil)
+	})
+	mm.RegisterServices(cfg)
+
+	store := migrationStore{}
+	mm.SetMigrationStore(cfg, store)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	handler := registered.Methods[0].Handler
+
+	res, err := handler(nil, sdk.WrapSDKContext(ctx), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "done", res)
+
+	store.SetMigrationProgress(ctx, module.MigrationProgress{ModuleName: "module1", Version: 1, ToVersion: 2})
+	_, err = handler(nil, sdk.WrapSDKContext(ctx), nil, nil)
+	require.EqualError(t, err, "module module1 is migrating from version 1 to version 2: conflict")
+
+	// the handlers are called without sdk.Context when registered
+	res, err = handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "done", res)
+}
+
 func TestManager_InitGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
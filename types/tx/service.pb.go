@@ -682,6 +682,115 @@ func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ProfileGasRequest is the request type for the Service.ProfileGas
+// RPC method.
+//
+// Since: cosmos-sdk 0.47
+type ProfileGasRequest struct {
+	// tx_bytes is the raw transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *ProfileGasRequest) Reset()         { *m = ProfileGasRequest{} }
+func (m *ProfileGasRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfileGasRequest) ProtoMessage()    {}
+func (*ProfileGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{10}
+}
+func (m *ProfileGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileGasRequest.Merge(m, src)
+}
+func (m *ProfileGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileGasRequest proto.InternalMessageInfo
+
+func (m *ProfileGasRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// ProfileGasResponse is the response type for the Service.ProfileGas
+// RPC method.
+//
+// Since: cosmos-sdk 0.47
+type ProfileGasResponse struct {
+	// gas_info is the information about gas used in the simulation, including
+	// the gas profile.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// pprof is the gas profile encoded as a gzipped pprof profile, which can be
+	// inspected with `go tool pprof`.
+	Pprof []byte `protobuf:"bytes,2,opt,name=pprof,proto3" json:"pprof,omitempty"`
+}
+
+func (m *ProfileGasResponse) Reset()         { *m = ProfileGasResponse{} }
+func (m *ProfileGasResponse) String() string { return proto.CompactTextString(m) }
+func (*ProfileGasResponse) ProtoMessage()    {}
+func (*ProfileGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{11}
+}
+func (m *ProfileGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileGasResponse.Merge(m, src)
+}
+func (m *ProfileGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileGasResponse proto.InternalMessageInfo
+
+func (m *ProfileGasResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *ProfileGasResponse) GetPprof() []byte {
+	if m != nil {
+		return m.Pprof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
@@ -695,73 +804,79 @@ func init() {
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
 	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+	proto.RegisterType((*ProfileGasRequest)(nil), "cosmos.tx.v1beta1.ProfileGasRequest")
+	proto.RegisterType((*ProfileGasResponse)(nil), "cosmos.tx.v1beta1.ProfileGasResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xd1, 0x6e, 0x1a, 0x47,
-	0x14, 0xf5, 0x2e, 0xb6, 0x21, 0x17, 0x3b, 0x21, 0xe3, 0xd4, 0x26, 0x24, 0xc5, 0x64, 0x53, 0x6c,
-	0x07, 0xc9, 0xbb, 0x0a, 0x4d, 0xa5, 0xaa, 0xaa, 0x54, 0x79, 0x81, 0xb8, 0xa8, 0x4d, 0x88, 0x06,
-	0x57, 0x51, 0xaa, 0x4a, 0x68, 0x81, 0x09, 0xac, 0x62, 0x76, 0xf0, 0xce, 0x60, 0x2d, 0x72, 0xac,
-	0x4a, 0x7d, 0xec, 0x53, 0xd5, 0x3e, 0xf4, 0x23, 0xfa, 0x23, 0x7d, 0xb4, 0xd4, 0x97, 0x3e, 0x56,
-	0x76, 0x3f, 0xa0, 0x9f, 0x50, 0xed, 0xec, 0x00, 0x0b, 0x5e, 0xe2, 0xd4, 0xea, 0x8b, 0x3d, 0xc3,
-	0x9c, 0x7b, 0xef, 0x99, 0x33, 0x73, 0xee, 0x2c, 0x6c, 0xb6, 0x28, 0xeb, 0x51, 0x66, 0x70, 0xcf,
-	0x38, 0x7e, 0xdc, 0x24, 0xdc, 0x7a, 0x6c, 0x30, 0xe2, 0x1e, 0xdb, 0x2d, 0xa2, 0xf7, 0x5d, 0xca,
-	0x29, 0xba, 0x1d, 0x00, 0x74, 0xee, 0xe9, 0x12, 0x90, 0xb9, 0xdf, 0xa1, 0xb4, 0x73, 0x48, 0x0c,
-	0xab, 0x6f, 0x1b, 0x96, 0xe3, 0x50, 0x6e, 0x71, 0x9b, 0x3a, 0x2c, 0x08, 0xc8, 0x3c, 0x94, 0x19,
-	0x9b, 0x16, 0x23, 0x86, 0xd5, 0x6c, 0xd9, 0xe3, 0xc4, 0xfe, 0x44, 0x82, 0x32, 0x97, 0xcb, 0x72,
-	0x4f, 0xae, 0x15, 0xc2, 0x09, 0x8e, 0x06, 0xc4, 0x1d, 0x8e, 0x31, 0x7d, 0xab, 0x63, 0x3b, 0xa2,
-	0x9a, 0xc4, 0xde, 0xe7, 0xc4, 0x69, 0x13, 0xb7, 0x67, 0x3b, 0xdc, 0xe0, 0xc3, 0x3e, 0x61, 0x46,
-	0xf3, 0x90, 0xb6, 0xde, 0xcc, 0x5d, 0x15, 0x7f, 0x83, 0x55, 0xed, 0x37, 0x05, 0xd0, 0x3e, 0xe1,
-	0x07, 0x1e, 0xab, 0x1c, 0x13, 0x87, 0x63, 0x72, 0x34, 0x20, 0x8c, 0xa3, 0x75, 0x58, 0x26, 0xfe,
-	0x9c, 0xa5, 0x95, 0x5c, 0x6c, 0xe7, 0x06, 0x96, 0x33, 0xf4, 0x14, 0x60, 0x52, 0x3e, 0xad, 0xe6,
-	0x94, 0x9d, 0x64, 0x71, 0x4b, 0x97, 0xea, 0xf8, 0x5c, 0x75, 0xc1, 0x75, 0xa4, 0x92, 0xfe, 0xc2,
-	0xea, 0x10, 0x99, 0x13, 0x87, 0x22, 0xd1, 0x27, 0x90, 0xa0, 0x6e, 0x9b, 0xb8, 0x8d, 0xe6, 0x30,
-	0x1d, 0xcb, 0x29, 0x3b, 0x37, 0x8b, 0x19, 0xfd, 0x92, 0xc6, 0x7a, 0xcd, 0x87, 0x98, 0x43, 0x1c,
-	0xa7, 0xc1, 0x40, 0x3b, 0x53, 0x60, 0x6d, 0x8a, 0x2d, 0xeb, 0x53, 0x87, 0x11, 0xb4, 0x0d, 0x31,
-	0xee, 0x05, 0x5c, 0x93, 0xc5, 0x0f, 0x22, 0x32, 0x1d, 0x78, 0xd8, 0x47, 0xa0, 0x7d, 0x58, 0xe1,
-	0x5e, 0xc3, 0x95, 0x71, 0x2c, 0xad, 0x8a, 0x88, 0x8f, 0xa6, 0x76, 0x20, 0x4e, 0x28, 0x14, 0x28,
-	0xc1, 0x38, 0xc9, 0xc7, 0x63, 0x3f, 0x51, 0x58, 0x88, 0x98, 0x10, 0x62, 0xfb, 0x4a, 0x21, 0x64,
-	0xa6, 0x50, 0xa8, 0x46, 0x00, 0x99, 0x2e, 0xb5, 0xda, 0x2d, 0x8b, 0x71, 0xbf, 0x58, 0xa0, 0xff,
-	0x5d, 0x48, 0x70, 0xaf, 0xd1, 0x1c, 0x72, 0xe2, 0xef, 0x4a, 0xd9, 0x59, 0xc1, 0x71, 0xee, 0x99,
-	0xfe, 0x14, 0x3d, 0x81, 0xc5, 0x1e, 0x6d, 0x13, 0x21, 0xfe, 0xcd, 0x62, 0x2e, 0x62, 0xb3, 0xe3,
-	0x7c, 0xcf, 0x68, 0x9b, 0x60, 0x81, 0xd6, 0xbe, 0x83, 0xb5, 0xa9, 0x32, 0x52, 0xb8, 0x0a, 0x24,
-	0x43, 0x7a, 0x88, 0x52, 0xef, 0x2b, 0x07, 0x4c, 0xe4, 0xd0, 0x5e, 0xc2, 0xad, 0xba, 0xdd, 0x1b,
-	0x1c, 0x5a, 0x7c, 0x74, 0xda, 0xe8, 0x11, 0xa8, 0xdc, 0x93, 0x09, 0xa3, 0x4f, 0xc4, 0x54, 0xd3,
-	0x0a, 0x56, 0xb9, 0x37, 0xb5, 0x59, 0x75, 0x6a, 0xb3, 0xda, 0x8f, 0x0a, 0xa4, 0x26, 0x99, 0x25,
-	0xe9, 0xcf, 0x21, 0xd1, 0xb1, 0x58, 0xc3, 0x76, 0x5e, 0x53, 0x59, 0xe0, 0xc1, 0x7c, 0xc6, 0xfb,
-	0x16, 0xab, 0x3a, 0xaf, 0x29, 0x8e, 0x77, 0x82, 0x01, 0xfa, 0x14, 0x96, 0x5d, 0xc2, 0x06, 0x87,
-	0x5c, 0x5e, 0xdf, 0xdc, 0xfc, 0x58, 0x2c, 0x70, 0x58, 0xe2, 0x35, 0x0d, 0x56, 0xc4, 0xe5, 0x1b,
-	0x6d, 0x11, 0xc1, 0x62, 0xd7, 0x62, 0x5d, 0xc1, 0xe1, 0x06, 0x16, 0x63, 0xed, 0x14, 0x56, 0x25,
-	0x46, 0x92, 0xcd, 0x5f, 0xa9, 0x83, 0xd0, 0x60, 0xe6, 0x20, 0xd4, 0x6b, 0x1e, 0x84, 0x07, 0xeb,
-	0xfb, 0x84, 0x9b, 0xbe, 0xfd, 0x5f, 0xda, 0xbc, 0x7b, 0xe0, 0xb1, 0x90, 0xa3, 0xbb, 0xc4, 0xee,
-	0x74, 0xb9, 0xe0, 0x12, 0xc3, 0x72, 0xf6, 0x7f, 0x39, 0x5a, 0xfb, 0x47, 0x81, 0x8d, 0x4b, 0xa5,
-	0xff, 0xab, 0x3d, 0x9f, 0x40, 0x42, 0xb4, 0xae, 0x86, 0xdd, 0x96, 0x54, 0xee, 0xea, 0x93, 0xf6,
-	0xa5, 0x07, 0x8d, 0x4b, 0x94, 0xa8, 0x96, 0x71, 0x5c, 0x40, 0xab, 0x6d, 0xb4, 0x0b, 0x4b, 0x62,
-	0x28, 0x6d, 0xb8, 0x31, 0x27, 0x04, 0x07, 0xa8, 0x19, 0xeb, 0x2e, 0x5e, 0xdb, 0xba, 0x85, 0x2f,
-	0x21, 0x2e, 0x3b, 0x14, 0x4a, 0xc3, 0x9d, 0x1a, 0x2e, 0x57, 0x70, 0xc3, 0x7c, 0xd5, 0xf8, 0xe6,
-	0x79, 0xfd, 0x45, 0xa5, 0x54, 0x7d, 0x5a, 0xad, 0x94, 0x53, 0x0b, 0x28, 0x05, 0x2b, 0xe3, 0x95,
-	0xbd, 0x7a, 0x29, 0xa5, 0xa0, 0xdb, 0xb0, 0x3a, 0xfe, 0xa5, 0x5c, 0xa9, 0x97, 0x52, 0x6a, 0xe1,
-	0x2d, 0xac, 0x4e, 0x99, 0x16, 0x65, 0x21, 0x63, 0xe2, 0xda, 0x5e, 0xb9, 0xb4, 0x57, 0x3f, 0x68,
-	0x3c, 0xab, 0x95, 0x2b, 0x33, 0x59, 0xd3, 0x70, 0x67, 0x66, 0xdd, 0xfc, 0xba, 0x56, 0xfa, 0x2a,
-	0xa5, 0xa0, 0x0d, 0x58, 0x9b, 0x59, 0xa9, 0xbf, 0x7a, 0x5e, 0x4a, 0xa9, 0x11, 0x21, 0x7b, 0x62,
-	0x25, 0x56, 0xfc, 0x79, 0x09, 0xe2, 0xf5, 0xe0, 0xbd, 0x43, 0x27, 0x90, 0x18, 0xf9, 0x0d, 0x69,
-	0x11, 0x27, 0x35, 0x63, 0xf3, 0xcc, 0xc3, 0x77, 0x62, 0xe4, 0xad, 0xdc, 0xfa, 0xe1, 0x8f, 0xbf,
-	0x7f, 0x51, 0x73, 0xda, 0x3d, 0x23, 0xe2, 0xa1, 0x95, 0xe0, 0xcf, 0x94, 0x02, 0x3a, 0x82, 0x25,
-	0x61, 0x1e, 0xb4, 0x19, 0x91, 0x35, 0x6c, 0xbd, 0x4c, 0x6e, 0x3e, 0x40, 0xd6, 0xcc, 0x8b, 0x9a,
-	0x9b, 0xe8, 0x43, 0x23, 0xea, 0x95, 0x65, 0xc6, 0x89, 0x6f, 0xd7, 0x53, 0xf4, 0x3d, 0x24, 0x43,
-	0x7d, 0x11, 0xe5, 0xdf, 0xd5, 0x4e, 0x27, 0xe5, 0xb7, 0xae, 0x82, 0x49, 0x12, 0x0f, 0x04, 0x89,
-	0x7b, 0xda, 0x7a, 0x34, 0x09, 0x7f, 0xcf, 0x6f, 0x21, 0x19, 0x7a, 0xd1, 0x22, 0x09, 0x5c, 0x7e,
-	0x9f, 0x23, 0x09, 0x44, 0x3c, 0x8c, 0x5a, 0x56, 0x10, 0x48, 0xa3, 0x39, 0x04, 0xd0, 0xaf, 0x0a,
-	0xdc, 0x9a, 0x71, 0x2d, 0x7a, 0x14, 0x9d, 0x3b, 0xa2, 0xa9, 0x64, 0x0a, 0xef, 0x03, 0x95, 0x54,
-	0x76, 0x05, 0x95, 0x6d, 0x94, 0x9f, 0x73, 0x20, 0xc2, 0x9c, 0xc6, 0x49, 0xd0, 0x96, 0x4e, 0xcd,
-	0x2f, 0x7e, 0x3f, 0xcf, 0x2a, 0x67, 0xe7, 0x59, 0xe5, 0xaf, 0xf3, 0xac, 0xf2, 0xd3, 0x45, 0x76,
-	0xe1, 0xec, 0x22, 0xbb, 0xf0, 0xe7, 0x45, 0x76, 0xe1, 0xdb, 0x7c, 0xc7, 0xe6, 0xdd, 0x41, 0x53,
-	0x6f, 0xd1, 0xde, 0x28, 0x55, 0xf0, 0x6f, 0x97, 0xb5, 0xdf, 0x8c, 0x3e, 0x71, 0xbc, 0xe6, 0xb2,
-	0xf8, 0xc0, 0xf9, 0xf8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x11, 0xc0, 0x8c, 0xdd, 0x09,
-	0x00, 0x00,
+	// 1028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0x1a, 0xc7,
+	0x17, 0xf6, 0x2e, 0xb6, 0x71, 0x0e, 0x76, 0x82, 0xc7, 0xfe, 0xd9, 0x64, 0x93, 0x1f, 0x26, 0xeb,
+	0xe0, 0x7f, 0x92, 0x77, 0x15, 0x37, 0x95, 0xaa, 0xaa, 0x52, 0x65, 0xfe, 0xc4, 0x45, 0x6d, 0x82,
+	0xb5, 0x50, 0x45, 0xa9, 0x2a, 0xa1, 0x05, 0xc6, 0xb0, 0x0a, 0xec, 0xe0, 0x9d, 0xc1, 0x5a, 0xe4,
+	0x58, 0x95, 0xaa, 0x5e, 0xf5, 0xaa, 0x52, 0x2f, 0xaa, 0x3e, 0x43, 0x5f, 0xa4, 0x97, 0x96, 0x7a,
+	0xd3, 0xcb, 0xca, 0xee, 0x03, 0xf4, 0x11, 0xaa, 0x9d, 0x1d, 0x60, 0x81, 0x25, 0xa4, 0x51, 0x6e,
+	0xec, 0x19, 0xe6, 0x3b, 0xe7, 0x7c, 0xf3, 0xcd, 0x9c, 0x6f, 0x07, 0xb6, 0x6a, 0x84, 0xb6, 0x09,
+	0xd5, 0x99, 0xab, 0x5f, 0x3c, 0xa9, 0x62, 0x66, 0x3e, 0xd1, 0x29, 0x76, 0x2e, 0xac, 0x1a, 0xd6,
+	0x3a, 0x0e, 0x61, 0x04, 0xad, 0xfa, 0x00, 0x8d, 0xb9, 0x9a, 0x00, 0x28, 0x0f, 0x1b, 0x84, 0x34,
+	0x5a, 0x58, 0x37, 0x3b, 0x96, 0x6e, 0xda, 0x36, 0x61, 0x26, 0xb3, 0x88, 0x4d, 0xfd, 0x00, 0x65,
+	0x5b, 0x64, 0xac, 0x9a, 0x14, 0xeb, 0x66, 0xb5, 0x66, 0x0d, 0x12, 0x7b, 0x13, 0x01, 0x52, 0x26,
+	0xcb, 0x32, 0x57, 0xac, 0x1d, 0x04, 0x13, 0x9c, 0x77, 0xb1, 0xd3, 0x1b, 0x60, 0x3a, 0x66, 0xc3,
+	0xb2, 0x79, 0x35, 0x81, 0x7d, 0xc8, 0xb0, 0x5d, 0xc7, 0x4e, 0xdb, 0xb2, 0x99, 0xce, 0x7a, 0x1d,
+	0x4c, 0xf5, 0x6a, 0x8b, 0xd4, 0x5e, 0x4f, 0x5d, 0xe5, 0x7f, 0xfd, 0x55, 0xf5, 0x37, 0x09, 0xd0,
+	0x09, 0x66, 0x65, 0x97, 0xe6, 0x2f, 0xb0, 0xcd, 0x0c, 0x7c, 0xde, 0xc5, 0x94, 0xa1, 0x0d, 0x58,
+	0xc4, 0xde, 0x9c, 0x26, 0xa4, 0x54, 0x64, 0xef, 0x8e, 0x21, 0x66, 0xe8, 0x19, 0xc0, 0xb0, 0x7c,
+	0x42, 0x4e, 0x49, 0x7b, 0xb1, 0xa3, 0x1d, 0x4d, 0xa8, 0xe3, 0x71, 0xd5, 0x38, 0xd7, 0xbe, 0x4a,
+	0xda, 0xa9, 0xd9, 0xc0, 0x22, 0xa7, 0x11, 0x88, 0x44, 0x1f, 0xc3, 0x12, 0x71, 0xea, 0xd8, 0xa9,
+	0x54, 0x7b, 0x89, 0x48, 0x4a, 0xda, 0xbb, 0x7b, 0xa4, 0x68, 0x13, 0x1a, 0x6b, 0x45, 0x0f, 0x92,
+	0xe9, 0x19, 0x51, 0xe2, 0x0f, 0xd4, 0x6b, 0x09, 0xd6, 0x46, 0xd8, 0xd2, 0x0e, 0xb1, 0x29, 0x46,
+	0xbb, 0x10, 0x61, 0xae, 0xcf, 0x35, 0x76, 0xf4, 0xbf, 0x90, 0x4c, 0x65, 0xd7, 0xf0, 0x10, 0xe8,
+	0x04, 0x96, 0x99, 0x5b, 0x71, 0x44, 0x1c, 0x4d, 0xc8, 0x3c, 0xe2, 0xf1, 0xc8, 0x0e, 0xf8, 0x09,
+	0x05, 0x02, 0x05, 0xd8, 0x88, 0xb1, 0xc1, 0xd8, 0x4b, 0x14, 0x14, 0x22, 0xc2, 0x85, 0xd8, 0x9d,
+	0x29, 0x84, 0xc8, 0x14, 0x08, 0x55, 0x31, 0xa0, 0x8c, 0x43, 0xcc, 0x7a, 0xcd, 0xa4, 0xac, 0xec,
+	0x0a, 0xad, 0xd0, 0x7d, 0x58, 0x62, 0x6e, 0xa5, 0xda, 0x63, 0xd8, 0xdb, 0x95, 0xb4, 0xb7, 0x6c,
+	0x44, 0x99, 0x9b, 0xf1, 0xa6, 0xe8, 0x29, 0xcc, 0xb7, 0x49, 0x1d, 0x73, 0xf1, 0xef, 0x1e, 0xa5,
+	0x42, 0x36, 0x3b, 0xc8, 0xf7, 0x9c, 0xd4, 0xb1, 0xc1, 0xd1, 0xea, 0xb7, 0xb0, 0x36, 0x52, 0x46,
+	0x08, 0x97, 0x87, 0x58, 0x40, 0x0f, 0x5e, 0xea, 0x5d, 0xe5, 0x80, 0xa1, 0x1c, 0xea, 0x4b, 0xb8,
+	0x57, 0xb2, 0xda, 0xdd, 0x96, 0xc9, 0xfa, 0xa7, 0x8d, 0xf6, 0x41, 0x66, 0xae, 0x48, 0x18, 0x7e,
+	0x22, 0x19, 0x39, 0x21, 0x19, 0x32, 0x73, 0x47, 0x36, 0x2b, 0x8f, 0x6c, 0x56, 0xfd, 0x51, 0x82,
+	0xf8, 0x30, 0xb3, 0x20, 0xfd, 0x19, 0x2c, 0x35, 0x4c, 0x5a, 0xb1, 0xec, 0x33, 0x22, 0x0a, 0x3c,
+	0x9a, 0xce, 0xf8, 0xc4, 0xa4, 0x05, 0xfb, 0x8c, 0x18, 0xd1, 0x86, 0x3f, 0x40, 0x9f, 0xc0, 0xa2,
+	0x83, 0x69, 0xb7, 0xc5, 0xc4, 0xf5, 0x4d, 0x4d, 0x8f, 0x35, 0x38, 0xce, 0x10, 0x78, 0x55, 0x85,
+	0x65, 0x7e, 0xf9, 0xfa, 0x5b, 0x44, 0x30, 0xdf, 0x34, 0x69, 0x93, 0x73, 0xb8, 0x63, 0xf0, 0xb1,
+	0x7a, 0x05, 0x2b, 0x02, 0x23, 0xc8, 0xa6, 0x67, 0xea, 0xc0, 0x35, 0x18, 0x3b, 0x08, 0xf9, 0x3d,
+	0x0f, 0xc2, 0x85, 0x8d, 0x13, 0xcc, 0x32, 0x5e, 0xfb, 0xbf, 0xb4, 0x58, 0xb3, 0xec, 0xd2, 0x40,
+	0x47, 0x37, 0xb1, 0xd5, 0x68, 0x32, 0xce, 0x25, 0x62, 0x88, 0xd9, 0x87, 0xea, 0x68, 0xf5, 0x1f,
+	0x09, 0x36, 0x27, 0x4a, 0xff, 0xd7, 0xf6, 0x7c, 0x0a, 0x4b, 0xdc, 0xba, 0x2a, 0x56, 0x5d, 0x50,
+	0xb9, 0xaf, 0x0d, 0xed, 0x4b, 0xf3, 0x8d, 0x8b, 0x97, 0x28, 0xe4, 0x8c, 0x28, 0x87, 0x16, 0xea,
+	0xe8, 0x10, 0x16, 0xf8, 0x50, 0xb4, 0xe1, 0xe6, 0x94, 0x10, 0xc3, 0x47, 0x8d, 0xb5, 0xee, 0xfc,
+	0xfb, 0xb7, 0xae, 0x06, 0xab, 0xa7, 0x0e, 0x39, 0xb3, 0x5a, 0xf8, 0xc4, 0xa4, 0xb3, 0x3b, 0x57,
+	0x6d, 0x02, 0x0a, 0xe2, 0x3f, 0xc8, 0x6d, 0x5e, 0x87, 0x85, 0x4e, 0xc7, 0x21, 0x67, 0xa2, 0x71,
+	0xfc, 0xc9, 0xc1, 0x17, 0x10, 0x15, 0xde, 0x89, 0x12, 0xb0, 0x5e, 0x34, 0x72, 0x79, 0xa3, 0x92,
+	0x79, 0x55, 0xf9, 0xfa, 0x45, 0xe9, 0x34, 0x9f, 0x2d, 0x3c, 0x2b, 0xe4, 0x73, 0xf1, 0x39, 0x14,
+	0x87, 0xe5, 0xc1, 0xca, 0x71, 0x29, 0x1b, 0x97, 0xd0, 0x2a, 0xac, 0x0c, 0x7e, 0xc9, 0xe5, 0x4b,
+	0xd9, 0xb8, 0x7c, 0xf0, 0x06, 0x56, 0x46, 0xec, 0x04, 0x25, 0x41, 0xc9, 0x18, 0xc5, 0xe3, 0x5c,
+	0xf6, 0xb8, 0x54, 0xae, 0x3c, 0x2f, 0xe6, 0xf2, 0x63, 0x59, 0x13, 0xb0, 0x3e, 0xb6, 0x9e, 0xf9,
+	0xaa, 0x98, 0xfd, 0x32, 0x2e, 0xa1, 0x4d, 0x58, 0x1b, 0x5b, 0x29, 0xbd, 0x7a, 0x91, 0x8d, 0xcb,
+	0x21, 0x21, 0xc7, 0x7c, 0x25, 0x72, 0xf4, 0xeb, 0x22, 0x44, 0x4b, 0xfe, 0x97, 0x18, 0x5d, 0xc2,
+	0x52, 0xdf, 0x09, 0x90, 0x1a, 0x72, 0x87, 0xc6, 0x0c, 0x48, 0xd9, 0x7e, 0x2b, 0x46, 0xf4, 0xcb,
+	0xce, 0xf7, 0x7f, 0xfc, 0xfd, 0xb3, 0x9c, 0x52, 0x1f, 0xe8, 0x21, 0x4f, 0x00, 0x01, 0xfe, 0x54,
+	0x3a, 0x40, 0xe7, 0xb0, 0xc0, 0xdb, 0x1a, 0x6d, 0x85, 0x64, 0x0d, 0x9a, 0x82, 0x92, 0x9a, 0x0e,
+	0x10, 0x35, 0xd3, 0xbc, 0xe6, 0x16, 0xfa, 0xbf, 0x1e, 0xf6, 0xfd, 0xa7, 0xfa, 0xa5, 0x67, 0x24,
+	0x57, 0xe8, 0x3b, 0x88, 0x05, 0x1c, 0x1b, 0xa5, 0xdf, 0x66, 0xf4, 0xc3, 0xf2, 0x3b, 0xb3, 0x60,
+	0x82, 0xc4, 0x23, 0x4e, 0xe2, 0x81, 0xba, 0x11, 0x4e, 0xc2, 0xdb, 0xf3, 0x1b, 0x88, 0x05, 0xbe,
+	0xb5, 0xa1, 0x04, 0x26, 0x5f, 0x0e, 0xca, 0xce, 0x2c, 0x98, 0x20, 0x90, 0xe4, 0x04, 0x12, 0x68,
+	0x0a, 0x01, 0xf4, 0x8b, 0x04, 0xf7, 0xc6, 0xfc, 0x04, 0xed, 0x87, 0xe7, 0x0e, 0xb1, 0x3b, 0xe5,
+	0xe0, 0x5d, 0xa0, 0x82, 0xca, 0x21, 0xa7, 0xb2, 0x8b, 0xd2, 0x53, 0x0e, 0x84, 0xdb, 0x86, 0x7e,
+	0xe9, 0x1b, 0xe6, 0x15, 0xfa, 0x41, 0x02, 0x18, 0xf6, 0x31, 0x7a, 0x1c, 0x52, 0x69, 0xc2, 0x16,
+	0x94, 0xf4, 0x0c, 0x94, 0xa0, 0xb2, 0xcf, 0xa9, 0x6c, 0xab, 0xc9, 0x10, 0x2a, 0x1d, 0x1f, 0x5e,
+	0x69, 0x98, 0xde, 0xf1, 0x64, 0x3e, 0xff, 0xfd, 0x26, 0x29, 0x5d, 0xdf, 0x24, 0xa5, 0xbf, 0x6e,
+	0x92, 0xd2, 0x4f, 0xb7, 0xc9, 0xb9, 0xeb, 0xdb, 0xe4, 0xdc, 0x9f, 0xb7, 0xc9, 0xb9, 0x6f, 0xd2,
+	0x0d, 0x8b, 0x35, 0xbb, 0x55, 0xad, 0x46, 0xda, 0xfd, 0x34, 0xfe, 0xbf, 0x43, 0x5a, 0x7f, 0xdd,
+	0x7f, 0x03, 0xba, 0xd5, 0x45, 0xfe, 0x02, 0xfc, 0xe8, 0xdf, 0x01, 0x00, 0x49, 0x2c, 0x3b, 0xe1,
+	0xfe, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -788,6 +903,11 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// ProfileGas simulates executing a transaction and returns the breakdown of
+	// the consumed gas, including in pprof format. It is meant for debugging.
+	//
+	// Since: cosmos-sdk 0.47
+	ProfileGas(ctx context.Context, in *ProfileGasRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error)
 }
 
 type serviceClient struct {
@@ -843,6 +963,15 @@ func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxs
 	return out, nil
 }
 
+func (c *serviceClient) ProfileGas(ctx context.Context, in *ProfileGasRequest, opts ...grpc.CallOption) (*ProfileGasResponse, error) {
+	out := new(ProfileGasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/ProfileGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -857,6 +986,11 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
+	// ProfileGas simulates executing a transaction and returns the breakdown of
+	// the consumed gas, including in pprof format. It is meant for debugging.
+	//
+	// Since: cosmos-sdk 0.47
+	ProfileGas(context.Context, *ProfileGasRequest) (*ProfileGasResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -878,6 +1012,9 @@ func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsE
 func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
 }
+func (*UnimplementedServiceServer) ProfileGas(ctx context.Context, req *ProfileGasRequest) (*ProfileGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileGas not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -973,6 +1110,24 @@ func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ProfileGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ProfileGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/ProfileGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ProfileGas(ctx, req.(*ProfileGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -997,6 +1152,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockWithTxs",
 			Handler:    _Service_GetBlockWithTxs_Handler,
 		},
+		{
+			MethodName: "ProfileGas",
+			Handler:    _Service_ProfileGas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1463,6 +1622,78 @@ func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfileGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfileGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pprof) > 0 {
+		i -= len(m.Pprof)
+		copy(dAtA[i:], m.Pprof)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Pprof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -1657,6 +1888,36 @@ func (m *GetBlockWithTxsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProfileGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *ProfileGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Pprof)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2886,6 +3147,210 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProfileGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pprof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pprof = append(m.Pprof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pprof == nil {
+				m.Pprof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
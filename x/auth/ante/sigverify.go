@@ -74,18 +74,21 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+		// Only make check if simulate=false. The pubkey of an account which was
+		// rotated no longer matches its address.
+		accPk := acc.GetPubKey()
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && (accPk == nil || !accPk.Equals(pk)) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
+
 		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		if accPk != nil {
 			continue
 		}
 		err = acc.SetPubKey(pk)
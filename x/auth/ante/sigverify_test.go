@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -64,6 +65,55 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetPubKeyRotated() {
+	suite.SetupTest(false) // setup
+	require := suite.Require()
+
+	accounts := suite.CreateTestAccounts(1)
+	priv1, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+	priv2, _, _ := testdata.KeyTestPubAddr()
+	priv3, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	require.NoError(acc.SetPubKey(priv1.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	signDoc, err := types.NewPubKeyRotationSignDoc(suite.ctx.ChainID(), addr, acc.GetAccountNumber(), priv1.PubKey())
+	require.NoError(err)
+	sig, err := priv2.Sign(signDoc.GetSignBytes())
+	require.NoError(err)
+	require.NoError(suite.app.AccountKeeper.RotatePubKey(suite.ctx, addr, priv2.PubKey(), sig))
+
+	testCases := []struct {
+		desc   string
+		priv   cryptotypes.PrivKey
+		expErr error
+	}{
+		{"signed by the replaced key", priv1, sdkerrors.ErrUnauthorized},
+		{"signed by an unrelated key", priv3, sdkerrors.ErrInvalidPubKey},
+		{"signed by the rotated key", priv2, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.desc, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+			require.NoError(err)
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(err, tc.expErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -43,7 +44,10 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetAccountsCmd(),
 		QueryParamsCmd(),
+		QueryPubKeyRotationParamsCmd(),
 		QueryModuleAccountsCmd(),
+		QueryPubKeyHistoryCmd(),
+		QueryPubKeyAtHeightCmd(),
 	)
 
 	return cmd
@@ -80,6 +84,38 @@ $ <appd> query auth params
 	return cmd
 }
 
+// QueryPubKeyRotationParamsCmd returns the command handler for public key
+// rotation parameters querying.
+func QueryPubKeyRotationParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey-rotation-params",
+		Short: "Query the current public key rotation parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the current public key rotation parameters:
+
+$ <appd> query auth pubkey-rotation-params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.PubKeyRotationParams)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountCmd returns a query account that will display the state of the
 // account at a given address.
 func GetAccountCmd() *cobra.Command {
@@ -135,13 +171,33 @@ func GetAccountsCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			queryClient := types.NewQueryClient(clientCtx)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Accounts(ctx, &types.QueryAccountsRequest{Pagination: pageReq})
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-accounts")
+
+	return cmd
+}
+
+// QueryAllModuleAccountsCmd returns a list of all the existing module accounts with their account information and permissions
+func QueryModuleAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Query all module accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.Accounts(cmd.Context(), &types.QueryAccountsRequest{Pagination: pageReq})
+
+			res, err := queryClient.ModuleAccounts(context.Background(), &types.QueryModuleAccountsRequest{})
 			if err != nil {
 				return err
 			}
@@ -151,16 +207,17 @@ func GetAccountsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "all-accounts")
 
 	return cmd
 }
 
-// QueryAllModuleAccountsCmd returns a list of all the existing module accounts with their account information and permissions
-func QueryModuleAccountsCmd() *cobra.Command {
+// QueryPubKeyHistoryCmd returns a query command that will display the public
+// keys of an account replaced by rotations.
+func QueryPubKeyHistoryCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "module-accounts",
-		Short: "Query all module accounts",
+		Use:   "pubkey-history [address]",
+		Short: "Query the public keys of an account replaced by rotations",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -168,8 +225,44 @@ func QueryModuleAccountsCmd() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.PubKeyHistory(ctx, &types.QueryPubKeyHistoryRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				})
+			})
+		},
+	}
 
-			res, err := queryClient.ModuleAccounts(context.Background(), &types.QueryModuleAccountsRequest{})
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pubkey history")
+
+	return cmd
+}
+
+// QueryPubKeyAtHeightCmd returns a query command that will display the public
+// key of an account at a given height.
+func QueryPubKeyAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey-at-height [address] [height]",
+		Short: "Query the public key which signed the transactions of an account at a given height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyAtHeight(cmd.Context(), &types.QueryPubKeyAtHeightRequest{
+				Address: args[0],
+				Height:  height,
+			})
 			if err != nil {
 				return err
 			}
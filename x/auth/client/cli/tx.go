@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for the auth module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRotatePubKeyCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRotatePubKeyCmd returns a CLI command handler for creating a
+// MsgRotatePubKey transaction.
+func NewRotatePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-pubkey [new_key_name]",
+		Short: "Replace the public key of the --from account, keeping its address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the public key of the --from account with the public key of a key of
+the keyring, keeping its address. The transaction is signed by the current key
+of the account, and the new key signs the rotation to prove its possession. The
+new key signs the transactions of the account once the rotation is executed.
+
+The account number of the account is queried, unless the --offline flag is
+set, in which case it is read from the --account-number flag.
+
+Example:
+$ %s tx auth rotate-pubkey new-key --from old-key
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newKey, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			newPubKey, err := newKey.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			fromKey, err := clientCtx.Keyring.Key(clientCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+			currentPubKey, err := fromKey.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			accNum := tx.NewFactoryCLI(clientCtx, cmd.Flags()).AccountNumber()
+			if !clientCtx.Offline {
+				accNum, _, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, from)
+				if err != nil {
+					return err
+				}
+			}
+
+			signDoc, err := types.NewPubKeyRotationSignDoc(clientCtx.ChainID, from, accNum, currentPubKey)
+			if err != nil {
+				return err
+			}
+			sig, _, err := clientCtx.Keyring.Sign(args[0], signDoc.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotatePubKey(from, newPubKey, sig)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1378,6 +1378,90 @@ func (s *IntegrationTestSuite) TestQueryParamsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryPubKeyRotationParamsCmd() {
+	val := s.network.Validators[0]
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryPubKeyRotationParamsCmd(), []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var params authtypes.PubKeyRotationParams
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &params))
+	s.Require().Equal(authtypes.DefaultPubKeyRotationParams(), params)
+}
+
+func (s *IntegrationTestSuite) TestRotatePubKeyCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	account, _, err := clientCtx.Keyring.NewMnemonic("rotateAccount", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	addr, err := account.GetAddress()
+	s.Require().NoError(err)
+	oldPk, err := account.GetPubKey()
+	s.Require().NoError(err)
+
+	_, err = s.createBankMsg(val, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100)))
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	newAccount, _, err := clientCtx.Keyring.NewMnemonic("rotateAccountNew", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	newPk, err := newAccount.GetPubKey()
+	s.Require().NoError(err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.NewRotatePubKeyCmd(), []string{
+		"rotateAccountNew",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, addr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 400000),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+	})
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryPubKeyHistoryCmd(), []string{
+		addr.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var historyRes authtypes.QueryPubKeyHistoryResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &historyRes))
+	s.Require().Len(historyRes.Entries, 1)
+	s.Require().True(oldPk.Equals(historyRes.Entries[0].GetPubKey()))
+
+	testCases := []struct {
+		name      string
+		height    int64
+		expPubKey cryptotypes.PubKey
+	}{
+		{"before the rotation", historyRes.Entries[0].EndHeight - 1, oldPk},
+		{"after the rotation", historyRes.Entries[0].EndHeight + 1, newPk},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryPubKeyAtHeightCmd(), []string{
+				addr.String(),
+				fmt.Sprintf("%d", tc.height),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			})
+			s.Require().NoError(err)
+
+			var res authtypes.QueryPubKeyAtHeightResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			var pk cryptotypes.PubKey
+			s.Require().NoError(clientCtx.InterfaceRegistry.UnpackAny(res.PubKey, &pk))
+			s.Require().True(tc.expPubKey.Equals(pk))
+		})
+	}
+}
+
 // TestTxWithoutPublicKey makes sure sending a proto tx message without the
 // public key doesn't cause any error in the RPC layer (broadcast).
 // See https://github.com/cosmos/cosmos-sdk/issues/7585 for more details.
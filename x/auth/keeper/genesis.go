@@ -11,6 +11,7 @@ import (
 // a genesis port script to the new fee collector account
 func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	ak.SetParams(ctx, data.Params)
+	ak.SetPubKeyRotationParams(ctx, data.PubKeyRotationParams)
 
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
@@ -23,6 +24,10 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, entry := range data.PubKeyHistory {
+		ak.setPubKeyHistoryEntry(ctx, entry)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -37,5 +42,12 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.PubKeyRotationParams = ak.GetPubKeyRotationParams(ctx)
+	ak.IteratePubKeyHistory(ctx, func(entry types.PubKeyHistoryEntry) bool {
+		genState.PubKeyHistory = append(genState.PubKeyHistory, entry)
+		return false
+	})
+
+	return genState
 }
@@ -82,7 +82,7 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 	params := ak.GetParams(ctx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, PubKeyRotationParams: ak.GetPubKeyRotationParams(ctx)}, nil
 }
 
 // ModuleAccounts returns all the existing Module Accounts
@@ -164,3 +164,67 @@ func (ak AccountKeeper) AddressStringToBytes(ctx context.Context, req *types.Add
 
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
+
+// PubKeyHistory returns the public keys of an account replaced by rotations.
+func (ak AccountKeeper) PubKeyHistory(c context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	historyStore := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryByAddressKey(addr))
+
+	var entries []types.PubKeyHistoryEntry
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key, value []byte) error {
+		var entry types.PubKeyHistoryEntry
+		if err := ak.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// PubKeyAtHeight returns the public key of an account at a given height.
+func (ak AccountKeeper) PubKeyAtHeight(c context.Context, req *types.QueryPubKeyAtHeightRequest) (*types.QueryPubKeyAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pubKey, err := ak.GetPubKeyAtHeight(ctx, addr, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if pubKey == nil {
+		return &types.QueryPubKeyAtHeightResponse{}, nil
+	}
+
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPubKeyAtHeightResponse{PubKey: any}, nil
+}
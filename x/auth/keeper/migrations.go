@@ -4,6 +4,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v047"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,3 +42,8 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return iterErr
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.paramSubspace)
+}
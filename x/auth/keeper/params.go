@@ -15,3 +15,15 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// SetPubKeyRotationParams sets the auth module's public key rotation parameters.
+func (ak AccountKeeper) SetPubKeyRotationParams(ctx sdk.Context, params types.PubKeyRotationParams) {
+	ak.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetPubKeyRotationParams gets the auth module's public key rotation
+// parameters. Contrary to GetParams, it is not called by the ante handlers.
+func (ak AccountKeeper) GetPubKeyRotationParams(ctx sdk.Context) (params types.PubKeyRotationParams) {
+	ak.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
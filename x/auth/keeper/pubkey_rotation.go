@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the public key of the account at addr with newPubKey,
+// keeping its address. The replaced public key is recorded in the public key
+// history of the account. The account must already have a public key, and it
+// can't have been rotated in the last PubKeyRotationCooldown blocks.
+// newPubKeySig must be the signature of the PubKeyRotationSignDoc of the
+// rotation by newPubKey, proving its possession.
+func (ak AccountKeeper) RotatePubKey(ctx sdk.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey, newPubKeySig []byte) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key to rotate", addr)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is the current public key")
+	}
+
+	params := ak.GetPubKeyRotationParams(ctx)
+	height := ctx.BlockHeight()
+
+	var startHeight int64
+	if last, found := ak.lastPubKeyRotation(ctx, addr); found {
+		next := last.EndHeight + int64(params.PubKeyRotationCooldown)
+		if height <= last.EndHeight || height < next {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+				"public key of %s rotated at height %d, next rotation allowed at height %d", addr, last.EndHeight, next)
+		}
+		startHeight = last.EndHeight
+	}
+
+	ctx.GasMeter().ConsumeGas(params.PubKeyRotationCost, "public key rotation")
+
+	signDoc, err := types.NewPubKeyRotationSignDoc(ctx.ChainID(), addr, acc.GetAccountNumber(), oldPubKey)
+	if err != nil {
+		return err
+	}
+	if !newPubKey.VerifySignature(signDoc.GetSignBytes(), newPubKeySig) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid new public key signature")
+	}
+
+	entry, err := types.NewPubKeyHistoryEntry(addr, oldPubKey, startHeight, height)
+	if err != nil {
+		return err
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+	ak.setPubKeyHistoryEntry(ctx, entry)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetPubKeyHistory returns the public keys of the account at addr replaced by
+// rotations, ordered by the height at which they were replaced.
+func (ak AccountKeeper) GetPubKeyHistory(ctx sdk.Context, addr sdk.AccAddress) (entries []types.PubKeyHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryByAddressKey(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PubKeyHistoryEntry
+		ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetPubKeyAtHeight returns the public key which signed the transactions of the
+// account at addr at the given height. At the height of a rotation, it returns
+// the replaced public key, which signed the rotation.
+func (ak AccountKeeper) GetPubKeyAtHeight(ctx sdk.Context, addr sdk.AccAddress, height int64) (cryptotypes.PubKey, error) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryByAddressKey(addr))
+	// the first entry replaced at or after height
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(uint64(height)), nil)
+	defer iterator.Close()
+
+	if iterator.Valid() {
+		var entry types.PubKeyHistoryEntry
+		ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+		return entry.GetPubKey(), nil
+	}
+
+	return ak.GetPubKey(ctx, addr)
+}
+
+// IteratePubKeyHistory iterates over the public key history of all the
+// accounts. Stop iteration when cb returns true.
+func (ak AccountKeeper) IteratePubKeyHistory(ctx sdk.Context, cb func(entry types.PubKeyHistoryEntry) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.PubKeyHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PubKeyHistoryEntry
+		ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+func (ak AccountKeeper) setPubKeyHistoryEntry(ctx sdk.Context, entry types.PubKeyHistoryEntry) {
+	addr := sdk.MustAccAddressFromBech32(entry.Address)
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PubKeyHistoryKey(addr, entry.EndHeight), ak.cdc.MustMarshal(&entry))
+}
+
+// lastPubKeyRotation returns the entry recorded by the last rotation of the
+// public key of the account at addr, if any.
+func (ak AccountKeeper) lastPubKeyRotation(ctx sdk.Context, addr sdk.AccAddress) (types.PubKeyHistoryEntry, bool) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyHistoryByAddressKey(addr))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.PubKeyHistoryEntry{}, false
+	}
+
+	var entry types.PubKeyHistoryEntry
+	ak.cdc.MustUnmarshal(iterator.Value(), &entry)
+	return entry, true
+}
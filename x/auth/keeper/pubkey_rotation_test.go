@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRotatePubKey() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+	priv1, pub1, addr := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+	priv3, pub3, _ := testdata.KeyTestPubAddr()
+	msgSrvr := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	params := app.AccountKeeper.GetPubKeyRotationParams(ctx)
+	params.PubKeyRotationCooldown = 5
+	app.AccountKeeper.SetPubKeyRotationParams(ctx, params)
+
+	// the account must exist and have a public key
+	msg, err := types.NewMsgRotatePubKey(addr, pub2, []byte("signature"))
+	suite.Require().NoError(err)
+	_, err = msgSrvr.RotatePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx, addr, pub2, nil), sdkerrors.ErrInvalidPubKey)
+
+	suite.Require().NoError(acc.SetPubKey(pub1))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx, addr, pub1, signRotation(ctx, priv1, acc)), sdkerrors.ErrInvalidPubKey)
+
+	// the new public key must sign the rotation of the account from its
+	// current public key
+	_, err = msgSrvr.RotatePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx, addr, pub2, signRotation(ctx, priv3, acc)), sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx.WithChainID("other-chain"), addr, pub2, signRotation(ctx, priv2, acc)), sdkerrors.ErrUnauthorized)
+
+	// the rotation consumes gas and keeps the address
+	msg, err = types.NewMsgRotatePubKey(addr, pub2, signRotation(ctx, priv2, acc))
+	suite.Require().NoError(err)
+	replayedSig := signRotation(ctx, priv3, acc)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = msgSrvr.RotatePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, params.PubKeyRotationCost)
+
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	suite.Require().Equal(addr, acc.GetAddress())
+	suite.Require().True(pub2.Equals(acc.GetPubKey()))
+
+	// a signature from before the rotation can't be replayed
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx.WithBlockHeight(15), addr, pub3, replayedSig), sdkerrors.ErrUnauthorized)
+
+	// rotations are rate limited
+	sig3 := signRotation(ctx, priv3, acc)
+	ctx = ctx.WithBlockHeight(14)
+	suite.Require().ErrorIs(app.AccountKeeper.RotatePubKey(ctx, addr, pub3, sig3), sdkerrors.ErrInvalidRequest)
+	ctx = ctx.WithBlockHeight(15)
+	suite.Require().NoError(app.AccountKeeper.RotatePubKey(ctx, addr, pub3, sig3))
+
+	history := app.AccountKeeper.GetPubKeyHistory(ctx, addr)
+	suite.Require().Len(history, 2)
+	suite.Require().True(pub1.Equals(history[0].GetPubKey()))
+	suite.Require().Equal(int64(0), history[0].StartHeight)
+	suite.Require().Equal(int64(10), history[0].EndHeight)
+	suite.Require().True(pub2.Equals(history[1].GetPubKey()))
+	suite.Require().Equal(int64(10), history[1].StartHeight)
+	suite.Require().Equal(int64(15), history[1].EndHeight)
+
+	testCases := []struct {
+		height int64
+		pubKey string
+	}{
+		{1, pub1.String()},
+		{10, pub1.String()},
+		{11, pub2.String()},
+		{15, pub2.String()},
+		{16, pub3.String()},
+	}
+	for _, tc := range testCases {
+		pk, err := app.AccountKeeper.GetPubKeyAtHeight(ctx, addr, tc.height)
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.pubKey, pk.String(), "height %d", tc.height)
+	}
+
+	// queries
+	goCtx := sdk.WrapSDKContext(ctx)
+	historyRes, err := app.AccountKeeper.PubKeyHistory(goCtx, &types.QueryPubKeyHistoryRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(historyRes.Entries, 1)
+	suite.Require().Equal(int64(15), historyRes.Entries[0].EndHeight)
+
+	atHeightRes, err := app.AccountKeeper.PubKeyAtHeight(goCtx, &types.QueryPubKeyAtHeightRequest{Address: addr.String(), Height: 12})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pub2, atHeightRes.PubKey.GetCachedValue())
+
+	// the history is exported in genesis
+	genState := app.AccountKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(history, genState.PubKeyHistory)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+}
+
+// signRotation returns the signature by priv of the rotation of the public key
+// of acc.
+func signRotation(ctx sdk.Context, priv cryptotypes.PrivKey, acc types.AccountI) []byte {
+	signDoc, err := types.NewPubKeyRotationSignDoc(ctx.ChainID(), acc.GetAddress(), acc.GetAccountNumber(), acc.GetPubKey())
+	if err != nil {
+		panic(err)
+	}
+
+	sig, err := priv.Sign(signDoc.GetSignBytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return sig
+}
@@ -0,0 +1,23 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Setting the PubKeyRotationCost and PubKeyRotationCooldown params in the
+// paramstore.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyPubKeyRotationCost, types.DefaultPubKeyRotationCost)
+	paramstore.Set(ctx, types.KeyPubKeyRotationCooldown, types.DefaultPubKeyRotationCooldown)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authKey := sdk.NewKVStoreKey("acc")
+	tAuthKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(authKey, tAuthKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, authKey, tAuthKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyRotationCost))
+	require.False(t, paramstore.Has(ctx, types.KeyPubKeyRotationCooldown))
+
+	require.NoError(t, v047.MigrateStore(ctx, paramstore))
+
+	var cost, cooldown uint64
+	paramstore.Get(ctx, types.KeyPubKeyRotationCost, &cost)
+	paramstore.Get(ctx, types.KeyPubKeyRotationCooldown, &cooldown)
+	require.Equal(t, types.DefaultPubKeyRotationCost, cost)
+	require.Equal(t, types.DefaultPubKeyRotationCooldown, cooldown)
+}
@@ -41,6 +41,13 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
 
+		case bytes.Equal(kvA.Key[:1], types.PubKeyHistoryKeyPrefix):
+			var entryA, entryB types.PubKeyHistoryEntry
+			ak.GetCodec().MustUnmarshal(kvA.Value, &entryA)
+			ak.GetCodec().MustUnmarshal(kvB.Value, &entryB)
+
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
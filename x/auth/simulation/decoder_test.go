@@ -31,6 +31,9 @@ func TestDecodeStore(t *testing.T) {
 
 	globalAccNumber := gogotypes.UInt64Value{Value: 10}
 
+	entry, err := types.NewPubKeyHistoryEntry(delAddr1, delPk1, 0, 10)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -41,6 +44,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshal(&globalAccNumber),
 			},
+			{
+				Key:   types.PubKeyHistoryKey(delAddr1, 10),
+				Value: cdc.MustMarshal(&entry),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -53,6 +60,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"PubKeyHistory", fmt.Sprintf("%v\n%v", entry, entry)},
 		{"other", ""},
 	}
 
@@ -19,6 +19,8 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	PubKeyRotationCost     = "pub_key_rotation_cost"
+	PubKeyRotationCooldown = "pub_key_rotation_cooldown"
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
@@ -87,6 +89,16 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenPubKeyRotationCost randomized PubKeyRotationCost
+func GenPubKeyRotationCost(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 10000, 200000))
+}
+
+// GenPubKeyRotationCooldown randomized PubKeyRotationCooldown
+func GenPubKeyRotationCooldown(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn types.RandomGenesisAccountsFn) {
 	var maxMemoChars uint64
@@ -119,11 +131,24 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var pubKeyRotationCost uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyRotationCost, &pubKeyRotationCost, simState.Rand,
+		func(r *rand.Rand) { pubKeyRotationCost = GenPubKeyRotationCost(r) },
+	)
+
+	var pubKeyRotationCooldown uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyRotationCooldown, &pubKeyRotationCooldown, simState.Rand,
+		func(r *rand.Rand) { pubKeyRotationCooldown = GenPubKeyRotationCooldown(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
 		sigVerifyCostED25519, sigVerifyCostSECP256K1)
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
+	authGenesis.PubKeyRotationParams = types.NewPubKeyRotationParams(pubKeyRotationCost, pubKeyRotationCooldown)
 
 	bz, err := json.MarshalIndent(&authGenesis.Params, "", " ")
 	if err != nil {
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -128,6 +129,32 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// ProfileGas implements the ServiceServer.ProfileGas RPC method.
+func (s txServer) ProfileGas(ctx context.Context, req *txtypes.ProfileGasRequest) (*txtypes.ProfileGasResponse, error) {
+	if req == nil || req.TxBytes == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	gasInfo, _, err := s.simulate(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if gasInfo.GasProfile == nil {
+		return nil, status.Error(codes.Unimplemented, "gas profiling is not supported by the simulation function")
+	}
+
+	var pprof bytes.Buffer
+	if err := gasInfo.GasProfile.WritePprof(&pprof); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode gas profile; %v", err)
+	}
+
+	return &txtypes.ProfileGasResponse{
+		GasInfo: &gasInfo,
+		Pprof:   pprof.Bytes(),
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -158,6 +158,48 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestProfileGas_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.ProfileGasRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.ProfileGasRequest{}, true, "empty txBytes is not allowed"},
+		{"valid request", &tx.ProfileGasRequest{TxBytes: txBytes}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.ProfileGas(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				profile := res.GetGasInfo().GetGasProfile()
+				s.Require().NotNil(profile)
+				s.Require().Equal(res.GetGasInfo().GetGasUsed(), profile.TotalGas())
+				s.Require().NotEmpty(res.Pprof)
+
+				handlers := make(map[string]bool)
+				for _, e := range profile.Entries {
+					handlers[e.Handler] = true
+				}
+				s.Require().True(handlers[sdk.GasProfileHandlerAnte])
+				s.Require().True(handlers[sdk.MsgTypeURL(&banktypes.MsgSend{})])
+			}
+		})
+	}
+}
+
 func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -118,6 +118,11 @@ func (acc *BaseAccount) SetSequence(seq uint64) error {
 	return nil
 }
 
+// ErrPubKeyAddressMismatch is returned by BaseAccount.Validate if the public key
+// of an account doesn't match its address, which is only valid once the public
+// key of the account was rotated.
+var ErrPubKeyAddressMismatch = errors.New("account address and pubkey address do not match")
+
 // Validate checks for errors on the account fields
 func (acc BaseAccount) Validate() error {
 	if acc.Address == "" || acc.PubKey == nil {
@@ -130,7 +135,7 @@ func (acc BaseAccount) Validate() error {
 	}
 
 	if !bytes.Equal(acc.GetPubKey().Address().Bytes(), accAddr.Bytes()) {
-		return errors.New("account address and pubkey address do not match")
+		return ErrPubKeyAddressMismatch
 	}
 
 	return nil
@@ -182,55 +182,173 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// PubKeyRotationParams defines the parameters of public key rotations. They
+// are kept apart from Params, which is read by the ante handlers of every
+// transaction.
+//
+// Since: cosmos-sdk 0.47
+type PubKeyRotationParams struct {
+	// pub_key_rotation_cost is the gas consumed by a public key rotation.
+	PubKeyRotationCost uint64 `protobuf:"varint,1,opt,name=pub_key_rotation_cost,json=pubKeyRotationCost,proto3" json:"pub_key_rotation_cost,omitempty"`
+	// pub_key_rotation_cooldown is the minimum number of blocks between two
+	// public key rotations of an account.
+	PubKeyRotationCooldown uint64 `protobuf:"varint,2,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3" json:"pub_key_rotation_cooldown,omitempty"`
+}
+
+func (m *PubKeyRotationParams) Reset()      { *m = PubKeyRotationParams{} }
+func (*PubKeyRotationParams) ProtoMessage() {}
+func (*PubKeyRotationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *PubKeyRotationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotationParams.Merge(m, src)
+}
+func (m *PubKeyRotationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotationParams proto.InternalMessageInfo
+
+func (m *PubKeyRotationParams) GetPubKeyRotationCost() uint64 {
+	if m != nil {
+		return m.PubKeyRotationCost
+	}
+	return 0
+}
+
+func (m *PubKeyRotationParams) GetPubKeyRotationCooldown() uint64 {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+// PubKeyHistoryEntry records a public key which was replaced by a public key
+// rotation, so that the signatures it produced can still be verified.
+//
+// Since: cosmos-sdk 0.47
+type PubKeyHistoryEntry struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pub_key is the replaced public key.
+	PubKey *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// start_height is the height at which pub_key was set by a previous
+	// rotation, or 0 if it was set by the first transaction of the account.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which pub_key was replaced. pub_key signed
+	// the transactions of the account up to this height, included.
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *PubKeyHistoryEntry) Reset()         { *m = PubKeyHistoryEntry{} }
+func (m *PubKeyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PubKeyHistoryEntry) ProtoMessage()    {}
+func (*PubKeyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *PubKeyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyHistoryEntry.Merge(m, src)
+}
+func (m *PubKeyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyHistoryEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyRotationParams)(nil), "cosmos.auth.v1beta1.PubKeyRotationParams")
+	proto.RegisterType((*PubKeyHistoryEntry)(nil), "cosmos.auth.v1beta1.PubKeyHistoryEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
-	0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x4c,
+	0x1c, 0x8e, 0x1b, 0xbf, 0x6d, 0x73, 0x69, 0x2b, 0xf5, 0x9a, 0xf6, 0x75, 0x22, 0xbd, 0x49, 0xde,
+	0x48, 0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x52, 0xb3, 0x35, 0xa5, 0xa2, 0x15, 0x14, 0x2a, 0x47,
+	0x30, 0xb0, 0x58, 0x67, 0xfb, 0xea, 0x58, 0x8d, 0x7d, 0xe6, 0xee, 0x5c, 0xe2, 0x7e, 0x02, 0x26,
+	0xc4, 0xc8, 0xd8, 0x0f, 0xc0, 0xd8, 0x99, 0x19, 0x75, 0xaa, 0x98, 0x60, 0x89, 0x50, 0x3a, 0x80,
+	0xf8, 0x14, 0xc8, 0xe7, 0x4b, 0x49, 0xaa, 0xae, 0x4c, 0xf6, 0x3d, 0xcf, 0xf3, 0xfb, 0xf7, 0xfc,
+	0xce, 0x06, 0x65, 0x9b, 0x30, 0x9f, 0xb0, 0x26, 0x8a, 0x78, 0xbf, 0x79, 0xd2, 0xb2, 0x30, 0x47,
+	0x2d, 0x71, 0xd0, 0x43, 0x4a, 0x38, 0x81, 0x6b, 0x29, 0xaf, 0x0b, 0x48, 0xf2, 0xa5, 0x62, 0x0a,
+	0x9a, 0x42, 0xd2, 0x94, 0x0a, 0x71, 0x28, 0x15, 0x5c, 0xe2, 0x92, 0x14, 0x4f, 0xde, 0x24, 0x5a,
+	0x74, 0x09, 0x71, 0x07, 0xb8, 0x29, 0x4e, 0x56, 0x74, 0xd4, 0x44, 0x41, 0x9c, 0x52, 0xb5, 0x1f,
+	0x0a, 0xc8, 0x77, 0x11, 0xc3, 0xdb, 0xb6, 0x4d, 0xa2, 0x80, 0xc3, 0x36, 0x58, 0x40, 0x8e, 0x43,
+	0x31, 0x63, 0x9a, 0x52, 0x55, 0xea, 0xb9, 0xae, 0xf6, 0xe5, 0xbc, 0x51, 0x90, 0x35, 0xb6, 0x53,
+	0xa6, 0xc7, 0xa9, 0x17, 0xb8, 0xc6, 0x44, 0x08, 0x1f, 0x83, 0x85, 0x30, 0xb2, 0xcc, 0x63, 0x1c,
+	0x6b, 0x73, 0x55, 0xa5, 0x9e, 0x6f, 0x17, 0xf4, 0xb4, 0xa0, 0x3e, 0x29, 0xa8, 0x6f, 0x07, 0x71,
+	0x57, 0xfb, 0x35, 0xaa, 0x14, 0xc2, 0xc8, 0x1a, 0x78, 0x76, 0xa2, 0xbd, 0x47, 0x7c, 0x8f, 0x63,
+	0x3f, 0xe4, 0xb1, 0x31, 0x1f, 0x46, 0xd6, 0x13, 0x1c, 0xc3, 0x3b, 0x60, 0x05, 0xa5, 0x7d, 0x98,
+	0x41, 0xe4, 0x5b, 0x98, 0x6a, 0xd9, 0xaa, 0x52, 0x57, 0x8d, 0x65, 0x89, 0x3e, 0x13, 0x20, 0x2c,
+	0x81, 0x45, 0x86, 0x5f, 0x47, 0x38, 0xb0, 0xb1, 0xa6, 0x0a, 0xc1, 0xf5, 0xb9, 0xa3, 0xbd, 0x3d,
+	0xab, 0x64, 0x3e, 0x9c, 0x55, 0x32, 0x3f, 0xcf, 0x2a, 0x99, 0x8b, 0xf3, 0xc6, 0xa2, 0x1c, 0x6c,
+	0xbf, 0xf6, 0x51, 0x01, 0xcb, 0x07, 0xc4, 0x89, 0x06, 0xd7, 0xb3, 0xee, 0x83, 0x25, 0x0b, 0x31,
+	0x6c, 0xca, 0xec, 0x62, 0xe0, 0x7c, 0xbb, 0xaa, 0xdf, 0xe2, 0xb9, 0x3e, 0xe5, 0x51, 0x57, 0xbd,
+	0x1c, 0x55, 0x14, 0x23, 0x6f, 0x4d, 0xd9, 0x06, 0x81, 0x1a, 0x20, 0x1f, 0x8b, 0xf9, 0x73, 0x86,
+	0x78, 0x87, 0x55, 0x90, 0x0f, 0x31, 0xf5, 0x3d, 0xc6, 0x3c, 0x12, 0x30, 0x2d, 0x5b, 0xcd, 0xd6,
+	0x73, 0xc6, 0x34, 0xd4, 0x29, 0x4d, 0x9a, 0xbd, 0x38, 0x6f, 0xac, 0xcc, 0xf4, 0xb6, 0x5f, 0xfb,
+	0x34, 0x07, 0xe6, 0x0f, 0x11, 0x45, 0x3e, 0x83, 0x3a, 0x58, 0xf3, 0xd1, 0xd0, 0xf4, 0xb1, 0x4f,
+	0x4c, 0xbb, 0x8f, 0x28, 0xb2, 0x39, 0xa6, 0xe9, 0x7e, 0x54, 0x63, 0xd5, 0x47, 0xc3, 0x03, 0xec,
+	0x93, 0x9d, 0x6b, 0x02, 0x56, 0xc1, 0x12, 0x1f, 0x9a, 0xcc, 0x73, 0xcd, 0x81, 0xe7, 0x7b, 0x5c,
+	0x34, 0xa5, 0x1a, 0x80, 0x0f, 0x7b, 0x9e, 0xfb, 0x34, 0x41, 0xe0, 0x7d, 0xb0, 0x2e, 0x14, 0xa7,
+	0xd8, 0xb4, 0x09, 0xe3, 0x66, 0x88, 0xa9, 0x69, 0xc5, 0x1c, 0x4b, 0xbf, 0x57, 0x13, 0xe9, 0x29,
+	0xde, 0x21, 0x8c, 0x1f, 0x62, 0xda, 0x8d, 0x39, 0x86, 0xcf, 0xc1, 0xbf, 0x49, 0xc2, 0x13, 0x4c,
+	0xbd, 0xa3, 0x38, 0x0d, 0xc2, 0x4e, 0x7b, 0x73, 0xb3, 0xb5, 0x95, 0xae, 0xa0, 0xab, 0x8d, 0x47,
+	0x95, 0x42, 0xcf, 0x73, 0x5f, 0x0a, 0x45, 0x12, 0xba, 0xfb, 0x48, 0xf0, 0x46, 0x81, 0xcd, 0xa0,
+	0x69, 0x14, 0x7c, 0x01, 0x8a, 0x37, 0x13, 0x32, 0x6c, 0x87, 0xed, 0xcd, 0x87, 0xc7, 0x2d, 0xed,
+	0x1f, 0x91, 0xb2, 0x34, 0x1e, 0x55, 0x36, 0x66, 0x52, 0xf6, 0x26, 0x0a, 0x63, 0x83, 0xdd, 0x8a,
+	0x77, 0x16, 0xe5, 0xee, 0x95, 0xda, 0x3b, 0x05, 0x14, 0x0e, 0xc5, 0xbd, 0x32, 0x08, 0x47, 0xdc,
+	0x23, 0x81, 0xb4, 0xb3, 0x05, 0xd6, 0xe5, 0x75, 0x35, 0xa9, 0x64, 0x44, 0x7d, 0x69, 0x28, 0x0c,
+	0x67, 0x82, 0x92, 0xf4, 0x70, 0x0b, 0x14, 0x6f, 0x09, 0x21, 0x03, 0x87, 0xbc, 0x09, 0xa4, 0xbd,
+	0x1b, 0x37, 0xc3, 0x52, 0x76, 0xaa, 0xa1, 0x6f, 0x0a, 0x80, 0x69, 0x43, 0x7b, 0x1e, 0xe3, 0x84,
+	0xc6, 0xbb, 0x01, 0xa7, 0xf1, 0x5f, 0xfd, 0xe2, 0x2e, 0xfe, 0x64, 0xb2, 0x69, 0x1c, 0x72, 0xa2,
+	0x4b, 0x47, 0x26, 0x5f, 0xdc, 0xff, 0x60, 0x89, 0x71, 0x44, 0xb9, 0xd9, 0xc7, 0x9e, 0xdb, 0xe7,
+	0x62, 0xff, 0x59, 0x23, 0x2f, 0xb0, 0x3d, 0x01, 0xc1, 0xff, 0x00, 0xc0, 0x81, 0x33, 0x11, 0xa8,
+	0x42, 0x90, 0xc3, 0x81, 0x93, 0xd2, 0x1d, 0x35, 0xb9, 0xc3, 0xdd, 0x9d, 0xcf, 0xe3, 0xb2, 0x72,
+	0x39, 0x2e, 0x2b, 0xdf, 0xc7, 0x65, 0xe5, 0xfd, 0x55, 0x39, 0x73, 0x79, 0x55, 0xce, 0x7c, 0xbd,
+	0x2a, 0x67, 0x5e, 0xdd, 0x75, 0x3d, 0xde, 0x8f, 0x2c, 0xdd, 0x26, 0xbe, 0xfc, 0x55, 0xc9, 0x47,
+	0x83, 0x39, 0xc7, 0xcd, 0x61, 0xfa, 0xe7, 0xe3, 0x71, 0x88, 0x99, 0x35, 0x2f, 0x9a, 0x7f, 0xf0,
+	0x7b, 0x00, 0x32, 0x4a, 0xf8, 0xaf, 0x15, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -269,6 +387,33 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PubKeyRotationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PubKeyRotationParams)
+	if !ok {
+		that2, ok := that.(PubKeyRotationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PubKeyRotationCost != that1.PubKeyRotationCost {
+		return false
+	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
+	return true
+}
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -420,6 +565,91 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKeyRotationCooldown != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyRotationCooldown))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKeyRotationCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyRotationCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -501,6 +731,44 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *PubKeyRotationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeyRotationCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyRotationCost))
+	}
+	if m.PubKeyRotationCooldown != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyRotationCooldown))
+	}
+	return n
+}
+
+func (m *PubKeyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovAuth(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovAuth(uint64(m.EndHeight))
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -958,6 +1226,250 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PubKeyRotationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCost", wireType)
+			}
+			m.PubKeyRotationCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyRotationCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			m.PubKeyRotationCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyRotationCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -17,15 +18,17 @@ var _ types.UnpackInterfacesMessage = GenesisState{}
 // RandomGenesisAccountsFn defines the function required to generate custom account types
 type RandomGenesisAccountsFn func(simState *module.SimulationState) GenesisAccounts
 
-// NewGenesisState - Create a new genesis state
+// NewGenesisState - Create a new genesis state with the default public key
+// rotation parameters
 func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
 	genAccounts, err := PackAccounts(accounts)
 	if err != nil {
 		panic(err)
 	}
 	return &GenesisState{
-		Params:   params,
-		Accounts: genAccounts,
+		Params:               params,
+		Accounts:             genAccounts,
+		PubKeyRotationParams: DefaultPubKeyRotationParams(),
 	}
 }
 
@@ -38,6 +41,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, entry := range g.PubKeyHistory {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -64,13 +72,26 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if err := data.PubKeyRotationParams.Validate(); err != nil {
+		return err
+	}
 
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidatePubKeyHistory(data.PubKeyHistory); err != nil {
+		return err
+	}
+
+	// the public key of a rotated account no longer matches its address
+	rotated := make(map[string]bool, len(data.PubKeyHistory))
+	for _, entry := range data.PubKeyHistory {
+		rotated[entry.Address] = true
+	}
+
+	return validateGenAccounts(genAccs, rotated)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -84,6 +105,10 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+func validateGenAccounts(accounts GenesisAccounts, rotated map[string]bool) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -96,7 +121,7 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 		addrMap[addrStr] = true
 
 		// check account specific validation
-		if err := acc.Validate(); err != nil {
+		if err := acc.Validate(); err != nil && !(rotated[addrStr] && errors.Is(err, ErrPubKeyAddressMismatch)) {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
 		}
 	}
@@ -30,6 +30,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pub_key_history is the history of the public keys replaced by public key
+	// rotations.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyHistory []PubKeyHistoryEntry `protobuf:"bytes,3,rep,name=pub_key_history,json=pubKeyHistory,proto3" json:"pub_key_history"`
+	// pub_key_rotation_params defines the parameters of public key rotations.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyRotationParams PubKeyRotationParams `protobuf:"bytes,4,opt,name=pub_key_rotation_params,json=pubKeyRotationParams,proto3" json:"pub_key_rotation_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +88,20 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPubKeyHistory() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.PubKeyHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyRotationParams() PubKeyRotationParams {
+	if m != nil {
+		return m.PubKeyRotationParams
+	}
+	return PubKeyRotationParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +109,28 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0xb6, 0x94, 0x3f, 0xe9, 0x5f, 0x84, 0x58, 0xb0, 0x56, 0x58, 0xab, 0x17, 0xdb,
+	0x83, 0xbb, 0xb6, 0x9e, 0x3c, 0xaa, 0x88, 0x82, 0x17, 0xa9, 0x78, 0xf1, 0x52, 0x36, 0x71, 0x9b,
+	0x86, 0xda, 0x4c, 0xc8, 0x4e, 0xc4, 0x7d, 0x0b, 0x9f, 0xc7, 0x27, 0xe8, 0xb1, 0x47, 0x4f, 0x22,
+	0xcd, 0x8b, 0x48, 0x77, 0xb7, 0xa2, 0x10, 0x3c, 0x65, 0xc8, 0xfc, 0x66, 0xbe, 0xef, 0xdb, 0xf1,
+	0xf6, 0x43, 0x90, 0x33, 0x90, 0x8c, 0xe7, 0x38, 0x61, 0xcf, 0xfd, 0x40, 0x20, 0xef, 0xb3, 0x48,
+	0x24, 0x42, 0xc6, 0x92, 0xa6, 0x19, 0x20, 0xf8, 0x5b, 0x06, 0xa1, 0x2b, 0x84, 0x5a, 0xa4, 0xbd,
+	0x13, 0x01, 0x44, 0x4f, 0x82, 0x69, 0x24, 0xc8, 0xc7, 0x8c, 0x27, 0xca, 0xf0, 0xed, 0x66, 0x04,
+	0x11, 0xe8, 0x92, 0xad, 0x2a, 0xfb, 0x97, 0x94, 0x09, 0xe9, 0x95, 0xba, 0x7f, 0xf0, 0x56, 0xf1,
+	0xfe, 0x5f, 0x19, 0xdd, 0x3b, 0xe4, 0x28, 0xfc, 0x53, 0xaf, 0x9e, 0xf2, 0x8c, 0xcf, 0x64, 0xcb,
+	0xed, 0xb8, 0xdd, 0xc6, 0x60, 0x97, 0x96, 0xf8, 0xa0, 0xb7, 0x1a, 0x39, 0xaf, 0xcd, 0x3f, 0xf6,
+	0x9c, 0xa1, 0x1d, 0xf0, 0x8f, 0xbd, 0x7f, 0x3c, 0x0c, 0x21, 0x4f, 0x50, 0xb6, 0x2a, 0x9d, 0x6a,
+	0xb7, 0x31, 0x68, 0x52, 0xe3, 0x97, 0xae, 0xfd, 0xd2, 0xb3, 0x44, 0x0d, 0xbf, 0x29, 0xff, 0xde,
+	0xdb, 0x4c, 0xf3, 0x60, 0x34, 0x15, 0x6a, 0x34, 0x89, 0x25, 0x42, 0xa6, 0x5a, 0x55, 0x3d, 0x78,
+	0x58, 0xae, 0x9a, 0x07, 0x37, 0x42, 0x5d, 0x1b, 0xf2, 0x32, 0xc1, 0x4c, 0x59, 0x07, 0x1b, 0xe9,
+	0xcf, 0x8e, 0x3f, 0xf6, 0xb6, 0xd7, 0x6b, 0x33, 0x40, 0x8e, 0x31, 0x24, 0x23, 0x1b, 0xaa, 0xa6,
+	0x43, 0xf5, 0xfe, 0x58, 0x3f, 0xb4, 0x13, 0xbf, 0x22, 0x36, 0xd3, 0xb2, 0xde, 0xc5, 0x7c, 0x49,
+	0xdc, 0xc5, 0x92, 0xb8, 0x9f, 0x4b, 0xe2, 0xbe, 0x16, 0xc4, 0x59, 0x14, 0xc4, 0x79, 0x2f, 0x88,
+	0xf3, 0xd0, 0x8b, 0x62, 0x9c, 0xe4, 0x01, 0x0d, 0x61, 0xc6, 0xec, 0x05, 0xcc, 0xe7, 0x48, 0x3e,
+	0x4e, 0xd9, 0x8b, 0x39, 0x07, 0xaa, 0x54, 0xc8, 0xa0, 0xae, 0xdf, 0xe6, 0xe4, 0x6b, 0x00, 0x04,
+	0x2c, 0x00, 0x7b, 0x13, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +153,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PubKeyRotationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PubKeyHistory) > 0 {
+		for iNdEx := len(m.PubKeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +229,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PubKeyHistory) > 0 {
+		for _, e := range m.PubKeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PubKeyRotationParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -282,6 +342,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyHistory = append(m.PubKeyHistory, PubKeyHistoryEntry{})
+			if err := m.PubKeyHistory[len(m.PubKeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKeyRotationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -50,6 +50,45 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisPubKeyHistory(t *testing.T) {
+	// an account whose public key was rotated from pk1 to pk2
+	addr := sdk.AccAddress(addr1)
+	acc := types.NewBaseAccountWithAddress(addr)
+	require.NoError(t, acc.SetPubKey(pk2))
+
+	entry := func(start, end int64) types.PubKeyHistoryEntry {
+		e, err := types.NewPubKeyHistoryEntry(addr, pk1, start, end)
+		require.NoError(t, err)
+		return e
+	}
+
+	testCases := []struct {
+		name    string
+		history []types.PubKeyHistoryEntry
+		expPass bool
+	}{
+		{"no history", nil, false},
+		{"valid history", []types.PubKeyHistoryEntry{entry(0, 10), entry(10, 20)}, true},
+		{"invalid heights", []types.PubKeyHistoryEntry{entry(10, 10)}, false},
+		{"overlapping entries", []types.PubKeyHistoryEntry{entry(0, 10), entry(5, 20)}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{acc})
+			genState.PubKeyHistory = tc.history
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -16,12 +17,18 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 )
 
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// PubKeyHistoryKeyPrefix prefix for the public keys replaced by rotations
+	PubKeyHistoryKeyPrefix = []byte{0x02}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +37,25 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// PubKeyHistoryKey returns the key of the public key of the address replaced
+// at the given height:
+// 0x02<addrLen (1 Byte)><addr_Bytes><height_Bytes>
+func PubKeyHistoryKey(addr sdk.AccAddress, height int64) []byte {
+	return append(PubKeyHistoryByAddressKey(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// PubKeyHistoryByAddressKey returns the prefix of the public keys of the
+// address replaced by rotations:
+// 0x02<addrLen (1 Byte)><addr_Bytes>
+func PubKeyHistoryByAddressKey(addr sdk.AccAddress) []byte {
+	return append(PubKeyHistoryKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// ParsePubKeyHistoryKey returns the address and the height from a key created
+// by PubKeyHistoryKey.
+func ParsePubKeyHistoryKey(key []byte) (sdk.AccAddress, int64) {
+	addrLen := key[1]
+	addr := sdk.AccAddress(key[2 : 2+addrLen])
+	return addr, int64(sdk.BigEndianToUint64(key[2+addrLen:]))
+}
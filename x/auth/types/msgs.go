@@ -0,0 +1,113 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// auth message types
+const (
+	TypeMsgRotatePubKey = "rotate_pub_key"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRotatePubKey{}
+	_ legacytx.LegacyMsg                 = &MsgRotatePubKey{}
+	_ codectypes.UnpackInterfacesMessage = MsgRotatePubKey{}
+)
+
+// NewMsgRotatePubKey creates a new MsgRotatePubKey instance. newPubKeySig is
+// the signature of the PubKeyRotationSignDoc of the rotation by newPubKey.
+//nolint:interfacer
+func NewMsgRotatePubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey, newPubKeySig []byte) (*MsgRotatePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{Address: addr.String(), NewPubKey: pkAny, NewPubKeySignature: newPubKeySig}, nil
+}
+
+// Route Implements Msg.
+func (msg MsgRotatePubKey) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRotatePubKey) Type() string { return TypeMsgRotatePubKey }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRotatePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty new public key")
+	}
+
+	if _, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey); !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	if len(msg.NewPubKeySignature) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "empty new public key signature")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRotatePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRotatePubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetNewPubKey returns the unpacked new public key.
+func (msg MsgRotatePubKey) GetNewPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
+
+// NewPubKeyRotationSignDoc creates the document to be signed by the new public
+// key of a rotation of the account at addr, whose public key is currentPubKey.
+//nolint:interfacer
+func NewPubKeyRotationSignDoc(chainID string, addr sdk.AccAddress, accNum uint64, currentPubKey cryptotypes.PubKey) (*PubKeyRotationSignDoc, error) {
+	pkAny, err := codectypes.NewAnyWithValue(currentPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKeyRotationSignDoc{
+		ChainId:       chainID,
+		Address:       addr.String(),
+		AccountNumber: accNum,
+		CurrentPubKey: pkAny,
+	}, nil
+}
+
+// GetSignBytes returns the bytes signed by the new public key of a rotation.
+func (d PubKeyRotationSignDoc) GetSignBytes() []byte {
+	bz, err := d.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
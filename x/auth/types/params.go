@@ -15,6 +15,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyRotationCost     uint64 = 100000
+	DefaultPubKeyRotationCooldown uint64 = 14400
 )
 
 // Parameter keys
@@ -24,9 +26,14 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyPubKeyRotationCost     = []byte("PubKeyRotationCost")
+	KeyPubKeyRotationCooldown = []byte("PubKeyRotationCooldown")
 )
 
-var _ paramtypes.ParamSet = &Params{}
+var (
+	_ paramtypes.ParamSet = &Params{}
+	_ paramtypes.ParamSet = &PubKeyRotationParams{}
+)
 
 // NewParams creates a new Params object
 func NewParams(
@@ -43,7 +50,7 @@ func NewParams(
 
 // ParamKeyTable for auth module
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{}).RegisterParamSet(&PubKeyRotationParams{})
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -150,6 +157,24 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePubKeyRotationCost(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePubKeyRotationCooldown(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -170,3 +195,45 @@ func (p Params) Validate() error {
 
 	return nil
 }
+
+// NewPubKeyRotationParams creates a new PubKeyRotationParams object
+func NewPubKeyRotationParams(pubKeyRotationCost, pubKeyRotationCooldown uint64) PubKeyRotationParams {
+	return PubKeyRotationParams{
+		PubKeyRotationCost:     pubKeyRotationCost,
+		PubKeyRotationCooldown: pubKeyRotationCooldown,
+	}
+}
+
+// DefaultPubKeyRotationParams returns a default set of public key rotation
+// parameters.
+func DefaultPubKeyRotationParams() PubKeyRotationParams {
+	return PubKeyRotationParams{
+		PubKeyRotationCost:     DefaultPubKeyRotationCost,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value
+// pairs of the public key rotation parameters. They are stored in the auth
+// module's param subspace next to, but not as part of, Params.
+func (p *PubKeyRotationParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPubKeyRotationCost, &p.PubKeyRotationCost, validatePubKeyRotationCost),
+		paramtypes.NewParamSetPair(KeyPubKeyRotationCooldown, &p.PubKeyRotationCooldown, validatePubKeyRotationCooldown),
+	}
+}
+
+// String implements the stringer interface.
+func (p PubKeyRotationParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate checks that the public key rotation parameters have valid values.
+func (p PubKeyRotationParams) Validate() error {
+	if err := validatePubKeyRotationCost(p.PubKeyRotationCost); err != nil {
+		return err
+	}
+
+	return validatePubKeyRotationCooldown(p.PubKeyRotationCooldown)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = PubKeyHistoryEntry{}
+
+// NewPubKeyHistoryEntry creates a new PubKeyHistoryEntry recording that pubKey
+// signed the transactions of addr from startHeight to endHeight.
+//nolint:interfacer
+func NewPubKeyHistoryEntry(addr sdk.AccAddress, pubKey cryptotypes.PubKey, startHeight, endHeight int64) (PubKeyHistoryEntry, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return PubKeyHistoryEntry{}, err
+	}
+
+	return PubKeyHistoryEntry{
+		Address:     addr.String(),
+		PubKey:      pkAny,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}, nil
+}
+
+// GetPubKey returns the unpacked public key of the entry.
+func (e PubKeyHistoryEntry) GetPubKey() cryptotypes.PubKey {
+	if e.PubKey == nil {
+		return nil
+	}
+
+	pk, _ := e.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// Validate performs basic validation of the entry.
+func (e PubKeyHistoryEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %w", e.Address, err)
+	}
+
+	if e.GetPubKey() == nil {
+		return fmt.Errorf("missing public key in the history of %s", e.Address)
+	}
+
+	if e.StartHeight < 0 || e.EndHeight <= e.StartHeight {
+		return fmt.Errorf("invalid heights [%d, %d] in the public key history of %s", e.StartHeight, e.EndHeight, e.Address)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e PubKeyHistoryEntry) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(e.PubKey, &pubKey)
+}
+
+// ValidatePubKeyHistory validates the entries of a public key history, and
+// checks that the entries of each address don't overlap.
+func ValidatePubKeyHistory(entries []PubKeyHistoryEntry) error {
+	// the highest end height of each address, the entries must be sorted by
+	// address and end height like in the store
+	lastEnd := make(map[string]int64, len(entries))
+	for _, e := range entries {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+
+		if end, ok := lastEnd[e.Address]; ok && e.StartHeight < end {
+			return fmt.Errorf("overlapping entries in the public key history of %s", e.Address)
+		}
+		lastEnd[e.Address] = e.EndHeight
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package types
 
-import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
 
 func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var account AccountI
@@ -8,3 +11,22 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryPubKeyHistoryResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, entry := range m.Entries {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryPubKeyHistoryResponse{}
+
+func (m *QueryPubKeyAtHeightResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryPubKeyAtHeightResponse{}
@@ -219,6 +219,10 @@ var xxx_messageInfo_QueryModuleAccountsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pub_key_rotation_params defines the parameters of public key rotations.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyRotationParams PubKeyRotationParams `protobuf:"bytes,2,opt,name=pub_key_rotation_params,json=pubKeyRotationParams,proto3" json:"pub_key_rotation_params"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -261,6 +265,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetPubKeyRotationParams() PubKeyRotationParams {
+	if m != nil {
+		return m.PubKeyRotationParams
+	}
+	return PubKeyRotationParams{}
+}
+
 // QueryAccountResponse is the response type for the Query/Account RPC method.
 type QueryAccountResponse struct {
 	// account defines the account of the corresponding address.
@@ -665,6 +676,225 @@ func (m *AddressStringToBytesResponse) GetAddressBytes() []byte {
 	return nil
 }
 
+// QueryPubKeyHistoryRequest is the request type for the Query/PubKeyHistory RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryPubKeyHistoryRequest struct {
+	// address defines the address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{14}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryResponse is the response type for the Query/PubKeyHistory RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryPubKeyHistoryResponse struct {
+	// entries are the replaced public keys of the account.
+	Entries []PubKeyHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{15}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetEntries() []PubKeyHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyAtHeightRequest is the request type for the Query/PubKeyAtHeight RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryPubKeyAtHeightRequest struct {
+	// address defines the address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height defines the height at which the public key is queried.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryPubKeyAtHeightRequest) Reset()         { *m = QueryPubKeyAtHeightRequest{} }
+func (m *QueryPubKeyAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyAtHeightRequest) ProtoMessage()    {}
+func (*QueryPubKeyAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{16}
+}
+func (m *QueryPubKeyAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyAtHeightRequest.Merge(m, src)
+}
+func (m *QueryPubKeyAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyAtHeightRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryPubKeyAtHeightResponse is the response type for the Query/PubKeyAtHeight RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryPubKeyAtHeightResponse struct {
+	// pub_key is the public key of the account at the height, if any.
+	PubKey *types.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryPubKeyAtHeightResponse) Reset()         { *m = QueryPubKeyAtHeightResponse{} }
+func (m *QueryPubKeyAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyAtHeightResponse) ProtoMessage()    {}
+func (*QueryPubKeyAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{17}
+}
+func (m *QueryPubKeyAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyAtHeightResponse.Merge(m, src)
+}
+func (m *QueryPubKeyAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyAtHeightResponse) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -680,63 +910,80 @@ func init() {
 	proto.RegisterType((*AddressBytesToStringResponse)(nil), "cosmos.auth.v1beta1.AddressBytesToStringResponse")
 	proto.RegisterType((*AddressStringToBytesRequest)(nil), "cosmos.auth.v1beta1.AddressStringToBytesRequest")
 	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
+	proto.RegisterType((*QueryPubKeyAtHeightRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyAtHeightRequest")
+	proto.RegisterType((*QueryPubKeyAtHeightResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyAtHeightResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0xbb, 0xbc, 0xef, 0x0b, 0x7d, 0x87, 0xc2, 0x61, 0x28, 0x09, 0xef, 0x16, 0x5a, 0xb2,
-	0xbc, 0x40, 0x8b, 0x74, 0x57, 0x8a, 0x17, 0x7f, 0xc4, 0x84, 0x82, 0x1a, 0x0f, 0x26, 0xb5, 0x70,
-	0xf2, 0x60, 0x33, 0xdb, 0x0e, 0x4b, 0x23, 0xdd, 0x29, 0x9d, 0xad, 0xa1, 0x31, 0x24, 0xc6, 0x13,
-	0x37, 0x4d, 0xfc, 0x07, 0xf0, 0x3f, 0xd0, 0x84, 0xab, 0x77, 0xc2, 0x89, 0xe8, 0xc5, 0x93, 0x31,
-	0xe0, 0xc1, 0x3f, 0xc3, 0x74, 0xe6, 0xd9, 0xb6, 0x4b, 0xa6, 0xed, 0x7a, 0xa2, 0x3b, 0xf3, 0x7c,
-	0xbf, 0xcf, 0x67, 0x9e, 0x67, 0x9e, 0x01, 0xa5, 0xca, 0x8c, 0xd7, 0x18, 0xb7, 0x48, 0xd3, 0xdb,
-	0xb3, 0x5e, 0xae, 0xd9, 0xd4, 0x23, 0x6b, 0xd6, 0x41, 0x93, 0x36, 0x5a, 0x66, 0xbd, 0xc1, 0x3c,
-	0x86, 0xa7, 0x64, 0x80, 0xd9, 0x0e, 0x30, 0x21, 0x40, 0x5f, 0x01, 0x95, 0x4d, 0x38, 0x95, 0xd1,
-	0x1d, 0x6d, 0x9d, 0x38, 0x55, 0x97, 0x78, 0x55, 0xe6, 0x4a, 0x03, 0x3d, 0xee, 0x30, 0x87, 0x89,
-	0x9f, 0x56, 0xfb, 0x17, 0xac, 0xfe, 0xe7, 0x30, 0xe6, 0xec, 0x53, 0x4b, 0x7c, 0xd9, 0xcd, 0x5d,
-	0x8b, 0xb8, 0x90, 0x51, 0x9f, 0x85, 0x2d, 0x52, 0xaf, 0x5a, 0xc4, 0x75, 0x99, 0x27, 0xdc, 0x38,
-	0xec, 0x26, 0x55, 0xc0, 0x02, 0x0e, 0x8c, 0xe5, 0x7e, 0x49, 0x66, 0x04, 0x78, 0xf1, 0x61, 0x3c,
-	0x47, 0xf1, 0xa7, 0x6d, 0xd6, 0x8d, 0x72, 0x99, 0x35, 0x5d, 0x8f, 0x17, 0xe9, 0x41, 0x93, 0x72,
-	0x0f, 0x3f, 0x44, 0xa8, 0x4b, 0x3d, 0xa3, 0xcd, 0x6b, 0xe9, 0xf1, 0xdc, 0x92, 0x09, 0xd2, 0xf6,
-	0x11, 0x4d, 0x59, 0x10, 0xc8, 0x66, 0x16, 0x88, 0x43, 0x41, 0x5b, 0xec, 0x51, 0x1a, 0x27, 0x1a,
-	0x9a, 0xbe, 0x96, 0x80, 0xd7, 0x99, 0xcb, 0x29, 0xbe, 0x8f, 0xa2, 0x04, 0xd6, 0x66, 0xb4, 0xf9,
-	0xbf, 0xd2, 0xe3, 0xb9, 0xb8, 0x29, 0x4f, 0x69, 0xfa, 0x05, 0x30, 0x37, 0xdc, 0x56, 0x3e, 0x76,
-	0x7e, 0x9a, 0x8d, 0x82, 0xfa, 0x71, 0xb1, 0xa3, 0xc1, 0x8f, 0x02, 0x84, 0x23, 0x82, 0x70, 0x79,
-	0x28, 0xa1, 0x4c, 0x1e, 0x40, 0xdc, 0x46, 0x53, 0xbd, 0x84, 0x7e, 0x05, 0x72, 0x68, 0x8c, 0x54,
-	0x2a, 0x0d, 0xca, 0xb9, 0x38, 0xfe, 0xbf, 0xf9, 0x99, 0x2f, 0xa7, 0xd9, 0x38, 0xf8, 0x6f, 0xc8,
-	0x9d, 0x6d, 0xaf, 0x51, 0x75, 0x9d, 0xa2, 0x1f, 0x78, 0x27, 0x7a, 0x7c, 0x92, 0x8a, 0xfc, 0x3a,
-	0x49, 0x45, 0x8c, 0x59, 0xa4, 0x0b, 0xd3, 0x27, 0xac, 0xd2, 0xdc, 0xa7, 0xd7, 0xaa, 0x6b, 0x14,
-	0x20, 0x65, 0x81, 0x34, 0x48, 0xad, 0x5b, 0x92, 0xdb, 0x68, 0xb4, 0x2e, 0x56, 0xa0, 0xe0, 0x09,
-	0x53, 0x71, 0xd1, 0x4c, 0x29, 0xca, 0xff, 0x7d, 0xf6, 0x3d, 0x15, 0x29, 0x82, 0xc0, 0xd8, 0x09,
-	0xf6, 0xb1, 0x63, 0x79, 0x0f, 0x8d, 0x41, 0xc5, 0xc0, 0x33, 0x4c, 0x91, 0x7d, 0x89, 0x11, 0x47,
-	0x38, 0xc0, 0x29, 0xe9, 0xcb, 0x28, 0xa1, 0x3c, 0x1b, 0xa4, 0xdc, 0x0a, 0xd9, 0x58, 0x7c, 0x7e,
-	0x9a, 0x9d, 0x0c, 0x78, 0xf4, 0xb4, 0xd7, 0x98, 0x46, 0x53, 0x79, 0x5a, 0xde, 0x5b, 0xcf, 0x15,
-	0x1a, 0x74, 0xb7, 0x7a, 0xe8, 0xe7, 0xbe, 0x8b, 0xe2, 0xc1, 0x65, 0x48, 0xba, 0x80, 0x26, 0x6c,
-	0xb1, 0x5e, 0xaa, 0x8b, 0x0d, 0xd9, 0xb3, 0x62, 0xcc, 0xee, 0x09, 0x36, 0xf2, 0x28, 0x01, 0x8d,
-	0xcb, 0xb7, 0x3c, 0xca, 0x77, 0x18, 0xf4, 0x0f, 0x3a, 0xbe, 0x80, 0x26, 0xa0, 0x91, 0x25, 0xbb,
-	0xbd, 0x2f, 0x3c, 0x62, 0xc5, 0x18, 0xe9, 0xd1, 0x18, 0x0f, 0xd0, 0xac, 0xda, 0x03, 0x40, 0x16,
-	0xd1, 0xa4, 0x6f, 0xc2, 0xc5, 0x0e, 0x90, 0xf8, 0xd6, 0x32, 0xdc, 0xd8, 0xea, 0xa0, 0xc8, 0x85,
-	0x1d, 0x26, 0xec, 0x7c, 0x94, 0x90, 0x2e, 0x9b, 0x1d, 0x98, 0x6b, 0x2e, 0xdd, 0xaa, 0x0c, 0x3d,
-	0x51, 0xee, 0x73, 0x14, 0xfd, 0x23, 0xfa, 0x89, 0x8f, 0x35, 0xe4, 0x5f, 0x02, 0x8e, 0x33, 0xca,
-	0xcb, 0xa7, 0x7a, 0x2c, 0xf4, 0x95, 0x30, 0xa1, 0x12, 0xc9, 0x58, 0x7c, 0xf3, 0xf5, 0xe7, 0xfb,
-	0x91, 0x14, 0x9e, 0xb3, 0x94, 0x8f, 0x96, 0x9f, 0xfd, 0xad, 0x86, 0xc6, 0x40, 0x8b, 0xd3, 0x43,
-	0xed, 0x7d, 0x90, 0x4c, 0x88, 0x48, 0xe0, 0xb0, 0x04, 0x47, 0x06, 0x2f, 0x0f, 0xe4, 0xb0, 0x5e,
-	0x41, 0xa9, 0x8e, 0xf0, 0x6b, 0x0d, 0x8d, 0xca, 0x39, 0xc0, 0xcb, 0xfd, 0xd3, 0x04, 0x26, 0x45,
-	0x4f, 0x0f, 0x0f, 0x04, 0x9c, 0x05, 0x81, 0x33, 0x87, 0x13, 0x4a, 0x1c, 0x39, 0xe4, 0xf8, 0x83,
-	0x86, 0x82, 0x03, 0xc3, 0xb1, 0xd5, 0x3f, 0x83, 0xf2, 0xe9, 0xd1, 0x6f, 0x86, 0x17, 0x00, 0xda,
-	0xaa, 0x40, 0x5b, 0xc2, 0xff, 0x2b, 0xd1, 0x6a, 0x42, 0x54, 0xea, 0x34, 0xee, 0x58, 0x43, 0xb1,
-	0xde, 0x09, 0xed, 0xd3, 0x3d, 0xc5, 0x6c, 0xf7, 0xe9, 0x9e, 0x6a, 0xdc, 0x87, 0x94, 0x4b, 0x0e,
-	0x3d, 0xfe, 0xa8, 0xa1, 0xb8, 0x6a, 0x56, 0xb1, 0xba, 0x06, 0x03, 0x9e, 0x06, 0x7d, 0xed, 0x0f,
-	0x14, 0x80, 0xb8, 0x2e, 0x10, 0xb3, 0xf8, 0xc6, 0x00, 0xc4, 0xce, 0xf5, 0x92, 0xe3, 0x79, 0x84,
-	0x3f, 0x75, 0x91, 0x03, 0x13, 0x3d, 0x18, 0x59, 0xf5, 0x84, 0x0c, 0x46, 0x56, 0x3e, 0x17, 0xc6,
-	0x2d, 0x81, 0x6c, 0xe2, 0xd5, 0x50, 0xc8, 0xf2, 0x61, 0x3a, 0xca, 0x6f, 0x9e, 0x5d, 0x26, 0xb5,
-	0x8b, 0xcb, 0xa4, 0xf6, 0xe3, 0x32, 0xa9, 0xbd, 0xbb, 0x4a, 0x46, 0x2e, 0xae, 0x92, 0x91, 0x6f,
-	0x57, 0xc9, 0xc8, 0xb3, 0x8c, 0x53, 0xf5, 0xf6, 0x9a, 0xb6, 0x59, 0x66, 0x35, 0xdf, 0x51, 0xfe,
-	0xc9, 0xf2, 0xca, 0x0b, 0xeb, 0x50, 0xda, 0x7b, 0xad, 0x3a, 0xe5, 0xf6, 0xa8, 0xf8, 0xd7, 0xb0,
-	0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x15, 0x47, 0x58, 0x91, 0x7c, 0x09, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x29, 0x38, 0xe1, 0xc5, 0xc9, 0x61, 0xe2, 0x42, 0xba, 0x4e, 0xed, 0x6a, 0x43,
+	0x9b, 0xb8, 0xd4, 0xbb, 0x8d, 0x83, 0x04, 0x2d, 0x08, 0x88, 0xdb, 0xd2, 0x22, 0x84, 0x14, 0xb6,
+	0x39, 0x71, 0xc0, 0xda, 0x75, 0x26, 0xeb, 0x55, 0x9b, 0x9d, 0xed, 0xfe, 0x40, 0x5d, 0x45, 0x91,
+	0x10, 0xa7, 0xdc, 0x40, 0xe2, 0xc0, 0x35, 0x1c, 0xb9, 0x20, 0x90, 0x82, 0xc4, 0x9f, 0x50, 0xe5,
+	0x54, 0xc1, 0x85, 0x13, 0x42, 0x09, 0x07, 0xfe, 0x0c, 0xe4, 0x99, 0xb7, 0xb6, 0xd7, 0x9a, 0xd8,
+	0x1b, 0xca, 0x29, 0xde, 0x99, 0xf7, 0xe3, 0x33, 0x6f, 0xde, 0x7c, 0x5f, 0xa0, 0xd6, 0x61, 0xe1,
+	0x2e, 0x0b, 0x0d, 0x2b, 0x8e, 0xba, 0xc6, 0x17, 0x6b, 0x36, 0x8d, 0xac, 0x35, 0xe3, 0x49, 0x4c,
+	0x83, 0x44, 0xf7, 0x03, 0x16, 0x31, 0xb2, 0x20, 0x0c, 0xf4, 0x9e, 0x81, 0x8e, 0x06, 0xea, 0x75,
+	0xf4, 0xb2, 0xad, 0x90, 0x0a, 0xeb, 0xbe, 0xaf, 0x6f, 0x39, 0xae, 0x67, 0x45, 0x2e, 0xf3, 0x44,
+	0x00, 0xb5, 0xec, 0x30, 0x87, 0xf1, 0x9f, 0x46, 0xef, 0x17, 0xae, 0x5e, 0x72, 0x18, 0x73, 0x1e,
+	0x53, 0x83, 0x7f, 0xd9, 0xf1, 0x8e, 0x61, 0x79, 0x98, 0x51, 0x5d, 0xc2, 0x2d, 0xcb, 0x77, 0x0d,
+	0xcb, 0xf3, 0x58, 0xc4, 0xa3, 0x85, 0xb8, 0x5b, 0x95, 0x01, 0x73, 0x38, 0x0c, 0x2c, 0xf6, 0xdb,
+	0x22, 0x23, 0xc2, 0xf3, 0x0f, 0xed, 0x73, 0x28, 0x7f, 0xda, 0x63, 0xdd, 0xe8, 0x74, 0x58, 0xec,
+	0x45, 0xa1, 0x49, 0x9f, 0xc4, 0x34, 0x8c, 0xc8, 0x87, 0x00, 0x03, 0xea, 0x45, 0xe5, 0x8a, 0xb2,
+	0x3a, 0xdb, 0xbc, 0xa6, 0xa3, 0x6b, 0xef, 0x88, 0xba, 0x28, 0x08, 0x66, 0xd3, 0x37, 0x2d, 0x87,
+	0xa2, 0xaf, 0x39, 0xe4, 0xa9, 0x1d, 0x2a, 0x70, 0x71, 0x24, 0x41, 0xe8, 0x33, 0x2f, 0xa4, 0xe4,
+	0x3d, 0x98, 0xb1, 0x70, 0x6d, 0x51, 0xb9, 0x72, 0x61, 0x75, 0xb6, 0x59, 0xd6, 0xc5, 0x29, 0xf5,
+	0xb4, 0x00, 0xfa, 0x86, 0x97, 0xb4, 0x4a, 0xc7, 0x47, 0x8d, 0x19, 0xf4, 0xfe, 0xc8, 0xec, 0xfb,
+	0x90, 0xfb, 0x19, 0xc2, 0x29, 0x4e, 0xb8, 0x32, 0x91, 0x50, 0x24, 0xcf, 0x20, 0x3e, 0x84, 0x85,
+	0x61, 0xc2, 0xb4, 0x02, 0x4d, 0x98, 0xb6, 0xb6, 0xb7, 0x03, 0x1a, 0x86, 0xfc, 0xf8, 0xaf, 0xb4,
+	0x16, 0x7f, 0x3b, 0x6a, 0x94, 0x31, 0xfe, 0x86, 0xd8, 0x79, 0x18, 0x05, 0xae, 0xe7, 0x98, 0xa9,
+	0xe1, 0xed, 0x99, 0x83, 0xc3, 0x5a, 0xe1, 0x9f, 0xc3, 0x5a, 0x41, 0x5b, 0x02, 0x95, 0x07, 0xfd,
+	0x84, 0x6d, 0xc7, 0x8f, 0xe9, 0x48, 0x75, 0xb5, 0x5f, 0x15, 0xcc, 0xb9, 0x69, 0x05, 0xd6, 0xee,
+	0xa0, 0x26, 0xb7, 0xa0, 0xe8, 0xf3, 0x15, 0xac, 0x78, 0x45, 0x97, 0x74, 0x9a, 0x2e, 0x9c, 0x5a,
+	0x2f, 0x3d, 0xfb, 0xb3, 0x56, 0x30, 0xd1, 0x81, 0xec, 0xc0, 0x6b, 0x7e, 0x6c, 0xb7, 0x1f, 0xd1,
+	0xa4, 0x1d, 0x60, 0x7b, 0xb4, 0x31, 0x96, 0xa8, 0x4d, 0x5d, 0x1e, 0x2b, 0xb6, 0x3f, 0xa6, 0x89,
+	0x89, 0x1e, 0x99, 0xc8, 0x65, 0x5f, 0xb2, 0xa7, 0x6d, 0x65, 0x1b, 0xa6, 0x8f, 0xfe, 0x2e, 0x4c,
+	0xe3, 0xd5, 0x20, 0x7b, 0x9e, 0xdb, 0x4c, 0x5d, 0xb4, 0x32, 0x90, 0x4c, 0x3d, 0x44, 0x99, 0x3a,
+	0x50, 0x91, 0x16, 0x11, 0x53, 0xde, 0xcd, 0xd9, 0x41, 0xe4, 0xf8, 0xa8, 0x31, 0x9f, 0x89, 0x31,
+	0xd4, 0x47, 0xda, 0x45, 0x58, 0x68, 0xd1, 0x4e, 0x77, 0xbd, 0xb9, 0x19, 0xd0, 0x1d, 0xf7, 0x69,
+	0x9a, 0xfb, 0x1d, 0x28, 0x67, 0x97, 0x31, 0xe9, 0x32, 0xcc, 0xd9, 0x7c, 0xbd, 0xed, 0xf3, 0x0d,
+	0xd1, 0x1c, 0x66, 0xc9, 0x1e, 0x32, 0xd6, 0x5a, 0x50, 0xc1, 0x0e, 0x69, 0x25, 0x11, 0x0d, 0xb7,
+	0x18, 0x36, 0x0a, 0xb6, 0xd6, 0x32, 0xcc, 0x61, 0xc7, 0xb4, 0xed, 0xde, 0x3e, 0x8f, 0x51, 0x32,
+	0x4b, 0xd6, 0x90, 0x8f, 0x76, 0x0f, 0x96, 0xe4, 0x31, 0x10, 0xe4, 0x2a, 0xcc, 0xa7, 0x41, 0x42,
+	0xbe, 0x83, 0x24, 0x69, 0x68, 0x61, 0xae, 0xdd, 0xed, 0xa3, 0x88, 0x85, 0x2d, 0xc6, 0xc3, 0xa5,
+	0x28, 0x39, 0xa3, 0xdc, 0xe9, 0xc3, 0x8c, 0x44, 0x19, 0x54, 0x65, 0xf2, 0x89, 0xbe, 0x53, 0xe0,
+	0x92, 0xb8, 0x65, 0xde, 0x58, 0x0f, 0xdc, 0x30, 0x62, 0x41, 0xf2, 0x02, 0xef, 0x6d, 0x44, 0xa5,
+	0xa6, 0xfe, 0xb3, 0x4a, 0xfd, 0xa8, 0x80, 0x2a, 0x23, 0xc3, 0xd3, 0xdd, 0x87, 0x69, 0xea, 0x45,
+	0x81, 0x4b, 0xd3, 0x3e, 0x5b, 0x19, 0xf3, 0x96, 0xd0, 0xf9, 0x9e, 0x17, 0x05, 0x09, 0xbe, 0xa4,
+	0xd4, 0xfb, 0xff, 0xd3, 0xac, 0x6e, 0x86, 0x77, 0x23, 0x7a, 0x40, 0x5d, 0xa7, 0xfb, 0x22, 0xd2,
+	0x45, 0x5e, 0x85, 0x62, 0x97, 0x07, 0xe1, 0x58, 0x17, 0x4c, 0xfc, 0xd2, 0x76, 0xa0, 0x22, 0xcd,
+	0x34, 0x28, 0x0d, 0xca, 0xce, 0xd8, 0x67, 0xbf, 0x78, 0x3c, 0x00, 0xe8, 0x04, 0x89, 0x1f, 0xb1,
+	0x54, 0x79, 0x8a, 0x42, 0x65, 0x9a, 0x3f, 0xcc, 0xc2, 0xcb, 0x3c, 0x11, 0x39, 0x50, 0x20, 0x55,
+	0x88, 0x90, 0xc8, 0x55, 0x4b, 0x36, 0xb2, 0xd4, 0xeb, 0x79, 0x4c, 0x05, 0xb6, 0x76, 0xf5, 0xab,
+	0xdf, 0xff, 0xfe, 0x76, 0xaa, 0x46, 0x2e, 0x1b, 0xd2, 0xd1, 0x99, 0x66, 0xff, 0x5a, 0x81, 0x69,
+	0xf4, 0x25, 0xab, 0x13, 0xc3, 0xa7, 0x20, 0xf5, 0x1c, 0x96, 0xc8, 0x61, 0x70, 0x8e, 0x3a, 0x59,
+	0x19, 0xcb, 0x61, 0xec, 0xe1, 0x2d, 0xed, 0x93, 0x2f, 0x15, 0x28, 0x0a, 0x91, 0x24, 0x2b, 0x67,
+	0xa7, 0xc9, 0xc8, 0xa8, 0xba, 0x3a, 0xd9, 0x10, 0x71, 0x96, 0x39, 0xce, 0x65, 0x52, 0x91, 0xe2,
+	0xe0, 0xa4, 0xf9, 0x5e, 0x81, 0xac, 0x9a, 0x86, 0xc4, 0x38, 0x3b, 0x83, 0x74, 0x00, 0xaa, 0x37,
+	0xf3, 0x3b, 0x20, 0xda, 0x0d, 0x8e, 0x76, 0x8d, 0xbc, 0x2e, 0x45, 0xdb, 0xe5, 0x4e, 0xed, 0xfe,
+	0xc5, 0x1d, 0x28, 0x50, 0x1a, 0x96, 0xef, 0x33, 0x6e, 0x4f, 0x22, 0xfc, 0x6a, 0x3d, 0x87, 0x65,
+	0xae, 0x72, 0x89, 0x89, 0x40, 0x7e, 0x52, 0xa0, 0x2c, 0x13, 0x72, 0x22, 0xaf, 0xc1, 0x98, 0xb9,
+	0xa1, 0xae, 0x9d, 0xc3, 0x03, 0x11, 0xd7, 0x39, 0x62, 0x83, 0xbc, 0x31, 0x06, 0xd1, 0xd8, 0xcb,
+	0x68, 0xf7, 0x3e, 0xf9, 0x79, 0x80, 0x9c, 0x91, 0xfb, 0xf1, 0xc8, 0xb2, 0xf9, 0xa2, 0xae, 0x9d,
+	0xc3, 0x03, 0x91, 0xdf, 0xe4, 0xc8, 0x3a, 0xb9, 0x91, 0x0b, 0x59, 0x4c, 0x2d, 0xce, 0x3c, 0x97,
+	0x11, 0x60, 0xa2, 0x8f, 0x69, 0x7b, 0xc9, 0x00, 0x52, 0x8d, 0xdc, 0xf6, 0x08, 0xfa, 0x3e, 0x07,
+	0xbd, 0x45, 0xde, 0xca, 0xf9, 0x78, 0x8d, 0xf4, 0x1f, 0xb4, 0x2e, 0x12, 0xfe, 0xa2, 0xc0, 0x7c,
+	0x56, 0x57, 0xc9, 0x44, 0x88, 0x11, 0xad, 0x57, 0x6f, 0xe6, 0x77, 0x40, 0xec, 0x0f, 0x38, 0xf6,
+	0x6d, 0xf2, 0xf6, 0x39, 0xb1, 0x8d, 0x3d, 0x31, 0x12, 0xf6, 0x5b, 0x77, 0x9e, 0x9d, 0x54, 0x95,
+	0xe7, 0x27, 0x55, 0xe5, 0xaf, 0x93, 0xaa, 0xf2, 0xcd, 0x69, 0xb5, 0xf0, 0xfc, 0xb4, 0x5a, 0xf8,
+	0xe3, 0xb4, 0x5a, 0xf8, 0xac, 0xee, 0xb8, 0x51, 0x37, 0xb6, 0xf5, 0x0e, 0xdb, 0x4d, 0xa3, 0x8b,
+	0x3f, 0x8d, 0x70, 0xfb, 0x91, 0xf1, 0x54, 0xa4, 0x8a, 0x12, 0x9f, 0x86, 0x76, 0x91, 0x0f, 0x88,
+	0xf5, 0x7f, 0x07, 0x00, 0xd4, 0x38, 0x68, 0x0b, 0x6e, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -775,6 +1022,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys of an account replaced by public key
+	// rotations, ordered by the height at which they were replaced.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
+	// PubKeyAtHeight returns the public key of an account at a given height.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyAtHeight(ctx context.Context, in *QueryPubKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryPubKeyAtHeightResponse, error)
 }
 
 type queryClient struct {
@@ -848,6 +1104,24 @@ func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStrin
 	return out, nil
 }
 
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyAtHeight(ctx context.Context, in *QueryPubKeyAtHeightRequest, opts ...grpc.CallOption) (*QueryPubKeyAtHeightResponse, error) {
+	out := new(QueryPubKeyAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -874,6 +1148,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// PubKeyHistory returns the public keys of an account replaced by public key
+	// rotations, ordered by the height at which they were replaced.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
+	// PubKeyAtHeight returns the public key of an account at a given height.
+	//
+	// Since: cosmos-sdk 0.47
+	PubKeyAtHeight(context.Context, *QueryPubKeyAtHeightRequest) (*QueryPubKeyAtHeightResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -901,6 +1184,12 @@ func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *
 func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyAtHeight(ctx context.Context, req *QueryPubKeyAtHeightRequest) (*QueryPubKeyAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyAtHeight not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1032,6 +1321,42 @@ func _Query_AddressStringToBytes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyAtHeight(ctx, req.(*QueryPubKeyAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1064,6 +1389,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddressStringToBytes",
 			Handler:    _Query_AddressStringToBytes_Handler,
 		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
+		{
+			MethodName: "PubKeyAtHeight",
+			Handler:    _Query_PubKeyAtHeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1226,6 +1559,16 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.PubKeyRotationParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1507,53 +1850,214 @@ func (m *AddressStringToBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Address)
@@ -1580,6 +2084,8 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.PubKeyRotationParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1694,6 +2200,71 @@ func (m *AddressStringToBytesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryPubKeyAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2100,6 +2671,39 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKeyRotationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2805,6 +3409,431 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PubKeyHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
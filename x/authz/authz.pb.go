@@ -69,6 +69,54 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// ModuleAuthorization gives the grantee unrestricted permissions to execute
+// any message of the provided proto package, or of its sub-packages, on behalf
+// of the granter's account, except the denied ones. Messages of the authz and
+// feegrant modules are never covered by a ModuleAuthorization.
+//
+// Since: cosmos-sdk 0.47
+type ModuleAuthorization struct {
+	// proto_package is the proto package of the messages to grant unrestricted
+	// permissions to execute, e.g. "cosmos.staking.v1beta1" or "cosmos.staking".
+	ProtoPackage string `protobuf:"bytes,1,opt,name=proto_package,json=protoPackage,proto3" json:"proto_package,omitempty"`
+	// deny_list contains the type URLs of the messages of the proto package which
+	// cannot be executed.
+	DenyList []string `protobuf:"bytes,2,rep,name=deny_list,json=denyList,proto3" json:"deny_list,omitempty"`
+}
+
+func (m *ModuleAuthorization) Reset()         { *m = ModuleAuthorization{} }
+func (m *ModuleAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ModuleAuthorization) ProtoMessage()    {}
+func (*ModuleAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *ModuleAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAuthorization.Merge(m, src)
+}
+func (m *ModuleAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -83,7 +131,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{2}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +173,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +212,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +243,7 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*ModuleAuthorization)(nil), "cosmos.authz.v1beta1.ModuleAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -203,33 +252,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbd, 0xae, 0xda, 0x30,
-	0x18, 0x8d, 0x81, 0xfe, 0x60, 0x44, 0xd5, 0x46, 0x19, 0x80, 0x21, 0xa0, 0xa8, 0x03, 0x0b, 0x89,
-	0xa0, 0x9d, 0xda, 0xa5, 0x44, 0x95, 0x50, 0x87, 0x0e, 0x4d, 0xe9, 0xd2, 0x05, 0x25, 0xe0, 0x9a,
-	0xa8, 0x38, 0x8e, 0x6c, 0xa7, 0x22, 0xbc, 0x43, 0x25, 0x1e, 0xa0, 0x8f, 0xc1, 0x43, 0xa0, 0x4e,
-	0xa8, 0x53, 0xa7, 0xfe, 0xc0, 0x8b, 0x5c, 0x61, 0x27, 0xba, 0xe4, 0x66, 0xb8, 0x57, 0xba, 0x53,
-	0xfc, 0x1d, 0x9f, 0x73, 0xfc, 0x7d, 0x27, 0x1f, 0xec, 0xcd, 0x29, 0x27, 0x94, 0x3b, 0x7e, 0x22,
-	0x96, 0x1b, 0xe7, 0xdb, 0x30, 0x40, 0xc2, 0x1f, 0xaa, 0xca, 0x8e, 0x19, 0x15, 0x54, 0x37, 0x14,
-	0xc3, 0x56, 0x58, 0xc6, 0xe8, 0xb4, 0x15, 0x3a, 0x93, 0x1c, 0x27, 0xa3, 0xc8, 0xa2, 0xd3, 0xc5,
-	0x94, 0xe2, 0x15, 0x72, 0x64, 0x15, 0x24, 0x5f, 0x1c, 0x11, 0x12, 0xc4, 0x85, 0x4f, 0xe2, 0x8c,
-	0x60, 0x60, 0x8a, 0xa9, 0x12, 0x9e, 0x4f, 0x19, 0xda, 0xbe, 0x29, 0xf3, 0xa3, 0x54, 0x5d, 0x59,
-	0xaf, 0xa1, 0x31, 0x41, 0x11, 0x62, 0xe1, 0x7c, 0x9c, 0x88, 0x25, 0x65, 0xe1, 0xc6, 0x17, 0x21,
-	0x8d, 0xf4, 0xa7, 0xb0, 0x4a, 0x38, 0x6e, 0x81, 0x1e, 0xe8, 0xd7, 0xbd, 0xf3, 0xf1, 0xd5, 0xb3,
-	0x9f, 0xbb, 0x41, 0xb3, 0x40, 0xb2, 0x7e, 0x00, 0xf8, 0x60, 0xc2, 0xfc, 0x48, 0xe8, 0xef, 0x61,
-	0xd3, 0xbf, 0xbc, 0x92, 0xc2, 0xc6, 0xc8, 0xb0, 0xd5, 0xcb, 0x76, 0xfe, 0xb2, 0x3d, 0x8e, 0x52,
-	0xb7, 0xec, 0xe4, 0x15, 0xd5, 0xfa, 0x5b, 0x08, 0xd1, 0x3a, 0x0e, 0x99, 0xf2, 0xaa, 0x48, 0xaf,
-	0x4e, 0xc9, 0x6b, 0x9a, 0x0f, 0xef, 0x3e, 0xde, 0xff, 0xe9, 0x82, 0xed, 0xdf, 0x2e, 0xf0, 0x2e,
-	0x74, 0xd6, 0xf7, 0x0a, 0xd4, 0x65, 0x7b, 0xc5, 0xd1, 0x46, 0xf0, 0x11, 0x3e, 0xa3, 0x88, 0xa9,
-	0xf1, 0xdc, 0xd6, 0xaf, 0xdd, 0x20, 0xff, 0x15, 0xe3, 0xc5, 0x82, 0x21, 0xce, 0x3f, 0x0a, 0x16,
-	0x46, 0xd8, 0xcb, 0x89, 0xd7, 0x1a, 0x24, 0xbb, 0xb9, 0x83, 0x06, 0x95, 0x33, 0xa9, 0xde, 0x2b,
-	0x93, 0x37, 0x85, 0x4c, 0x6a, 0xb7, 0x66, 0x52, 0x2b, 0xe5, 0xf1, 0x12, 0x3e, 0x91, 0x71, 0x7c,
-	0x48, 0x50, 0x82, 0xde, 0x09, 0x44, 0x74, 0x0b, 0x36, 0x09, 0xc7, 0x33, 0x91, 0xc6, 0x68, 0x96,
-	0xb0, 0x15, 0x6f, 0x81, 0x5e, 0xb5, 0x5f, 0xf7, 0x1a, 0x84, 0xe3, 0x69, 0x1a, 0xa3, 0x4f, 0x6c,
-	0xc5, 0x5d, 0x77, 0xff, 0xdf, 0xd4, 0xf6, 0x47, 0x13, 0x1c, 0x8e, 0x26, 0xf8, 0x77, 0x34, 0xc1,
-	0xf6, 0x64, 0x6a, 0x87, 0x93, 0xa9, 0xfd, 0x3e, 0x99, 0xda, 0xe7, 0xe7, 0x38, 0x14, 0xcb, 0x24,
-	0xb0, 0xe7, 0x94, 0x64, 0xab, 0x9a, 0x7d, 0x06, 0x7c, 0xf1, 0xd5, 0x59, 0xab, 0x75, 0x0f, 0x1e,
-	0xca, 0xfe, 0x5e, 0x5c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb3, 0xf3, 0x46, 0x13, 0x03, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0x76, 0xc0, 0xea, 0x51, 0x04, 0xa1, 0x87, 0xac, 0x48, 0x69, 0x55, 0x38, 0xec,
+	0xd2, 0x44, 0x1b, 0x9c, 0xe0, 0x42, 0x23, 0xa4, 0x09, 0x89, 0x49, 0x10, 0xc6, 0x85, 0x4b, 0xe4,
+	0x36, 0x3f, 0x5c, 0xb3, 0x24, 0x8e, 0x6c, 0x07, 0x2d, 0x7b, 0x07, 0xa4, 0x3d, 0x00, 0x8f, 0xb1,
+	0x87, 0xa8, 0x38, 0x4d, 0x9c, 0x38, 0xf1, 0xa7, 0x7d, 0x11, 0x54, 0x3b, 0x15, 0x0d, 0x41, 0x02,
+	0x69, 0xa7, 0xd8, 0x5f, 0x7f, 0xbf, 0xdf, 0xd8, 0x1f, 0xcb, 0x78, 0x30, 0xe5, 0x32, 0xe1, 0xd2,
+	0x23, 0xb9, 0x9a, 0x9d, 0x79, 0x1f, 0xf6, 0x27, 0xa0, 0xc8, 0xbe, 0x99, 0xb9, 0x99, 0xe0, 0x8a,
+	0x5b, 0x5d, 0xe3, 0x70, 0x8d, 0x56, 0x3a, 0x7a, 0xbb, 0x46, 0x0d, 0xb5, 0xc7, 0x2b, 0x2d, 0x7a,
+	0xd2, 0xeb, 0x53, 0xce, 0x69, 0x0c, 0x9e, 0x9e, 0x4d, 0xf2, 0x77, 0x9e, 0x62, 0x09, 0x48, 0x45,
+	0x92, 0xac, 0x34, 0x74, 0x29, 0xa7, 0xdc, 0x04, 0x57, 0xa3, 0x52, 0xdd, 0xfd, 0x33, 0x46, 0xd2,
+	0xc2, 0x2c, 0x0d, 0x9f, 0xe0, 0xee, 0x21, 0xa4, 0x20, 0xd8, 0x74, 0x9c, 0xab, 0x19, 0x17, 0xec,
+	0x8c, 0x28, 0xc6, 0x53, 0xeb, 0x36, 0x6e, 0x25, 0x92, 0xda, 0x68, 0x80, 0xf6, 0xda, 0xc1, 0x6a,
+	0xf8, 0xf8, 0xce, 0xe7, 0x8b, 0x51, 0xa7, 0x62, 0x1a, 0xbe, 0xc7, 0x77, 0x8f, 0x78, 0x94, 0xc7,
+	0x50, 0xcd, 0xde, 0xc7, 0x1d, 0x5d, 0x1e, 0x66, 0x64, 0x7a, 0x42, 0x28, 0x94, 0x2d, 0x37, 0xb5,
+	0xf8, 0xd2, 0x68, 0xd6, 0x3d, 0xdc, 0x8e, 0x20, 0x2d, 0xc2, 0x98, 0x49, 0x65, 0x37, 0x07, 0xad,
+	0xbd, 0x76, 0xb0, 0xbd, 0x12, 0x5e, 0x30, 0xa9, 0xfe, 0xf6, 0xaf, 0x4f, 0x08, 0x5f, 0x3b, 0x14,
+	0x24, 0x55, 0xd6, 0x11, 0xee, 0x90, 0xcd, 0x25, 0x5d, 0xbf, 0x73, 0xd0, 0x75, 0xcd, 0x29, 0xdd,
+	0xf5, 0x29, 0xdd, 0x71, 0x5a, 0xf8, 0xf5, 0xa6, 0xa0, 0x9a, 0xb6, 0x9e, 0x61, 0x0c, 0xa7, 0x19,
+	0x13, 0xa6, 0xab, 0xa9, 0xbb, 0x7a, 0xb5, 0xae, 0xe3, 0x35, 0x68, 0x7f, 0x7b, 0xfe, 0xad, 0x8f,
+	0xce, 0xbf, 0xf7, 0x51, 0xb0, 0x91, 0x1b, 0x7e, 0x6c, 0x62, 0x4b, 0x6f, 0xaf, 0x8a, 0xe2, 0x00,
+	0xdf, 0xa0, 0x2b, 0x15, 0x84, 0x81, 0xe0, 0xdb, 0x5f, 0x2e, 0x46, 0xeb, 0x6b, 0x1f, 0x47, 0x91,
+	0x00, 0x29, 0x5f, 0x2b, 0xc1, 0x52, 0x1a, 0xac, 0x8d, 0xbf, 0x33, 0x60, 0x37, 0xff, 0x2f, 0x03,
+	0x75, 0x26, 0xad, 0x2b, 0x31, 0x79, 0x5a, 0x61, 0xb2, 0xf5, 0x4f, 0x26, 0x5b, 0x35, 0x1e, 0x8f,
+	0xf0, 0x2d, 0x8d, 0xe3, 0x55, 0x0e, 0x39, 0x3c, 0x57, 0x90, 0x58, 0x43, 0xdc, 0x49, 0x24, 0x0d,
+	0x55, 0x91, 0x41, 0x98, 0x8b, 0x58, 0xda, 0x48, 0x5f, 0xfa, 0x4e, 0x22, 0xe9, 0x71, 0x91, 0xc1,
+	0x1b, 0x11, 0x4b, 0xdf, 0x9f, 0xff, 0x74, 0x1a, 0xf3, 0x85, 0x83, 0x2e, 0x17, 0x0e, 0xfa, 0xb1,
+	0x70, 0xd0, 0xf9, 0xd2, 0x69, 0x5c, 0x2e, 0x9d, 0xc6, 0xd7, 0xa5, 0xd3, 0x78, 0xfb, 0x80, 0x32,
+	0x35, 0xcb, 0x27, 0xee, 0x94, 0x27, 0xe5, 0xb3, 0x28, 0x3f, 0x23, 0x19, 0x9d, 0x78, 0xa7, 0xe6,
+	0x69, 0x4d, 0xae, 0xeb, 0xfd, 0x3d, 0xfc, 0x35, 0x00, 0x84, 0x61, 0xd6, 0xb2, 0x7f, 0x03, 0x00,
 	0x00,
 }
 
@@ -263,6 +315,45 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenyList) > 0 {
+		for iNdEx := len(m.DenyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyList[iNdEx])
+			copy(dAtA[i:], m.DenyList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenyList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProtoPackage) > 0 {
+		i -= len(m.ProtoPackage)
+		copy(dAtA[i:], m.ProtoPackage)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ProtoPackage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -423,6 +514,25 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *ModuleAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtoPackage)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.DenyList) > 0 {
+		for _, s := range m.DenyList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -568,6 +678,120 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModuleAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoPackage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoPackage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyList = append(m.DenyList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -69,25 +71,17 @@ $ %s query %s grants cosmos1skjw.. cosmos1skjwj.. %s
 			if len(args) >= 3 {
 				msgAuthorized = args[2]
 			}
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.Grants(
-				cmd.Context(),
-				&authz.QueryGrantsRequest{
-					Granter:    granter.String(),
-					Grantee:    grantee.String(),
-					MsgTypeUrl: msgAuthorized,
-					Pagination: pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Grants(
+					ctx,
+					&authz.QueryGrantsRequest{
+						Granter:    granter.String(),
+						Grantee:    grantee.String(),
+						MsgTypeUrl: msgAuthorized,
+						Pagination: pageReq,
+					},
+				)
+			})
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -119,24 +113,16 @@ $ %s q %s grants-by-granter cosmos1skj..
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := authz.NewQueryClient(clientCtx)
-			res, err := queryClient.GranterGrants(
-				cmd.Context(),
-				&authz.QueryGranterGrantsRequest{
-					Granter:    granter.String(),
-					Pagination: pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GranterGrants(
+					ctx,
+					&authz.QueryGranterGrantsRequest{
+						Granter:    granter.String(),
+						Pagination: pageReq,
+					},
+				)
+			})
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -168,24 +154,16 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := authz.NewQueryClient(clientCtx)
-			res, err := queryClient.GranteeGrants(
-				cmd.Context(),
-				&authz.QueryGranteeGrantsRequest{
-					Grantee:    grantee.String(),
-					Pagination: pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GranteeGrants(
+					ctx,
+					&authz.QueryGranteeGrantsRequest{
+						Grantee:    grantee.String(),
+						Pagination: pageReq,
+					},
+				)
+			})
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
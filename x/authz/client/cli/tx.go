@@ -26,6 +26,8 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagProtoPackage      = "proto-package"
+	FlagDenyMsgTypes      = "deny-msg-types"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -54,7 +56,7 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"module\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -62,7 +64,8 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. module --proto-package=cosmos.staking --deny-msg-types=/cosmos.staking.v1beta1.MsgCreateValidator --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -101,6 +104,18 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "module":
+				protoPackage, err := cmd.Flags().GetString(FlagProtoPackage)
+				if err != nil {
+					return err
+				}
+
+				denyMsgTypes, err := cmd.Flags().GetStringSlice(FlagDenyMsgTypes)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewModuleAuthorization(protoPackage, denyMsgTypes...)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -181,6 +196,8 @@ Examples:
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagProtoPackage, "", "The proto package (e.g. cosmos.staking) whose Msgs are covered by a ModuleAuthorization")
+	cmd.Flags().StringSlice(FlagDenyMsgTypes, []string{}, "Msg type URLs excluded from a ModuleAuthorization separated by ,")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
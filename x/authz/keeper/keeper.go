@@ -79,6 +79,25 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 	return nil
 }
 
+// getGrantForMsg returns the grant authorizing the grantee to execute msg on
+// behalf of the granter, along with the message type URL it is stored under.
+// A grant for the exact message type takes precedence over the module-wide
+// grants, which are looked up from the most to the least specific proto package.
+func (k Keeper) getGrantForMsg(ctx sdk.Context, grantee, granter sdk.AccAddress, msg sdk.Msg) (authz.Grant, string, bool) {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgTypeURL)); found {
+		return grant, msgTypeURL, true
+	}
+
+	for _, typeURL := range authz.ModuleAuthorizationMsgTypeURLs(msgTypeURL) {
+		if grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL)); found {
+			return grant, typeURL, true
+		}
+	}
+
+	return authz.Grant{}, "", false
+}
+
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
@@ -93,10 +112,9 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 
 		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
 		if !granter.Equals(grantee) {
-			skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
-
-			grant, found := k.getGrant(ctx, skey)
+			grant, msgTypeURL, found := k.getGrantForMsg(ctx, grantee, granter, msg)
 			if !found {
+				skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
 				return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "failed to update grant with key %s", string(skey))
 			}
 
@@ -114,7 +132,7 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 				return nil, err
 			}
 			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+				err = k.DeleteGrant(ctx, grantee, granter, msgTypeURL)
 			} else if resp.Updated != nil {
 				err = k.update(ctx, grantee, granter, resp.Updated)
 			}
@@ -9,8 +9,10 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -317,6 +319,81 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionModuleAuthorization() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
+	expiration := s.ctx.BlockTime().AddDate(0, 1, 0)
+	balance := app.BankKeeper.GetAllBalances(s.ctx, granterAddr)
+
+	send := &banktypes.MsgSend{
+		Amount:      coins10,
+		FromAddress: granterAddr.String(),
+		ToAddress:   recipientAddr.String(),
+	}
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(granterAddr, coins10)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(recipientAddr, coins10)},
+	}
+
+	a := authz.NewModuleAuthorization("cosmos.bank", sdk.MsgTypeURL(multiSend))
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, &expiration))
+
+	_, err := app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+	require.Equal(balance.Sub(coins10...), app.BankKeeper.GetAllBalances(s.ctx, granterAddr))
+
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{multiSend})
+	require.ErrorContains(err, "deny list")
+
+	// a grant for the exact message type takes precedence
+	require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins10), &expiration))
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+
+	authorizations, err := app.AuthzKeeper.GetAuthorizations(s.ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(authorizations, 1)
+	require.Equal(a.MsgTypeURL(), authorizations[0].MsgTypeURL())
+
+	// the module-wide grant is used again once the exact grant is used up
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.NoError(err)
+	require.Equal(balance.Sub(coins10...).Sub(coins10...).Sub(coins10...), app.BankKeeper.GetAllBalances(s.ctx, granterAddr))
+
+	require.NoError(app.AuthzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, a.MsgTypeURL()))
+	_, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{send})
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+}
+
+func (s *TestSuite) TestDispatchActionModuleAuthorizationRotatePubKey() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	expiration := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	// the grantee would take over the granter's account with its own key
+	granteeKey := secp256k1.GenPrivKey()
+	rotate, err := authtypes.NewMsgRotatePubKey(granterAddr, granteeKey.PubKey(), []byte("signature"))
+	require.NoError(err)
+	granterPubKey := app.AccountKeeper.GetAccount(s.ctx, granterAddr).GetPubKey()
+
+	for _, protoPackage := range []string{"cosmos", "cosmos.auth", "cosmos.auth.v1beta1"} {
+		a := authz.NewModuleAuthorization(protoPackage)
+		require.NoError(app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, &expiration))
+
+		_, err := app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{rotate})
+		require.ErrorContains(err, "cannot be covered by a module authorization", protoPackage)
+		require.Equal(granterPubKey, app.AccountKeeper.GetAccount(s.ctx, granterAddr).GetPubKey())
+
+		require.NoError(app.AuthzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, a.MsgTypeURL()))
+	}
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	app, addrs := s.app, s.addrs
@@ -33,8 +33,9 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 	if err != nil {
 		return nil, err
 	}
+	// module-wide authorizations cover a type URL prefix rather than a single message
 	t := authorization.MsgTypeURL()
-	if k.router.HandlerByTypeURL(t) == nil {
+	if _, ok := authorization.(*authz.ModuleAuthorization); !ok && k.router.HandlerByTypeURL(t) == nil {
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
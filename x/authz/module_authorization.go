@@ -0,0 +1,129 @@
+package authz
+
+import (
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &ModuleAuthorization{}
+
+// protectedProtoPackages are the proto packages whose messages can never be
+// covered by a ModuleAuthorization, as they would let the grantee take control
+// of the granter's account, by rotating its public key, or manage the granter's
+// grants and fee allowances.
+var protectedProtoPackages = []string{
+	"cosmos.auth",
+	"cosmos.authz",
+	"cosmos.feegrant",
+}
+
+// protectedMsgTypeURLs are the messages outside of the protected proto packages
+// which can never be covered by a ModuleAuthorization, as they would let the
+// grantee take control of the groups and group policy accounts administered by
+// the granter.
+var protectedMsgTypeURLs = []string{
+	"/cosmos.group.v1.MsgUpdateGroupAdmin",
+	"/cosmos.group.v1.MsgUpdateGroupPolicyAdmin",
+}
+
+var protoPackageRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// NewModuleAuthorization creates a new ModuleAuthorization object.
+func NewModuleAuthorization(protoPackage string, denyList ...string) *ModuleAuthorization {
+	return &ModuleAuthorization{
+		ProtoPackage: protoPackage,
+		DenyList:     denyList,
+	}
+}
+
+// ModuleAuthorizationMsgTypeURL returns the type URL prefix under which a
+// ModuleAuthorization for the given proto package is stored.
+func ModuleAuthorizationMsgTypeURL(protoPackage string) string {
+	return "/" + protoPackage + "."
+}
+
+// ModuleAuthorizationMsgTypeURLs returns the type URL prefixes under which
+// the ModuleAuthorizations covering the given message type URL are stored,
+// from the most to the least specific proto package.
+func ModuleAuthorizationMsgTypeURLs(msgTypeURL string) []string {
+	name := strings.TrimPrefix(msgTypeURL, "/")
+
+	var typeURLs []string
+	for i := strings.LastIndex(name, "."); i > 0; i = strings.LastIndex(name, ".") {
+		name = name[:i]
+		typeURLs = append(typeURLs, ModuleAuthorizationMsgTypeURL(name))
+	}
+
+	return typeURLs
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. It returns the prefix of the
+// type URLs of the messages covered by the authorization.
+func (a ModuleAuthorization) MsgTypeURL() string {
+	return ModuleAuthorizationMsgTypeURL(a.ProtoPackage)
+}
+
+// Accept implements Authorization.Accept.
+func (a ModuleAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if !strings.HasPrefix(msgTypeURL, a.MsgTypeURL()) {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("%s is not a message of %s", msgTypeURL, a.ProtoPackage)
+	}
+
+	if isProtectedMsgTypeURL(msgTypeURL) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s cannot be covered by a module authorization", msgTypeURL)
+	}
+
+	for _, denied := range a.DenyList {
+		if denied == msgTypeURL {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("%s is in the deny list", msgTypeURL)
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ModuleAuthorization) ValidateBasic() error {
+	if !protoPackageRegex.MatchString(a.ProtoPackage) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid proto package %q", a.ProtoPackage)
+	}
+
+	if isProtectedMsgTypeURL(a.MsgTypeURL()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("messages of %s cannot be covered by a module authorization", a.ProtoPackage)
+	}
+
+	denied := make(map[string]bool, len(a.DenyList))
+	for _, msgTypeURL := range a.DenyList {
+		if !strings.HasPrefix(msgTypeURL, a.MsgTypeURL()) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("denied message %s is not a message of %s", msgTypeURL, a.ProtoPackage)
+		}
+		if denied[msgTypeURL] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denied message %s", msgTypeURL)
+		}
+		denied[msgTypeURL] = true
+	}
+
+	return nil
+}
+
+// isProtectedMsgTypeURL returns true if the given type URL, or type URL prefix,
+// belongs to one of the protected proto packages, or is a protected message.
+func isProtectedMsgTypeURL(msgTypeURL string) bool {
+	for _, protoPackage := range protectedProtoPackages {
+		if strings.HasPrefix(msgTypeURL, ModuleAuthorizationMsgTypeURL(protoPackage)) {
+			return true
+		}
+	}
+
+	for _, protected := range protectedMsgTypeURLs {
+		if msgTypeURL == protected {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+package authz_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestModuleAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		auth      *authz.ModuleAuthorization
+		expectErr bool
+	}{
+		{"valid package", authz.NewModuleAuthorization("cosmos.staking.v1beta1"), false},
+		{"valid package prefix", authz.NewModuleAuthorization("cosmos.staking"), false},
+		{"valid deny list", authz.NewModuleAuthorization("cosmos.staking", "/cosmos.staking.v1beta1.MsgCreateValidator"), false},
+		{"empty package", authz.NewModuleAuthorization(""), true},
+		{"invalid package", authz.NewModuleAuthorization("/cosmos.staking."), true},
+		{"auth package", authz.NewModuleAuthorization("cosmos.auth"), true},
+		{"authz package", authz.NewModuleAuthorization("cosmos.authz.v1beta1"), true},
+		{"feegrant package", authz.NewModuleAuthorization("cosmos.feegrant"), true},
+		{"group package", authz.NewModuleAuthorization("cosmos.group.v1"), false},
+		{"denied msg outside package", authz.NewModuleAuthorization("cosmos.staking", "/cosmos.bank.v1beta1.MsgSend"), true},
+		{
+			"duplicate denied msg",
+			authz.NewModuleAuthorization("cosmos.staking", "/cosmos.staking.v1beta1.MsgDelegate", "/cosmos.staking.v1beta1.MsgDelegate"),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestModuleAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	a := authz.NewModuleAuthorization("cosmos.staking", "/cosmos.staking.v1beta1.MsgCreateValidator")
+	require.Equal(t, "/cosmos.staking.", a.MsgTypeURL())
+
+	resp, err := a.Accept(ctx, &stakingtypes.MsgDelegate{})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = a.Accept(ctx, &stakingtypes.MsgCreateValidator{})
+	require.Error(t, err)
+
+	_, err = a.Accept(ctx, &banktypes.MsgSend{})
+	require.Error(t, err)
+
+	// authz messages are never accepted, even by a root package authorization
+	_, err = authz.NewModuleAuthorization("cosmos").Accept(ctx, &authz.MsgGrant{})
+	require.Error(t, err)
+
+	// nor the public key rotations
+	_, err = authz.NewModuleAuthorization("cosmos").Accept(ctx, &authtypes.MsgRotatePubKey{})
+	require.Error(t, err)
+}
+
+func TestModuleAuthorizationMsgTypeURLs(t *testing.T) {
+	require.Equal(t,
+		[]string{"/cosmos.staking.v1beta1.", "/cosmos.staking.", "/cosmos."},
+		authz.ModuleAuthorizationMsgTypeURLs("/cosmos.staking.v1beta1.MsgDelegate"),
+	)
+	require.Empty(t, authz.ModuleAuthorizationMsgTypeURLs("/MsgTest"))
+}
+
+// accountControlMsgTypeURLs are the messages which let their signer take control
+// of an account, or of the accounts it administers.
+var accountControlMsgTypeURLs = []string{
+	"/cosmos.auth.v1beta1.MsgRotatePubKey",
+	"/cosmos.authz.v1beta1.MsgGrant",
+	"/cosmos.authz.v1beta1.MsgRevoke",
+	"/cosmos.authz.v1beta1.MsgExec",
+	"/cosmos.feegrant.v1beta1.MsgGrantAllowance",
+	"/cosmos.feegrant.v1beta1.MsgRevokeAllowance",
+	"/cosmos.group.v1.MsgUpdateGroupAdmin",
+	"/cosmos.group.v1.MsgUpdateGroupPolicyAdmin",
+}
+
+func TestModuleAuthorizationAccountControlMsgs(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	registry := app.InterfaceRegistry()
+
+	registered := make(map[string]bool)
+	for _, msgTypeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		registered[msgTypeURL] = true
+	}
+
+	accountControl := make(map[string]bool)
+	for _, msgTypeURL := range accountControlMsgTypeURLs {
+		require.True(t, registered[msgTypeURL], "%s is not registered", msgTypeURL)
+		accountControl[msgTypeURL] = true
+	}
+
+	for msgTypeURL := range registered {
+		// every message of the account control packages, and every message
+		// changing an admin, must be protected
+		switch {
+		case strings.HasPrefix(msgTypeURL, "/cosmos.auth."),
+			strings.HasPrefix(msgTypeURL, "/cosmos.authz."),
+			strings.HasPrefix(msgTypeURL, "/cosmos.feegrant."),
+			strings.Contains(msgTypeURL, "Admin"):
+			accountControl[msgTypeURL] = true
+		}
+	}
+
+	for msgTypeURL := range accountControl {
+		msg, err := registry.Resolve(msgTypeURL)
+		require.NoError(t, err)
+
+		for _, typeURL := range authz.ModuleAuthorizationMsgTypeURLs(msgTypeURL) {
+			protoPackage := strings.TrimSuffix(strings.TrimPrefix(typeURL, "/"), ".")
+			a := authz.NewModuleAuthorization(protoPackage)
+			if a.ValidateBasic() != nil {
+				continue
+			}
+
+			_, err := a.Accept(ctx, msg.(sdk.Msg))
+			require.Error(t, err, "%s is covered by a module authorization for %s", msgTypeURL, protoPackage)
+		}
+	}
+}
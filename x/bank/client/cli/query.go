@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -70,22 +72,14 @@ Example:
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-			ctx := cmd.Context()
 			if denom == "" {
-				params := types.NewQueryAllBalancesRequest(addr, pageReq)
-				res, err := queryClient.AllBalances(ctx, params)
-				if err != nil {
-					return err
-				}
-				return clientCtx.PrintProto(res)
+				return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+					return queryClient.AllBalances(ctx, types.NewQueryAllBalancesRequest(addr, pageReq))
+				})
 			}
 
 			params := types.NewQueryBalanceRequest(addr, denom)
-			res, err := queryClient.Balance(ctx, params)
+			res, err := queryClient.Balance(cmd.Context(), params)
 			if err != nil {
 				return err
 			}
@@ -132,12 +126,9 @@ To query for the client metadata of a specific coin denomination use:
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
-				res, err := queryClient.DenomsMetadata(cmd.Context(), &types.QueryDenomsMetadataRequest{})
-				if err != nil {
-					return err
-				}
-
-				return clientCtx.PrintProto(res)
+				return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+					return queryClient.DenomsMetadata(ctx, &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+				})
 			}
 
 			res, err := queryClient.DenomMetadata(cmd.Context(), &types.QueryDenomMetadataRequest{Denom: denom})
@@ -151,6 +142,7 @@ To query for the client metadata of a specific coin denomination use:
 
 	cmd.Flags().String(FlagDenom, "", "The specific denomination to query client metadata for")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations metadata")
 
 	return cmd
 }
@@ -185,17 +177,10 @@ To query for the total supply of a specific coin denomination use:
 			queryClient := types.NewQueryClient(clientCtx)
 			ctx := cmd.Context()
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
 			if denom == "" {
-				res, err := queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq})
-				if err != nil {
-					return err
-				}
-
-				return clientCtx.PrintProto(res)
+				return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+					return queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq})
+				})
 			}
 
 			res, err := queryClient.SupplyOf(ctx, &types.QuerySupplyOfRequest{Denom: denom})
@@ -716,6 +716,47 @@ func (suite *IntegrationTestSuite) TestSpendableCoins() {
 	suite.Require().Equal(origCoins.Sub(delCoins...), app.BankKeeper.SpendableCoins(ctx, addr1))
 }
 
+func (suite *IntegrationTestSuite) TestLockedCoinsFn() {
+	ctx := suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 100))
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	bacc := authtypes.NewBaseAccountWithAddress(addr1)
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	authKeeper.SetAccount(ctx, vacc)
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr2))
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr1, origCoins))
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr2, origCoins))
+
+	// the functions added after the keeper was copied apply to the copy
+	keeperCopy := keeper
+	keeper.AddLockedCoinsFn(func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 20))
+	})
+	keeper.AddLockedCoinsFn(func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 40))
+	})
+
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 40))
+	suite.Require().Equal(lockedCoins, keeperCopy.LockedCoins(ctx, addr2))
+	suite.Require().Equal(origCoins.Sub(lockedCoins...), keeperCopy.SpendableCoins(ctx, addr2))
+	suite.Require().Error(keeperCopy.SendCoins(ctx, addr2, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 70))))
+
+	// the coins locked by the vesting schedule and by the functions overlap
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 40)), keeper.LockedCoins(ctx, addr1))
+
+	ctx = ctx.WithBlockTime(endTime)
+	suite.Require().Equal(lockedCoins, keeper.LockedCoins(ctx, addr1))
+}
+
 func (suite *IntegrationTestSuite) TestVestingAccountSend() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
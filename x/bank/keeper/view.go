@@ -32,22 +32,40 @@ type ViewKeeper interface {
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 }
 
+// LockedCoinsFn returns the coins of an account locked by a module, which can't
+// exceed its balances.
+type LockedCoinsFn func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	ak       types.AccountKeeper
+
+	// lockedCoinsFns is shared by all the copies of the keeper, so that the
+	// functions added after the keeper was passed to other modules apply to
+	// them too
+	lockedCoinsFns *[]LockedCoinsFn
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper) BaseViewKeeper {
 	return BaseViewKeeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		ak:       ak,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		ak:             ak,
+		lockedCoinsFns: &[]LockedCoinsFn{},
 	}
 }
 
+// AddLockedCoinsFn adds a function returning the coins of an account locked by
+// a module, such as the tokens locked by the governance votes. The coins locked
+// for an account are the greatest amount of each denom locked by its vesting
+// schedule or any of the functions. It must only be called while wiring the app.
+func (k BaseViewKeeper) AddLockedCoinsFn(fn LockedCoinsFn) {
+	*k.lockedCoinsFns = append(*k.lockedCoinsFns, fn)
+}
+
 // Logger returns a module-specific logger.
 func (k BaseViewKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -164,19 +182,25 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address. For standard accounts, the result will always be no coins
+// unless they are locked by a function added with AddLockedCoinsFn. For vesting
+// accounts, LockedCoins is delegated to the concrete vesting account type.
 func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(ctx.BlockTime())
+			locked = vacc.LockedCoins(ctx.BlockTime())
 		}
 	}
 
-	return sdk.NewCoins()
+	for _, fn := range *k.lockedCoinsFns {
+		locked = locked.Max(fn(ctx, addr))
+	}
+
+	return locked
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
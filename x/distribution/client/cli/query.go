@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -31,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryBurnedFees(),
 	)
 
 	return distQueryCmd
@@ -192,25 +195,17 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return fmt.Errorf("end-height %s not a valid uint, please input a valid end-height", args[2])
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.ValidatorSlashes(
-				cmd.Context(),
-				&types.QueryValidatorSlashesRequest{
-					ValidatorAddress: validatorAddr.String(),
-					StartingHeight:   startHeight,
-					EndingHeight:     endHeight,
-					Pagination:       pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.ValidatorSlashes(
+					ctx,
+					&types.QueryValidatorSlashesRequest{
+						ValidatorAddress: validatorAddr.String(),
+						StartingHeight:   startHeight,
+						EndingHeight:     endHeight,
+						Pagination:       pageReq,
+					},
+				)
+			})
 		},
 	}
 
@@ -319,3 +314,39 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryBurnedFees returns the command for fetching the cumulative amount
+// of burned fees.
+func GetCmdQueryBurnedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query the cumulative amount of burned fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amount of collected fees burned by the distribution module.
+
+Example:
+$ %s query distribution burned-fees
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedFees(cmd.Context(), &types.QueryBurnedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
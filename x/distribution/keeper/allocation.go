@@ -6,6 +6,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -32,6 +33,12 @@ func (k Keeper) AllocateTokens(
 		panic(err)
 	}
 
+	// burn the configured fraction of the collected fees before allocating them
+	if burned := k.burnFees(ctx, feesCollectedInt); !burned.IsZero() {
+		feesCollectedInt = feesCollectedInt.Sub(burned...)
+		feesCollected = sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+	}
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
@@ -135,3 +142,42 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.Vali
 	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 }
+
+// burnFees burns the fee burn fraction of the given fees, which must be held by
+// the distribution module account, and returns the burned amount. The burned
+// amount is truncated per denom and added to the cumulative burned fees.
+func (k Keeper) burnFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	fraction := k.GetFeeBurnFraction(ctx)
+	if fraction.IsZero() || fees.IsZero() {
+		return sdk.Coins{}
+	}
+
+	burn, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(fraction).TruncateDecimal()
+	if burn.IsZero() {
+		return sdk.Coins{}
+	}
+
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if !moduleAcc.HasPermission(authtypes.Burner) {
+		k.Logger(ctx).Error(
+			"skipping fee burn, the distribution module account does not have burner permissions",
+			"fee_burn_fraction", fraction.String(),
+		)
+		return sdk.Coins{}
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+		panic(err)
+	}
+
+	k.SetBurnedFees(ctx, k.GetBurnedFees(ctx).Add(burn...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burn.String()),
+		),
+	)
+
+	return burn
+}
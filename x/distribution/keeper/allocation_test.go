@@ -195,3 +195,61 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
 }
+
+func TestAllocateTokensWithFeeBurn(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// burn half of the collected fees
+	params := app.DistrKeeper.GetParams(ctx)
+	params.FeeBurnFraction = sdk.NewDecWithPrec(5, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)), sdk.NewCoin("atom", sdk.NewInt(1)))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NotNil(t, feeCollector)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	app.DistrKeeper.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+
+	// half of the stake fees are burned, the atom fees are too small to be burned
+	burned := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+	require.Equal(t, burned, app.DistrKeeper.GetBurnedFees(ctx))
+	require.Equal(t, supply.Sub(burned[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	// the remaining fees are allocated
+	allocated := app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards.Add(app.DistrKeeper.GetFeePool(ctx).CommunityPool...)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(burned...)...), allocated)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeBurnFees {
+			found = true
+			require.Equal(t, burned.String(), string(event.Attributes[0].Value))
+		}
+	}
+	require.True(t, found)
+
+	// burned fees accumulate across blocks
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+	app.DistrKeeper.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+	require.Equal(t, burned.Add(burned...), app.DistrKeeper.GetBurnedFees(ctx))
+}
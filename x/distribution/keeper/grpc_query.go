@@ -246,3 +246,11 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// BurnedFees queries the cumulative amount of burned fees
+func (k Keeper) BurnedFees(c context.Context, req *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	burnedFees := k.GetBurnedFees(ctx)
+
+	return &types.QueryBurnedFeesResponse{BurnedFees: burnedFees}, nil
+}
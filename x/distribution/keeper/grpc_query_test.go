@@ -75,6 +75,7 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+					FeeBurnFraction:     sdk.NewDecWithPrec(5, 1),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -655,6 +656,21 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCBurnedFees() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.BurnedFees(gocontext.Background(), &types.QueryBurnedFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.BurnedFees)
+
+	burnedFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	app.DistrKeeper.SetBurnedFees(ctx, burnedFees)
+
+	res, err = queryClient.BurnedFees(gocontext.Background(), &types.QueryBurnedFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(burnedFees, res.BurnedFees)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
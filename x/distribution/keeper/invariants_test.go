@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestCanWithdrawInvariant(t *testing.T) {
+	testCases := []struct {
+		name            string
+		feeBurnFraction sdk.Dec
+	}{
+		{"no fee burn", sdk.ZeroDec()},
+		{"partial fee burn", sdk.NewDecWithPrec(33, 2)},
+		{"full fee burn", sdk.OneDec()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			params := app.DistrKeeper.GetParams(ctx)
+			params.FeeBurnFraction = tc.feeBurnFraction
+			app.DistrKeeper.SetParams(ctx, params)
+
+			addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+			valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+			tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+			// create a validator with 10% commission and a delegation to it
+			tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDec(0))
+			tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+			tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			feeCollector := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+			votes := []abci.VoteInfo{
+				{
+					Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 200},
+					SignedLastBlock: true,
+				},
+			}
+
+			for i := 0; i < 3; i++ {
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+				fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(97+i))))
+				require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, feeCollector.GetName(), fees))
+				app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr1, votes)
+
+				for _, invariant := range []sdk.Invariant{
+					keeper.NonNegativeOutstandingInvariant(app.DistrKeeper),
+					keeper.CanWithdrawInvariant(app.DistrKeeper),
+					keeper.ModuleAccountInvariant(app.DistrKeeper),
+				} {
+					msg, broken := invariant(ctx)
+					require.False(t, broken, msg)
+				}
+			}
+
+			burned := app.DistrKeeper.GetBurnedFees(ctx)
+			if tc.feeBurnFraction.IsZero() {
+				require.True(t, burned.IsZero())
+			} else {
+				require.False(t, burned.IsZero())
+			}
+
+			// the module account holds exactly the outstanding rewards and the community pool
+			distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+			balances := app.BankKeeper.GetAllBalances(ctx, distrAcc.GetAddress())
+			var outstanding sdk.DecCoins
+			app.DistrKeeper.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards disttypes.ValidatorOutstandingRewards) (stop bool) {
+				outstanding = outstanding.Add(rewards.Rewards...)
+				return false
+			})
+			expected, _ := outstanding.Add(app.DistrKeeper.GetFeePoolCommunityCoins(ctx)...).TruncateDecimal()
+			require.Equal(t, balances, expected)
+		})
+	}
+}
@@ -41,3 +41,9 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetFeeBurnFraction returns the current distribution fee burn fraction.
+func (k Keeper) GetFeeBurnFraction(ctx sdk.Context) (fraction sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeBurnFraction, &fraction)
+	return fraction
+}
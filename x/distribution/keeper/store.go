@@ -61,6 +61,26 @@ func (k Keeper) SetFeePool(ctx sdk.Context, feePool types.FeePool) {
 	store.Set(types.FeePoolKey, b)
 }
 
+// GetBurnedFees returns the cumulative amount of fees burned by the module.
+func (k Keeper) GetBurnedFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BurnedFeesKey)
+	if b == nil {
+		return sdk.Coins{}
+	}
+
+	var burnedFees types.BurnedFees
+	k.cdc.MustUnmarshal(b, &burnedFees)
+	return burnedFees.Amount
+}
+
+// SetBurnedFees sets the cumulative amount of fees burned by the module.
+func (k Keeper) SetBurnedFees(ctx sdk.Context, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.BurnedFees{Amount: amount})
+	store.Set(types.BurnedFeesKey, b)
+}
+
 // GetPreviousProposerConsAddr returns the proposer consensus address for the
 // current block.
 func (k Keeper) GetPreviousProposerConsAddr(ctx sdk.Context) sdk.ConsAddress {
@@ -0,0 +1,41 @@
+package v047
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Setting the FeeBurnFraction param in the paramstore, burning none of the
+// collected fees as before.
+// - Adding the Burner permission to the stored distribution module account,
+// which would otherwise keep the permissions it was created with and could not
+// burn any fee.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace, ak types.AccountKeeper) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyFeeBurnFraction, sdk.ZeroDec())
+
+	moduleAcc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	macc, ok := moduleAcc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected %s module account type %T", types.ModuleName, moduleAcc)
+	}
+
+	macc.Permissions = append(macc.Permissions, authtypes.Burner)
+	ak.SetModuleAccount(ctx, macc)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the distribution module account of a v0.46 chain has no permission
+	moduleAcc := app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	moduleAcc.Permissions = nil
+	app.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
+
+	// the v0.46 paramstore has no fee burn fraction
+	paramstore := app.GetSubspace(types.ModuleName)
+	paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	paramsStore.Delete(types.ParamStoreKeyFeeBurnFraction)
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyFeeBurnFraction))
+
+	require.NoError(t, v047.MigrateStore(ctx, paramstore, app.AccountKeeper))
+
+	var fraction sdk.Dec
+	paramstore.Get(ctx, types.ParamStoreKeyFeeBurnFraction, &fraction)
+	require.True(t, fraction.IsZero())
+
+	moduleAcc = app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	require.Equal(t, []string{authtypes.Burner}, moduleAcc.Permissions)
+
+	// running the migration again keeps a single Burner permission
+	require.NoError(t, v047.MigrateStore(ctx, paramstore, app.AccountKeeper))
+	moduleAcc = app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	require.Equal(t, []string{authtypes.Burner}, moduleAcc.Permissions)
+}
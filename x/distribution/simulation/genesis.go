@@ -18,6 +18,7 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	FeeBurnFraction     = "fee_burn_fraction"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +41,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenFeeBurnFraction randomized FeeBurnFraction
+func GenFeeBurnFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +72,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var feeBurnFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnFraction, &feeBurnFraction, simState.Rand,
+		func(r *rand.Rand) { feeBurnFraction = GenFeeBurnFraction(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +85,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			FeeBurnFraction:     feeBurnFraction,
 		},
 	}
 
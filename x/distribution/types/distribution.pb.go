@@ -32,6 +32,11 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// fee_burn_fraction is the fraction of the collected fees burned before
+	// their allocation.
+	//
+	// Since: cosmos-sdk 0.47
+	FeeBurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_fraction"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -80,11 +85,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -422,6 +428,53 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+// BurnedFees is the cumulative amount of collected fees burned.
+//
+// Since: cosmos-sdk 0.47
+type BurnedFees struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *BurnedFees) Reset()         { *m = BurnedFees{} }
+func (m *BurnedFees) String() string { return proto.CompactTextString(m) }
+func (*BurnedFees) ProtoMessage()    {}
+func (*BurnedFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{8}
+}
+func (m *BurnedFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedFees.Merge(m, src)
+}
+func (m *BurnedFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedFees proto.InternalMessageInfo
+
+func (m *BurnedFees) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // CommunityPoolSpendProposal details a proposal for use of community funds,
 // together with how many coins are proposed to be spent, and to which
 // recipient account.
@@ -435,7 +488,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +533,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +587,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +630,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,6 +668,7 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*BurnedFees)(nil), "cosmos.distribution.v1beta1.BurnedFees")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
@@ -626,65 +680,67 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x10, 0xc7, 0x49, 0xa7, 0x6d, 0x42, 0x27, 0x4e, 0xea, 0xb8, 0x95, 0x1d, 0xad, 0x04,
+	0x04, 0x55, 0x71, 0x9a, 0xf6, 0x16, 0x71, 0x89, 0x9d, 0x44, 0x70, 0x6a, 0xb4, 0x41, 0x80, 0xb8,
+	0xac, 0xc6, 0xbb, 0xcf, 0xf6, 0x28, 0xeb, 0x99, 0xed, 0xcc, 0xac, 0x93, 0x9e, 0xb9, 0x00, 0x27,
+	0x24, 0x2e, 0x88, 0x03, 0xea, 0x11, 0x71, 0xee, 0x85, 0x23, 0xb7, 0x1e, 0x4b, 0x2f, 0x20, 0x0e,
+	0x01, 0x25, 0x42, 0x42, 0xdc, 0xf8, 0x07, 0x68, 0x76, 0xc6, 0x6b, 0x07, 0x42, 0x95, 0x83, 0x2d,
+	0x4e, 0xf6, 0xbc, 0x37, 0xfb, 0x7d, 0xdf, 0x7b, 0xf3, 0xde, 0x9b, 0xc1, 0x8d, 0x50, 0xa8, 0xbe,
+	0x50, 0x9b, 0x11, 0x53, 0x5a, 0xb2, 0x76, 0xaa, 0x99, 0xe0, 0x9b, 0x83, 0xad, 0x36, 0x68, 0xba,
+	0x75, 0xc1, 0xd8, 0x48, 0xa4, 0xd0, 0x82, 0xdc, 0xb1, 0xfb, 0x1b, 0x17, 0x5c, 0x6e, 0x7f, 0xb5,
+	0xdc, 0x15, 0x5d, 0x91, 0xed, 0xdb, 0x34, 0xff, 0xec, 0x27, 0xd5, 0x9a, 0xa3, 0x68, 0x53, 0x05,
+	0x39, 0x74, 0x28, 0x98, 0x83, 0xac, 0xae, 0x5a, 0x7f, 0x60, 0x3f, 0x74, 0xf8, 0xd9, 0xc2, 0xfb,
+	0x6b, 0x06, 0x97, 0x0e, 0xa8, 0xa4, 0x7d, 0x45, 0x28, 0xbe, 0x19, 0x8a, 0x7e, 0x3f, 0xe5, 0x4c,
+	0x3f, 0x09, 0x34, 0x3d, 0xa9, 0xa0, 0x35, 0xb4, 0x7e, 0xad, 0xf9, 0xce, 0xf3, 0xd3, 0x7a, 0xe1,
+	0x97, 0xd3, 0xfa, 0x9b, 0x5d, 0xa6, 0x7b, 0x69, 0xbb, 0x11, 0x8a, 0xbe, 0x83, 0x70, 0x3f, 0x1b,
+	0x2a, 0x3a, 0xda, 0xd4, 0x4f, 0x12, 0x50, 0x8d, 0x5d, 0x08, 0x5f, 0x3e, 0xdb, 0xc0, 0x8e, 0x61,
+	0x17, 0x42, 0xff, 0x46, 0x0e, 0xf9, 0x3e, 0x3d, 0x21, 0x1c, 0x97, 0x8d, 0x46, 0x23, 0x24, 0x11,
+	0x0a, 0x64, 0x20, 0xe1, 0x98, 0xca, 0xa8, 0xf2, 0xda, 0x04, 0x98, 0x88, 0x41, 0x3e, 0x70, 0xc0,
+	0x7e, 0x86, 0x4b, 0x12, 0xbc, 0xdc, 0x16, 0x3c, 0x55, 0xff, 0x22, 0x9c, 0x99, 0x00, 0xe1, 0x52,
+	0x06, 0xfd, 0x0f, 0xc6, 0x07, 0x78, 0xf9, 0x98, 0xe9, 0x5e, 0x24, 0xe9, 0x71, 0x40, 0xa3, 0x48,
+	0x06, 0xc0, 0x69, 0x3b, 0x86, 0xa8, 0x52, 0x5c, 0x43, 0xeb, 0xf3, 0xfe, 0xd2, 0xd0, 0xb9, 0x13,
+	0x45, 0x72, 0xcf, 0xba, 0x48, 0x0f, 0xdf, 0xea, 0x00, 0x04, 0xed, 0x54, 0xf2, 0xa0, 0x23, 0x69,
+	0x68, 0x4e, 0xbc, 0x32, 0x3b, 0x01, 0x85, 0x8b, 0x1d, 0x80, 0x66, 0x2a, 0xf9, 0xbe, 0x03, 0xdd,
+	0x2e, 0x7e, 0xf5, 0xb4, 0x5e, 0xf0, 0x7e, 0x44, 0xb8, 0xfa, 0x01, 0x8d, 0x59, 0x44, 0xb5, 0x90,
+	0xef, 0x32, 0xa5, 0x85, 0x64, 0x21, 0x8d, 0x6d, 0x04, 0x8a, 0x7c, 0x86, 0xf0, 0xed, 0x30, 0xed,
+	0xa7, 0x31, 0xd5, 0x6c, 0x00, 0x2e, 0x63, 0x81, 0xa4, 0x9a, 0x89, 0x0a, 0x5a, 0x9b, 0x59, 0xbf,
+	0xfe, 0xe0, 0xae, 0xab, 0xe9, 0x86, 0x49, 0xf9, 0xb0, 0x36, 0x0d, 0x63, 0x4b, 0x30, 0xde, 0x7c,
+	0x68, 0x34, 0x7f, 0xf7, 0x6b, 0xfd, 0xde, 0xd5, 0x34, 0x9b, 0x6f, 0x94, 0xbf, 0x3c, 0x62, 0xb4,
+	0x3a, 0x7c, 0xc3, 0x47, 0xde, 0xc2, 0x8b, 0x12, 0x3a, 0x20, 0x81, 0x87, 0x10, 0x84, 0x22, 0xe5,
+	0x3a, 0xab, 0x95, 0x9b, 0xfe, 0x42, 0x6e, 0x6e, 0x19, 0xab, 0xf7, 0x0d, 0xc2, 0xb7, 0xf3, 0x98,
+	0x5a, 0xa9, 0x94, 0xc0, 0xf5, 0x30, 0xa0, 0x23, 0x3c, 0x67, 0x83, 0x50, 0xd3, 0xd3, 0x3f, 0x64,
+	0x20, 0x2b, 0xb8, 0x94, 0x80, 0x64, 0xc2, 0x16, 0x75, 0xd1, 0x77, 0x2b, 0xef, 0x4b, 0x84, 0x6b,
+	0xb9, 0xc0, 0x9d, 0xd0, 0x85, 0x0b, 0x51, 0x4b, 0xf4, 0xfb, 0x4c, 0x29, 0x26, 0x38, 0x79, 0x8c,
+	0x71, 0x98, 0xaf, 0xa6, 0x27, 0x75, 0x8c, 0xc4, 0xfb, 0x1c, 0xe1, 0x3b, 0xb9, 0xaa, 0x47, 0xa9,
+	0x56, 0x9a, 0xf2, 0x88, 0xf1, 0xee, 0xff, 0x91, 0x3a, 0xef, 0x6b, 0x84, 0x97, 0x72, 0x31, 0x87,
+	0x31, 0x55, 0xbd, 0xbd, 0x01, 0x70, 0x4d, 0xde, 0xc6, 0xaf, 0x0f, 0x86, 0xe6, 0xc0, 0x25, 0x17,
+	0x65, 0xc9, 0x5d, 0xcc, 0xed, 0x07, 0x99, 0x99, 0x7c, 0x84, 0xe7, 0xf3, 0x0e, 0x9a, 0xc4, 0x50,
+	0xc9, 0xd1, 0x4c, 0xa6, 0xca, 0x97, 0x88, 0x53, 0x24, 0xc6, 0x2b, 0x23, 0x75, 0xca, 0x38, 0x02,
+	0xc8, 0x3c, 0x2e, 0x63, 0xf7, 0x1b, 0xaf, 0x18, 0xe8, 0x8d, 0x4b, 0x20, 0x9b, 0x45, 0x23, 0xd9,
+	0x2f, 0x0f, 0x2e, 0x61, 0x73, 0x1d, 0xfc, 0x09, 0xc2, 0x73, 0xfb, 0x00, 0x07, 0x42, 0xc4, 0xe4,
+	0x04, 0x2f, 0x8c, 0xc6, 0x76, 0x22, 0x44, 0x3c, 0xbd, 0x93, 0x1a, 0xdd, 0x0f, 0x86, 0xd9, 0x7b,
+	0x8c, 0xb1, 0x99, 0x2e, 0x10, 0xed, 0x03, 0x28, 0x12, 0xe2, 0x12, 0xed, 0x67, 0x1d, 0x6a, 0xf9,
+	0x57, 0x2f, 0xe5, 0xcf, 0xc8, 0xef, 0x3b, 0xf2, 0xf5, 0x2b, 0x90, 0x5b, 0x66, 0x07, 0xed, 0xfd,
+	0x8e, 0x70, 0xb5, 0x35, 0x2e, 0xe2, 0x30, 0x01, 0x1e, 0xd9, 0x19, 0x4c, 0x63, 0x52, 0xc6, 0xb3,
+	0x9a, 0xe9, 0x18, 0xec, 0xd5, 0xe5, 0xdb, 0x05, 0x59, 0xc3, 0xd7, 0x23, 0x50, 0xa1, 0x64, 0xc9,
+	0xa8, 0x2e, 0xfc, 0x71, 0x13, 0xb9, 0x8b, 0xaf, 0x49, 0x08, 0x59, 0xc2, 0x80, 0x6b, 0x7b, 0x37,
+	0xf8, 0x23, 0xc3, 0x58, 0x64, 0xc5, 0xa9, 0x45, 0xb6, 0x7d, 0xe3, 0xd3, 0xa7, 0xf5, 0x82, 0x39,
+	0xdc, 0x3f, 0xcc, 0x01, 0xff, 0x80, 0xf0, 0xf2, 0x2e, 0xc4, 0xd0, 0xcd, 0xce, 0x5f, 0x53, 0xa9,
+	0x19, 0xef, 0xbe, 0xc7, 0x3b, 0xd9, 0x44, 0x4c, 0x24, 0x0c, 0x98, 0x30, 0xb7, 0xda, 0x78, 0x2f,
+	0x2c, 0x0c, 0xcd, 0xae, 0x15, 0x7c, 0x3c, 0xab, 0x34, 0x3d, 0x82, 0x89, 0xf4, 0x81, 0x85, 0x22,
+	0xf7, 0x70, 0xa9, 0x07, 0xac, 0xdb, 0xb3, 0x49, 0x2a, 0x36, 0x97, 0xfe, 0x3c, 0xad, 0x2f, 0x86,
+	0x12, 0xcc, 0xac, 0xe6, 0x81, 0x75, 0xf9, 0x6e, 0x8b, 0xf7, 0x13, 0xc2, 0xab, 0x2e, 0x06, 0x26,
+	0x78, 0x1e, 0x8d, 0xbb, 0x28, 0xf7, 0xf0, 0xad, 0x51, 0xdb, 0x98, 0x9b, 0x12, 0x94, 0x72, 0x2f,
+	0x8e, 0xca, 0xcb, 0x67, 0x1b, 0x65, 0x47, 0xbe, 0x63, 0x3d, 0x87, 0x5a, 0x9a, 0xa9, 0x34, 0x9a,
+	0x03, 0xce, 0x4e, 0x18, 0x2e, 0xe5, 0x6f, 0x88, 0x29, 0x55, 0xbd, 0x23, 0xd8, 0x9e, 0x77, 0x27,
+	0x84, 0xbc, 0xef, 0x11, 0x7e, 0xe3, 0xbf, 0xab, 0xf0, 0x43, 0xa6, 0x7b, 0xbb, 0x90, 0x08, 0xc5,
+	0xf4, 0x94, 0x0a, 0x72, 0x65, 0xac, 0x20, 0x8d, 0xcb, 0xad, 0x48, 0x05, 0xcf, 0x45, 0x96, 0xd8,
+	0x3e, 0x1f, 0xfc, 0xe1, 0x72, 0xa4, 0xbd, 0xf9, 0xe8, 0xdb, 0xb3, 0x1a, 0x7a, 0x7e, 0x56, 0x43,
+	0x2f, 0xce, 0x6a, 0xe8, 0xb7, 0xb3, 0x1a, 0xfa, 0xe2, 0xbc, 0x56, 0x78, 0x71, 0x5e, 0x2b, 0xfc,
+	0x7c, 0x5e, 0x2b, 0x7c, 0xbc, 0xf5, 0xca, 0xc4, 0x9c, 0x5c, 0x7c, 0xc4, 0x66, 0x79, 0x6a, 0x97,
+	0xb2, 0x87, 0xe4, 0xc3, 0xbf, 0x07, 0x00, 0xd0, 0x1e, 0x44, 0x64, 0xe8, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +774,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.FeeBurnFraction.Equal(that1.FeeBurnFraction) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -927,6 +986,35 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BurnedFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BurnedFees)
+	if !ok {
+		that2, ok := that.(BurnedFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1045,6 +1133,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1358,6 +1456,43 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurnedFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1587,6 +1722,8 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1700,6 +1837,21 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
+func (m *BurnedFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CommunityPoolSpendProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1949,6 +2101,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2615,6 +2801,90 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BurnedFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnedFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnedFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -347,6 +347,11 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// burned_fees defines the cumulative amount of collected fees burned at
+	// genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -398,63 +403,66 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0x21, 0x4d, 0xc6, 0x45, 0x94, 0x6d, 0x6a, 0xd6, 0x69, 0x59, 0xa7, 0xa5, 0x87,
+	0x20, 0xd4, 0x75, 0xe3, 0x22, 0x40, 0x45, 0x20, 0xd9, 0x6e, 0x0a, 0x9c, 0x1a, 0xd9, 0x88, 0x4a,
+	0x48, 0x68, 0x35, 0xde, 0x1d, 0xaf, 0x07, 0xd6, 0x3b, 0xd6, 0xcc, 0xec, 0xa6, 0x48, 0x9c, 0x90,
+	0x90, 0x7a, 0x44, 0x82, 0x0f, 0xd0, 0x23, 0x42, 0xe2, 0xc6, 0x67, 0x40, 0x3d, 0x70, 0xa8, 0x38,
+	0x71, 0x40, 0x80, 0x1c, 0x0e, 0x7c, 0x05, 0x6e, 0x68, 0x67, 0x66, 0xff, 0x29, 0x9b, 0xad, 0xd3,
+	0x26, 0xa7, 0x64, 0x76, 0xde, 0x7b, 0xbf, 0xdf, 0xef, 0xbd, 0xe7, 0xf7, 0x06, 0xbc, 0xee, 0x10,
+	0x36, 0x23, 0xac, 0xe3, 0x62, 0xc6, 0x29, 0x1e, 0x87, 0x1c, 0x93, 0xa0, 0x13, 0xed, 0x8e, 0x11,
+	0x87, 0xbb, 0x1d, 0x0f, 0x05, 0x88, 0x61, 0x66, 0xcd, 0x29, 0xe1, 0x44, 0xbf, 0x2c, 0x4d, 0xad,
+	0xbc, 0xa9, 0xa5, 0x4c, 0xb7, 0x36, 0x3d, 0xe2, 0x11, 0x61, 0xd7, 0x89, 0xff, 0x93, 0x2e, 0x5b,
+	0xa6, 0x8a, 0x3e, 0x86, 0x0c, 0xa5, 0x51, 0x1d, 0x82, 0x03, 0x75, 0x6f, 0x55, 0xa1, 0x17, 0x70,
+	0xa4, 0x7d, 0x4b, 0xda, 0xdb, 0x12, 0x48, 0xf1, 0x11, 0x87, 0x6b, 0x3f, 0x69, 0xe0, 0xd2, 0x1d,
+	0xe4, 0x23, 0x0f, 0x72, 0x42, 0xef, 0x63, 0x3e, 0x75, 0x29, 0x3c, 0xf8, 0x28, 0x98, 0x10, 0x7d,
+	0x0f, 0xbc, 0xec, 0x26, 0x17, 0x36, 0x74, 0x5d, 0x8a, 0x18, 0x33, 0xb4, 0x6d, 0x6d, 0x67, 0xa3,
+	0x6f, 0xfc, 0xf6, 0xf3, 0x8d, 0x4d, 0x15, 0xa6, 0x27, 0x6f, 0x46, 0x9c, 0xe2, 0xc0, 0x1b, 0x5e,
+	0x48, 0x5d, 0xd4, 0x77, 0x7d, 0x00, 0x2e, 0x1c, 0xa8, 0xb0, 0x69, 0x94, 0xfa, 0x53, 0xa2, 0xbc,
+	0x94, 0x78, 0xa8, 0xcf, 0xb7, 0xd7, 0x1f, 0x3e, 0x6a, 0xd7, 0xfe, 0x7d, 0xd4, 0xae, 0x5d, 0xfb,
+	0x4f, 0x03, 0x57, 0x3f, 0x81, 0x3e, 0x76, 0x63, 0x8c, 0x7b, 0x21, 0x67, 0x1c, 0x06, 0x6e, 0xec,
+	0x83, 0x0e, 0x20, 0x75, 0xd9, 0x10, 0x39, 0x84, 0xba, 0x31, 0xf7, 0x28, 0x31, 0x5a, 0x9e, 0x7b,
+	0xea, 0x92, 0x70, 0xff, 0x5a, 0x03, 0x17, 0x49, 0x86, 0x61, 0x53, 0x09, 0x62, 0xd4, 0xb7, 0x57,
+	0x76, 0x1a, 0xdd, 0x2b, 0xaa, 0x0c, 0x56, 0x5c, 0xa6, 0xa4, 0xa2, 0xd6, 0x1d, 0xe4, 0x0c, 0x08,
+	0x0e, 0xfa, 0xb7, 0x1e, 0xff, 0xd9, 0xae, 0xfd, 0xf8, 0x57, 0xfb, 0x0d, 0x0f, 0xf3, 0x69, 0x38,
+	0xb6, 0x1c, 0x32, 0x53, 0x99, 0x57, 0x7f, 0x6e, 0x30, 0xf7, 0x8b, 0x0e, 0xff, 0x72, 0x8e, 0x58,
+	0xe2, 0xc3, 0x86, 0x3a, 0x39, 0xa2, 0x28, 0xa7, 0xfd, 0x0f, 0x0d, 0x5c, 0x4f, 0xb5, 0xf7, 0x1c,
+	0x27, 0x9c, 0x85, 0x3e, 0xe4, 0xc8, 0x1d, 0x90, 0xd9, 0x0c, 0x33, 0x86, 0x49, 0x70, 0xba, 0xf2,
+	0x1d, 0xd0, 0x80, 0x19, 0x8a, 0xa8, 0x5a, 0xa3, 0xfb, 0xae, 0x55, 0xd1, 0xcf, 0x56, 0x35, 0xbd,
+	0xfe, 0x6a, 0x9c, 0x94, 0x61, 0x3e, 0x6a, 0x4e, 0xde, 0x3f, 0x1a, 0xd8, 0x4e, 0xfd, 0x3f, 0xc4,
+	0x8c, 0x13, 0x8a, 0x1d, 0xe8, 0x9f, 0x49, 0x65, 0x9b, 0x60, 0x6d, 0x8e, 0x28, 0x26, 0x52, 0xd5,
+	0xea, 0x50, 0x9d, 0xf4, 0xfb, 0xe0, 0x5c, 0x52, 0xe4, 0x15, 0x21, 0xf7, 0xed, 0xe5, 0xe4, 0x1e,
+	0xa1, 0xab, 0xa4, 0x26, 0xd1, 0x72, 0x32, 0x7f, 0xd1, 0xc0, 0xab, 0xa9, 0xdf, 0x20, 0xa4, 0x14,
+	0x05, 0xfc, 0x4c, 0x34, 0x7e, 0x9c, 0x69, 0x91, 0xa5, 0x7b, 0x73, 0x39, 0x2d, 0x45, 0x4e, 0xc7,
+	0x0b, 0xf9, 0xbe, 0x0e, 0x2e, 0xa7, 0xa3, 0x63, 0xc4, 0x21, 0xe5, 0x38, 0xf0, 0xe2, 0xd1, 0x91,
+	0xc9, 0x38, 0x8d, 0x01, 0x52, 0x9a, 0x8d, 0xfa, 0x89, 0xb3, 0xf1, 0x19, 0x78, 0x91, 0x29, 0x8e,
+	0x36, 0x0e, 0x26, 0x44, 0xd5, 0xb7, 0x5b, 0x99, 0x93, 0x52, 0x79, 0x2a, 0x23, 0xe7, 0x59, 0xee,
+	0x5b, 0x2e, 0x2d, 0x0f, 0xeb, 0xa0, 0x95, 0xe6, 0x72, 0xe4, 0x43, 0x36, 0xdd, 0x8b, 0x44, 0x3a,
+	0x4f, 0xb9, 0x7f, 0xa7, 0x08, 0x7b, 0x53, 0x9e, 0xf4, 0xaf, 0x3c, 0xe5, 0xfa, 0x7a, 0xa5, 0xd0,
+	0xd7, 0x9f, 0x83, 0x4b, 0x19, 0x2c, 0x8b, 0x49, 0xd9, 0x28, 0x66, 0x65, 0xac, 0x8a, 0x2c, 0xdc,
+	0x5c, 0xae, 0x33, 0x32, 0x35, 0x2a, 0x07, 0x17, 0xa3, 0xa3, 0x57, 0xb9, 0x54, 0xfc, 0xba, 0x01,
+	0xce, 0x7f, 0x20, 0x97, 0xe1, 0x88, 0x43, 0x8e, 0xf4, 0x1e, 0x58, 0x9b, 0x43, 0x0a, 0x67, 0x52,
+	0x72, 0xa3, 0xfb, 0x5a, 0x25, 0xee, 0xbe, 0x30, 0x55, 0x50, 0xca, 0x51, 0xdf, 0x03, 0xeb, 0x13,
+	0x84, 0xec, 0x39, 0x21, 0xbe, 0x6a, 0xeb, 0xeb, 0x95, 0x41, 0xee, 0x22, 0xb4, 0x4f, 0x88, 0x9f,
+	0xb4, 0xf1, 0x44, 0x1e, 0x75, 0x0a, 0x8c, 0xac, 0x39, 0xd3, 0x05, 0x15, 0x37, 0x46, 0xfc, 0xcb,
+	0x5f, 0x59, 0xbe, 0x33, 0xf2, 0x3b, 0x53, 0x81, 0x34, 0xdd, 0xb2, 0x4b, 0xd1, 0xc9, 0x73, 0x8a,
+	0x22, 0x4c, 0x42, 0xb1, 0x8a, 0xe7, 0x84, 0x21, 0x6a, 0xac, 0x3e, 0xad, 0xf6, 0x89, 0xcb, 0xbe,
+	0xf2, 0xd0, 0xc3, 0xf2, 0xa5, 0xf4, 0x82, 0x60, 0xfd, 0xfe, 0x72, 0x95, 0x3c, 0x6e, 0x73, 0x2a,
+	0x05, 0x25, 0x7b, 0x48, 0xff, 0x4e, 0x03, 0x57, 0x73, 0xad, 0x9b, 0x8d, 0x70, 0xdb, 0x49, 0x07,
+	0x3c, 0x33, 0xd6, 0x04, 0x8b, 0xde, 0x73, 0x2c, 0x89, 0x02, 0x91, 0x76, 0x54, 0x69, 0xcb, 0xf4,
+	0x6f, 0x34, 0x70, 0x25, 0x63, 0x35, 0x4d, 0xc7, 0x70, 0x9a, 0x96, 0x73, 0x82, 0xd0, 0x7b, 0xcf,
+	0x38, 0xc6, 0x0b, 0x64, 0xb6, 0xa2, 0x63, 0xed, 0xf4, 0xaf, 0x40, 0x2b, 0xa3, 0xe1, 0xc8, 0x09,
+	0x9a, 0x72, 0x58, 0x17, 0x1c, 0x6e, 0x3f, 0xcb, 0xf8, 0x2d, 0x10, 0x78, 0x25, 0x2a, 0x37, 0xd2,
+	0x1f, 0xe4, 0xbb, 0xb9, 0x30, 0xe6, 0x98, 0xb1, 0x21, 0xc0, 0xdf, 0x39, 0xf9, 0x9c, 0x2b, 0x40,
+	0x37, 0xdd, 0x32, 0x13, 0xa6, 0x53, 0xd0, 0x2c, 0x1d, 0x2c, 0xcc, 0x00, 0x02, 0xf7, 0xad, 0x93,
+	0x4e, 0x96, 0x02, 0xea, 0x66, 0xc9, 0x7c, 0x61, 0xba, 0x0f, 0x1a, 0xe3, 0x90, 0x06, 0xc8, 0xb5,
+	0x27, 0x08, 0x31, 0xa3, 0x21, 0x80, 0x5a, 0xa5, 0xaf, 0x31, 0xf1, 0x14, 0xbb, 0xa9, 0x9e, 0x62,
+	0x3b, 0x4b, 0x3c, 0xc5, 0xe4, 0x3b, 0x0c, 0xc8, 0xf8, 0x77, 0x11, 0xca, 0x2d, 0xbc, 0xfe, 0xbd,
+	0x1f, 0x16, 0xa6, 0xf6, 0x78, 0x61, 0x6a, 0x4f, 0x16, 0xa6, 0xf6, 0xf7, 0xc2, 0xd4, 0xbe, 0x3d,
+	0x34, 0x6b, 0x4f, 0x0e, 0xcd, 0xda, 0xef, 0x87, 0x66, 0xed, 0xd3, 0xdd, 0xca, 0xe8, 0x0f, 0x8a,
+	0x8f, 0x75, 0x01, 0x36, 0x5e, 0x13, 0x6f, 0xf0, 0x5b, 0xff, 0x0f, 0x00, 0xaa, 0x3f, 0xba, 0x94,
+	0x4e, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -780,6 +788,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,6 +1121,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2367,6 +2395,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -15,6 +15,7 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyFeeBurnFraction     = []byte("feeburnfraction")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,6 +30,7 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		FeeBurnFraction:     sdk.ZeroDec(),
 	}
 }
 
@@ -44,6 +46,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeBurnFraction, &p.FeeBurnFraction, validateFeeBurnFraction),
 	}
 }
 
@@ -69,6 +72,9 @@ func (p Params) ValidateBasic() error {
 			"sum of base, bonus proposer rewards, and community tax cannot be greater than one: %s", v,
 		)
 	}
+	if err := validateFeeBurnFraction(p.FeeBurnFraction); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -138,3 +144,22 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBurnFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee burn fraction must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn fraction must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn fraction too large: %s", v)
+	}
+
+	return nil
+}
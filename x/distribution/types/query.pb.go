@@ -839,6 +839,95 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryBurnedFeesRequest is the request type for the Query/BurnedFees RPC
+// method.
+//
+// Since: cosmos-sdk 0.47
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse is the response type for the Query/BurnedFees RPC
+// method.
+//
+// Since: cosmos-sdk 0.47
+type QueryBurnedFeesResponse struct {
+	// burned_fees defines the cumulative amount of collected fees burned.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -858,6 +947,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesResponse")
 }
 
 func init() {
@@ -865,78 +956,83 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x84, 0xdc, 0x4d, 0xb0, 0xa3, 0x0d,
-	0x25, 0x11, 0x51, 0xbc, 0x4d, 0x22, 0x15, 0x68, 0x41, 0x90, 0x5f, 0xa5, 0x52, 0xab, 0x36, 0x75,
-	0xab, 0xa6, 0x70, 0xb1, 0xd6, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0xc7, 0x44, 0x55,
-	0x2f, 0x94, 0x4a, 0x5c, 0x90, 0x90, 0xb8, 0xf4, 0x98, 0x33, 0x67, 0x10, 0x12, 0x7f, 0x41, 0x8f,
-	0x15, 0x48, 0xa8, 0x27, 0x40, 0x09, 0x42, 0xbd, 0x70, 0xe6, 0x5a, 0x79, 0x66, 0xd6, 0xde, 0xb5,
-	0xd7, 0x6b, 0x3b, 0xae, 0x4f, 0x75, 0x67, 0xe7, 0x7d, 0xdf, 0xfb, 0xbc, 0x99, 0x37, 0xef, 0x29,
-	0x30, 0x5f, 0xa4, 0xac, 0x42, 0x99, 0x61, 0x39, 0x8c, 0x7b, 0x4e, 0xa1, 0xc6, 0x1d, 0xea, 0x1a,
-	0xbb, 0xcb, 0x05, 0xc2, 0xcd, 0x65, 0xe3, 0x41, 0x8d, 0x78, 0x7b, 0xd9, 0xaa, 0x47, 0x39, 0xc5,
-	0xd3, 0x72, 0x63, 0x36, 0xb8, 0x31, 0xab, 0x36, 0x6a, 0xef, 0x2b, 0x95, 0x82, 0xc9, 0x88, 0xb4,
-	0x6a, 0x6a, 0x54, 0x4d, 0xdb, 0x71, 0x4d, 0xb1, 0x5b, 0x08, 0x69, 0x53, 0x36, 0xb5, 0xa9, 0xf8,
-	0x69, 0x34, 0x7e, 0xa9, 0xd5, 0x19, 0x9b, 0x52, 0xbb, 0x4c, 0x0c, 0xb3, 0xea, 0x18, 0xa6, 0xeb,
-	0x52, 0x2e, 0x4c, 0x98, 0xfa, 0x9a, 0x0e, 0xea, 0xfb, 0xca, 0x45, 0xea, 0xf8, 0x9a, 0xd9, 0x38,
-	0x8a, 0x50, 0xc4, 0x72, 0xff, 0x39, 0xb9, 0x3f, 0x2f, 0xc3, 0x50, 0x64, 0xe2, 0x3f, 0xfa, 0x14,
-	0xe0, 0x5b, 0x0d, 0x80, 0x6d, 0xd3, 0x33, 0x2b, 0x2c, 0x47, 0x1e, 0xd4, 0x08, 0xe3, 0xfa, 0x3d,
-	0x38, 0x1b, 0x5a, 0x65, 0x55, 0xea, 0x32, 0x82, 0xd7, 0x60, 0xbc, 0x2a, 0x56, 0x52, 0x68, 0x16,
-	0x2d, 0x9c, 0x5a, 0x99, 0xcb, 0xc6, 0x64, 0x29, 0x2b, 0x8d, 0xd7, 0xc7, 0x9e, 0xfd, 0x99, 0x49,
-	0xe4, 0x94, 0xa1, 0x5e, 0x85, 0x79, 0xa1, 0x7c, 0xd7, 0x2c, 0x3b, 0x96, 0xc9, 0xa9, 0x77, 0xb3,
-	0xc6, 0x19, 0x37, 0x5d, 0xcb, 0x71, 0xed, 0x1c, 0xa9, 0x9b, 0x9e, 0xe5, 0x07, 0x81, 0xb7, 0xe0,
-	0xcc, 0xae, 0xbf, 0x2b, 0x6f, 0x5a, 0x96, 0x47, 0x98, 0x74, 0x7c, 0x72, 0x3d, 0xf5, 0xdb, 0x4f,
-	0x4b, 0x53, 0xca, 0xf7, 0x9a, 0xfc, 0x72, 0x9b, 0x7b, 0x0d, 0x89, 0xc9, 0xa6, 0x89, 0x5a, 0xd7,
-	0xbf, 0x41, 0xb0, 0xd0, 0xdb, 0xa5, 0x22, 0xbc, 0x07, 0x27, 0x3c, 0xb9, 0xa4, 0x10, 0x3f, 0x8c,
-	0x45, 0x8c, 0x91, 0x54, 0xdc, 0xbe, 0x9c, 0x5e, 0x82, 0x4c, 0x38, 0x8a, 0x0d, 0x5a, 0xa9, 0x38,
-	0x8c, 0x39, 0xd4, 0x7d, 0xcd, 0xc0, 0x4f, 0x10, 0xcc, 0x76, 0x77, 0xa5, 0x40, 0x4d, 0x80, 0x62,
-	0x73, 0x55, 0xb1, 0x5e, 0xee, 0x8f, 0x75, 0xad, 0x58, 0xac, 0x55, 0x6a, 0x65, 0x93, 0x13, 0xab,
-	0x25, 0xac, 0x70, 0x03, 0xa2, 0xfa, 0x93, 0x24, 0xcc, 0x84, 0xe3, 0xb8, 0x5d, 0x36, 0x59, 0x89,
-	0xbc, 0xe6, 0x03, 0xc6, 0xf3, 0x30, 0xc1, 0xb8, 0xe9, 0x71, 0xc7, 0xb5, 0xf3, 0x25, 0xe2, 0xd8,
-	0x25, 0x9e, 0x4a, 0xce, 0xa2, 0x85, 0xb1, 0xdc, 0x5b, 0xfe, 0xf2, 0x55, 0xb1, 0x8a, 0xe7, 0xe0,
-	0x34, 0x11, 0x47, 0xe4, 0x6f, 0x3b, 0x26, 0xb6, 0xbd, 0x29, 0x17, 0xd5, 0xa6, 0x2b, 0x00, 0xad,
-	0x1a, 0x4e, 0x8d, 0x89, 0xc4, 0xbc, 0xe7, 0x27, 0xa6, 0x51, 0x90, 0x59, 0xf9, 0x4c, 0xb4, 0x6e,
-	0xb9, 0x4d, 0x14, 0x50, 0x2e, 0x60, 0x79, 0xe9, 0x8d, 0x6f, 0xf7, 0x33, 0x89, 0xa7, 0xfb, 0x19,
-	0xa4, 0xff, 0x8a, 0xe0, 0x9d, 0x2e, 0x79, 0x50, 0x87, 0xb1, 0x0d, 0x27, 0x98, 0x5c, 0x4a, 0xa1,
-	0xd9, 0x63, 0x0b, 0xa7, 0x56, 0x2e, 0xf4, 0x77, 0x12, 0x42, 0x67, 0x6b, 0x97, 0xb8, 0xdc, 0xbf,
-	0x6d, 0x4a, 0x06, 0x7f, 0x1e, 0xa2, 0x48, 0x0a, 0x8a, 0xf9, 0x9e, 0x14, 0x32, 0x9c, 0x20, 0x86,
-	0xfe, 0x8b, 0x1f, 0xfc, 0x26, 0x29, 0x13, 0x5b, 0xac, 0x75, 0x96, 0xa9, 0x25, 0xbf, 0x0d, 0x72,
-	0x8a, 0x4d, 0x13, 0xff, 0x14, 0x23, 0x2f, 0x43, 0x72, 0xd0, 0xcb, 0x20, 0xd3, 0xfe, 0x72, 0x3f,
-	0x93, 0xd0, 0xbf, 0x43, 0x90, 0xee, 0x16, 0xb9, 0xca, 0xfb, 0xfd, 0x60, 0xb5, 0x37, 0xf2, 0x3e,
-	0x13, 0x4a, 0x91, 0x9f, 0x9c, 0x4d, 0x52, 0xdc, 0xa0, 0x8e, 0xbb, 0xbe, 0xda, 0xc8, 0xf1, 0x8f,
-	0x7f, 0x65, 0x16, 0x6d, 0x87, 0x97, 0x6a, 0x85, 0x6c, 0x91, 0x56, 0xd4, 0x63, 0xaa, 0xfe, 0x59,
-	0x62, 0xd6, 0x7d, 0x83, 0xef, 0x55, 0x09, 0xf3, 0x6d, 0x58, 0xeb, 0x01, 0xa8, 0x81, 0xde, 0x16,
-	0xce, 0x1d, 0xca, 0xcd, 0xf2, 0x48, 0xb2, 0x19, 0x48, 0xc3, 0xbf, 0x08, 0xe6, 0x62, 0xfd, 0xaa,
-	0x5c, 0xdc, 0x6d, 0xcf, 0xc5, 0xc5, 0xd8, 0x3b, 0xd8, 0x52, 0xdb, 0xf4, 0x7d, 0x4b, 0xc5, 0xb6,
-	0x77, 0x0f, 0xdb, 0x70, 0x9c, 0x37, 0xfc, 0xa5, 0x92, 0xa3, 0xca, 0xb0, 0xd4, 0xd7, 0x3d, 0xf5,
-	0xc0, 0x36, 0xe3, 0x69, 0x96, 0xc9, 0xe8, 0x92, 0x7b, 0x5d, 0xbd, 0xb4, 0x91, 0x3e, 0x55, 0x62,
-	0xd3, 0x00, 0xcd, 0x5b, 0x2a, 0x73, 0x7b, 0x32, 0x17, 0x58, 0x09, 0xa8, 0xd5, 0xe1, 0xdd, 0xb0,
-	0xda, 0x8e, 0xc3, 0x4b, 0x96, 0x67, 0xd6, 0x95, 0xe3, 0x91, 0x61, 0xec, 0xc2, 0xf9, 0x1e, 0x8e,
-	0x15, 0xcb, 0x06, 0x4c, 0xd6, 0xd5, 0xa7, 0xbe, 0x1d, 0x4f, 0xd4, 0xc3, 0x62, 0x01, 0xbf, 0xd3,
-	0x70, 0x4e, 0xf8, 0x6d, 0xb4, 0x91, 0x9a, 0xeb, 0xf0, 0xbd, 0x6d, 0x4a, 0xcb, 0xfe, 0x0c, 0xf2,
-	0x18, 0x81, 0x16, 0xf5, 0x55, 0x85, 0x42, 0x60, 0xac, 0x4a, 0x69, 0x79, 0x74, 0x85, 0x2b, 0xe4,
-	0x57, 0x5e, 0x4c, 0xc0, 0x71, 0x11, 0x05, 0x7e, 0x8a, 0x60, 0x5c, 0x8e, 0x34, 0xd8, 0x88, 0x2d,
-	0x8d, 0xce, 0x79, 0x4a, 0xbb, 0xd0, 0xbf, 0x81, 0xc4, 0xd3, 0x17, 0xbf, 0xfe, 0xfd, 0x9f, 0x1f,
-	0x92, 0xe7, 0xf1, 0x9c, 0x11, 0x37, 0xeb, 0xc9, 0xa1, 0x0a, 0x3f, 0x4e, 0xc2, 0x74, 0xcc, 0x28,
-	0x82, 0x37, 0x7b, 0xbb, 0xef, 0x3d, 0x8f, 0x69, 0x5b, 0x43, 0xaa, 0x28, 0xb2, 0x1d, 0x41, 0x76,
-	0x0b, 0xdf, 0x8c, 0x25, 0x6b, 0x15, 0x88, 0xf1, 0xb0, 0xa3, 0x2f, 0x3c, 0x32, 0x68, 0x4b, 0x3f,
-	0xef, 0xbf, 0x34, 0x07, 0x08, 0xce, 0x46, 0x8c, 0x3c, 0xf8, 0xe3, 0x01, 0xe2, 0xee, 0x18, 0xca,
-	0xb4, 0x4f, 0x8e, 0x68, 0xad, 0x68, 0x6f, 0x08, 0xda, 0xab, 0xf8, 0xca, 0x30, 0xb4, 0xad, 0xa1,
-	0x0a, 0xff, 0x81, 0x60, 0xb2, 0x7d, 0x8e, 0xc0, 0x1f, 0x0d, 0x10, 0x63, 0x78, 0x06, 0xd3, 0x2e,
-	0x1d, 0xc5, 0x54, 0xb1, 0x5d, 0x13, 0x6c, 0x5b, 0x78, 0x63, 0x18, 0x36, 0x7f, 0x62, 0xf9, 0x0f,
-	0xc1, 0x99, 0x8e, 0x4e, 0x8d, 0xfb, 0x08, 0xaf, 0xdb, 0x60, 0xa2, 0x5d, 0x3e, 0x92, 0xad, 0x62,
-	0xcb, 0x0b, 0xb6, 0x2f, 0xf0, 0x4e, 0x2c, 0x5b, 0xf3, 0x4d, 0x65, 0xc6, 0xc3, 0x8e, 0x27, 0xf9,
-	0x91, 0xa1, 0x6e, 0x66, 0x14, 0x37, 0x7e, 0x89, 0xe0, 0xed, 0xe8, 0x96, 0x8c, 0x3f, 0x1d, 0x24,
-	0xf0, 0x88, 0x21, 0x42, 0xfb, 0xec, 0xe8, 0x02, 0x03, 0x1d, 0x6d, 0x7f, 0xf8, 0xa2, 0x30, 0x23,
-	0x3a, 0x64, 0x3f, 0x85, 0xd9, 0xbd, 0x99, 0xf7, 0x53, 0x98, 0x31, 0x6d, 0xb9, 0xcf, 0xc2, 0xec,
-	0x41, 0xd8, 0xba, 0xdb, 0xf8, 0x7f, 0x04, 0xa9, 0x6e, 0xfd, 0x13, 0xaf, 0x0d, 0x10, 0x6b, 0x74,
-	0xd3, 0xd7, 0xd6, 0x87, 0x91, 0x50, 0xcc, 0x77, 0x04, 0xf3, 0x0d, 0x7c, 0x7d, 0x18, 0xe6, 0xf6,
-	0x01, 0x00, 0xff, 0x8c, 0xe0, 0x74, 0xa8, 0x47, 0xe3, 0x8b, 0xbd, 0x63, 0x8d, 0x6a, 0xf9, 0xda,
-	0x07, 0x03, 0xdb, 0x29, 0xb0, 0x55, 0x01, 0xb6, 0x84, 0x17, 0x63, 0xc1, 0x8a, 0xbe, 0x6d, 0xbe,
-	0xd1, 0xda, 0xd7, 0xaf, 0x3d, 0x3b, 0x48, 0xa3, 0xe7, 0x07, 0x69, 0xf4, 0xf7, 0x41, 0x1a, 0x7d,
-	0x7f, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x5e, 0x1c, 0xa6, 0x13, 0x5f, 0x2e, 0xc7, 0xce, 0x09,
-	0x5f, 0x85, 0xd5, 0xc5, 0xd8, 0x50, 0x18, 0x17, 0x7f, 0x4e, 0x59, 0x7d, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0xc9, 0xca, 0xd1, 0x61, 0x12, 0x00, 0x00,
+	// 1201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xb8, 0x69, 0x4a, 0x5f, 0x28, 0x4d, 0xa6, 0x51, 0x71, 0x36, 0xc1, 0x8e, 0x36, 0x94,
+	0x58, 0x8d, 0xe2, 0xcd, 0x0f, 0x54, 0xa0, 0xa5, 0x82, 0x38, 0x3f, 0xa8, 0xd4, 0xaa, 0x4d, 0xdd,
+	0xaa, 0x29, 0x5c, 0xac, 0xb5, 0x77, 0x58, 0xaf, 0x6a, 0xef, 0xb8, 0xbb, 0xe3, 0x84, 0xa8, 0xea,
+	0x85, 0xb6, 0x82, 0x0b, 0x12, 0x12, 0x97, 0x1e, 0x73, 0x85, 0x33, 0x08, 0x89, 0xbf, 0xa0, 0xc7,
+	0x0a, 0x24, 0xc4, 0x09, 0x50, 0x82, 0x50, 0x2f, 0x9c, 0xb9, 0x56, 0x9e, 0x99, 0x5d, 0xef, 0xc6,
+	0xf6, 0xda, 0x9b, 0x34, 0xa7, 0xba, 0x6f, 0xe7, 0x7d, 0xef, 0xfb, 0xde, 0xcc, 0x9b, 0xf9, 0x14,
+	0x98, 0x2e, 0x53, 0xb7, 0x46, 0x5d, 0xcd, 0xb0, 0x5c, 0xe6, 0x58, 0xa5, 0x06, 0xb3, 0xa8, 0xad,
+	0x6d, 0xce, 0x97, 0x08, 0xd3, 0xe7, 0xb5, 0xfb, 0x0d, 0xe2, 0x6c, 0xe7, 0xea, 0x0e, 0x65, 0x14,
+	0x8f, 0x8b, 0x85, 0xb9, 0xe0, 0xc2, 0x9c, 0x5c, 0xa8, 0x9c, 0x97, 0x28, 0x25, 0xdd, 0x25, 0x22,
+	0xcb, 0xc7, 0xa8, 0xeb, 0xa6, 0x65, 0xeb, 0x7c, 0x35, 0x07, 0x52, 0x46, 0x4d, 0x6a, 0x52, 0xfe,
+	0x53, 0x6b, 0xfe, 0x92, 0xd1, 0x09, 0x93, 0x52, 0xb3, 0x4a, 0x34, 0xbd, 0x6e, 0x69, 0xba, 0x6d,
+	0x53, 0xc6, 0x53, 0x5c, 0xf9, 0x35, 0x1d, 0xc4, 0xf7, 0x90, 0xcb, 0xd4, 0xf2, 0x30, 0x73, 0x51,
+	0x2a, 0x42, 0x8c, 0xc5, 0xfa, 0x31, 0xb1, 0xbe, 0x28, 0x68, 0x48, 0x65, 0xfc, 0x3f, 0xea, 0x28,
+	0xe0, 0x9b, 0x4d, 0x01, 0xeb, 0xba, 0xa3, 0xd7, 0xdc, 0x02, 0xb9, 0xdf, 0x20, 0x2e, 0x53, 0xef,
+	0xc2, 0x99, 0x50, 0xd4, 0xad, 0x53, 0xdb, 0x25, 0x78, 0x09, 0x06, 0xeb, 0x3c, 0x92, 0x42, 0x93,
+	0x28, 0x3b, 0xb4, 0x30, 0x95, 0x8b, 0xe8, 0x52, 0x4e, 0x24, 0xe7, 0x07, 0x9e, 0xfd, 0x99, 0x49,
+	0x14, 0x64, 0xa2, 0x5a, 0x87, 0x69, 0x8e, 0x7c, 0x47, 0xaf, 0x5a, 0x86, 0xce, 0xa8, 0x73, 0xa3,
+	0xc1, 0x5c, 0xa6, 0xdb, 0x86, 0x65, 0x9b, 0x05, 0xb2, 0xa5, 0x3b, 0x86, 0x47, 0x02, 0xaf, 0xc2,
+	0xc8, 0xa6, 0xb7, 0xaa, 0xa8, 0x1b, 0x86, 0x43, 0x5c, 0x51, 0xf8, 0x64, 0x3e, 0xf5, 0xeb, 0x8f,
+	0xb3, 0xa3, 0xb2, 0xf6, 0x92, 0xf8, 0x72, 0x8b, 0x39, 0x4d, 0x88, 0x61, 0x3f, 0x45, 0xc6, 0xd5,
+	0xc7, 0x08, 0xb2, 0xbd, 0x4b, 0x4a, 0x85, 0x77, 0xe1, 0x84, 0x23, 0x42, 0x52, 0xe2, 0xfb, 0x91,
+	0x12, 0x23, 0x20, 0xa5, 0x6e, 0x0f, 0x4e, 0xad, 0x40, 0x26, 0xcc, 0x62, 0x99, 0xd6, 0x6a, 0x96,
+	0xeb, 0x5a, 0xd4, 0x7e, 0xc5, 0x82, 0x9f, 0x20, 0x98, 0xec, 0x5e, 0x4a, 0x0a, 0xd5, 0x01, 0xca,
+	0x7e, 0x54, 0x6a, 0xbd, 0xd4, 0x9f, 0xd6, 0xa5, 0x72, 0xb9, 0x51, 0x6b, 0x54, 0x75, 0x46, 0x8c,
+	0x16, 0xb0, 0x94, 0x1b, 0x00, 0x55, 0x9f, 0x24, 0x61, 0x22, 0xcc, 0xe3, 0x56, 0x55, 0x77, 0x2b,
+	0xe4, 0x15, 0x6f, 0x30, 0x9e, 0x86, 0xd3, 0x2e, 0xd3, 0x1d, 0x66, 0xd9, 0x66, 0xb1, 0x42, 0x2c,
+	0xb3, 0xc2, 0x52, 0xc9, 0x49, 0x94, 0x1d, 0x28, 0xbc, 0xe1, 0x85, 0xaf, 0xf0, 0x28, 0x9e, 0x82,
+	0x53, 0xc4, 0x36, 0x02, 0xcb, 0x8e, 0xf1, 0x65, 0xaf, 0x8b, 0xa0, 0x5c, 0xb4, 0x06, 0xd0, 0x9a,
+	0xe1, 0xd4, 0x00, 0x6f, 0xcc, 0x3b, 0x5e, 0x63, 0x9a, 0x03, 0x99, 0x13, 0xd7, 0x44, 0xeb, 0x94,
+	0x9b, 0x44, 0x0a, 0x2a, 0x04, 0x32, 0x2f, 0xbe, 0xf6, 0xf5, 0x4e, 0x26, 0xf1, 0x74, 0x27, 0x83,
+	0xd4, 0x5f, 0x10, 0xbc, 0xd5, 0xa5, 0x0f, 0x72, 0x33, 0xd6, 0xe1, 0x84, 0x2b, 0x42, 0x29, 0x34,
+	0x79, 0x2c, 0x3b, 0xb4, 0x30, 0xd7, 0xdf, 0x4e, 0x70, 0x9c, 0xd5, 0x4d, 0x62, 0x33, 0xef, 0xb4,
+	0x49, 0x18, 0xfc, 0x49, 0x48, 0x45, 0x92, 0xab, 0x98, 0xee, 0xa9, 0x42, 0xd0, 0x09, 0xca, 0x50,
+	0x7f, 0xf6, 0xc8, 0xaf, 0x90, 0x2a, 0x31, 0x79, 0xac, 0x7d, 0x4c, 0x0d, 0xf1, 0x2d, 0xce, 0x2e,
+	0xfa, 0x29, 0xde, 0x2e, 0x76, 0x3c, 0x0c, 0xc9, 0xb8, 0x87, 0x41, 0xb4, 0xfd, 0xc5, 0x4e, 0x26,
+	0xa1, 0x7e, 0x83, 0x20, 0xdd, 0x8d, 0xb9, 0xec, 0xfb, 0xbd, 0xe0, 0xb4, 0x37, 0xfb, 0x3e, 0x11,
+	0x6a, 0x91, 0xd7, 0x9c, 0x15, 0x52, 0x5e, 0xa6, 0x96, 0x9d, 0x5f, 0x6c, 0xf6, 0xf8, 0x87, 0xbf,
+	0x32, 0x33, 0xa6, 0xc5, 0x2a, 0x8d, 0x52, 0xae, 0x4c, 0x6b, 0xf2, 0x32, 0x95, 0xff, 0xcc, 0xba,
+	0xc6, 0x3d, 0x8d, 0x6d, 0xd7, 0x89, 0xeb, 0xe5, 0xb8, 0xad, 0x0b, 0xa0, 0x01, 0xea, 0x3e, 0x3a,
+	0xb7, 0x29, 0xd3, 0xab, 0x47, 0xd2, 0xcd, 0x40, 0x1b, 0xfe, 0x45, 0x30, 0x15, 0x59, 0x57, 0xf6,
+	0xe2, 0xce, 0xfe, 0x5e, 0x5c, 0x88, 0x3c, 0x83, 0x2d, 0xb4, 0x15, 0xaf, 0xb6, 0x40, 0xdc, 0x77,
+	0xef, 0x61, 0x13, 0x8e, 0xb3, 0x66, 0xbd, 0x54, 0xf2, 0xa8, 0x3a, 0x2c, 0xf0, 0x55, 0x47, 0x5e,
+	0xb0, 0x3e, 0x1f, 0x7f, 0x4c, 0x8e, 0xae, 0xb9, 0xd7, 0x60, 0xb2, 0x7b, 0x4d, 0xd9, 0xd8, 0x34,
+	0x80, 0x7f, 0x4a, 0x45, 0x6f, 0x4f, 0x16, 0x02, 0x91, 0x00, 0xda, 0x16, 0xbc, 0x1d, 0x46, 0xdb,
+	0xb0, 0x58, 0xc5, 0x70, 0xf4, 0x2d, 0x59, 0xf8, 0xc8, 0x64, 0x6c, 0xc2, 0xb9, 0x1e, 0x85, 0xa5,
+	0x96, 0x65, 0x18, 0xde, 0x92, 0x9f, 0xfa, 0x2e, 0x7c, 0x7a, 0x2b, 0x0c, 0x16, 0xa8, 0x3b, 0x0e,
+	0x63, 0xbc, 0x6e, 0xf3, 0x19, 0x69, 0xd8, 0x16, 0xdb, 0x5e, 0xa7, 0xb4, 0xea, 0x79, 0x90, 0x47,
+	0x08, 0x94, 0x4e, 0x5f, 0x25, 0x15, 0x02, 0x03, 0x75, 0x4a, 0xab, 0x47, 0x37, 0xb8, 0x1c, 0x5e,
+	0x4d, 0xc1, 0x59, 0x4e, 0x22, 0xdf, 0x70, 0x6c, 0x62, 0xac, 0x11, 0xff, 0xf5, 0x52, 0xbf, 0x42,
+	0xf0, 0x66, 0xdb, 0x27, 0x49, 0xae, 0x0a, 0x43, 0x25, 0x1e, 0x2d, 0x7e, 0x4e, 0xfc, 0x4b, 0x7d,
+	0xac, 0x23, 0x47, 0x4e, 0x70, 0x4e, 0x12, 0xcc, 0xf6, 0x41, 0x50, 0xb0, 0x83, 0x92, 0x5f, 0x75,
+	0xe1, 0xf1, 0x08, 0x1c, 0xe7, 0x4c, 0xf0, 0x53, 0x04, 0x83, 0xc2, 0x76, 0x61, 0x2d, 0x72, 0x7c,
+	0xdb, 0x3d, 0x9f, 0x32, 0xd7, 0x7f, 0x82, 0x50, 0xa9, 0xce, 0x7c, 0xf9, 0xdb, 0x3f, 0xdf, 0x25,
+	0xcf, 0xe1, 0x29, 0x2d, 0xca, 0x8f, 0x0a, 0xe3, 0x87, 0x1f, 0x25, 0x61, 0x3c, 0xc2, 0x2e, 0xe1,
+	0x95, 0xde, 0xe5, 0x7b, 0x7b, 0x46, 0x65, 0xf5, 0x90, 0x28, 0x52, 0xd9, 0x06, 0x57, 0x76, 0x13,
+	0xdf, 0x88, 0x54, 0xd6, 0x1a, 0x62, 0xed, 0x41, 0xdb, 0xdb, 0xf5, 0x50, 0xa3, 0x2d, 0xfc, 0xa2,
+	0x77, 0x1b, 0xee, 0x22, 0x38, 0xd3, 0xc1, 0x96, 0xe1, 0x0f, 0x63, 0xf0, 0x6e, 0x33, 0x8e, 0xca,
+	0xe5, 0x03, 0x66, 0x4b, 0xb5, 0xd7, 0xb9, 0xda, 0x2b, 0x78, 0xed, 0x30, 0x6a, 0x5b, 0xc6, 0x0f,
+	0xff, 0x8e, 0x60, 0x78, 0xbf, 0xd7, 0xc1, 0x1f, 0xc4, 0xe0, 0x18, 0xf6, 0x89, 0xca, 0xc5, 0x83,
+	0xa4, 0x4a, 0x6d, 0x57, 0xb9, 0xb6, 0x55, 0xbc, 0x7c, 0x18, 0x6d, 0x9e, 0xab, 0xfa, 0x0f, 0xc1,
+	0x48, 0x9b, 0x9b, 0xc0, 0x7d, 0xd0, 0xeb, 0x66, 0x9e, 0x94, 0x4b, 0x07, 0xca, 0x95, 0xda, 0x8a,
+	0x5c, 0xdb, 0xa7, 0x78, 0x23, 0x52, 0x9b, 0x7f, 0xef, 0xbb, 0xda, 0x83, 0xb6, 0x67, 0xe3, 0xa1,
+	0x26, 0x4f, 0x66, 0x27, 0xdd, 0xf8, 0x05, 0x82, 0xb3, 0x9d, 0x6d, 0x03, 0xfe, 0x28, 0x0e, 0xf1,
+	0x0e, 0x46, 0x47, 0xf9, 0xf8, 0xe0, 0x00, 0xb1, 0xb6, 0xb6, 0x3f, 0xf9, 0x7c, 0x30, 0x3b, 0xbc,
+	0xe2, 0xfd, 0x0c, 0x66, 0x77, 0xc3, 0xa1, 0x5c, 0x3e, 0x60, 0x76, 0xac, 0xc1, 0xec, 0xa1, 0xb0,
+	0x75, 0xb6, 0xf1, 0xff, 0x08, 0x52, 0xdd, 0xde, 0x78, 0xbc, 0x14, 0x83, 0x6b, 0x67, 0x63, 0xa2,
+	0xe4, 0x0f, 0x03, 0x21, 0x35, 0xdf, 0xe6, 0x9a, 0xaf, 0xe3, 0x6b, 0x87, 0xd1, 0xbc, 0xdf, 0xa4,
+	0xe0, 0x9f, 0x10, 0x9c, 0x0a, 0xf9, 0x08, 0x7c, 0xa1, 0x37, 0xd7, 0x4e, 0xb6, 0x44, 0x79, 0x2f,
+	0x76, 0x9e, 0x14, 0xb6, 0xc8, 0x85, 0xcd, 0xe2, 0x99, 0x48, 0x61, 0x65, 0x2f, 0xb7, 0xd8, 0xb4,
+	0x1f, 0xf8, 0x7b, 0x04, 0xd0, 0xf2, 0x17, 0x78, 0xb1, 0x77, 0xf1, 0x36, 0xa3, 0xa2, 0xbc, 0x1b,
+	0x2f, 0x49, 0xd2, 0x9d, 0xe3, 0x74, 0xcf, 0xe3, 0x6c, 0x24, 0xdd, 0x80, 0xcb, 0xc9, 0x5f, 0x7d,
+	0xb6, 0x9b, 0x46, 0xcf, 0x77, 0xd3, 0xe8, 0xef, 0xdd, 0x34, 0xfa, 0x76, 0x2f, 0x9d, 0x78, 0xbe,
+	0x97, 0x4e, 0xfc, 0xb1, 0x97, 0x4e, 0x7c, 0x36, 0x1f, 0x69, 0x6b, 0xbe, 0x08, 0x43, 0x73, 0x97,
+	0x53, 0x1a, 0xe4, 0x7f, 0x9e, 0x5a, 0x7c, 0x39, 0x00, 0xec, 0x45, 0x2d, 0x46, 0xb1, 0x13, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1066,10 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	//
+	// Since: cosmos-sdk 0.47
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -1061,6 +1161,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1082,6 +1191,10 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	//
+	// Since: cosmos-sdk 0.47
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1115,6 +1228,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1282,6 +1398,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1322,6 +1456,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1947,6 +2085,66 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2212,6 +2410,30 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3817,6 +4039,140 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
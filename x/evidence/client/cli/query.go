@@ -55,12 +55,7 @@ func QueryEvidenceCmd() func(*cobra.Command, []string) error {
 			return queryEvidence(clientCtx, args[0])
 		}
 
-		pageReq, err := client.ReadPageRequest(cmd.Flags())
-		if err != nil {
-			return err
-		}
-
-		return queryAllEvidence(clientCtx, pageReq)
+		return queryAllEvidence(cmd, clientCtx)
 	}
 }
 
@@ -81,17 +76,14 @@ func queryEvidence(clientCtx client.Context, hash string) error {
 	return clientCtx.PrintProto(res.Evidence)
 }
 
-func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest) error {
+func queryAllEvidence(cmd *cobra.Command, clientCtx client.Context) error {
 	queryClient := types.NewQueryClient(clientCtx)
 
-	params := &types.QueryAllEvidenceRequest{
-		Pagination: pageReq,
-	}
-
-	res, err := queryClient.AllEvidence(context.Background(), params)
-	if err != nil {
-		return err
-	}
+	return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+		params := &types.QueryAllEvidenceRequest{
+			Pagination: pageReq,
+		}
 
-	return clientCtx.PrintProto(res)
+		return queryClient.AllEvidence(ctx, params)
+	})
 }
@@ -30,6 +30,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 
 		k.SetEvidence(ctx, evi)
 	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, b := range gs.Bounties {
+		k.SetBounty(ctx, b)
+	}
 }
 
 // ExportGenesis returns the evidence module's exported genesis.
@@ -49,5 +55,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	return &types.GenesisState{
 		Evidence: evidence,
+		Params:   k.GetParams(ctx),
+		Bounties: k.GetAllBounties(ctx),
 	}
 }
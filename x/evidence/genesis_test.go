@@ -57,7 +57,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence, nil)
 			},
 			true,
 			func() {
@@ -78,7 +78,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence, nil)
 			},
 			false,
 			func() {
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// SlashWithBounty slashes the validator with the given consensus address for
+// an infraction committed at infractionHeight, as a result of evidence submitted
+// through MsgSubmitEvidence. The BountyFraction of the tokens slashed from the
+// validator is paid to the submitter instead of being burned.
+//
+// An infraction can only be slashed once through SlashWithBounty: an error is
+// returned if the infraction of the validator at infractionHeight was already
+// submitted. It is meant to be called by evidence Handlers, with the submitter
+// they are given.
+func (k Keeper) SlashWithBounty(
+	ctx sdk.Context, submitter sdk.AccAddress, consAddr sdk.ConsAddress,
+	fraction sdk.Dec, power, infractionHeight int64,
+) error {
+	if submitter.Empty() {
+		return sdkerrors.ErrInvalidAddress.Wrap("empty submitter address")
+	}
+	if _, found := k.GetBounty(ctx, consAddr, infractionHeight); found {
+		return sdkerrors.Wrapf(types.ErrInfractionSubmitted, "validator %s at height %d", consAddr, infractionHeight)
+	}
+
+	// See HandleEquivocationEvidence for the distribution height.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	_, bounty := k.slashingKeeper.SlashWithBounty(
+		ctx, consAddr, fraction, power, distributionHeight, submitter, k.GetBountyFraction(ctx),
+	)
+
+	amount := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bounty)
+	k.SetBounty(ctx, types.NewBounty(submitter, consAddr, infractionHeight, amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBounty,
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+			sdk.NewAttribute(types.AttributeKeyConsensusAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, strconv.FormatInt(infractionHeight, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetBounty sets a Bounty in the module's KVStore.
+func (k Keeper) SetBounty(ctx sdk.Context, bounty types.Bounty) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BountyKey(bounty.GetConsensusAddress(), bounty.InfractionHeight), k.cdc.MustMarshal(&bounty))
+}
+
+// GetBounty retrieves the Bounty paid for the infraction of the given validator
+// at the given height, if it exists.
+func (k Keeper) GetBounty(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64) (types.Bounty, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.BountyKey(consAddr, infractionHeight))
+	if bz == nil {
+		return types.Bounty{}, false
+	}
+
+	var bounty types.Bounty
+	k.cdc.MustUnmarshal(bz, &bounty)
+	return bounty, true
+}
+
+// IterateBounties iterates over all the stored Bounty objects. If cb returns
+// true, the iteration stops.
+func (k Keeper) IterateBounties(ctx sdk.Context, cb func(types.Bounty) bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixBounty)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bounty types.Bounty
+		k.cdc.MustUnmarshal(iterator.Value(), &bounty)
+
+		if cb(bounty) {
+			break
+		}
+	}
+}
+
+// GetAllBounties returns all the stored Bounty objects.
+func (k Keeper) GetAllBounties(ctx sdk.Context) (bounties []types.Bounty) {
+	k.IterateBounties(ctx, func(b types.Bounty) bool {
+		bounties = append(bounties, b)
+		return false
+	})
+
+	return bounties
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// testBountyHandler slashes the validator of an Equivocation through
+// SlashWithBounty.
+func testBountyHandler(k keeper.Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence, submitter sdk.AccAddress) error {
+		if err := e.ValidateBasic(); err != nil {
+			return err
+		}
+
+		ee := e.(*types.Equivocation)
+		return k.SlashWithBounty(
+			ctx, submitter, ee.GetConsensusAddress(),
+			sdk.NewDecWithPrec(5, 2), ee.GetValidatorPower(), ee.GetHeight(),
+		)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitEvidenceWithBounty() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(2)
+	suite.populateValidators(ctx)
+
+	evidenceKeeper := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		suite.app.StakingKeeper, suite.app.SlashingKeeper,
+	)
+	evidenceKeeper.SetRouter(types.NewRouter().AddRoute(types.RouteEquivocation, testBountyHandler(*evidenceKeeper)))
+	msgServer := keeper.NewMsgServerImpl(*evidenceKeeper)
+
+	// pay half of the slashed tokens to the submitter
+	evidenceKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(5, 1)))
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	oldSupply := suite.app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	submitter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	consAddr := sdk.ConsAddress(val.Address())
+	e := &types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(1, 0),
+		Power:            power,
+		ConsensusAddress: consAddr.String(),
+	}
+	msg, err := types.NewMsgSubmitEvidence(submitter, e)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SubmitEvidence(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	// 5% of the validator tokens are slashed, half of which is paid to the submitter
+	slashed := oldTokens.Sub(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens())
+	suite.Require().Equal(suite.app.StakingKeeper.TokensFromConsensusPower(ctx, 5), slashed)
+
+	bounty := sdk.NewCoin(bondDenom, slashed.QuoRaw(2))
+	suite.Require().Equal(bounty, suite.app.BankKeeper.GetBalance(ctx, submitter, bondDenom))
+	suite.Require().Equal(oldSupply.Sub(bounty), suite.app.BankKeeper.GetSupply(ctx, bondDenom))
+
+	stored, found := evidenceKeeper.GetBounty(ctx, consAddr, e.Height)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewBounty(submitter, consAddr, e.Height, bounty), stored)
+	suite.Require().Len(evidenceKeeper.GetAllBounties(ctx), 1)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBounty {
+			emitted = true
+		}
+	}
+	suite.Require().True(emitted)
+
+	// a different evidence of the same infraction is rejected
+	e2 := &types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(2, 0),
+		Power:            power,
+		ConsensusAddress: consAddr.String(),
+	}
+	msg, err = types.NewMsgSubmitEvidence(submitter, e2)
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SubmitEvidence(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidEvidence)
+	suite.Require().Contains(err.Error(), types.ErrInfractionSubmitted.Error())
+	suite.Require().Equal(bounty, suite.app.BankKeeper.GetBalance(ctx, submitter, bondDenom))
+}
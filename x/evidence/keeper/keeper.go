@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper defines the evidence module's keeper. The keeper is responsible for
@@ -21,18 +22,25 @@ import (
 type Keeper struct {
 	cdc            codec.BinaryCodec
 	storeKey       storetypes.StoreKey
+	paramSpace     paramtypes.Subspace
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
 }
 
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
+		paramSpace:     paramSpace,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
 	}
@@ -72,10 +80,10 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
-// the corresponding registered Evidence Handler. An error is returned if no
-// registered Handler exists or if the Handler fails. Otherwise, the evidence is
-// persisted.
-func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) error {
+// the corresponding registered Evidence Handler with the evidence submitter. An
+// error is returned if no registered Handler exists or if the Handler fails.
+// Otherwise, the evidence is persisted.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence, submitter sdk.AccAddress) error {
 	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
 		return sdkerrors.Wrap(types.ErrEvidenceExists, evidence.Hash().String())
 	}
@@ -84,7 +92,7 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	}
 
 	handler := k.router.GetRoute(evidence.Route())
-	if err := handler(ctx, evidence); err != nil {
+	if err := handler(ctx, evidence, submitter); err != nil {
 		return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
 	}
 
@@ -51,7 +51,7 @@ func newPubKey(pk string) (res cryptotypes.PubKey) {
 }
 
 func testEquivocationHandler(_ interface{}) types.Handler {
-	return func(ctx sdk.Context, e exported.Evidence) error {
+	return func(ctx sdk.Context, e exported.Evidence, _ sdk.AccAddress) error {
 		if err := e.ValidateBasic(); err != nil {
 			return err
 		}
@@ -84,7 +84,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	// recreate keeper in order to use custom testing types
 	evidenceKeeper := keeper.NewKeeper(
-		app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper,
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.StakingKeeper, app.SlashingKeeper,
 	)
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
@@ -119,7 +119,7 @@ func (suite *KeeperTestSuite) populateEvidence(ctx sdk.Context, numEvidence int)
 			ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
 		}
 
-		suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence[i]))
+		suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence[i], nil))
 	}
 
 	return evidence
@@ -147,7 +147,7 @@ func (suite *KeeperTestSuite) TestSubmitValidEvidence() {
 		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
 	}
 
-	suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e))
+	suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e, nil))
 
 	res, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, e.Hash())
 	suite.True(ok)
@@ -165,8 +165,8 @@ func (suite *KeeperTestSuite) TestSubmitValidEvidence_Duplicate() {
 		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
 	}
 
-	suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e))
-	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e))
+	suite.Nil(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e, nil))
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e, nil))
 
 	res, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, e.Hash())
 	suite.True(ok)
@@ -183,7 +183,7 @@ func (suite *KeeperTestSuite) TestSubmitInvalidEvidence() {
 		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
 	}
 
-	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e))
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, e, nil))
 
 	res, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, e.Hash())
 	suite.False(ok)
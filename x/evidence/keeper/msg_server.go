@@ -24,7 +24,7 @@ func (ms msgServer) SubmitEvidence(goCtx context.Context, msg *types.MsgSubmitEv
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	evidence := msg.GetEvidence()
-	if err := ms.Keeper.SubmitEvidence(ctx, evidence); err != nil {
+	if err := ms.Keeper.SubmitEvidence(ctx, evidence, msg.GetSubmitter()); err != nil {
 		return nil, err
 	}
 
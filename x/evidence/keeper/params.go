@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// GetParams returns the total set of evidence parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the evidence parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBountyFraction returns the fraction of the slashed tokens paid to the
+// evidence submitters.
+func (k Keeper) GetBountyFraction(ctx sdk.Context) (fraction sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyBountyFraction, &fraction)
+	return fraction
+}
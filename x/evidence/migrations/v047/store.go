@@ -0,0 +1,23 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Setting the evidence params in the paramstore, which do not pay any bounty
+// to the evidence submitters.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	return nil
+}
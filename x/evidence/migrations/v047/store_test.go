@@ -0,0 +1,30 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/evidence/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	evidenceKey := sdk.NewKVStoreKey("evidence")
+	tEvidenceKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(evidenceKey, tEvidenceKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, evidenceKey, tEvidenceKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.KeyBountyFraction))
+
+	require.NoError(t, v047.MigrateStore(ctx, paramstore))
+
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
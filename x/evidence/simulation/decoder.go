@@ -30,6 +30,16 @@ func NewDecodeStore(cdc EvidenceUnmarshaler) func(kvA, kvB kv.Pair) string {
 			}
 
 			return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixBounty):
+			var bountyA, bountyB types.Bounty
+			if err := bountyA.Unmarshal(kvA.Value); err != nil {
+				panic(fmt.Sprintf("cannot unmarshal bounty: %s", err.Error()))
+			}
+			if err := bountyB.Unmarshal(kvB.Value); err != nil {
+				panic(fmt.Sprintf("cannot unmarshal bounty: %s", err.Error()))
+			}
+
+			return fmt.Sprintf("%v\n%v", bountyA, bountyB)
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -14,13 +15,21 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence       = "evidence"
+	BountyFraction = "bounty_fraction"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenBountyFraction returns a randomized BountyFraction parameter.
+func GenBountyFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
@@ -30,7 +39,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var bountyFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BountyFraction, &bountyFraction, simState.Rand,
+		func(r *rand.Rand) { bountyFraction = GenBountyFraction(r) },
+	)
+
+	evidenceGenesis := types.NewGenesisState(types.NewParams(bountyFraction), ev, []types.Bounty{})
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
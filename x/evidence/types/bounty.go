@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBounty creates a new Bounty object.
+func NewBounty(submitter sdk.AccAddress, consAddr sdk.ConsAddress, infractionHeight int64, amount sdk.Coin) Bounty {
+	return Bounty{
+		Submitter:        submitter.String(),
+		ConsensusAddress: consAddr.String(),
+		InfractionHeight: infractionHeight,
+		Amount:           amount,
+	}
+}
+
+// GetConsensusAddress returns the consensus address of the slashed validator.
+func (b Bounty) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(b.ConsensusAddress)
+	return addr
+}
+
+// Validate performs basic validation of a Bounty.
+func (b Bounty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Submitter); err != nil {
+		return fmt.Errorf("invalid submitter address: %w", err)
+	}
+	if _, err := sdk.ConsAddressFromBech32(b.ConsensusAddress); err != nil {
+		return fmt.Errorf("invalid consensus address: %w", err)
+	}
+	if b.InfractionHeight < 1 {
+		return fmt.Errorf("invalid infraction height: %d", b.InfractionHeight)
+	}
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid bounty amount: %w", err)
+	}
+
+	return nil
+}
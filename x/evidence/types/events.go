@@ -3,7 +3,11 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeBounty         = "evidence_bounty"
 
-	AttributeValueCategory   = "evidence"
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeValueCategory       = "evidence"
+	AttributeKeyEvidenceHash     = "evidence_hash"
+	AttributeKeySubmitter        = "submitter"
+	AttributeKeyConsensusAddress = "consensus_address"
+	AttributeKeyInfractionHeight = "infraction_height"
 )
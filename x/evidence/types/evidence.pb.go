@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -69,8 +71,102 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the parameters for the x/evidence module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// bounty_fraction is the fraction of the tokens slashed as a result of
+	// evidence submitted through MsgSubmitEvidence which is paid to the submitter
+	// instead of being burned.
+	BountyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=bounty_fraction,json=bountyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bounty_fraction"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// Bounty records the slashing of a validator for an infraction as a result of
+// evidence submitted through MsgSubmitEvidence, and the bounty paid to its
+// submitter. An infraction can only be slashed once through MsgSubmitEvidence.
+//
+// Since: cosmos-sdk 0.47
+type Bounty struct {
+	// submitter is the address of the evidence submitter.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// consensus_address is the consensus address of the slashed validator.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// infraction_height is the height of the infraction.
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// amount is the bounty paid to the submitter.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *Bounty) Reset()         { *m = Bounty{} }
+func (m *Bounty) String() string { return proto.CompactTextString(m) }
+func (*Bounty) ProtoMessage()    {}
+func (*Bounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *Bounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounty.Merge(m, src)
+}
+func (m *Bounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounty proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
+	proto.RegisterType((*Bounty)(nil), "cosmos.evidence.v1beta1.Bounty")
 }
 
 func init() {
@@ -78,30 +174,97 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0xb5, 0xff, 0xf6, 0xaf, 0x20, 0x74, 0x80, 0xa8, 0x82, 0xd0, 0xc1, 0xa9, 0x18, 0x50, 0x97,
-	0x26, 0x2a, 0x2c, 0x88, 0x8d, 0x4a, 0x9d, 0xd8, 0x0a, 0x13, 0x4b, 0x95, 0x8b, 0x71, 0x2d, 0x48,
-	0xbe, 0x10, 0x3b, 0x05, 0xde, 0x80, 0xb1, 0x23, 0x63, 0x47, 0x1e, 0x80, 0x87, 0xa8, 0xc4, 0x52,
-	0x31, 0x31, 0x01, 0x4a, 0x17, 0x1e, 0x03, 0xd5, 0x76, 0xcb, 0x64, 0x9f, 0xe3, 0x73, 0xb1, 0xfd,
-	0x59, 0x87, 0x11, 0x88, 0x04, 0x84, 0x4f, 0xc7, 0x3c, 0xa6, 0x69, 0x44, 0xfd, 0x71, 0x37, 0xa4,
-	0x32, 0xe8, 0xae, 0x09, 0x2f, 0xcb, 0x41, 0x82, 0xbd, 0xa7, 0x75, 0xde, 0x9a, 0x36, 0xba, 0x66,
-	0x83, 0x01, 0x03, 0xa5, 0xf1, 0x97, 0x3b, 0x2d, 0x6f, 0xba, 0x0c, 0x80, 0xdd, 0x52, 0x5f, 0xa1,
-	0xb0, 0xb8, 0xf6, 0x25, 0x4f, 0xa8, 0x90, 0x41, 0x92, 0x19, 0xc1, 0xbe, 0xce, 0x1b, 0x6a, 0xa7,
-	0x09, 0x57, 0xe0, 0xe0, 0x0d, 0x5b, 0xf5, 0xfe, 0x5d, 0xc1, 0xc7, 0x10, 0x05, 0x92, 0x43, 0x6a,
-	0xef, 0x5a, 0xb5, 0x11, 0xe5, 0x6c, 0x24, 0x1d, 0xdc, 0xc2, 0xed, 0xca, 0xc0, 0x20, 0xfb, 0xc4,
-	0xaa, 0x2e, 0x63, 0x9d, 0x7f, 0x2d, 0xdc, 0xde, 0x3a, 0x6a, 0x7a, 0xba, 0xd3, 0x5b, 0x75, 0x7a,
-	0x97, 0xab, 0xce, 0xde, 0xc6, 0xec, 0xd3, 0x45, 0x93, 0x2f, 0x17, 0x0f, 0x94, 0xc3, 0x6e, 0x58,
-	0xff, 0x33, 0xb8, 0xa7, 0xb9, 0x53, 0x51, 0x81, 0x1a, 0xd8, 0x7d, 0x6b, 0x27, 0x82, 0x54, 0xd0,
-	0x54, 0x14, 0x62, 0x18, 0xc4, 0x71, 0x4e, 0x85, 0x70, 0xaa, 0x2d, 0xdc, 0xde, 0xec, 0x39, 0xef,
-	0xaf, 0x9d, 0x86, 0xb9, 0xe5, 0x99, 0x3e, 0xb9, 0x90, 0x39, 0x4f, 0xd9, 0x60, 0x7b, 0x6d, 0x31,
-	0xfc, 0x69, 0xfd, 0x69, 0xea, 0xa2, 0xe7, 0xa9, 0x8b, 0x7e, 0xa6, 0x2e, 0xea, 0x9d, 0xbf, 0x94,
-	0x04, 0xcf, 0x4a, 0x82, 0xe7, 0x25, 0xc1, 0xdf, 0x25, 0xc1, 0x93, 0x05, 0x41, 0xf3, 0x05, 0x41,
-	0x1f, 0x0b, 0x82, 0xae, 0x3a, 0x8c, 0xcb, 0x51, 0x11, 0x7a, 0x11, 0x24, 0xe6, 0x13, 0xcc, 0xd2,
-	0x11, 0xf1, 0x8d, 0xff, 0xf0, 0x37, 0x16, 0xf9, 0x98, 0x51, 0x11, 0xd6, 0xd4, 0xdb, 0x8e, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x32, 0xf0, 0x2c, 0xb6, 0x01, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xb5, 0xc1, 0x22, 0xd7, 0x0a, 0x5a, 0x2b, 0x02, 0x37, 0x83, 0x1d, 0x65, 0xa8, 0x22,
+	0xa1, 0xd8, 0x6a, 0x91, 0x00, 0x55, 0x2c, 0x98, 0x16, 0x21, 0xb1, 0x20, 0xc3, 0xc4, 0x12, 0x9d,
+	0x2f, 0x57, 0xe7, 0x04, 0xbe, 0x17, 0x7c, 0xe7, 0x40, 0xff, 0x01, 0x63, 0x47, 0xc6, 0x8c, 0xfc,
+	0x80, 0xfe, 0x88, 0x4a, 0x2c, 0x55, 0x27, 0xc4, 0x50, 0x50, 0xb2, 0xb0, 0xf3, 0x07, 0x90, 0xef,
+	0x2e, 0xc9, 0x82, 0x40, 0x9d, 0xec, 0xf7, 0xde, 0xf7, 0xde, 0x7d, 0xdf, 0x77, 0xef, 0xf0, 0x2e,
+	0x05, 0x59, 0x80, 0x8c, 0xd9, 0x84, 0x0f, 0x99, 0xa0, 0x2c, 0x9e, 0xec, 0x65, 0x4c, 0x91, 0xbd,
+	0x65, 0x22, 0x1a, 0x97, 0xa0, 0xc0, 0xbb, 0x6b, 0x70, 0xd1, 0x32, 0x6d, 0x71, 0xed, 0x56, 0x0e,
+	0x39, 0x68, 0x4c, 0x5c, 0xff, 0x19, 0x78, 0x3b, 0xcc, 0x01, 0xf2, 0x77, 0x2c, 0xd6, 0x51, 0x56,
+	0x1d, 0xc7, 0x8a, 0x17, 0x4c, 0x2a, 0x52, 0x8c, 0x2d, 0x60, 0xc7, 0xcc, 0x1b, 0x98, 0x4e, 0x3b,
+	0xdc, 0x94, 0x02, 0x4b, 0x29, 0x23, 0x72, 0x45, 0x87, 0x02, 0x17, 0xa6, 0xde, 0xfd, 0x8a, 0xf0,
+	0xe6, 0xd1, 0xfb, 0x8a, 0x4f, 0x80, 0x12, 0xc5, 0x41, 0x78, 0x77, 0xb0, 0x3b, 0x62, 0x3c, 0x1f,
+	0x29, 0x1f, 0x75, 0x50, 0x6f, 0x3d, 0xb5, 0x91, 0xf7, 0x08, 0x37, 0xea, 0x63, 0xfd, 0xb5, 0x0e,
+	0xea, 0x6d, 0xec, 0xb7, 0x23, 0xc3, 0x29, 0x5a, 0x70, 0x8a, 0x5e, 0x2f, 0x38, 0x25, 0x37, 0xcf,
+	0xaf, 0x42, 0xe7, 0xf4, 0x47, 0x88, 0x52, 0xdd, 0xe1, 0xb5, 0xf0, 0x8d, 0x31, 0x7c, 0x60, 0xa5,
+	0xbf, 0xae, 0x07, 0x9a, 0xc0, 0x3b, 0xc2, 0xdb, 0x14, 0x84, 0x64, 0x42, 0x56, 0x72, 0x40, 0x86,
+	0xc3, 0x92, 0x49, 0xe9, 0x37, 0x3a, 0xa8, 0xd7, 0x4c, 0xfc, 0xcb, 0xb3, 0x7e, 0xcb, 0xaa, 0x78,
+	0x62, 0x2a, 0xaf, 0x54, 0xc9, 0x45, 0x9e, 0x6e, 0x2d, 0x5b, 0x6c, 0xfe, 0x60, 0xf3, 0xd3, 0x34,
+	0x74, 0x3e, 0x4f, 0x43, 0xe7, 0xd7, 0x34, 0x74, 0xba, 0x80, 0xdd, 0x97, 0xa4, 0x24, 0x85, 0xf4,
+	0x18, 0xbe, 0x9d, 0x41, 0x25, 0xd4, 0xc9, 0xe0, 0xb8, 0x24, 0xb4, 0x56, 0xa6, 0xf5, 0x34, 0x93,
+	0xc7, 0x35, 0xbb, 0xef, 0x57, 0xe1, 0x6e, 0xce, 0xd5, 0xa8, 0xca, 0x22, 0x0a, 0x85, 0x75, 0xcc,
+	0x7e, 0xfa, 0x72, 0xf8, 0x36, 0x56, 0x27, 0x63, 0x26, 0xa3, 0x43, 0x46, 0x2f, 0xcf, 0xfa, 0xd8,
+	0x52, 0x39, 0x64, 0x34, 0xbd, 0x65, 0x86, 0x3e, 0xb3, 0x33, 0xbb, 0xbf, 0x11, 0x76, 0x13, 0x9d,
+	0xf2, 0x1e, 0xe0, 0xa6, 0xac, 0xb2, 0x82, 0x2b, 0xc5, 0x4a, 0x1f, 0xfd, 0x47, 0xc8, 0x0a, 0xfa,
+	0x77, 0x23, 0xd6, 0xae, 0x6b, 0x84, 0x77, 0x0f, 0x6f, 0x73, 0xb1, 0xd0, 0x3a, 0xb0, 0x57, 0x68,
+	0x1c, 0xdf, 0x5a, 0x15, 0x9e, 0x9b, 0xcb, 0x7c, 0x88, 0x5d, 0x52, 0xd4, 0xb4, 0xb5, 0xe3, 0x1b,
+	0xfb, 0x3b, 0x91, 0x3d, 0xa5, 0x5e, 0x93, 0xc5, 0x36, 0x46, 0x4f, 0x81, 0x8b, 0xa4, 0x51, 0xfb,
+	0x95, 0x5a, 0xf8, 0x41, 0xa3, 0xb6, 0x3b, 0x79, 0xf1, 0x65, 0x16, 0xa0, 0xf3, 0x59, 0x80, 0x2e,
+	0x66, 0x01, 0xfa, 0x39, 0x0b, 0xd0, 0xe9, 0x3c, 0x70, 0x2e, 0xe6, 0x81, 0xf3, 0x6d, 0x1e, 0x38,
+	0x6f, 0xfa, 0xff, 0x74, 0xf6, 0xe3, 0xea, 0x75, 0x68, 0x93, 0x33, 0x57, 0xaf, 0xd0, 0xfd, 0x3f,
+	0x03, 0x00, 0xda, 0x8c, 0xdc, 0x2d, 0x3d, 0x03, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BountyFraction.Equal(that1.BountyFraction) {
+		return false
+	}
+	return true
+}
+func (this *Bounty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Bounty)
+	if !ok {
+		that2, ok := that.(Bounty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submitter != that1.Submitter {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -150,6 +313,91 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BountyFraction.Size()
+		i -= size
+		if _, err := m.BountyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Bounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InfractionHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -182,6 +430,39 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BountyFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *Bounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.InfractionHeight))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -341,6 +622,256 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BountyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bounty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bounty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bounty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -13,6 +15,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		BondDenom(sdk.Context) string
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -22,7 +25,8 @@ type (
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
 		Tombstone(sdk.Context, sdk.ConsAddress)
-		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
+		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64) math.Int
+		SlashWithBounty(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64, sdk.AccAddress, sdk.Dec) (math.Int, math.Int)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
@@ -12,7 +12,7 @@ import (
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates a new genesis state for the evidence module.
-func NewGenesisState(e []exported.Evidence) *GenesisState {
+func NewGenesisState(params Params, e []exported.Evidence, bounties []Bounty) *GenesisState {
 	evidence := make([]*types.Any, len(e))
 	for i, evi := range e {
 		msg, ok := evi.(proto.Message)
@@ -27,6 +27,8 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 	}
 	return &GenesisState{
 		Evidence: evidence,
+		Params:   params,
+		Bounties: bounties,
 	}
 }
 
@@ -34,6 +36,8 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Evidence: []*types.Any{},
+		Params:   DefaultParams(),
+		Bounties: []Bounty{},
 	}
 }
 
@@ -50,6 +54,23 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Bounties))
+	for _, b := range gs.Bounties {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+
+		key := string(BountyKey(b.GetConsensusAddress(), b.InfractionHeight))
+		if seen[key] {
+			return fmt.Errorf("duplicate bounty for %s at height %d", b.ConsensusAddress, b.InfractionHeight)
+		}
+		seen[key] = true
+	}
+
 	return nil
 }
 
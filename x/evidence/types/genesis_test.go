@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
@@ -44,11 +45,11 @@ func TestNewGenesisState(t *testing.T) {
 
 			if tc.expPass {
 				require.NotPanics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence, nil)
 				})
 			} else {
 				require.Panics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence, nil)
 				})
 			}
 		})
@@ -60,6 +61,8 @@ func TestGenesisStateValidate(t *testing.T) {
 		genesisState *types.GenesisState
 		testEvidence []exported.Evidence
 		pk           = ed25519.GenPrivKey()
+		submitter    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		consAddr     = sdk.ConsAddress(pk.PubKey().Address())
 	)
 
 	testCases := []struct {
@@ -79,7 +82,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence, nil)
 			},
 			true,
 		},
@@ -95,7 +98,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence, nil)
 			},
 			false,
 		},
@@ -108,6 +111,49 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid bounty fraction",
+			func() {
+				genesisState = types.NewGenesisState(types.NewParams(sdk.NewDecWithPrec(11, 1)), nil, nil)
+			},
+			false,
+		},
+		{
+			"bounty fraction of one",
+			func() {
+				genesisState = types.NewGenesisState(types.NewParams(sdk.OneDec()), nil, nil)
+			},
+			false,
+		},
+		{
+			"valid bounties",
+			func() {
+				genesisState = types.NewGenesisState(types.NewParams(sdk.NewDecWithPrec(1, 1)), nil, []types.Bounty{
+					types.NewBounty(submitter, consAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+					types.NewBounty(submitter, consAddr, 2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)),
+				})
+			},
+			true,
+		},
+		{
+			"invalid bounty",
+			func() {
+				genesisState = types.NewGenesisState(types.DefaultParams(), nil, []types.Bounty{
+					types.NewBounty(submitter, consAddr, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+				})
+			},
+			false,
+		},
+		{
+			"duplicate bounty",
+			func() {
+				genesisState = types.NewGenesisState(types.DefaultParams(), nil, []types.Bounty{
+					types.NewBounty(submitter, consAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+					types.NewBounty(submitter, consAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+				})
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
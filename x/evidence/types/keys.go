@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -17,4 +22,14 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixBounty   = []byte{0x01}
 )
+
+// BountyKey returns the key of the Bounty of the given validator for the
+// infraction at the given height.
+// Items are stored with the following key: values
+//
+// - 0x01<consAddrLen (1 Byte)><consAddr_Bytes><infractionHeight_Bytes>: Bounty
+func BountyKey(consAddr sdk.ConsAddress, infractionHeight int64) []byte {
+	return append(append(KeyPrefixBounty, address.MustLengthPrefix(consAddr)...), sdk.Uint64ToBigEndian(uint64(infractionHeight))...)
+}
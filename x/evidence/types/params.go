@@ -1,11 +1,65 @@
 package types
 
 import (
+	"fmt"
 	"time"
-)
 
-// DONTCOVER
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// Parameter store keys
+var KeyBountyFraction = []byte("BountyFraction")
+
+// ParamKeyTable returns the parameter key table for the evidence module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object.
+func NewParams(bountyFraction sdk.Dec) Params {
+	return Params{
+		BountyFraction: bountyFraction,
+	}
+}
+
+// DefaultParams returns the default evidence module parameters, which do not
+// pay any bounty.
+func DefaultParams() Params {
+	return NewParams(sdk.ZeroDec())
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBountyFraction, &p.BountyFraction, validateBountyFraction),
+	}
+}
+
+// Validate performs basic validation on evidence parameters.
+func (p Params) Validate() error {
+	return validateBountyFraction(p.BountyFraction)
+}
+
+func validateBountyFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("bounty fraction must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("bounty fraction must be positive: %s", v)
+	}
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("bounty fraction must be less than one: %s", v)
+	}
+
+	return nil
+}
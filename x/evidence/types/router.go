@@ -11,8 +11,10 @@ type (
 	// Handler defines an agnostic Evidence handler. The handler is responsible
 	// for executing all corresponding business logic necessary for verifying the
 	// evidence as valid. In addition, the Handler may execute any necessary
-	// slashing and potential jailing.
-	Handler func(sdk.Context, exported.Evidence) error
+	// slashing and potential jailing. The submitter is the address which
+	// submitted the evidence, to which the Handler may pay a bounty through the
+	// Keeper's SlashWithBounty.
+	Handler func(ctx sdk.Context, evidence exported.Evidence, submitter sdk.AccAddress) error
 
 	// Router defines a contract for which any Evidence handling module must
 	// implement in order to route Evidence to registered Handlers.
@@ -10,7 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
-func testHandler(sdk.Context, exported.Evidence) error { return nil }
+func testHandler(sdk.Context, exported.Evidence, sdk.AccAddress) error { return nil }
 
 func TestRouterSeal(t *testing.T) {
 	r := types.NewRouter()
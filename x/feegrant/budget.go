@@ -0,0 +1,104 @@
+package feegrant
+
+import (
+	"regexp"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// reBudgetName defines the accepted budget names: up to 64 letters, digits,
+// dashes, underscores and dots.
+var reBudgetName = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+// NewBudget creates a new Budget of the granter. Its remaining amount is set
+// to spendLimit, and it is reset one period after blockTime.
+//nolint:interfacer
+func NewBudget(granter sdk.AccAddress, name string, spendLimit sdk.Coins, period time.Duration, blockTime time.Time) Budget {
+	return Budget{
+		Granter:     granter.String(),
+		Name:        name,
+		SpendLimit:  spendLimit,
+		Period:      period,
+		Remaining:   spendLimit,
+		PeriodReset: blockTime.Add(period),
+	}
+}
+
+// Accept deducts the fee from the amount remaining in the budget, after having
+// reset it if its period has elapsed. It returns an error if the remaining
+// amount does not cover the fee.
+func (b *Budget) Accept(blockTime time.Time, fee sdk.Coins) error {
+	b.TryResetPeriod(blockTime)
+
+	remaining, isNeg := b.Remaining.SafeSub(fee...)
+	if isNeg {
+		return sdkerrors.Wrapf(ErrFeeLimitExceeded, "budget %s", b.Name)
+	}
+
+	b.Remaining = remaining
+	return nil
+}
+
+// TryResetPeriod resets the remaining amount to the spend limit if the period
+// of the budget is not zero and PeriodReset has been hit. Like for
+// PeriodicAllowance, the next reset steps from PeriodReset if we are within one
+// period, or from blockTime otherwise.
+func (b *Budget) TryResetPeriod(blockTime time.Time) {
+	if b.Period <= 0 || blockTime.Before(b.PeriodReset) {
+		return
+	}
+
+	b.Remaining = b.SpendLimit
+	b.PeriodReset = b.PeriodReset.Add(b.Period)
+	if blockTime.After(b.PeriodReset) {
+		b.PeriodReset = blockTime.Add(b.Period)
+	}
+}
+
+// ValidateBasic performs basic validation on Budget.
+func (b Budget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(b.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if err := ValidateBudget(b.Name, b.SpendLimit, b.Period); err != nil {
+		return err
+	}
+
+	if !b.Remaining.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "remaining amount is invalid: %s", b.Remaining)
+	}
+
+	return nil
+}
+
+// ValidateBudget validates the name, spend limit and period of a budget.
+func ValidateBudget(name string, spendLimit sdk.Coins, period time.Duration) error {
+	if err := ValidateBudgetName(name); err != nil {
+		return err
+	}
+
+	if !spendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", spendLimit)
+	}
+	if spendLimit.Empty() || !spendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+
+	if period < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+
+	return nil
+}
+
+// ValidateBudgetName returns an error if the budget name is invalid.
+func ValidateBudgetName(name string) error {
+	if !reBudgetName.MatchString(name) {
+		return sdkerrors.Wrapf(ErrInvalidBudget, "invalid budget name %q", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestBudgetAccept(t *testing.T) {
+	_, _, granter := testdata.KeyTestPubAddr()
+	now := time.Now()
+	oneHour := time.Hour
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	cases := map[string]struct {
+		period      time.Duration
+		fees        []sdk.Coins
+		blockTime   time.Time
+		accept      bool
+		remaining   sdk.Coins
+		periodReset time.Time
+	}{
+		"within limit": {
+			period:      oneHour,
+			fees:        []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("atom", 40))},
+			blockTime:   now,
+			accept:      true,
+			remaining:   sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+			periodReset: now.Add(oneHour),
+		},
+		"exceeds limit across fees": {
+			period: oneHour,
+			fees: []sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+			},
+			blockTime: now,
+			accept:    false,
+		},
+		"wrong denom": {
+			period:    oneHour,
+			fees:      []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("eth", 1))},
+			blockTime: now,
+			accept:    false,
+		},
+		"reset after period": {
+			period: oneHour,
+			fees: []sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("atom", 90)),
+			},
+			blockTime:   now.Add(90 * time.Minute),
+			accept:      true,
+			remaining:   sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			periodReset: now.Add(2 * oneHour),
+		},
+		"reset long after period": {
+			period:      oneHour,
+			fees:        []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+			blockTime:   now.Add(5 * oneHour),
+			accept:      true,
+			remaining:   sdk.NewCoins(sdk.NewInt64Coin("atom", 90)),
+			periodReset: now.Add(6 * oneHour),
+		},
+		"no period never resets": {
+			period:    0,
+			fees:      []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+			blockTime: now.Add(5 * oneHour),
+			accept:    false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			budget := feegrant.NewBudget(granter, "team", atom, tc.period, now)
+			require.NoError(t, budget.ValidateBasic())
+
+			// spend most of the budget in the first period when testing resets
+			if tc.blockTime.After(now) {
+				require.NoError(t, budget.Accept(now, sdk.NewCoins(sdk.NewInt64Coin("atom", 95))))
+			}
+
+			var err error
+			for _, fee := range tc.fees {
+				if err = budget.Accept(tc.blockTime, fee); err != nil {
+					break
+				}
+			}
+
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remaining, budget.Remaining)
+			require.True(t, tc.periodReset.Equal(budget.PeriodReset), "%s != %s", tc.periodReset, budget.PeriodReset)
+		})
+	}
+}
+
+func TestBudgetValidateBasic(t *testing.T) {
+	_, _, granter := testdata.KeyTestPubAddr()
+	now := time.Now()
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	cases := map[string]struct {
+		budget feegrant.Budget
+		valid  bool
+	}{
+		"valid": {
+			budget: feegrant.NewBudget(granter, "team-1.ops_a", atom, time.Hour, now),
+			valid:  true,
+		},
+		"invalid granter": {
+			budget: feegrant.Budget{Granter: "invalid", Name: "team", SpendLimit: atom},
+			valid:  false,
+		},
+		"empty name": {
+			budget: feegrant.NewBudget(granter, "", atom, time.Hour, now),
+			valid:  false,
+		},
+		"invalid name": {
+			budget: feegrant.NewBudget(granter, "team/1", atom, time.Hour, now),
+			valid:  false,
+		},
+		"empty spend limit": {
+			budget: feegrant.NewBudget(granter, "team", sdk.Coins{}, time.Hour, now),
+			valid:  false,
+		},
+		"negative period": {
+			budget: feegrant.NewBudget(granter, "team", atom, -time.Hour, now),
+			valid:  false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.budget.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
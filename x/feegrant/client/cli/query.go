@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
@@ -27,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQueryBudget(),
+		GetCmdQueryBudgets(),
 	)
 
 	return feegrantQueryCmd
@@ -102,23 +106,15 @@ $ %s query feegrant grants-by-grantee [grantee]
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.Allowances(
-				cmd.Context(),
-				&feegrant.QueryAllowancesRequest{
-					Grantee:    granteeAddr.String(),
-					Pagination: pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Allowances(
+					ctx,
+					&feegrant.QueryAllowancesRequest{
+						Grantee:    granteeAddr.String(),
+						Pagination: pageReq,
+					},
+				)
+			})
 		},
 	}
 
@@ -150,28 +146,102 @@ $ %s query feegrant grants-by-granter [granter]
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.AllowancesByGranter(
+					ctx,
+					&feegrant.QueryAllowancesByGranterRequest{
+						Granter:    granterAddr.String(),
+						Pagination: pageReq,
+					},
+				)
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
+
+// GetCmdQueryBudget returns cmd to query for a budget of a granter.
+func GetCmdQueryBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget [granter] [name]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a budget of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries a budget of a granter, with the amount remaining to be spent.
+
+Example:
+$ %s query feegrant budget [granter] [name]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.AllowancesByGranter(
+			res, err := queryClient.Budget(
 				cmd.Context(),
-				&feegrant.QueryAllowancesByGranterRequest{
-					Granter:    granterAddr.String(),
-					Pagination: pageReq,
+				&feegrant.QueryBudgetRequest{
+					Granter: granterAddr.String(),
+					Name:    args[1],
 				},
 			)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return clientCtx.PrintProto(res.Budget)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "grants")
+
+	return cmd
+}
+
+// GetCmdQueryBudgets returns cmd to query for all the budgets of a granter.
+func GetCmdQueryBudgets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budgets [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all the budgets of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the budgets of a granter address.
+
+Example:
+$ %s query feegrant budgets [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Budgets(
+					ctx,
+					&feegrant.QueryBudgetsRequest{
+						Granter:    granterAddr.String(),
+						Pagination: pageReq,
+					},
+				)
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "budgets")
 
 	return cmd
 }
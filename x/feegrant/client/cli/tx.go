@@ -22,6 +22,7 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagBudget      = "budget"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +39,8 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdSetBudget(),
+		NewCmdDeleteBudget(),
 	)
 
 	return feegrantTxCmd
@@ -57,8 +60,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --budget onboarding
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -165,6 +170,11 @@ Examples:
 				return err
 			}
 
+			msg.Budget, err = cmd.Flags().GetString(FlagBudget)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -175,6 +185,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().String(FlagBudget, "", "The name of an existing budget of the granter the fees are also drawn from")
 
 	return cmd
 }
@@ -215,6 +226,89 @@ Example:
 	return cmd
 }
 
+// NewCmdSetBudget returns a CLI command handler for creating a MsgSetBudget transaction.
+func NewCmdSetBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-budget [granter_key_or_address] [name]",
+		Short: "Create or reset a budget shared by the fee allowances of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create or reset a budget capping the fees paid from your address under the
+fee allowances linked to it. The remaining amount of the budget is reset to the spend limit.
+Note, the'--from' flag is ignored as it is implied from [granter].
+
+Examples:
+%s tx %s set-budget cosmos1skjw... onboarding --spend-limit 10000stake or
+%s tx %s set-budget cosmos1skjw... onboarding --spend-limit 1000stake --period 86400
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			limit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgSetBudget(clientCtx.GetFromAddress(), args[1], limit, getPeriod(periodClock))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max amount which can be spent under the linked allowances in each period, or in total")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) after which the budget is reset, if not mentioned it is never reset (ex: 3600)")
+
+	return cmd
+}
+
+// NewCmdDeleteBudget returns a CLI command handler for creating a MsgDeleteBudget transaction.
+func NewCmdDeleteBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-budget [granter] [name]",
+		Short: "Delete a budget of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delete a budget of a granter. The fee allowances linked to it can no longer
+be used. Note, the'--from' flag is ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s delete-budget cosmos1skj.. onboarding
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgDeleteBudget(clientCtx.GetFromAddress(), args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
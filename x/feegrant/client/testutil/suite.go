@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -712,6 +713,109 @@ func (s *IntegrationTestSuite) TestNewCmdRevokeFeegrant() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewCmdSetBudget() {
+	val := s.network.Validators[0]
+	granter := val.Address
+	clientCtx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          func() *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid budget name",
+			cli.NewCmdSetBudget,
+			append(
+				[]string{
+					granter.String(),
+					"team/1",
+					fmt.Sprintf("--%s=100%s", cli.FlagSpendLimit, s.cfg.BondDenom),
+				},
+				commonFlags...,
+			),
+			true, 0,
+		},
+		{
+			"valid budget",
+			cli.NewCmdSetBudget,
+			append(
+				[]string{
+					granter.String(),
+					"team",
+					fmt.Sprintf("--%s=100%s", cli.FlagSpendLimit, s.cfg.BondDenom),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+				},
+				commonFlags...,
+			),
+			false, 0,
+		},
+		{
+			"valid budget with amino",
+			cli.NewCmdSetBudget,
+			append(
+				[]string{
+					granter.String(),
+					"amino",
+					fmt.Sprintf("--%s=100%s", cli.FlagSpendLimit, s.cfg.BondDenom),
+					fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+				},
+				commonFlags...,
+			),
+			false, 0,
+		},
+		{
+			"delete non existing budget",
+			cli.NewCmdDeleteBudget,
+			append([]string{granter.String(), "unknown"}, commonFlags...),
+			false, feegrant.ErrNoBudget.ABCICode(),
+		},
+		{
+			"delete budget",
+			cli.NewCmdDeleteBudget,
+			append([]string{granter.String(), "amino"}, commonFlags...),
+			false, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd(), tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBudgets(), []string{
+		granter.String(),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var resp feegrant.QueryBudgetsResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), out.String())
+	s.Require().Len(resp.Budgets, 1)
+	s.Require().Equal("team", resp.Budgets[0].Name)
+	s.Require().Equal(time.Hour, resp.Budgets[0].Period)
+}
+
 func (s *IntegrationTestSuite) TestTxWithFeeGrant() {
 	val := s.network.Validators[0]
 	clientCtx := val.ClientCtx
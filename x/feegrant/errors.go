@@ -22,4 +22,8 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrInvalidBudget error if the budget is invalid
+	ErrInvalidBudget = sdkerrors.Register(DefaultCodespace, 8, "invalid budget")
+	// ErrNoBudget error if there is no budget with that name for the granter
+	ErrNoBudget = sdkerrors.Register(DefaultCodespace, 9, "no budget")
 )
@@ -6,9 +6,13 @@ const (
 	EventTypeRevokeFeeGrant = "revoke_feegrant"
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
+	EventTypeSetBudget      = "set_feegrant_budget"
+	EventTypeDeleteBudget   = "delete_feegrant_budget"
+	EventTypeUseBudget      = "use_feegrant_budget"
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
+	AttributeKeyBudget  = "budget"
 
 	AttributeValueCategory = ModuleName
 )
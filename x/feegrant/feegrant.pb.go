@@ -226,6 +226,11 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *types1.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// budget is the name of the granter's Budget the fees paid under this grant
+	// are also drawn from. It is empty if the grant is not linked to a budget.
+	//
+	// Since: cosmos-sdk 0.47
+	Budget string `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -282,11 +287,117 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+func (m *Grant) GetBudget() string {
+	if m != nil {
+		return m.Budget
+	}
+	return ""
+}
+
+// Budget is a spend limit shared by all the allowances of a granter which are
+// linked to it. Fees paid under any linked allowance are drawn from both the
+// allowance and the budget.
+//
+// Since: cosmos-sdk 0.47
+type Budget struct {
+	// granter is the address of the user whose funds the budget caps.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name is the identifier of the budget among the budgets of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// spend_limit specifies the maximum amount of tokens that can be spent
+	// under the linked allowances in each period, or in total if period is zero.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period specifies the time duration after which the remaining amount is
+	// reset to spend_limit. If it is zero, the budget is never reset.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// remaining is the amount of tokens left to be spent before period_reset.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// period_reset is the time at which the remaining amount is reset. It is
+	// ignored if period is zero.
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *Budget) Reset()         { *m = Budget{} }
+func (m *Budget) String() string { return proto.CompactTextString(m) }
+func (*Budget) ProtoMessage()    {}
+func (*Budget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *Budget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Budget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Budget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Budget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Budget.Merge(m, src)
+}
+func (m *Budget) XXX_Size() int {
+	return m.Size()
+}
+func (m *Budget) XXX_DiscardUnknown() {
+	xxx_messageInfo_Budget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Budget proto.InternalMessageInfo
+
+func (m *Budget) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *Budget) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Budget) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *Budget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Budget) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *Budget) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*Budget)(nil), "cosmos.feegrant.v1beta1.Budget")
 }
 
 func init() {
@@ -294,44 +405,48 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0x6b, 0x27, 0xff, 0x7f, 0x2e, 0x50, 0x5a, 0x53, 0xc0, 0xed, 0xe0, 0x54, 0x1d, 0x68,
+	0x18, 0x6a, 0xd3, 0xb2, 0x95, 0x85, 0xb8, 0x40, 0x85, 0x44, 0x25, 0xe4, 0x32, 0xb1, 0x44, 0x67,
+	0xfb, 0x6a, 0x4e, 0xc4, 0x77, 0x96, 0xef, 0x02, 0xcd, 0x37, 0x60, 0xec, 0xc8, 0x84, 0x98, 0x59,
+	0x58, 0xfa, 0x21, 0x2a, 0x06, 0x54, 0xc1, 0xc2, 0x44, 0x51, 0xf3, 0x45, 0x90, 0xef, 0xce, 0x71,
+	0x48, 0x78, 0x8d, 0xc2, 0x94, 0xbb, 0xf3, 0xf3, 0x7b, 0x7b, 0x1e, 0x9f, 0x03, 0xae, 0x87, 0x94,
+	0x25, 0x94, 0xb9, 0x07, 0x08, 0xc5, 0x19, 0x24, 0xdc, 0x7d, 0xbe, 0x19, 0x20, 0x0e, 0x37, 0x87,
+	0x07, 0x4e, 0x9a, 0x51, 0x4e, 0xcd, 0x6b, 0xb2, 0xce, 0x19, 0x1e, 0xab, 0xba, 0x95, 0xa5, 0x98,
+	0xc6, 0x54, 0xd4, 0xb8, 0xf9, 0x4a, 0x96, 0xaf, 0x2c, 0xc7, 0x94, 0xc6, 0x5d, 0xe4, 0x8a, 0x5d,
+	0xd0, 0x3b, 0x70, 0x21, 0xe9, 0x17, 0x8f, 0x24, 0x53, 0x47, 0x62, 0x14, 0xad, 0x7c, 0x64, 0x2b,
+	0x33, 0x01, 0x64, 0x68, 0x68, 0x24, 0xa4, 0x98, 0xa8, 0xe7, 0xcd, 0x71, 0x56, 0x8e, 0x13, 0xc4,
+	0x38, 0x4c, 0xd2, 0x82, 0x60, 0xbc, 0x20, 0xea, 0x65, 0x90, 0x63, 0xaa, 0x08, 0xd6, 0x3e, 0x69,
+	0x60, 0xde, 0x83, 0x0c, 0x87, 0xed, 0x6e, 0x97, 0xbe, 0x80, 0x24, 0x44, 0x66, 0x17, 0x34, 0x58,
+	0x8a, 0x48, 0xd4, 0xe9, 0xe2, 0x04, 0x73, 0x4b, 0x5b, 0xd5, 0x5b, 0x8d, 0xad, 0x65, 0x47, 0xf9,
+	0xca, 0x9d, 0x14, 0x51, 0x9d, 0x1d, 0x8a, 0x89, 0x77, 0xf3, 0xe4, 0x4b, 0xb3, 0xf2, 0xf6, 0xac,
+	0xd9, 0x8a, 0x31, 0x7f, 0xda, 0x0b, 0x9c, 0x90, 0x26, 0x2a, 0x84, 0xfa, 0xd9, 0x60, 0xd1, 0x33,
+	0x97, 0xf7, 0x53, 0xc4, 0x04, 0x80, 0xf9, 0x40, 0xf0, 0x3f, 0xcc, 0xe9, 0xcd, 0x3b, 0x00, 0xa0,
+	0xc3, 0x14, 0x4b, 0x53, 0xd6, 0xdc, 0xaa, 0xd6, 0x6a, 0x6c, 0xad, 0x38, 0xd2, 0xb5, 0x53, 0xb8,
+	0x76, 0x1e, 0x17, 0xb1, 0x3c, 0xe3, 0xe8, 0xac, 0xa9, 0xf9, 0x23, 0x98, 0xed, 0xc5, 0xf7, 0xc7,
+	0x1b, 0x17, 0xef, 0x23, 0x34, 0x4c, 0xf0, 0x60, 0x6d, 0xa0, 0x83, 0xc5, 0x47, 0x28, 0xc3, 0x34,
+	0x1a, 0x0d, 0xb6, 0x03, 0xaa, 0x41, 0x1e, 0xd5, 0xd2, 0x84, 0xca, 0xba, 0xf3, 0x93, 0x09, 0x3a,
+	0xdf, 0x37, 0xc4, 0x33, 0xf2, 0x80, 0xbe, 0xc4, 0x9a, 0xb7, 0x41, 0x2d, 0x15, 0xcc, 0xca, 0xeb,
+	0xf2, 0x84, 0xd7, 0xbb, 0xaa, 0xc3, 0xde, 0xff, 0x39, 0xee, 0x55, 0x6e, 0x57, 0x41, 0xcc, 0x3e,
+	0x30, 0xe5, 0xaa, 0x33, 0xda, 0x61, 0x7d, 0xf6, 0x1d, 0x5e, 0x90, 0x32, 0xfb, 0x65, 0x9f, 0x7b,
+	0x40, 0x9d, 0x75, 0x42, 0x48, 0xa4, 0xbc, 0x65, 0xcc, 0x5e, 0x78, 0x5e, 0x8a, 0xec, 0x40, 0x22,
+	0xb4, 0xcd, 0x5d, 0x70, 0x41, 0xc9, 0x66, 0x88, 0x21, 0x6e, 0x55, 0x7f, 0x3b, 0x60, 0xd1, 0x35,
+	0x31, 0xe4, 0x86, 0x44, 0xfa, 0x39, 0xf0, 0x47, 0x53, 0x7e, 0xad, 0x81, 0xcb, 0x62, 0x8b, 0xa2,
+	0x3d, 0x16, 0x97, 0x73, 0xbe, 0x07, 0xea, 0xb0, 0xd8, 0xa8, 0x59, 0x2f, 0x4d, 0x08, 0xb6, 0x49,
+	0xdf, 0x9b, 0xe4, 0xf4, 0x4b, 0xa4, 0x79, 0x03, 0x2c, 0x40, 0xc9, 0xde, 0x49, 0x10, 0x63, 0x30,
+	0x46, 0xcc, 0x9a, 0x5b, 0xd5, 0x5b, 0x75, 0xff, 0x92, 0x3a, 0xdf, 0x53, 0xc7, 0xdb, 0x57, 0x5e,
+	0xbe, 0x69, 0x56, 0x26, 0x0d, 0x7e, 0xd0, 0x40, 0x75, 0x37, 0x7f, 0xb3, 0xcc, 0x2d, 0xf0, 0x9f,
+	0x78, 0xc5, 0x50, 0x26, 0x0c, 0xd5, 0x3d, 0xeb, 0xe3, 0xf1, 0xc6, 0x92, 0xea, 0x7b, 0x3b, 0x8a,
+	0x32, 0xc4, 0xd8, 0x3e, 0xcf, 0x30, 0x89, 0xfd, 0xa2, 0xb0, 0xc4, 0x20, 0x6b, 0xee, 0xcf, 0x30,
+	0x63, 0xd1, 0xf5, 0xa9, 0xa3, 0x5f, 0x05, 0xb5, 0xa0, 0x17, 0xc5, 0x88, 0x5b, 0x46, 0xae, 0xec,
+	0xab, 0xdd, 0xda, 0x3b, 0x1d, 0xd4, 0x3c, 0xb1, 0x9c, 0x2a, 0x91, 0x09, 0x0c, 0x02, 0x13, 0x15,
+	0xc7, 0x17, 0xeb, 0xf1, 0xaf, 0x8d, 0xfe, 0x6f, 0xbf, 0x36, 0xe5, 0xed, 0x35, 0xfe, 0xfe, 0xf6,
+	0x62, 0x50, 0xcf, 0x50, 0x02, 0x31, 0xc1, 0x24, 0xb6, 0xaa, 0xb3, 0x37, 0x5a, 0xb2, 0x4f, 0x5c,
+	0x9b, 0xda, 0x94, 0xd7, 0xc6, 0x6b, 0x9f, 0x9c, 0xdb, 0xda, 0xe9, 0xb9, 0xad, 0x7d, 0x3d, 0xb7,
+	0xb5, 0xa3, 0x81, 0x5d, 0x39, 0x1d, 0xd8, 0x95, 0xcf, 0x03, 0xbb, 0xf2, 0x64, 0xfd, 0x97, 0xbe,
+	0x0e, 0x87, 0x7f, 0x77, 0x41, 0x4d, 0xa8, 0xdd, 0xfa, 0x36, 0x00, 0x3c, 0x50, 0xf6, 0xf4, 0x19,
+	0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -522,6 +637,13 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Budget) > 0 {
+		i -= len(m.Budget)
+		copy(dAtA[i:], m.Budget)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Budget)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -551,6 +673,87 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFeegrant(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeegrant(v)
 	base := offset
@@ -645,6 +848,43 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	l = len(m.Budget)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
 	return n
 }
 
@@ -1238,6 +1478,286 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
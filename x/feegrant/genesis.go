@@ -2,20 +2,39 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(entries []Grant) *GenesisState {
+func NewGenesisState(entries []Grant, budgets []Budget) *GenesisState {
 	return &GenesisState{
 		Allowances: entries,
+		Budgets:    budgets,
 	}
 }
 
-// ValidateGenesis ensures all grants in the genesis state are valid
+// ValidateGenesis ensures all grants and budgets in the genesis state are valid,
+// and that the grants are only linked to existing budgets of their granter
 func ValidateGenesis(data GenesisState) error {
+	budgets := make(map[[2]string]bool, len(data.Budgets))
+	for _, b := range data.Budgets {
+		if err := b.ValidateBasic(); err != nil {
+			return err
+		}
+		key := [2]string{b.Granter, b.Name}
+		if budgets[key] {
+			return sdkerrors.Wrapf(ErrInvalidBudget, "duplicate budget %s of %s", b.Name, b.Granter)
+		}
+		budgets[key] = true
+	}
+
 	for _, f := range data.Allowances {
+		if f.Budget != "" && !budgets[[2]string{f.Granter, f.Budget}] {
+			return sdkerrors.Wrapf(ErrNoBudget, "budget %s of %s", f.Budget, f.Granter)
+		}
+
 		grant, err := f.GetGrant()
 		if err != nil {
 			return err
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// SetBudget creates or overwrites a budget of its granter.
+func (k Keeper) SetBudget(ctx sdk.Context, budget feegrant.Budget) error {
+	if err := budget.ValidateBasic(); err != nil {
+		return err
+	}
+
+	granter, err := sdk.AccAddressFromBech32(budget.Granter)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&budget)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.BudgetKey(granter, budget.Name), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeSetBudget,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, budget.Granter),
+			sdk.NewAttribute(feegrant.AttributeKeyBudget, budget.Name),
+		),
+	)
+
+	return nil
+}
+
+// GetBudget returns the budget of the granter with the given name.
+func (k Keeper) GetBudget(ctx sdk.Context, granter sdk.AccAddress, name string) (*feegrant.Budget, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.BudgetKey(granter, name))
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrapf(feegrant.ErrNoBudget, "budget %s of %s", name, granter)
+	}
+
+	var budget feegrant.Budget
+	if err := k.cdc.Unmarshal(bz, &budget); err != nil {
+		return nil, err
+	}
+
+	return &budget, nil
+}
+
+// DeleteBudget deletes the budget of the granter with the given name. The
+// grants linked to it are kept, but can no longer be used.
+func (k Keeper) DeleteBudget(ctx sdk.Context, granter sdk.AccAddress, name string) error {
+	if _, err := k.GetBudget(ctx, granter, name); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(feegrant.BudgetKey(granter, name))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeDeleteBudget,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyBudget, name),
+		),
+	)
+
+	return nil
+}
+
+// IterateAllBudgets iterates over all the budgets in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateAllBudgets(ctx sdk.Context, cb func(budget feegrant.Budget) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.BudgetKeyPrefix)
+	defer iter.Close()
+
+	stop := false
+	for ; iter.Valid() && !stop; iter.Next() {
+		var budget feegrant.Budget
+		if err := k.cdc.Unmarshal(iter.Value(), &budget); err != nil {
+			return err
+		}
+
+		stop = cb(budget)
+	}
+
+	return nil
+}
+
+// LinkBudget links the grant between the granter and grantee to the budget of
+// the granter with the given name, so that the fees paid under the grant are
+// also drawn from the budget. An empty name unlinks the grant.
+func (k Keeper) LinkBudget(ctx sdk.Context, granter, grantee sdk.AccAddress, name string) error {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		if _, err := k.GetBudget(ctx, granter, name); err != nil {
+			return err
+		}
+	}
+
+	grant.Budget = name
+	bz, err := k.cdc.Marshal(grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.FeeAllowanceKey(granter, grantee), bz)
+	return nil
+}
+
+// useBudget draws the fee from the budget of the granter with the given name.
+func (k Keeper) useBudget(ctx sdk.Context, granter sdk.AccAddress, name string, fee sdk.Coins) error {
+	budget, err := k.GetBudget(ctx, granter, name)
+	if err != nil {
+		return err
+	}
+
+	if err := budget.Accept(ctx.BlockTime(), fee); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(budget)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(feegrant.BudgetKey(granter, name), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeUseBudget,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, budget.Granter),
+			sdk.NewAttribute(feegrant.AttributeKeyBudget, name),
+		),
+	)
+
+	return nil
+}
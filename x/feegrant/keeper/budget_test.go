@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func (suite *KeeperTestSuite) TestSetBudget() {
+	granter := suite.addrs[0]
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	// invalid budgets are rejected
+	_, err := suite.msgSrvr.SetBudget(suite.ctx, feegrant.NewMsgSetBudget(granter, "team/1", spendLimit, time.Hour))
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.SetBudget(suite.ctx, feegrant.NewMsgSetBudget(granter, "team", spendLimit, time.Hour))
+	suite.Require().NoError(err)
+
+	budget, err := suite.keeper.GetBudget(suite.sdkCtx, granter, "team")
+	suite.Require().NoError(err)
+	suite.Require().Equal(spendLimit, budget.Remaining)
+	suite.Require().True(suite.sdkCtx.BlockTime().Add(time.Hour).Equal(budget.PeriodReset))
+
+	// overwriting a budget resets its remaining amount
+	budget.Remaining = sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	suite.Require().NoError(suite.keeper.SetBudget(suite.sdkCtx, *budget))
+
+	newLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+	_, err = suite.msgSrvr.SetBudget(suite.ctx, feegrant.NewMsgSetBudget(granter, "team", newLimit, 0))
+	suite.Require().NoError(err)
+
+	budget, err = suite.keeper.GetBudget(suite.sdkCtx, granter, "team")
+	suite.Require().NoError(err)
+	suite.Require().Equal(newLimit, budget.Remaining)
+
+	// delete
+	msg := feegrant.NewMsgDeleteBudget(granter, "team")
+	_, err = suite.msgSrvr.DeleteBudget(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.GetBudget(suite.sdkCtx, granter, "team")
+	suite.Require().ErrorIs(err, feegrant.ErrNoBudget)
+
+	_, err = suite.msgSrvr.DeleteBudget(suite.ctx, &msg)
+	suite.Require().ErrorIs(err, feegrant.ErrNoBudget)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeeWithBudget() {
+	granter, grantee1, grantee2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 60))
+
+	_, err := suite.msgSrvr.SetBudget(suite.ctx, feegrant.NewMsgSetBudget(granter, "team", spendLimit, time.Hour))
+	suite.Require().NoError(err)
+
+	// a grant can't be linked to an unknown budget
+	suite.Require().ErrorIs(suite.grantAllowanceWithBudget(granter, grantee1, "unknown"), feegrant.ErrNoBudget)
+
+	// each allowance covers the fee, but the budget shared by both doesn't
+	suite.Require().NoError(suite.grantAllowanceWithBudget(granter, grantee1, "team"))
+	suite.Require().NoError(suite.grantAllowanceWithBudget(granter, grantee2, "team"))
+
+	grant, err := suite.keeper.Allowance(suite.ctx, &feegrant.QueryAllowanceRequest{
+		Granter: granter.String(),
+		Grantee: grantee1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal("team", grant.Allowance.Budget)
+
+	ctx, _ := suite.sdkCtx.CacheContext()
+	suite.Require().NoError(suite.keeper.UseGrantedFees(ctx, granter, grantee1, fee, []sdk.Msg{}))
+	err = suite.keeper.UseGrantedFees(ctx, granter, grantee2, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// the budget is replenished in the next period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.keeper.UseGrantedFees(ctx, granter, grantee2, fee, []sdk.Msg{}))
+
+	budget, err := suite.keeper.GetBudget(ctx, granter, "team")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), budget.Remaining)
+
+	// grants linked to a deleted budget can't be used anymore
+	suite.Require().NoError(suite.keeper.DeleteBudget(suite.sdkCtx, granter, "team"))
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee1, fee, []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrNoBudget)
+}
+
+func (suite *KeeperTestSuite) TestBudgetQueries() {
+	granter := suite.addrs[0]
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	_, err := suite.keeper.Budget(suite.ctx, &feegrant.QueryBudgetRequest{Granter: granter.String(), Name: "team"})
+	suite.Require().Error(err)
+
+	for _, name := range []string{"team-a", "team-b", "team-c"} {
+		_, err = suite.msgSrvr.SetBudget(suite.ctx, feegrant.NewMsgSetBudget(granter, name, spendLimit, 0))
+		suite.Require().NoError(err)
+	}
+
+	_, err = suite.keeper.Budget(suite.ctx, &feegrant.QueryBudgetRequest{Granter: granter.String(), Name: "team/a"})
+	suite.Require().Error(err)
+
+	res, err := suite.keeper.Budget(suite.ctx, &feegrant.QueryBudgetRequest{Granter: granter.String(), Name: "team-b"})
+	suite.Require().NoError(err)
+	suite.Require().Equal("team-b", res.Budget.Name)
+	suite.Require().Equal(spendLimit, res.Budget.Remaining)
+
+	budgets, err := suite.keeper.Budgets(suite.ctx, &feegrant.QueryBudgetsRequest{
+		Granter:    granter.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(budgets.Budgets, 2)
+	suite.Require().NotNil(budgets.Pagination.NextKey)
+
+	budgets, err = suite.keeper.Budgets(suite.ctx, &feegrant.QueryBudgetsRequest{Granter: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(budgets.Budgets)
+}
+
+func (suite *KeeperTestSuite) grantAllowanceWithBudget(granter, grantee sdk.AccAddress, budget string) error {
+	any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, &feegrant.MsgGrantAllowance{
+		Granter:   granter.String(),
+		Grantee:   grantee.String(),
+		Allowance: any,
+		Budget:    budget,
+	})
+	return err
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -45,14 +46,23 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
 	suite.Require().NoError(err)
 
+	err = suite.keeper.SetBudget(suite.ctx, feegrant.NewBudget(granterAddr, "team", coins, time.Hour, now))
+	suite.Require().NoError(err)
+	err = suite.keeper.LinkBudget(suite.ctx, granterAddr, granteeAddr, "team")
+	suite.Require().NoError(err)
+
 	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
-	// revoke fee allowance
+	suite.Require().Len(genesis.Budgets, 1)
+	suite.Require().Equal("team", genesis.Allowances[0].Budget)
+	// revoke fee allowance and delete budget
 	_, err = msgSrvr.RevokeAllowance(sdk.WrapSDKContext(suite.ctx), &feegrant.MsgRevokeAllowance{
 		Granter: granterAddr.String(),
 		Grantee: granteeAddr.String(),
 	})
 	suite.Require().NoError(err)
+	err = suite.keeper.DeleteBudget(suite.ctx, granterAddr, "team")
+	suite.Require().NoError(err)
 	err = suite.keeper.InitGenesis(suite.ctx, genesis)
 	suite.Require().NoError(err)
 
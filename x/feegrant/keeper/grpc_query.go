@@ -34,7 +34,12 @@ func (q Keeper) Allowance(c context.Context, req *feegrant.QueryAllowanceRequest
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	feeAllowance, err := q.GetAllowance(ctx, granterAddr, granteeAddr)
+	grant, err := q.getGrant(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	feeAllowance, err := grant.GetGrant()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -54,6 +59,7 @@ func (q Keeper) Allowance(c context.Context, req *feegrant.QueryAllowanceRequest
 			Granter:   granterAddr.String(),
 			Grantee:   granteeAddr.String(),
 			Allowance: feeAllowanceAny,
+			Budget:    grant.Budget,
 		},
 	}, nil
 }
@@ -133,3 +139,65 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// Budget returns a budget of a granter, with the amount remaining at the current block time.
+func (q Keeper) Budget(c context.Context, req *feegrant.QueryBudgetRequest) (*feegrant.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := feegrant.ValidateBudgetName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	budget, err := q.GetBudget(ctx, granterAddr, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// report the amount which can be spent now, the budget may have been reset
+	// since it was last used
+	budget.TryResetPeriod(ctx.BlockTime())
+
+	return &feegrant.QueryBudgetResponse{Budget: budget}, nil
+}
+
+// Budgets returns all the budgets of a granter.
+func (q Keeper) Budgets(c context.Context, req *feegrant.QueryBudgetsRequest) (*feegrant.QueryBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var budgets []*feegrant.Budget
+
+	store := ctx.KVStore(q.storeKey)
+	budgetStore := prefix.NewStore(store, feegrant.BudgetPrefixByGranter(granterAddr))
+	pageRes, err := query.Paginate(budgetStore, req.Pagination, func(key []byte, value []byte) error {
+		var budget feegrant.Budget
+		if err := q.cdc.Unmarshal(value, &budget); err != nil {
+			return err
+		}
+
+		budgets = append(budgets, &budget)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+}
@@ -118,12 +118,13 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return nil
 }
 
-// UpdateAllowance updates the existing grant.
+// UpdateAllowance updates the existing grant. The grant stays linked to the
+// same budget, if any.
 func (k Keeper) UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
 	store := ctx.KVStore(k.storeKey)
 	key := feegrant.FeeAllowanceKey(granter, grantee)
 
-	_, err := k.getGrant(ctx, granter, grantee)
+	existingGrant, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
@@ -132,6 +133,7 @@ func (k Keeper) UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress
 	if err != nil {
 		return err
 	}
+	grant.Budget = existingGrant.Budget
 
 	bz, err := k.cdc.Marshal(&grant)
 	if err != nil {
@@ -223,7 +225,8 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the grant is linked to a budget of the granter, the fee must be covered by both the allowance and the budget.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
@@ -236,6 +239,12 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	}
 
 	remove, err := grant.Accept(ctx, fee, msgs)
+	if err == nil && f.Budget != "" {
+		// the fees are drawn from both the allowance and the budget it is linked to
+		if err := k.useBudget(ctx, granter, f.Budget, fee); err != nil {
+			return err
+		}
+	}
 
 	if remove {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
@@ -271,6 +280,12 @@ func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
 func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error {
+	for _, b := range data.Budgets {
+		if err := k.SetBudget(ctx, b); err != nil {
+			return err
+		}
+	}
+
 	for _, f := range data.Allowances {
 		granter, err := sdk.AccAddressFromBech32(f.Granter)
 		if err != nil {
@@ -290,6 +305,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 		if err != nil {
 			return err
 		}
+
+		if f.Budget != "" {
+			if err := k.LinkBudget(ctx, granter, grantee, f.Budget); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -302,10 +323,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets []feegrant.Budget
+	err = k.IterateAllBudgets(ctx, func(budget feegrant.Budget) bool {
+		budgets = append(budgets, budget)
+		return false
+	})
 
-	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+	return feegrant.NewGenesisState(grants, budgets), err
 }
 
 func (k Keeper) removeFromGrantQueue(ctx sdk.Context, exp *time.Time, allowanceKey []byte) {
@@ -19,3 +19,10 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3. Budgets are stored under a new
+// prefix and the existing grants are not linked to any budget, so there is no
+// state to migrate.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return nil
+}
@@ -42,6 +42,13 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
 	}
 
+	// The budget must exist before the grant is linked to it
+	if msg.Budget != "" {
+		if _, err := k.Keeper.GetBudget(ctx, granter, msg.Budget); err != nil {
+			return nil, err
+		}
+	}
+
 	allowance, err := msg.GetFeeAllowanceI()
 	if err != nil {
 		return nil, err
@@ -52,6 +59,12 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 		return nil, err
 	}
 
+	if msg.Budget != "" {
+		if err := k.Keeper.LinkBudget(ctx, granter, grantee, msg.Budget); err != nil {
+			return nil, err
+		}
+	}
+
 	return &feegrant.MsgGrantAllowanceResponse{}, nil
 }
 
@@ -76,3 +89,36 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// SetBudget creates or resets a budget of the granter.
+func (k msgServer) SetBudget(goCtx context.Context, msg *feegrant.MsgSetBudget) (*feegrant.MsgSetBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := feegrant.NewBudget(granter, msg.Name, msg.SpendLimit, msg.Period, ctx.BlockTime())
+	if err := k.Keeper.SetBudget(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgSetBudgetResponse{}, nil
+}
+
+// DeleteBudget deletes a budget of the granter.
+func (k msgServer) DeleteBudget(goCtx context.Context, msg *feegrant.MsgDeleteBudget) (*feegrant.MsgDeleteBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.DeleteBudget(ctx, granter, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgDeleteBudgetResponse{}, nil
+}
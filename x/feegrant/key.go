@@ -29,6 +29,10 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// BudgetKeyPrefix is the set of the kvstore for budget data
+	// - 0x02<budget_key_bytes>: budget
+	BudgetKeyPrefix = []byte{0x02}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -48,6 +52,22 @@ func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
 
+// BudgetKey is the canonical key to store a budget of a granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes><name_bytes>
+func BudgetKey(granter sdk.AccAddress, name string) []byte {
+	return append(BudgetPrefixByGranter(granter), name...)
+}
+
+// BudgetPrefixByGranter returns a prefix to scan for all the budgets of the given granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes>
+func BudgetPrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(BudgetKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
 // FeeAllowancePrefixQueue is the canonical key to store grant key.
 //
 // Key format:
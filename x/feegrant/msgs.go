@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +14,8 @@ import (
 var (
 	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
 	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _ sdk.Msg            = &MsgSetBudget{}, &MsgDeleteBudget{}
+	_, _ legacytx.LegacyMsg = &MsgSetBudget{}, &MsgDeleteBudget{}
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -46,6 +50,11 @@ func (msg MsgGrantAllowance) ValidateBasic() error {
 	if msg.Grantee == msg.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if msg.Budget != "" {
+		if err := ValidateBudgetName(msg.Budget); err != nil {
+			return err
+		}
+	}
 	allowance, err := msg.GetFeeAllowanceI()
 	if err != nil {
 		return err
@@ -134,3 +143,80 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgSetBudget returns a message to create or reset a budget of the granter.
+//nolint:interfacer
+func NewMsgSetBudget(granter sdk.AccAddress, name string, spendLimit sdk.Coins, period time.Duration) *MsgSetBudget {
+	return &MsgSetBudget{
+		Granter:    granter.String(),
+		Name:       name,
+		SpendLimit: spendLimit,
+		Period:     period,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return ValidateBudget(msg.Name, msg.SpendLimit, msg.Period)
+}
+
+// GetSigners gets the granter account associated with the budget.
+func (msg MsgSetBudget) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetBudget) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetBudget) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSetBudget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgDeleteBudget returns a message to delete a budget of the granter.
+//nolint:interfacer
+func NewMsgDeleteBudget(granter sdk.AccAddress, name string) MsgDeleteBudget {
+	return MsgDeleteBudget{Granter: granter.String(), Name: name}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDeleteBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return ValidateBudgetName(msg.Name)
+}
+
+// GetSigners gets the granter account associated with the budget.
+func (msg MsgDeleteBudget) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgDeleteBudget) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgDeleteBudget) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgDeleteBudget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -19,6 +19,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.BudgetKeyPrefix):
+			var budgetA, budgetB feegrant.Budget
+			cdc.MustUnmarshal(kvA.Value, &budgetA)
+			cdc.MustUnmarshal(kvB.Value, &budgetB)
+			return fmt.Sprintf("%v\n%v", budgetA, budgetB)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -34,9 +35,14 @@ func TestDecodeStore(t *testing.T) {
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
 
+	budget := feegrant.NewBudget(granterAddr, "team", sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))), time.Hour, time.Now().UTC())
+	budgetBz, err := cdc.Marshal(&budget)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(feegrant.FeeAllowanceKeyPrefix), Value: grantBz},
+			{Key: feegrant.BudgetKey(granterAddr, "team"), Value: budgetBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -46,6 +52,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", fmt.Sprintf("%v\n%v", grant, grant)},
+		{"Budget", fmt.Sprintf("%v\n%v", budget, budget)},
 		{"other", ""},
 	}
 
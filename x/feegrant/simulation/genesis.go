@@ -67,7 +67,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { feegrants = genFeeGrants(r, simState.Accounts) },
 	)
 
-	feegrantGenesis := feegrant.NewGenesisState(feegrants)
+	feegrantGenesis := feegrant.NewGenesisState(feegrants, nil)
 	bz, err := simState.Cdc.MarshalJSON(feegrantGenesis)
 	if err != nil {
 		panic(err)
@@ -8,21 +8,28 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +46,11 @@ type MsgGrantAllowance struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *types.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// budget is the optional name of an existing budget of the granter the fees
+	// paid under the allowance are also drawn from.
+	//
+	// Since: cosmos-sdk 0.47
+	Budget string `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
 func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
@@ -95,6 +107,13 @@ func (m *MsgGrantAllowance) GetAllowance() *types.Any {
 	return nil
 }
 
+func (m *MsgGrantAllowance) GetBudget() string {
+	if m != nil {
+		return m.Budget
+	}
+	return ""
+}
+
 // MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response type.
 type MsgGrantAllowanceResponse struct {
 }
@@ -224,41 +243,272 @@ func (m *MsgRevokeAllowanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeAllowanceResponse proto.InternalMessageInfo
 
+// MsgSetBudget creates or resets a Budget of Granter. The remaining amount of
+// the budget is reset to SpendLimit.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetBudget struct {
+	// granter is the address of the user whose funds the budget caps.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name is the identifier of the budget among the budgets of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// spend_limit specifies the maximum amount of tokens that can be spent
+	// under the linked allowances in each period, or in total if period is zero.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period specifies the time duration after which the remaining amount is
+	// reset to spend_limit. If it is zero, the budget is never reset.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *MsgSetBudget) Reset()         { *m = MsgSetBudget{} }
+func (m *MsgSetBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBudget) ProtoMessage()    {}
+func (*MsgSetBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{4}
+}
+func (m *MsgSetBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBudget.Merge(m, src)
+}
+func (m *MsgSetBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBudget proto.InternalMessageInfo
+
+func (m *MsgSetBudget) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgSetBudget) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSetBudget) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MsgSetBudget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// MsgSetBudgetResponse defines the Msg/SetBudget response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSetBudgetResponse struct {
+}
+
+func (m *MsgSetBudgetResponse) Reset()         { *m = MsgSetBudgetResponse{} }
+func (m *MsgSetBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBudgetResponse) ProtoMessage()    {}
+func (*MsgSetBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{5}
+}
+func (m *MsgSetBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBudgetResponse.Merge(m, src)
+}
+func (m *MsgSetBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBudgetResponse proto.InternalMessageInfo
+
+// MsgDeleteBudget deletes a Budget of Granter.
+//
+// Since: cosmos-sdk 0.47
+type MsgDeleteBudget struct {
+	// granter is the address of the user whose funds the budget caps.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// name is the identifier of the budget among the budgets of the granter.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgDeleteBudget) Reset()         { *m = MsgDeleteBudget{} }
+func (m *MsgDeleteBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBudget) ProtoMessage()    {}
+func (*MsgDeleteBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgDeleteBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBudget.Merge(m, src)
+}
+func (m *MsgDeleteBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteBudget proto.InternalMessageInfo
+
+func (m *MsgDeleteBudget) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgDeleteBudget) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgDeleteBudgetResponse defines the Msg/DeleteBudget response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgDeleteBudgetResponse struct {
+}
+
+func (m *MsgDeleteBudgetResponse) Reset()         { *m = MsgDeleteBudgetResponse{} }
+func (m *MsgDeleteBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteBudgetResponse) ProtoMessage()    {}
+func (*MsgDeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgDeleteBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteBudgetResponse.Merge(m, src)
+}
+func (m *MsgDeleteBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteBudgetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
 	proto.RegisterType((*MsgRevokeAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowance")
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
+	proto.RegisterType((*MsgSetBudget)(nil), "cosmos.feegrant.v1beta1.MsgSetBudget")
+	proto.RegisterType((*MsgSetBudgetResponse)(nil), "cosmos.feegrant.v1beta1.MsgSetBudgetResponse")
+	proto.RegisterType((*MsgDeleteBudget)(nil), "cosmos.feegrant.v1beta1.MsgDeleteBudget")
+	proto.RegisterType((*MsgDeleteBudgetResponse)(nil), "cosmos.feegrant.v1beta1.MsgDeleteBudgetResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0x88, 0x84, 0xd2, 0x05, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x1d, 0x8e, 0x39,
-	0x39, 0xf9, 0xe5, 0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x5b, 0x53, 0x8b, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x9a, 0xe8, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93,
-	0x2a, 0xc1, 0x44, 0x9c, 0x9e, 0x54, 0x21, 0x57, 0x2e, 0xce, 0x44, 0x98, 0xa5, 0x12, 0xcc, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0x22, 0x7a, 0x10, 0x0f, 0xea, 0xc1, 0x3c, 0xa8, 0xe7, 0x98, 0x57, 0xe9,
-	0x24, 0x78, 0x6a, 0x8b, 0x2e, 0xaf, 0x5b, 0x6a, 0x2a, 0xdc, 0x89, 0x9e, 0x41, 0x08, 0x9d, 0x56,
-	0x3c, 0x4d, 0xcf, 0x37, 0x68, 0xc1, 0x1c, 0xa2, 0x24, 0xcd, 0x25, 0x89, 0xe1, 0xa3, 0xa0, 0xd4,
-	0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa5, 0x2e, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xa0, 0xd4,
-	0xb2, 0xfc, 0xec, 0x54, 0xba, 0x7b, 0x18, 0xcd, 0xa5, 0x32, 0x5c, 0x52, 0x98, 0x6e, 0x81, 0x39,
-	0xd5, 0xe8, 0x0d, 0x23, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x01, 0x17, 0x1f, 0x5a, 0xf4, 0x68,
-	0xe9, 0xe1, 0x48, 0x1d, 0x7a, 0x18, 0x1e, 0x97, 0x32, 0x22, 0x5e, 0x2d, 0xcc, 0x66, 0xa1, 0x62,
-	0x2e, 0x7e, 0xf4, 0x00, 0xd2, 0xc6, 0x67, 0x0c, 0x9a, 0x62, 0x29, 0x63, 0x12, 0x14, 0xc3, 0x2c,
-	0x75, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf5, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x68, 0xaa, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9,
-	0xfa, 0x15, 0xf0, 0x5c, 0x94, 0xc4, 0x06, 0x4e, 0x33, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xb7, 0x32, 0x99, 0x5f, 0x03, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8d, 0x9b, 0xaa, 0xbf, 0x5f, 0x2e, 0x81, 0xaa, 0x56, 0xd4, 0x3a, 0x06, 0x39, 0x51, 0x24,
+	0x44, 0x54, 0x14, 0xbb, 0x49, 0x37, 0x98, 0x62, 0x0a, 0x08, 0x89, 0x2c, 0xee, 0xc6, 0x52, 0xd9,
+	0xf1, 0xd7, 0xc3, 0xd4, 0xf1, 0x59, 0xbe, 0x4b, 0x68, 0x56, 0x46, 0x16, 0x18, 0xf9, 0x1b, 0x98,
+	0x18, 0xba, 0xf1, 0x0f, 0x54, 0x4c, 0x15, 0x13, 0x13, 0x45, 0xc9, 0x00, 0x7f, 0x06, 0xb2, 0x7d,
+	0xe7, 0x14, 0x47, 0x44, 0x01, 0x21, 0xa6, 0xbb, 0xd3, 0xf7, 0xbe, 0xf7, 0xde, 0x7d, 0xf7, 0x12,
+	0xa3, 0xc6, 0x80, 0xd0, 0x21, 0xa1, 0xc6, 0x31, 0x00, 0x8e, 0xec, 0x80, 0x19, 0xe3, 0x8e, 0x03,
+	0xcc, 0xee, 0x18, 0xec, 0x54, 0x0f, 0x23, 0xc2, 0x88, 0xbc, 0x93, 0x22, 0x74, 0x81, 0xd0, 0x39,
+	0x42, 0xad, 0x62, 0x82, 0x49, 0x82, 0x31, 0xe2, 0x5d, 0x0a, 0x57, 0x6b, 0x98, 0x10, 0xec, 0x83,
+	0x91, 0x9c, 0x9c, 0xd1, 0xb1, 0x61, 0x07, 0x13, 0x5e, 0xd2, 0xf2, 0x25, 0x77, 0x14, 0xd9, 0xcc,
+	0x23, 0x81, 0x68, 0x4d, 0x95, 0x8e, 0x52, 0x4e, 0x2e, 0x9b, 0x96, 0xb8, 0x09, 0x63, 0x48, 0xb1,
+	0x31, 0xee, 0xc4, 0x8b, 0xe0, 0xe4, 0x05, 0xc7, 0xa6, 0x90, 0x79, 0x1f, 0x10, 0x8f, 0x73, 0x36,
+	0xbf, 0x4b, 0x68, 0xab, 0x4f, 0xf1, 0xa3, 0xd8, 0x79, 0xcf, 0xf7, 0xc9, 0x0b, 0x3b, 0x18, 0x80,
+	0xdc, 0x45, 0xff, 0x25, 0x77, 0x81, 0x48, 0x91, 0x1a, 0x52, 0xab, 0x64, 0x2a, 0x9f, 0xce, 0xda,
+	0x55, 0xae, 0xd8, 0x73, 0xdd, 0x08, 0x28, 0x3d, 0x64, 0x91, 0x17, 0x60, 0x4b, 0x00, 0xe7, 0x3d,
+	0xa0, 0xac, 0xad, 0xd6, 0x03, 0xf2, 0x03, 0x54, 0xb2, 0x85, 0xa8, 0x52, 0x6c, 0x48, 0xad, 0x72,
+	0xb7, 0xaa, 0xa7, 0x53, 0xd0, 0xc5, 0x14, 0xf4, 0x5e, 0x30, 0x31, 0xb7, 0x3e, 0x9e, 0xb5, 0xaf,
+	0x3d, 0x04, 0xc8, 0x2c, 0x3e, 0xb6, 0xe6, 0x9d, 0xf2, 0x36, 0xda, 0x70, 0x46, 0x2e, 0x06, 0xa6,
+	0xac, 0xc7, 0xca, 0x16, 0x3f, 0xdd, 0xad, 0xbc, 0xfc, 0xf6, 0x7e, 0x57, 0x18, 0x6c, 0xde, 0x40,
+	0xb5, 0x85, 0x9b, 0x5a, 0x40, 0x43, 0x12, 0x50, 0x68, 0xbe, 0x92, 0x90, 0xdc, 0xa7, 0xd8, 0x82,
+	0x31, 0x39, 0x81, 0x7f, 0x3e, 0x88, 0x9c, 0xd3, 0x9b, 0x48, 0x5d, 0xf4, 0x92, 0x59, 0x7d, 0xbd,
+	0x86, 0x2a, 0x7d, 0x8a, 0x0f, 0x81, 0x99, 0xc9, 0x35, 0xff, 0xc8, 0xa4, 0x8c, 0xd6, 0x03, 0x7b,
+	0xc8, 0x1d, 0x5a, 0xc9, 0x5e, 0xf6, 0x51, 0x99, 0x86, 0x10, 0xb8, 0x47, 0xbe, 0x37, 0xf4, 0x98,
+	0x52, 0x6c, 0x14, 0x5b, 0xe5, 0x6e, 0x4d, 0xe7, 0x44, 0x71, 0x82, 0x44, 0xb6, 0xf5, 0xfb, 0xc4,
+	0x0b, 0xcc, 0xbd, 0xf3, 0x2f, 0xf5, 0xc2, 0xbb, 0xcb, 0x7a, 0x0b, 0x7b, 0xec, 0xd9, 0xc8, 0xd1,
+	0x07, 0x64, 0xc8, 0x53, 0xc9, 0x97, 0x36, 0x75, 0x4f, 0x0c, 0x36, 0x09, 0x81, 0x26, 0x0d, 0xd4,
+	0x42, 0x09, 0xff, 0x93, 0x98, 0x5e, 0xbe, 0x87, 0x36, 0x42, 0x88, 0x3c, 0xe2, 0x26, 0x8f, 0x16,
+	0x0b, 0xe5, 0x1f, 0xfe, 0x80, 0xc7, 0xdf, 0xfc, 0x3f, 0x16, 0x7a, 0x7b, 0x59, 0x97, 0x2c, 0xde,
+	0x92, 0x9b, 0xd7, 0x36, 0xaa, 0x5e, 0x1d, 0x48, 0x36, 0x29, 0x8c, 0x36, 0xfb, 0x14, 0x1f, 0x80,
+	0x0f, 0x0c, 0xfe, 0xee, 0xac, 0x72, 0x06, 0x6a, 0x68, 0x27, 0x27, 0x24, 0x3c, 0x74, 0x3f, 0x14,
+	0x51, 0xb1, 0x4f, 0xb1, 0x1c, 0xa2, 0xeb, 0xb9, 0x1f, 0xd9, 0xae, 0xfe, 0x8b, 0x7f, 0x0e, 0x7d,
+	0x21, 0xa6, 0x6a, 0x77, 0x75, 0xac, 0x50, 0x96, 0x29, 0xda, 0xcc, 0xc7, 0xf9, 0xce, 0x32, 0x9a,
+	0x1c, 0x58, 0xdd, 0xff, 0x0d, 0x70, 0x26, 0x6a, 0xa3, 0xd2, 0x3c, 0x98, 0xb7, 0x96, 0x31, 0x64,
+	0x30, 0xb5, 0xbd, 0x12, 0x2c, 0x93, 0x78, 0x8e, 0x2a, 0x3f, 0x3d, 0x69, 0x6b, 0x59, 0xfb, 0x55,
+	0xa4, 0xba, 0xb7, 0x2a, 0x52, 0x68, 0x99, 0xbd, 0xf3, 0xa9, 0x26, 0x5d, 0x4c, 0x35, 0xe9, 0xeb,
+	0x54, 0x93, 0xde, 0xcc, 0xb4, 0xc2, 0xc5, 0x4c, 0x2b, 0x7c, 0x9e, 0x69, 0x85, 0xa7, 0xb7, 0x97,
+	0x86, 0xfe, 0x34, 0xfb, 0x60, 0x38, 0x1b, 0x49, 0x9e, 0xf7, 0x7f, 0x0c, 0x00, 0xa2, 0x67, 0x91,
+	0x82, 0x4a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +529,16 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// SetBudget creates or resets a budget shared by the allowances of the
+	// granter which are linked to it.
+	//
+	// Since: cosmos-sdk 0.47
+	SetBudget(ctx context.Context, in *MsgSetBudget, opts ...grpc.CallOption) (*MsgSetBudgetResponse, error)
+	// DeleteBudget deletes a budget of the granter. The allowances linked to it
+	// can no longer be used.
+	//
+	// Since: cosmos-sdk 0.47
+	DeleteBudget(ctx context.Context, in *MsgDeleteBudget, opts ...grpc.CallOption) (*MsgDeleteBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -307,6 +567,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) SetBudget(ctx context.Context, in *MsgSetBudget, opts ...grpc.CallOption) (*MsgSetBudgetResponse, error) {
+	out := new(MsgSetBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/SetBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteBudget(ctx context.Context, in *MsgDeleteBudget, opts ...grpc.CallOption) (*MsgDeleteBudgetResponse, error) {
+	out := new(MsgDeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/DeleteBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -315,6 +593,16 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// SetBudget creates or resets a budget shared by the allowances of the
+	// granter which are linked to it.
+	//
+	// Since: cosmos-sdk 0.47
+	SetBudget(context.Context, *MsgSetBudget) (*MsgSetBudgetResponse, error)
+	// DeleteBudget deletes a budget of the granter. The allowances linked to it
+	// can no longer be used.
+	//
+	// Since: cosmos-sdk 0.47
+	DeleteBudget(context.Context, *MsgDeleteBudget) (*MsgDeleteBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -327,6 +615,12 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (*UnimplementedMsgServer) SetBudget(ctx context.Context, req *MsgSetBudget) (*MsgSetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (*UnimplementedMsgServer) DeleteBudget(ctx context.Context, req *MsgDeleteBudget) (*MsgDeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -368,6 +662,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/SetBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBudget(ctx, req.(*MsgSetBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/DeleteBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteBudget(ctx, req.(*MsgDeleteBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -380,6 +710,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _Msg_SetBudget_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _Msg_DeleteBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -405,6 +743,13 @@ func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Budget) > 0 {
+		i -= len(m.Budget)
+		copy(dAtA[i:], m.Budget)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Budget)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -517,68 +862,274 @@ func (m *MsgRevokeAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Budget)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -719,6 +1270,38 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -954,6 +1537,401 @@ func (m *MsgRevokeAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,434 @@
+package genutil
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GenTxCheckOptions defines the limits enforced by CheckGenTxs on top of the
+// stateless validation performed for every genesis transaction.
+type GenTxCheckOptions struct {
+	// MaxTotalPower is the maximum combined consensus power of all genesis
+	// transactions. It is ignored when not positive.
+	MaxTotalPower int64
+	// MaxValidatorPowerShare is the maximum share of the combined consensus
+	// power a single genesis validator may hold. It is ignored when nil or
+	// not positive.
+	MaxValidatorPowerShare sdk.Dec
+}
+
+// GenTxReport contains the result of checking a single genesis transaction.
+type GenTxReport struct {
+	File             string   `json:"file"`
+	Moniker          string   `json:"moniker,omitempty"`
+	ValidatorAddress string   `json:"validator_address,omitempty"`
+	DelegatorAddress string   `json:"delegator_address,omitempty"`
+	NodeAddress      string   `json:"node_address,omitempty"`
+	SelfDelegation   string   `json:"self_delegation,omitempty"`
+	Power            int64    `json:"power"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+// CeremonyReport contains the result of checking a directory of genesis
+// transactions against a genesis file.
+type CeremonyReport struct {
+	ChainID    string        `json:"chain_id"`
+	GenTxs     []GenTxReport `json:"gentxs"`
+	TotalPower int64         `json:"total_power"`
+	Errors     []string      `json:"errors,omitempty"`
+}
+
+// HasErrors returns true if any genesis transaction, or the set of genesis
+// transactions as a whole, failed a check.
+func (r CeremonyReport) HasErrors() bool {
+	if len(r.Errors) > 0 {
+		return true
+	}
+
+	for _, gentx := range r.GenTxs {
+		if len(gentx.Errors) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *GenTxReport) addError(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+// CheckGenTxs validates every genesis transaction in genTxsDir against the
+// provided genesis document. Contrary to CollectTxs, it does not stop at the
+// first failure but reports every problem found, including duplicate
+// validator operators, consensus keys, monikers and node IDs, delegators
+// without enough balance and violations of the power limits set in opts.
+func CheckGenTxs(
+	cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, genDoc tmtypes.GenesisDoc,
+	genTxsDir string, genBalIterator types.GenesisBalancesIterator, opts GenTxCheckOptions,
+) (CeremonyReport, error) {
+	report := CeremonyReport{ChainID: genDoc.ChainID, GenTxs: []GenTxReport{}}
+
+	appState, err := types.GenesisStateFromGenDoc(genDoc)
+	if err != nil {
+		return report, err
+	}
+
+	fos, err := os.ReadDir(genTxsDir)
+	if err != nil {
+		return report, err
+	}
+
+	bondDenom := sdk.DefaultBondDenom
+	if appState[stakingtypes.ModuleName] != nil {
+		bondDenom = stakingtypes.GetGenesisStateFromAppState(cdc, appState).Params.BondDenom
+	}
+
+	balancesMap := make(map[string]sdk.Coins)
+	genBalIterator.IterateGenesisBalances(
+		cdc, appState,
+		func(balance bankexported.GenesisBalance) (stop bool) {
+			balancesMap[balance.GetAddress().String()] = balance.GetCoins()
+			return false
+		},
+	)
+
+	var (
+		valAddrs    = make(map[string]string)
+		consKeys    = make(map[string]string)
+		monikers    = make(map[string]string)
+		nodeIDs     = make(map[string]string)
+		delegations = make(map[string]sdk.Int)
+	)
+
+	for _, fo := range fos {
+		if fo.IsDir() || !strings.HasSuffix(fo.Name(), ".json") {
+			continue
+		}
+
+		gentxReport := GenTxReport{File: fo.Name()}
+
+		jsonRawTx, err := os.ReadFile(filepath.Join(genTxsDir, fo.Name()))
+		if err != nil {
+			return report, err
+		}
+
+		genTx, err := types.ValidateAndGetGenTx(jsonRawTx, txJSONDecoder)
+		if err != nil {
+			gentxReport.addError("%s", err)
+			report.GenTxs = append(report.GenTxs, gentxReport)
+			continue
+		}
+
+		msg := genTx.GetMsgs()[0].(*stakingtypes.MsgCreateValidator)
+		gentxReport.Moniker = msg.Description.Moniker
+		gentxReport.ValidatorAddress = msg.ValidatorAddress
+		gentxReport.DelegatorAddress = msg.DelegatorAddress
+		gentxReport.SelfDelegation = msg.Value.String()
+		gentxReport.Power = sdk.TokensToConsensusPower(msg.Value.Amount, sdk.DefaultPowerReduction)
+
+		// the memo holds the node ID and address, e.g.
+		// "528fd3df22b31f4969b05652bfe8f0fe921321d5@192.168.2.37:26656"
+		if memoTx, ok := genTx.(sdk.TxWithMemo); ok {
+			gentxReport.NodeAddress = memoTx.GetMemo()
+		}
+
+		if gentxReport.NodeAddress == "" {
+			gentxReport.addError("missing node ID and address in memo")
+		} else if nodeID := strings.SplitN(gentxReport.NodeAddress, "@", 2)[0]; nodeIDs[nodeID] != "" {
+			gentxReport.addError("duplicate node ID %s, already used by %s", nodeID, nodeIDs[nodeID])
+		} else {
+			nodeIDs[nodeID] = fo.Name()
+		}
+
+		if msg.Value.Denom != bondDenom {
+			gentxReport.addError("self-delegation denom %s does not match bond denom %s", msg.Value.Denom, bondDenom)
+		}
+
+		if other, ok := valAddrs[msg.ValidatorAddress]; ok {
+			gentxReport.addError("duplicate validator operator %s, already used by %s", msg.ValidatorAddress, other)
+		} else {
+			valAddrs[msg.ValidatorAddress] = fo.Name()
+		}
+
+		if pk, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey); ok {
+			key := hex.EncodeToString(pk.Bytes())
+			if other, ok := consKeys[key]; ok {
+				gentxReport.addError("duplicate consensus public key %s, already used by %s", sdk.ConsAddress(pk.Address()), other)
+			} else {
+				consKeys[key] = fo.Name()
+			}
+		}
+
+		moniker := strings.ToLower(strings.TrimSpace(msg.Description.Moniker))
+		if other, ok := monikers[moniker]; ok {
+			gentxReport.addError("duplicate moniker %q, already used by %s", msg.Description.Moniker, other)
+		} else {
+			monikers[moniker] = fo.Name()
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err == nil {
+			if _, ok := balancesMap[sdk.AccAddress(valAddr).String()]; !ok {
+				gentxReport.addError("validator operator account %s has no balance in genesis state", sdk.AccAddress(valAddr))
+			}
+		}
+
+		// a delegator may sign several genesis transactions, in which case the
+		// sum of all its self-delegations must be covered by its balance
+		delegated, ok := delegations[msg.DelegatorAddress]
+		if !ok {
+			delegated = sdk.ZeroInt()
+		}
+		delegated = delegated.Add(msg.Value.Amount)
+		delegations[msg.DelegatorAddress] = delegated
+
+		if coins, ok := balancesMap[msg.DelegatorAddress]; !ok {
+			gentxReport.addError("delegator account %s has no balance in genesis state", msg.DelegatorAddress)
+		} else if coins.AmountOf(msg.Value.Denom).LT(delegated) {
+			gentxReport.addError(
+				"insufficient funds for delegation from %s: %s%s < %s%s",
+				msg.DelegatorAddress, coins.AmountOf(msg.Value.Denom), msg.Value.Denom, delegated, msg.Value.Denom,
+			)
+		}
+
+		report.TotalPower += gentxReport.Power
+		report.GenTxs = append(report.GenTxs, gentxReport)
+	}
+
+	if len(report.GenTxs) == 0 {
+		report.Errors = append(report.Errors, "there must be at least one genesis tx")
+	}
+
+	if opts.MaxTotalPower > 0 && report.TotalPower > opts.MaxTotalPower {
+		report.Errors = append(report.Errors,
+			fmt.Sprintf("total power %d exceeds the maximum of %d", report.TotalPower, opts.MaxTotalPower))
+	}
+
+	if !opts.MaxValidatorPowerShare.IsNil() && opts.MaxValidatorPowerShare.IsPositive() && report.TotalPower > 0 {
+		for i := range report.GenTxs {
+			share := sdk.NewDec(report.GenTxs[i].Power).QuoInt64(report.TotalPower)
+			if share.GT(opts.MaxValidatorPowerShare) {
+				report.GenTxs[i].addError("power share %s exceeds the maximum of %s", share, opts.MaxValidatorPowerShare)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// CanonicalGenesisHash returns the SHA-256 hash of the genesis file at the
+// given path. The file is hashed in its canonical form, i.e. compact JSON with
+// sorted keys, so that the hash does not depend on how the file is indented.
+func CanonicalGenesisHash(genFile string) ([]byte, error) {
+	bz, err := os.ReadFile(genFile)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted, err := sdk.SortJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(sorted)
+	return hash[:], nil
+}
+
+// GenesisAccountRecord defines a genesis account to be added with
+// AddGenesisAccounts. The account is a vesting account if VestingAmount is not
+// empty: a continuous one if VestingStart is set, a delayed one otherwise.
+type GenesisAccountRecord struct {
+	Address       sdk.AccAddress
+	Coins         sdk.Coins
+	VestingAmount sdk.Coins
+	VestingStart  int64
+	VestingEnd    int64
+}
+
+// ParseGenesisAccountsCSV reads genesis account records from CSV. Every row
+// has the following columns, where the vesting columns are optional:
+//
+//	address,coins[,vesting_amount,vesting_start,vesting_end]
+//
+// Coins are comma separated, so they must be quoted when more than one denom
+// is given. Vesting times are unix epochs. Rows starting with '#' and a header
+// row whose first column is "address" are ignored.
+func ParseGenesisAccountsCSV(r io.Reader) ([]GenesisAccountRecord, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []GenesisAccountRecord
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(row[0]), "address") {
+			continue
+		}
+
+		record, err := parseGenesisAccountRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("invalid row %d: %w", line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func parseGenesisAccountRow(row []string) (GenesisAccountRecord, error) {
+	var record GenesisAccountRecord
+
+	if len(row) != 2 && len(row) != 5 {
+		return record, fmt.Errorf("expected 2 or 5 columns, got %d", len(row))
+	}
+
+	addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(row[0]))
+	if err != nil {
+		return record, err
+	}
+	record.Address = addr
+
+	if record.Coins, err = sdk.ParseCoinsNormalized(strings.TrimSpace(row[1])); err != nil {
+		return record, fmt.Errorf("failed to parse coins: %w", err)
+	}
+
+	if len(row) == 2 {
+		return record, nil
+	}
+
+	if record.VestingAmount, err = sdk.ParseCoinsNormalized(strings.TrimSpace(row[2])); err != nil {
+		return record, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	if start := strings.TrimSpace(row[3]); start != "" {
+		if record.VestingStart, err = strconv.ParseInt(start, 10, 64); err != nil {
+			return record, fmt.Errorf("failed to parse vesting start time: %w", err)
+		}
+	}
+
+	if end := strings.TrimSpace(row[4]); end != "" {
+		if record.VestingEnd, err = strconv.ParseInt(end, 10, 64); err != nil {
+			return record, fmt.Errorf("failed to parse vesting end time: %w", err)
+		}
+	}
+
+	return record, nil
+}
+
+// GenesisAccount builds the genesis account described by the record.
+func (r GenesisAccountRecord) GenesisAccount() (authtypes.GenesisAccount, error) {
+	baseAccount := authtypes.NewBaseAccount(r.Address, nil, 0, 0)
+	if r.VestingAmount.IsZero() {
+		return baseAccount, nil
+	}
+
+	baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, r.VestingAmount.Sort(), r.VestingEnd)
+	if baseVestingAccount.OriginalVesting.IsAnyGT(r.Coins) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	switch {
+	case r.VestingStart != 0 && r.VestingEnd != 0:
+		return authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, r.VestingStart), nil
+
+	case r.VestingEnd != 0:
+		return authvesting.NewDelayedVestingAccountRaw(baseVestingAccount), nil
+
+	default:
+		return nil, errors.New("invalid vesting parameters; must supply start and end time or end time")
+	}
+}
+
+// AddGenesisAccounts adds the given accounts and their balances to the auth and
+// bank genesis states of appState. It fails without modifying appState if any
+// record is invalid or if an address already exists in the genesis state or
+// appears more than once in records.
+func AddGenesisAccounts(
+	cdc codec.Codec, appState map[string]json.RawMessage, records []GenesisAccountRecord,
+) (map[string]json.RawMessage, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return appState, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	seen := make(map[string]bool, len(records))
+	for _, record := range records {
+		if accs.Contains(record.Address) || seen[record.Address.String()] {
+			return appState, fmt.Errorf("cannot add account at existing address %s", record.Address)
+		}
+		seen[record.Address.String()] = true
+
+		genAccount, err := record.GenesisAccount()
+		if err != nil {
+			return appState, fmt.Errorf("invalid account %s: %w", record.Address, err)
+		}
+
+		if err := genAccount.Validate(); err != nil {
+			return appState, fmt.Errorf("failed to validate new genesis account %s: %w", record.Address, err)
+		}
+
+		accs = append(accs, genAccount)
+
+		balance := banktypes.Balance{Address: record.Address.String(), Coins: record.Coins.Sort()}
+		bankGenState.Balances = append(bankGenState.Balances, balance)
+		bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return appState, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return appState, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return appState, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	return appState, nil
+}
@@ -0,0 +1,182 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func writeGenTx(
+	t *testing.T, encCfg simappparams.EncodingConfig, dir, name, moniker, memo string,
+	delAddr sdk.AccAddress, consPk cryptotypes.PubKey, amount int64,
+) {
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(delAddr), consPk, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		stakingtypes.NewDescription(moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo(memo)
+
+	bz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), bz, 0o600))
+}
+
+func ceremonyGenDoc(t *testing.T, encCfg simappparams.EncodingConfig, balances ...banktypes.Balance) tmtypes.GenesisDoc {
+	appState := simapp.ModuleBasics.DefaultGenesis(encCfg.Codec)
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = balances
+	appState[banktypes.ModuleName] = encCfg.Codec.MustMarshalJSON(bankGenState)
+
+	bz, err := json.Marshal(appState)
+	require.NoError(t, err)
+
+	return tmtypes.GenesisDoc{ChainID: "test-chain", AppState: bz}
+}
+
+func TestCheckGenTxs(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	dir := t.TempDir()
+
+	del1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	del2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	del3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	consPk1 := ed25519.GenPrivKey().PubKey()
+	consPk2 := ed25519.GenPrivKey().PubKey()
+
+	genDoc := ceremonyGenDoc(t, encCfg,
+		banktypes.Balance{Address: del1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))},
+		banktypes.Balance{Address: del2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))},
+		banktypes.Balance{Address: del3.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))},
+	)
+
+	writeGenTx(t, encCfg, dir, "1.json", "alice", "id1@127.0.0.1:26656", del1, consPk1, 5_000_000)
+
+	report, err := genutil.CheckGenTxs(
+		encCfg.Codec, encCfg.TxConfig.TxJSONDecoder(), genDoc, dir, banktypes.GenesisBalancesIterator{}, genutil.GenTxCheckOptions{},
+	)
+	require.NoError(t, err)
+	require.False(t, report.HasErrors(), report)
+	require.Len(t, report.GenTxs, 1)
+	require.Equal(t, int64(5), report.TotalPower)
+	require.Equal(t, "alice", report.GenTxs[0].Moniker)
+
+	// insufficient balance, duplicate consensus key, moniker and node ID
+	writeGenTx(t, encCfg, dir, "2.json", "Alice ", "id1@127.0.0.2:26656", del2, consPk1, 2_000_000)
+	writeGenTx(t, encCfg, dir, "3.json", "carol", "", del3, consPk2, 9_000_000)
+
+	report, err = genutil.CheckGenTxs(
+		encCfg.Codec, encCfg.TxConfig.TxJSONDecoder(), genDoc, dir, banktypes.GenesisBalancesIterator{},
+		genutil.GenTxCheckOptions{MaxTotalPower: 10, MaxValidatorPowerShare: sdk.NewDecWithPrec(5, 1)},
+	)
+	require.NoError(t, err)
+	require.True(t, report.HasErrors())
+	require.Len(t, report.GenTxs, 3)
+	require.Equal(t, int64(16), report.TotalPower)
+	require.Len(t, report.Errors, 1)
+	require.Empty(t, report.GenTxs[0].Errors)
+
+	errs := strings.Join(report.GenTxs[1].Errors, "\n")
+	require.Contains(t, errs, "duplicate node ID id1")
+	require.Contains(t, errs, "duplicate consensus public key")
+	require.Contains(t, errs, "duplicate moniker")
+	require.Contains(t, errs, "insufficient funds")
+
+	errs = strings.Join(report.GenTxs[2].Errors, "\n")
+	require.Contains(t, errs, "missing node ID")
+	require.Contains(t, errs, "power share")
+}
+
+func TestParseGenesisAccountsCSV(t *testing.T) {
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	csv := "address,coins,vesting_amount,vesting_start,vesting_end\n" +
+		"# comment\n" +
+		addr1.String() + ",100stake\n" +
+		addr2.String() + ",\"100stake,50atom\",50stake,1000,2000\n"
+
+	records, err := genutil.ParseGenesisAccountsCSV(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, addr1, records[0].Address)
+	require.True(t, records[0].VestingAmount.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 100)), records[1].Coins)
+	require.Equal(t, int64(1000), records[1].VestingStart)
+	require.Equal(t, int64(2000), records[1].VestingEnd)
+
+	_, err = genutil.ParseGenesisAccountsCSV(strings.NewReader(addr1.String() + ",100stake,50stake\n"))
+	require.Error(t, err)
+
+	_, err = genutil.ParseGenesisAccountsCSV(strings.NewReader("invalid,100stake\n"))
+	require.Error(t, err)
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	appState := simapp.ModuleBasics.DefaultGenesis(encCfg.Codec)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	records := []genutil.GenesisAccountRecord{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{
+			Address:       addr2,
+			Coins:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			VestingAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			VestingEnd:    2000,
+		},
+	}
+
+	appState, err := genutil.AddGenesisAccounts(encCfg.Codec, appState, records)
+	require.NoError(t, err)
+
+	authGenState := authtypes.GetGenesisStateFromAppState(encCfg.Codec, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 2)
+
+	for _, acc := range accs {
+		if acc.GetAddress().Equals(addr2) {
+			require.IsType(t, &authvesting.DelayedVestingAccount{}, acc)
+		}
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(encCfg.Codec, appState)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), bankGenState.Supply)
+
+	// adding an existing address fails
+	_, err = genutil.AddGenesisAccounts(encCfg.Codec, appState, records[:1])
+	require.Error(t, err)
+
+	// vesting more than the balance fails
+	_, err = genutil.AddGenesisAccounts(encCfg.Codec, appState, []genutil.GenesisAccountRecord{{
+		Address:       sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		Coins:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		VestingAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+		VestingEnd:    2000,
+	}})
+	require.Error(t, err)
+}
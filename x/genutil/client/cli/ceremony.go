@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagMaxTotalPower          = "max-total-power"
+	flagMaxValidatorPowerShare = "max-validator-power-share"
+)
+
+// CeremonyCmd returns the command group used by launch coordinators to check
+// genesis transactions and finalize the genesis file.
+func CeremonyCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "ceremony",
+		Short:                      "Genesis ceremony subcommands for launch coordinators",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CheckGenTxsCmd(genBalIterator, defaultNodeHome),
+		FinalizeGenesisCmd(genBalIterator, defaultNodeHome),
+		BulkAddGenesisAccountsCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// CheckGenTxsCmd returns the command that checks a directory of genesis
+// transactions against a genesis file and prints a detailed report.
+func CheckGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-gentxs [genesis-file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Check genesis transactions against a genesis file and report every problem found",
+		Long: fmt.Sprintf(`Check every genesis transaction in the gentx directory against the genesis file
+at the default location or at the location passed as an argument. Contrary to collect-gentxs, all
+problems are reported at once, including duplicate validator operators, consensus keys, monikers and
+node IDs, delegators without enough balance and violations of the configured power limits.
+
+Example:
+$ %s genesis ceremony check-gentxs --gentx-dir=/path/to/gentxs --max-total-power=1000 --max-validator-power-share=0.1
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			opts, err := genTxCheckOptionsFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			report, err := genutil.CheckGenTxs(
+				clientCtx.Codec, clientCtx.TxConfig.TxJSONDecoder(), *genDoc,
+				genTxsDirFromCmd(cmd, config.RootDir), genBalIterator, opts,
+			)
+			if err != nil {
+				return err
+			}
+
+			if err := printCeremonyReport(cmd, report); err != nil {
+				return err
+			}
+
+			if report.HasErrors() {
+				return errors.New("genesis transactions check failed")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	addGenTxCheckFlags(cmd)
+
+	return cmd
+}
+
+// FinalizeGenesisCmd returns the command that checks and collects the genesis
+// transactions, sets the genesis time and prints the canonical hash of the
+// resulting genesis file.
+func FinalizeGenesisCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize",
+		Args:  cobra.NoArgs,
+		Short: "Check and collect genesis txs, set the genesis time and print the canonical genesis hash",
+		Long: fmt.Sprintf(`Check the genesis transactions like check-gentxs does, then collect them into the
+genesis file, set its genesis time and print the SHA-256 hash of the resulting genesis file in its
+canonical form (compact JSON with sorted keys), so that participants can compare it with their own copy.
+
+Example:
+$ %s genesis ceremony finalize --genesis-time=2022-07-01T15:00:00Z --gentx-dir=/path/to/gentxs
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			nodeID, valPubKey, err := genutil.InitializeNodeValidatorFiles(config)
+			if err != nil {
+				return errors.Wrap(err, "failed to initialize node validator files")
+			}
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			opts, err := genTxCheckOptionsFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			genTxsDir := genTxsDirFromCmd(cmd, config.RootDir)
+			report, err := genutil.CheckGenTxs(
+				clientCtx.Codec, clientCtx.TxConfig.TxJSONDecoder(), *genDoc, genTxsDir, genBalIterator, opts,
+			)
+			if err != nil {
+				return err
+			}
+
+			if report.HasErrors() {
+				if err := printCeremonyReport(cmd, report); err != nil {
+					return err
+				}
+
+				return errors.New("genesis transactions check failed, genesis file left untouched")
+			}
+
+			if genesisTime, _ := cmd.Flags().GetString(flagGenesisTime); genesisTime != "" {
+				var t time.Time
+				if err := t.UnmarshalText([]byte(genesisTime)); err != nil {
+					return errors.Wrap(err, "failed to unmarshal genesis time")
+				}
+
+				genDoc.GenesisTime = t
+			}
+
+			initCfg := types.NewInitConfig(genDoc.ChainID, genTxsDir, nodeID, valPubKey)
+			if _, err := genutil.GenAppStateFromConfig(
+				clientCtx.Codec, clientCtx.TxConfig, config, initCfg, *genDoc, genBalIterator,
+			); err != nil {
+				return errors.Wrap(err, "failed to get genesis app state from config")
+			}
+
+			hash, err := genutil.CanonicalGenesisHash(config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to compute genesis hash")
+			}
+
+			genDoc, err = tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			out, err := json.MarshalIndent(finalizeInfo{
+				ChainID:     genDoc.ChainID,
+				GenesisTime: genDoc.GenesisTime,
+				GenTxs:      len(report.GenTxs),
+				TotalPower:  report.TotalPower,
+				SHA256:      hex.EncodeToString(hash),
+			}, "", " ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagGenesisTime, "", "set genesis_time with this flag (RFC3339), keep the current one if empty")
+	addGenTxCheckFlags(cmd)
+
+	return cmd
+}
+
+// BulkAddGenesisAccountsCmd returns the command that imports genesis accounts
+// and vesting schedules from a CSV file.
+func BulkAddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-add-accounts [csv-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add genesis accounts and vesting schedules from a CSV file to genesis.json",
+		Long: fmt.Sprintf(`Add genesis accounts to genesis.json from a CSV file with the following columns,
+the vesting ones being optional:
+
+    address,coins[,vesting_amount,vesting_start,vesting_end]
+
+Coins must be quoted when more than one denom is given. Vesting times are unix epochs: accounts
+with a start and end time get a continuous vesting schedule, accounts with only an end time a
+delayed one. Lines starting with '#' and a header line starting with "address" are ignored.
+The genesis file is left untouched if any line is invalid.
+
+Example:
+$ %s genesis ceremony bulk-add-accounts accounts.csv
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			records, err := genutil.ParseGenesisAccountsCSV(f)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse accounts file")
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := types.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to unmarshal genesis state")
+			}
+
+			appState, err = genutil.AddGenesisAccounts(clientCtx.Codec, appState, records)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal application genesis state")
+			}
+
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			cmd.Printf("Added %d genesis accounts\n", len(records))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+type finalizeInfo struct {
+	ChainID     string    `json:"chain_id"`
+	GenesisTime time.Time `json:"genesis_time"`
+	GenTxs      int       `json:"gentxs"`
+	TotalPower  int64     `json:"total_power"`
+	SHA256      string    `json:"sha256"`
+}
+
+func addGenTxCheckFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagGenTxDir, "", "override default \"gentx\" directory from which to check genesis transactions; default [--home]/config/gentx/")
+	cmd.Flags().Int64(flagMaxTotalPower, 0, "maximum combined consensus power of all genesis transactions, ignored if zero")
+	cmd.Flags().String(flagMaxValidatorPowerShare, "", "maximum share of the combined consensus power held by a single validator (e.g. 0.1), ignored if empty")
+}
+
+func genTxCheckOptionsFromCmd(cmd *cobra.Command) (genutil.GenTxCheckOptions, error) {
+	var opts genutil.GenTxCheckOptions
+
+	opts.MaxTotalPower, _ = cmd.Flags().GetInt64(flagMaxTotalPower)
+
+	if share, _ := cmd.Flags().GetString(flagMaxValidatorPowerShare); share != "" {
+		dec, err := sdk.NewDecFromStr(share)
+		if err != nil {
+			return opts, errors.Wrapf(err, "invalid %s", flagMaxValidatorPowerShare)
+		}
+
+		opts.MaxValidatorPowerShare = dec
+	}
+
+	return opts, nil
+}
+
+func genTxsDirFromCmd(cmd *cobra.Command, rootDir string) string {
+	if genTxsDir, _ := cmd.Flags().GetString(flagGenTxDir); genTxsDir != "" {
+		return genTxsDir
+	}
+
+	return filepath.Join(rootDir, "config", "gentx")
+}
+
+func printCeremonyReport(cmd *cobra.Command, report genutil.CeremonyReport) error {
+	out, err := json.MarshalIndent(report, "", " ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(out))
+	return nil
+}
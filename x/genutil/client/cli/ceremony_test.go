@@ -0,0 +1,193 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupCeremonyHome writes a genesis file funding the given delegator and a
+// gentx of the given amount to a fresh home directory.
+func setupCeremonyHome(t *testing.T, encCfg simappparams.EncodingConfig, delAddr sdk.AccAddress, amount int64) (string, context.Context) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	appState := simapp.ModuleBasics.DefaultGenesis(encCfg.Codec)
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = []banktypes.Balance{
+		{Address: delAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))},
+	}
+	appState[banktypes.ModuleName] = encCfg.Codec.MustMarshalJSON(bankGenState)
+
+	appStateBz, err := json.Marshal(appState)
+	require.NoError(t, err)
+
+	genDoc := tmtypes.GenesisDoc{ChainID: "test-chain", AppState: appStateBz}
+	require.NoError(t, genDoc.SaveAs(cfg.GenesisFile()))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(delAddr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		stakingtypes.NewDescription("alice", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("id1@127.0.0.1:26656")
+
+	bz, err := encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	genTxsDir := filepath.Join(home, "config", "gentx")
+	require.NoError(t, os.MkdirAll(genTxsDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(genTxsDir, "alice.json"), bz, 0o600))
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino).
+		WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	return home, ctx
+}
+
+func executeCeremonyCmd(ctx context.Context, cmd *cobra.Command, args ...string) (string, error) {
+	_, out := testutil.ApplyMockIO(cmd)
+	cmd.SetArgs(args)
+
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestCheckGenTxsCmd(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	delAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	home, ctx := setupCeremonyHome(t, encCfg, delAddr, 5_000_000)
+
+	out, err := executeCeremonyCmd(ctx, genutilcli.CheckGenTxsCmd(banktypes.GenesisBalancesIterator{}, home),
+		fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+	)
+	require.NoError(t, err)
+	require.Contains(t, out, `"total_power": 5`)
+
+	out, err = executeCeremonyCmd(ctx, genutilcli.CheckGenTxsCmd(banktypes.GenesisBalancesIterator{}, home),
+		fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+		"--max-total-power=1",
+	)
+	require.EqualError(t, err, "genesis transactions check failed")
+	require.Contains(t, out, "total power 5 exceeds the maximum of 1")
+}
+
+func TestFinalizeGenesisCmd(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	delAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	home, ctx := setupCeremonyHome(t, encCfg, delAddr, 5_000_000)
+
+	out, err := executeCeremonyCmd(ctx, genutilcli.FinalizeGenesisCmd(banktypes.GenesisBalancesIterator{}, home),
+		fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+		"--genesis-time=2022-07-01T15:00:00Z",
+	)
+	require.NoError(t, err)
+
+	var info struct {
+		ChainID string `json:"chain_id"`
+		GenTxs  int    `json:"gentxs"`
+		SHA256  string `json:"sha256"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &info))
+	require.Equal(t, "test-chain", info.ChainID)
+	require.Equal(t, 1, info.GenTxs)
+	require.Len(t, info.SHA256, 64)
+
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, "2022-07-01T15:00:00Z", genDoc.GenesisTime.UTC().Format("2006-01-02T15:04:05Z07:00"))
+	require.Len(t, genutiltypes.GetGenesisStateFromAppState(encCfg.Codec, appState).GenTxs, 1)
+}
+
+func TestFinalizeGenesisCmdInvalidGenTxs(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	delAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	home, ctx := setupCeremonyHome(t, encCfg, delAddr, 50_000_000)
+
+	genFile := filepath.Join(home, "config", "genesis.json")
+	before, err := os.ReadFile(genFile)
+	require.NoError(t, err)
+
+	_, err = executeCeremonyCmd(ctx, genutilcli.FinalizeGenesisCmd(banktypes.GenesisBalancesIterator{}, home),
+		fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+	)
+	require.EqualError(t, err, "genesis transactions check failed, genesis file left untouched")
+
+	after, err := os.ReadFile(genFile)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
+
+func TestBulkAddGenesisAccountsCmd(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	delAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	home, ctx := setupCeremonyHome(t, encCfg, delAddr, 5_000_000)
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	csvFile := filepath.Join(home, "accounts.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte(
+		"address,coins,vesting_amount,vesting_start,vesting_end\n"+
+			addr1.String()+",100stake\n"+
+			addr2.String()+",100stake,50stake,1000,2000\n",
+	), 0o600))
+
+	out, err := executeCeremonyCmd(ctx, genutilcli.BulkAddGenesisAccountsCmd(home),
+		csvFile, fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+	)
+	require.NoError(t, err)
+	require.Contains(t, out, "Added 2 genesis accounts")
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	accs, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(encCfg.Codec, appState).Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 2)
+	require.Len(t, banktypes.GetGenesisStateFromAppState(encCfg.Codec, appState).Balances, 3)
+
+	// a file with an invalid line leaves the genesis file untouched
+	require.NoError(t, os.WriteFile(csvFile, []byte("invalid,100stake\n"), 0o600))
+	_, err = executeCeremonyCmd(ctx, genutilcli.BulkAddGenesisAccountsCmd(home),
+		csvFile, fmt.Sprintf("--%s=%s", cli.HomeFlag, home),
+	)
+	require.Error(t, err)
+}
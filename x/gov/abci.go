@@ -57,46 +57,23 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		}
 
 		if passes {
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err == nil {
-				for idx, msg = range messages {
-					handler := keeper.Router().Handler(msg)
-					_, err = handler(cacheCtx, msg)
-					if err != nil {
-						break
-					}
-				}
+			executionDelay := time.Duration(0)
+			if messages, err := proposal.GetMsgs(); err == nil {
+				executionDelay = keeper.GetExecutionParams(ctx).ExecutionDelayFor(messages)
 			}
 
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
-				// any events emitted, so we re-emit to "merge" the events into the
-				// original Context's EventManager.
-				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-
-				// write state to the underlying multi-store
-				writeCache()
+			if executionDelay > 0 {
+				// the messages are executed once the execution delay has
+				// expired, unless the execution gets cancelled meanwhile
+				executionTime := ctx.BlockHeader().Time.Add(executionDelay)
+				proposal.ExecutionTime = &executionTime
+				proposal.Status = v1.StatusPassedPendingExecution
+				keeper.InsertPendingExecutionProposalQueue(ctx, proposal.Id, executionTime)
+
+				tagValue = types.AttributeValueProposalPendingExecution
+				logMsg = fmt.Sprintf("passed, pending execution at %s", executionTime)
 			} else {
-				proposal.Status = v1.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				proposal.Status, tagValue, logMsg = executeProposal(ctx, keeper, proposal)
 			}
 		} else {
 			proposal.Status = v1.StatusRejected
@@ -127,4 +104,85 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		)
 		return false
 	})
+	// execute the passed proposals whose execution delay has expired, unless
+	// they have been vetoed meanwhile
+	keeper.IteratePendingExecutionProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
+		var tagValue, logMsg string
+
+		vetoed, _ := keeper.TallyEmergencyVeto(ctx, proposal)
+		if vetoed {
+			proposal.Status = v1.StatusRejected
+			tagValue = types.AttributeValueProposalVetoed
+			logMsg = "vetoed during its execution delay"
+		} else {
+			proposal.Status, tagValue, logMsg = executeProposal(ctx, keeper, proposal)
+		}
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.RemoveFromPendingExecutionProposalQueue(ctx, proposal.Id, *proposal.ExecutionTime)
+
+		logger.Info(
+			"proposal execution delay expired",
+			"proposal", proposal.Id,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePendingExecutionProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			),
+		)
+		return false
+	})
+
+	// prune the conviction locks which have ended
+	keeper.IterateExpiredConvictionLocksQueue(ctx, ctx.BlockHeader().Time, func(lock v1.ConvictionLock) bool {
+		keeper.RemoveConvictionLock(ctx, lock)
+		return false
+	})
+}
+
+// executeProposal attempts to execute all the messages of a passed proposal
+// and returns the resulting proposal status, together with the event tag and
+// log message describing it.
+func executeProposal(ctx sdk.Context, keeper keeper.Keeper, proposal v1.Proposal) (status v1.ProposalStatus, tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	cacheCtx, writeCache := ctx.CacheContext()
+	messages, err := proposal.GetMsgs()
+	if err == nil {
+		for idx, msg = range messages {
+			handler := keeper.Router().Handler(msg)
+			_, err = handler(cacheCtx, msg)
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err != nil {
+		return v1.StatusFailed, types.AttributeValueProposalFailed,
+			fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	// The cached context is created with a new EventManager. However, since
+	// the proposal handler execution was successful, we want to track/keep
+	// any events emitted, so we re-emit to "merge" the events into the
+	// original Context's EventManager.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	return v1.StatusPassed, types.AttributeValueProposalPassed, "passed"
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -384,3 +385,167 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestProposalPendingExecution(t *testing.T) {
+	testCases := []struct {
+		name           string
+		malleate       func(ctx sdk.Context, app *simapp.SimApp, addrs []sdk.AccAddress, proposalID uint64)
+		expectedStatus v1.ProposalStatus
+	}{
+		{
+			"executed once the execution delay has expired",
+			func(sdk.Context, *simapp.SimApp, []sdk.AccAddress, uint64) {},
+			v1.StatusPassed,
+		},
+		{
+			"emergency vetoed",
+			func(ctx sdk.Context, app *simapp.SimApp, addrs []sdk.AccAddress, proposalID uint64) {
+				err := app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.ErrorIs(t, err, types.ErrInvalidVote)
+
+				err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+				require.NoError(t, err)
+			},
+			v1.StatusRejected,
+		},
+		{
+			"cancelled by the guardian",
+			func(ctx sdk.Context, app *simapp.SimApp, addrs []sdk.AccAddress, proposalID uint64) {
+				govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+
+				_, err := govMsgSvr.CancelProposalExecution(sdk.WrapSDKContext(ctx), v1.NewMsgCancelProposalExecution(addrs[0], proposalID))
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+				_, err = govMsgSvr.CancelProposalExecution(sdk.WrapSDKContext(ctx), v1.NewMsgCancelProposalExecution(addrs[1], proposalID))
+				require.NoError(t, err)
+
+				_, err = govMsgSvr.CancelProposalExecution(sdk.WrapSDKContext(ctx), v1.NewMsgCancelProposalExecution(addrs[1], proposalID))
+				require.ErrorIs(t, err, types.ErrNotPendingExecution)
+			},
+			v1.StatusRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			// proposals containing a legacy content are delayed longer than the others
+			content := mkTestLegacyContent(t)
+			app.GovKeeper.SetExecutionParams(ctx, v1.NewExecutionParams(
+				time.Hour,
+				[]v1.MsgExecutionDelay{{MsgTypeUrl: sdk.MsgTypeURL(content), Delay: 2 * time.Hour}},
+				addrs[1].String(),
+				v1.DefaultEmergencyVetoThreshold,
+			))
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{content}, "")
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], proposalCoins)
+			require.NoError(t, err)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(*app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusPassedPendingExecution, proposal.Status)
+			require.Equal(t, ctx.BlockHeader().Time.Add(2*time.Hour), *proposal.ExecutionTime)
+
+			tc.malleate(ctx, app, addrs, proposal.Id)
+
+			// nothing happens right before the execution time
+			ctx = ctx.WithBlockTime(proposal.ExecutionTime.Add(-time.Second))
+			before, _ := app.GovKeeper.GetProposal(ctx, proposal.Id)
+			gov.EndBlocker(ctx, app.GovKeeper)
+			after, _ := app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.Equal(t, before.Status, after.Status)
+
+			ctx = ctx.WithBlockTime(*proposal.ExecutionTime)
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedStatus, proposal.Status)
+
+			pendingQueue := app.GovKeeper.PendingExecutionProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, pendingQueue.Valid())
+			pendingQueue.Close()
+			require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.Id))
+		})
+	}
+}
+
+func TestConvictionLockEndBlocker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	lockDuration := time.Hour * 24 * 28
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ConvictionLevels = []v1.ConvictionLevel{v1.NewConvictionLevel(lockDuration, sdk.NewDec(2))}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	voteMsg := v1.NewMsgVote(addrs[0], proposal.Id, v1.OptionYes, "")
+	voteMsg.Conviction = 1
+	_, err = govMsgSvr.Vote(sdk.WrapSDKContext(ctx), voteMsg)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(*app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the tokens of the voter are locked from the tally of their vote
+	lock, found := app.GovKeeper.GetConvictionLock(ctx, addrs[0], proposal.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), lock.Amount)
+	require.Equal(t, ctx.BlockTime().Add(lockDuration), lock.EndTime)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = lock.EndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	_, found = app.GovKeeper.GetConvictionLock(ctx, addrs[0], proposal.Id)
+	require.False(t, found)
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -38,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryConvictionLocks(),
 	)
 
 	return govQueryCmd
@@ -143,29 +146,29 @@ $ %s query gov proposals --page=2 --limit=100
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.Proposals(
-				cmd.Context(),
-				&v1.QueryProposalsRequest{
-					ProposalStatus: proposalStatus,
-					Voter:          bechVoterAddr,
-					Depositor:      bechDepositorAddr,
-					Pagination:     pageReq,
-				},
-			)
-			if err != nil {
-				return err
-			}
+			found := false
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				res, err := queryClient.Proposals(
+					ctx,
+					&v1.QueryProposalsRequest{
+						ProposalStatus: proposalStatus,
+						Voter:          bechVoterAddr,
+						Depositor:      bechDepositorAddr,
+						Pagination:     pageReq,
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
 
-			if len(res.GetProposals()) == 0 {
-				return fmt.Errorf("no proposals found")
-			}
+				// filtered pages may be empty, so only fail if none of them had proposals
+				found = found || len(res.GetProposals()) > 0
+				if !found && len(res.GetPagination().GetNextKey()) == 0 {
+					return nil, fmt.Errorf("no proposals found")
+				}
 
-			return clientCtx.PrintProto(res)
+				return res, nil
+			})
 		},
 	}
 
@@ -293,6 +296,10 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 
 			propStatus := proposalRes.GetProposal().Status
 			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
+				if all, _ := cmd.Flags().GetBool(flags.FlagAll); all {
+					return fmt.Errorf("--%s is not supported for the votes of proposals past their voting period", flags.FlagAll)
+				}
+
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -310,20 +317,12 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.Votes(
-				ctx,
-				&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Votes(
+					ctx,
+					&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+				)
+			})
 		},
 	}
 
@@ -428,20 +427,12 @@ $ %s query gov deposits 1
 				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			res, err := queryClient.Deposits(
-				ctx,
-				&v1.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq},
-			)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Deposits(
+					ctx,
+					&v1.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq},
+				)
+			})
 		},
 	}
 
@@ -530,7 +521,7 @@ $ %s query gov params
 			}
 			queryClient := v1.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
+			// Query store for all 4 params
 			ctx := cmd.Context()
 			votingRes, err := queryClient.Params(
 				ctx,
@@ -556,10 +547,19 @@ $ %s query gov params
 				return err
 			}
 
+			executionRes, err := queryClient.Params(
+				ctx,
+				&v1.QueryParamsRequest{ParamsType: "execution"},
+			)
+			if err != nil {
+				return err
+			}
+
 			params := v1.NewParams(
 				*votingRes.GetVotingParams(),
 				*tallyRes.GetTallyParams(),
 				*depositRes.GetDepositParams(),
+				*executionRes.GetExecutionParams(),
 			)
 
 			return clientCtx.PrintObjectLegacy(params)
@@ -576,7 +576,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|execution) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -584,8 +584,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param execution
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -612,8 +613,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "execution":
+				out = res.GetExecutionParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|execution), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -665,3 +668,46 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryConvictionLocks implements the query conviction locks command.
+func GetCmdQueryConvictionLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conviction-locks [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokens of a voter locked by their conviction votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the conviction locks of a voter, created at the tally of their
+conviction votes. The locked tokens can't be spent until the end of the locks.
+
+Example:
+$ %s query gov conviction-locks cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.ConvictionLocks(
+					ctx,
+					&v1.QueryConvictionLocksRequest{Voter: voterAddr.String(), Pagination: pageReq},
+				)
+			})
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "conviction locks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
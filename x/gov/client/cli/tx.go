@@ -31,6 +31,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagMetadata     = "metadata"
+	flagConviction   = "conviction"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -70,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdCancelProposalExecution(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -252,6 +254,46 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposalExecution implements cancelling the execution of a passed
+// proposal pending execution.
+func NewCmdCancelProposalExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-execution [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the execution of a passed proposal pending execution, as the guardian",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the execution of a passed proposal during its execution delay. The
+transaction must be signed by the guardian set in the governance execution params.
+
+Example:
+$ %s tx gov cancel-execution 1 --from guardian
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposalExecution(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -264,8 +306,12 @@ find the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
+
+To lock your tokens after the end of the voting period in exchange for a
+greater vote weight, choose a conviction level of the voting params:
+$ %s tx gov vote 1 yes --conviction 2 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -293,14 +339,21 @@ $ %s tx gov vote 1 yes --from mykey
 				return err
 			}
 
+			conviction, err := cmd.Flags().GetUint32(flagConviction)
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := v1.NewMsgVote(from, proposalID, byteVoteOption, metadata)
+			msg.Conviction = conviction
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagMetadata, "", "Specify metadata of the vote")
+	cmd.Flags().Uint32(flagConviction, 0, "Specify the 1-based index of the conviction level of the voting params to lock your tokens for, none if 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -348,13 +401,20 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 				return err
 			}
 
+			conviction, err := cmd.Flags().GetUint32(flagConviction)
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := v1.NewMsgVoteWeighted(from, proposalID, options, metadata)
+			msg.Conviction = conviction
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagMetadata, "", "Specify metadata of the weighted vote")
+	cmd.Flags().Uint32(flagConviction, 0, "Specify the 1-based index of the conviction level of the voting params to lock your tokens for, none if 0")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -24,7 +24,7 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","conviction_levels":null},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"},"execution_params":{"execution_delay":"0","msg_execution_delays":null,"emergency_veto_threshold":"0.334000000000000000"}}`,
 		},
 		{
 			"text output",
@@ -35,11 +35,16 @@ deposit_params:
   min_deposit:
   - amount: "10000000"
     denom: stake
+execution_params:
+  emergency_veto_threshold: "0.334000000000000000"
+  execution_delay: "0"
+  msg_execution_delays: null
 tally_params:
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  conviction_levels: null
   voting_period: "172800000000000"
 	`,
 		},
@@ -73,7 +78,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","conviction_levels":null}`,
 		},
 		{
 			"tally params",
@@ -91,6 +96,14 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 			},
 			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
 		},
+		{
+			"execution params",
+			[]string{
+				"execution",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"execution_delay":"0","msg_execution_delays":null,"emergency_veto_threshold":"0.334000000000000000"}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -15,6 +15,9 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, *data.DepositParams)
 	k.SetVotingParams(ctx, *data.VotingParams)
 	k.SetTallyParams(ctx, *data.TallyParams)
+	if data.ExecutionParams != nil {
+		k.SetExecutionParams(ctx, *data.ExecutionParams)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -38,10 +41,16 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
 		case v1.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		case v1.StatusPassedPendingExecution:
+			k.InsertPendingExecutionProposalQueue(ctx, proposal.Id, *proposal.ExecutionTime)
 		}
 		k.SetProposal(ctx, *proposal)
 	}
 
+	for _, lock := range data.ConvictionLocks {
+		k.SetConvictionLock(ctx, lock)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -60,6 +69,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	executionParams := k.GetExecutionParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits v1.Deposits
@@ -80,5 +90,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v1.GenesisState {
 		DepositParams:      &depositParams,
 		VotingParams:       &votingParams,
 		TallyParams:        &tallyParams,
+		ExecutionParams:    &executionParams,
+		ConvictionLocks:    k.GetAllConvictionLocks(ctx),
 	}
 }
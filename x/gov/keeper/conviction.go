@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GetConvictionLock gets the conviction lock of a voter on a specific proposal
+func (keeper Keeper) GetConvictionLock(ctx sdk.Context, voterAddr sdk.AccAddress, proposalID uint64) (lock v1.ConvictionLock, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ConvictionLockKey(voterAddr, proposalID))
+	if bz == nil {
+		return lock, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetConvictionLock sets a conviction lock to the gov store and inserts it in
+// the conviction lock queue, replacing any lock of the voter on the proposal
+func (keeper Keeper) SetConvictionLock(ctx sdk.Context, lock v1.ConvictionLock) {
+	voterAddr := sdk.MustAccAddressFromBech32(lock.Voter)
+	if prev, found := keeper.GetConvictionLock(ctx, voterAddr, lock.ProposalId); found {
+		keeper.RemoveConvictionLock(ctx, prev)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ConvictionLockKey(voterAddr, lock.ProposalId), keeper.cdc.MustMarshal(&lock))
+	store.Set(types.ConvictionLockQueueKey(voterAddr, lock.ProposalId, lock.EndTime), []byte{})
+}
+
+// RemoveConvictionLock removes a conviction lock from the gov store and from the
+// conviction lock queue
+func (keeper Keeper) RemoveConvictionLock(ctx sdk.Context, lock v1.ConvictionLock) {
+	voterAddr := sdk.MustAccAddressFromBech32(lock.Voter)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ConvictionLockKey(voterAddr, lock.ProposalId))
+	store.Delete(types.ConvictionLockQueueKey(voterAddr, lock.ProposalId, lock.EndTime))
+}
+
+// IterateConvictionLocks iterates over the conviction locks of a voter and
+// performs a callback function
+func (keeper Keeper) IterateConvictionLocks(ctx sdk.Context, voterAddr sdk.AccAddress, cb func(lock v1.ConvictionLock) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConvictionLocksKey(voterAddr))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock v1.ConvictionLock
+		keeper.cdc.MustUnmarshal(iterator.Value(), &lock)
+
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// GetAllConvictionLocks returns all the conviction locks from the store
+func (keeper Keeper) GetAllConvictionLocks(ctx sdk.Context) (locks []v1.ConvictionLock) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConvictionLocksKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lock v1.ConvictionLock
+		keeper.cdc.MustUnmarshal(iterator.Value(), &lock)
+		locks = append(locks, lock)
+	}
+
+	return
+}
+
+// IterateExpiredConvictionLocksQueue iterates over the conviction locks ending
+// by endTime and performs a callback function
+func (keeper Keeper) IterateExpiredConvictionLocksQueue(ctx sdk.Context, endTime time.Time, cb func(lock v1.ConvictionLock) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.ConvictionLockQueuePrefix, sdk.PrefixEndBytes(types.ConvictionLockByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		voterAddr, proposalID, _ := types.SplitConvictionLockQueueKey(iterator.Key())
+		lock, found := keeper.GetConvictionLock(ctx, voterAddr, proposalID)
+		if !found {
+			panic(fmt.Sprintf("conviction lock of %s on proposal %d does not exist", voterAddr, proposalID))
+		}
+
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// hasConvictionLocks returns whether a voter has any conviction lock. It doesn't
+// consume gas, as it is checked on every spendable balance computation of the
+// bank keeper and seeks a single key.
+func (keeper Keeper) hasConvictionLocks(ctx sdk.Context, voterAddr sdk.AccAddress) bool {
+	store := ctx.MultiStore().GetKVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConvictionLocksKey(voterAddr))
+
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// ConvictionLockedCoins returns the staking tokens of an account which can't be
+// spent because of its active conviction locks. The bonded and unbonding tokens
+// of the account count towards the locked amount, so only the part of the
+// greatest active lock exceeding them, up to the balance, is locked. It is meant
+// to be added to the bank keeper with AddLockedCoinsFn, and only consumes gas
+// for the accounts having conviction locks.
+func (keeper Keeper) ConvictionLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if !keeper.hasConvictionLocks(ctx, addr) {
+		return sdk.NewCoins()
+	}
+
+	var locked sdk.Coin
+	keeper.IterateConvictionLocks(ctx, addr, func(lock v1.ConvictionLock) bool {
+		if lock.EndTime.After(ctx.BlockTime()) && (locked.Amount.IsNil() || lock.Amount.Amount.GT(locked.Amount)) {
+			locked = lock.Amount
+		}
+		return false
+	})
+	if locked.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+
+	liquid := locked.Amount.Sub(keeper.sk.GetDelegatorBonded(ctx, addr)).Sub(keeper.sk.GetDelegatorUnbonding(ctx, addr))
+	if !liquid.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	balance := keeper.bankKeeper.GetBalance(ctx, addr, locked.Denom)
+	return sdk.NewCoins(sdk.NewCoin(locked.Denom, math.MinInt(liquid, balance.Amount)))
+}
+
+// lockConviction locks the staking tokens a voter voted with on a proposal for
+// the lock duration of their conviction level, from the tally of their vote.
+// The lock of the voter on the proposal is extended if they already have one.
+func (keeper Keeper) lockConviction(ctx sdk.Context, voterAddr sdk.AccAddress, proposalID uint64, amount math.Int, level v1.ConvictionLevel) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	lock := v1.ConvictionLock{
+		Voter:      voterAddr.String(),
+		ProposalId: proposalID,
+		Amount:     sdk.NewCoin(keeper.sk.BondDenom(ctx), amount),
+		EndTime:    ctx.BlockTime().Add(level.LockDuration),
+	}
+	if prev, found := keeper.GetConvictionLock(ctx, voterAddr, proposalID); found {
+		lock.Amount.Amount = math.MaxInt(lock.Amount.Amount, prev.Amount.Amount)
+		if prev.EndTime.After(lock.EndTime) {
+			lock.EndTime = prev.EndTime
+		}
+	}
+	keeper.SetConvictionLock(ctx, lock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvictionLock,
+			sdk.NewAttribute(types.AttributeKeyVoter, lock.Voter),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyLockAmount, lock.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLockEndTime, lock.EndTime.String()),
+		),
+	)
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestConvictionLocks(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	lock1 := v1.ConvictionLock{Voter: addrs[0].String(), ProposalId: 1, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000), EndTime: now.Add(time.Hour)}
+	lock2 := v1.ConvictionLock{Voter: addrs[0].String(), ProposalId: 2, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 20000000), EndTime: now.Add(time.Minute)}
+	app.GovKeeper.SetConvictionLock(ctx, lock1)
+	app.GovKeeper.SetConvictionLock(ctx, lock2)
+
+	lock, found := app.GovKeeper.GetConvictionLock(ctx, addrs[0], 1)
+	require.True(t, found)
+	require.Equal(t, lock1, lock)
+	require.Equal(t, []v1.ConvictionLock{lock1, lock2}, app.GovKeeper.GetAllConvictionLocks(ctx))
+
+	// the greatest active lock can't be spent
+	require.Equal(t, sdk.NewCoins(lock2.Amount), app.GovKeeper.ConvictionLockedCoins(ctx, addrs[0]))
+	require.Equal(t, sdk.NewCoins(lock2.Amount), app.BankKeeper.LockedCoins(ctx, addrs[0]))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000)), app.BankKeeper.SpendableCoins(ctx, addrs[0]))
+	require.Error(t, app.BankKeeper.SendCoins(ctx, addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15000000))))
+	require.Empty(t, app.BankKeeper.LockedCoins(ctx, addrs[1]))
+
+	// the accounts without locks don't pay for the lock check
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.Empty(t, app.GovKeeper.ConvictionLockedCoins(gasCtx, addrs[1]))
+	require.Zero(t, gasCtx.GasMeter().GasConsumed())
+
+	// the locked tokens can be delegated, the delegated tokens counting towards the lock
+	val := app.StakingKeeper.GetAllValidators(ctx)[0]
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[0], sdk.NewInt(15000000), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000000)), app.BankKeeper.LockedCoins(ctx, addrs[0]))
+
+	// the ended locks don't lock anything
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	require.Empty(t, app.BankKeeper.LockedCoins(ctx, addrs[0]))
+
+	// the ended locks are pruned
+	var expired []v1.ConvictionLock
+	app.GovKeeper.IterateExpiredConvictionLocksQueue(ctx, ctx.BlockTime(), func(lock v1.ConvictionLock) bool {
+		expired = append(expired, lock)
+		app.GovKeeper.RemoveConvictionLock(ctx, lock)
+		return false
+	})
+	require.Equal(t, []v1.ConvictionLock{lock2}, expired)
+	require.Equal(t, []v1.ConvictionLock{lock1}, app.GovKeeper.GetAllConvictionLocks(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryConvictionLocks() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.ConvictionLocks(ctx.Context(), &v1.QueryConvictionLocksRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ConvictionLocks(ctx.Context(), &v1.QueryConvictionLocksRequest{Voter: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Locks)
+
+	endTime := ctx.BlockTime().Add(time.Hour).UTC()
+	locks := []v1.ConvictionLock{
+		{Voter: addrs[0].String(), ProposalId: 1, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), EndTime: endTime},
+		{Voter: addrs[0].String(), ProposalId: 2, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), EndTime: endTime},
+		{Voter: addrs[1].String(), ProposalId: 1, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 30), EndTime: endTime},
+	}
+	for _, lock := range locks {
+		app.GovKeeper.SetConvictionLock(ctx, lock)
+	}
+
+	res, err = queryClient.ConvictionLocks(ctx.Context(), &v1.QueryConvictionLocksRequest{Voter: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(locks[:2], res.Locks)
+
+	res, err = queryClient.ConvictionLocks(ctx.Context(), &v1.QueryConvictionLocksRequest{
+		Voter:      addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(locks[:1], res.Locks)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
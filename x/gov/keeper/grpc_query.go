@@ -176,6 +176,10 @@ func (q Keeper) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.Query
 		tallyParams := q.GetTallyParams(ctx)
 		return &v1.QueryParamsResponse{TallyParams: &tallyParams}, nil
 
+	case v1.ParamExecution:
+		executionParams := q.GetExecutionParams(ctx)
+		return &v1.QueryParamsResponse{ExecutionParams: &executionParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -277,6 +281,43 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ConvictionLocks returns the conviction locks of a voter
+func (q Keeper) ConvictionLocks(c context.Context, req *v1.QueryConvictionLocksRequest) (*v1.QueryConvictionLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var locks []v1.ConvictionLock
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	locksStore := prefix.NewStore(store, types.ConvictionLocksKey(voter))
+
+	pageRes, err := query.Paginate(locksStore, req.Pagination, func(key []byte, value []byte) error {
+		var lock v1.ConvictionLock
+		if err := q.cdc.Unmarshal(value, &lock); err != nil {
+			return err
+		}
+
+		locks = append(locks, lock)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryConvictionLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
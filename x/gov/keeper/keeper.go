@@ -145,6 +145,19 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertPendingExecutionProposalQueue inserts a ProposalID into the pending execution proposal queue at executionTime
+func (keeper Keeper) InsertPendingExecutionProposalQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.PendingExecutionProposalQueueKey(proposalID, executionTime), bz)
+}
+
+// RemoveFromPendingExecutionProposalQueue removes a proposalID from the Pending Execution Proposal Queue
+func (keeper Keeper) RemoveFromPendingExecutionProposalQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.PendingExecutionProposalQueueKey(proposalID, executionTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -185,6 +198,25 @@ func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time
 	}
 }
 
+// IteratePendingExecutionProposalsQueue iterates over the proposals in the pending execution proposal queue
+// and performs a callback function
+func (keeper Keeper) IteratePendingExecutionProposalsQueue(ctx sdk.Context, executionTime time.Time, cb func(proposal v1.Proposal) (stop bool)) {
+	iterator := keeper.PendingExecutionProposalQueueIterator(ctx, executionTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitPendingExecutionProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -197,6 +229,13 @@ func (keeper Keeper) InactiveProposalQueueIterator(ctx sdk.Context, endTime time
 	return store.Iterator(types.InactiveProposalQueuePrefix, sdk.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
 }
 
+// PendingExecutionProposalQueueIterator returns an sdk.Iterator for all the proposals in the Pending Execution Queue
+// that are executed by executionTime
+func (keeper Keeper) PendingExecutionProposalQueueIterator(ctx sdk.Context, executionTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.PendingExecutionProposalQueuePrefix, sdk.PrefixEndBytes(types.PendingExecutionProposalByTimeKey(executionTime)))
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than a pre-defined maxMetadataLen.
 func (keeper Keeper) assertMetadataLength(metadata string) error {
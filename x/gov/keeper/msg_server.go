@@ -113,7 +113,7 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	if err != nil {
 		return nil, err
 	}
-	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
+	err = k.Keeper.AddConvictionVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata, msg.Conviction)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +143,7 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	if accErr != nil {
 		return nil, accErr
 	}
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
+	err := k.Keeper.AddConvictionVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata, msg.Conviction)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +206,37 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) CancelProposalExecution(goCtx context.Context, msg *v1.MsgCancelProposalExecution) (*v1.MsgCancelProposalExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	guardian := k.GetExecutionParams(ctx).Guardian
+	if guardian == "" {
+		return nil, types.ErrNoGuardian
+	}
+	if guardian != msg.Guardian {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected guardian %s got %s", guardian, msg.Guardian)
+	}
+
+	if err := k.Keeper.CancelProposalExecution(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelProposalExecution,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalExecutionCancelled),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Guardian),
+		),
+	})
+
+	return &v1.MsgCancelProposalExecutionResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -26,6 +26,14 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
 	return tallyParams
 }
 
+// GetExecutionParams returns the current ExecutionParams from the global param
+// store, or the default ones if they have never been set.
+func (keeper Keeper) GetExecutionParams(ctx sdk.Context) v1.ExecutionParams {
+	executionParams := v1.DefaultExecutionParams()
+	keeper.paramSpace.GetIfExists(ctx, v1.ParamStoreKeyExecutionParams, &executionParams)
+	return executionParams
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +48,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingPara
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
 	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetExecutionParams sets ExecutionParams to the global param store
+func (keeper Keeper) SetExecutionParams(ctx sdk.Context, executionParams v1.ExecutionParams) {
+	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyExecutionParams, &executionParams)
+}
@@ -136,10 +136,37 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	if proposal.VotingEndTime != nil {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
 	}
+	if proposal.ExecutionTime != nil {
+		keeper.RemoveFromPendingExecutionProposalQueue(ctx, proposalID, *proposal.ExecutionTime)
+	}
 
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// CancelProposalExecution cancels the execution of a passed proposal pending
+// execution, which is then considered rejected, and deletes the emergency
+// vetoes cast on it.
+func (keeper Keeper) CancelProposalExecution(ctx sdk.Context, proposalID uint64) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != v1.StatusPassedPendingExecution {
+		return sdkerrors.Wrapf(types.ErrNotPendingExecution, "%d", proposalID)
+	}
+
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		keeper.deleteVote(ctx, proposalID, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+
+	keeper.RemoveFromPendingExecutionProposalQueue(ctx, proposalID, *proposal.ExecutionTime)
+	proposal.Status = v1.StatusRejected
+	keeper.SetProposal(ctx, proposal)
+
+	return nil
+}
+
 // IterateProposals iterates over the all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
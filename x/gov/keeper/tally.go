@@ -9,15 +9,81 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters, multiplied by their conviction. The quorum is computed from the voting power without the
+// conviction multipliers.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
-	results := make(map[v1.VoteOption]sdk.Dec)
+	results, totalVotingPower, participation := keeper.tallyVotes(ctx, proposal)
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := participation.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
+	if percentVoting.LT(quorum) {
+		return false, false, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
+	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// TallyEmergencyVeto iterates over the votes cast on a proposal pending
+// execution and returns whether the NoWithVeto votes reach the emergency veto
+// threshold, as a proportion of the total bonded stake, together with the
+// tally of these votes.
+func (keeper Keeper) TallyEmergencyVeto(ctx sdk.Context, proposal v1.Proposal) (vetoed bool, tallyResults v1.TallyResult) {
+	results, _, _ := keeper.tallyVotes(ctx, proposal)
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false, tallyResults
+	}
+
+	emergencyVetoThreshold, _ := sdk.NewDecFromStr(keeper.GetExecutionParams(ctx).EmergencyVetoThreshold)
+	percentVeto := results[v1.OptionNoWithVeto].Quo(sdk.NewDecFromInt(totalBonded))
+
+	return percentVeto.GTE(emergencyVetoThreshold), tallyResults
+}
+
+// tallyVotes computes the voting power cast for each vote option on a
+// proposal, deleting the votes along the way. The voting power the voters
+// delegated themselves is multiplied by their conviction, and is locked for
+// the lock duration of their conviction level. participation is the total
+// voting power without the conviction multipliers.
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposal v1.Proposal) (results map[v1.VoteOption]sdk.Dec, totalVotingPower, participation sdk.Dec) {
+	results = make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = sdk.ZeroDec()
 	results[v1.OptionAbstain] = sdk.ZeroDec()
 	results[v1.OptionNo] = sdk.ZeroDec()
 	results[v1.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
+	participation = sdk.ZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
@@ -43,6 +109,12 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			currValidators[valAddrStr] = val
 		}
 
+		multiplier := sdk.OneDec()
+		if vote.Conviction != nil {
+			multiplier, _ = sdk.NewDecFromStr(vote.Conviction.Multiplier)
+		}
+		voterPower := sdk.ZeroDec()
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
@@ -55,7 +127,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				voterPower = voterPower.Add(votingPower)
+				participation = participation.Add(votingPower)
 
+				votingPower = votingPower.Mul(multiplier)
 				for _, option := range vote.Options {
 					weight, _ := sdk.NewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
@@ -67,6 +142,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			return false
 		})
 
+		if vote.Conviction != nil {
+			keeper.lockConviction(ctx, voter, vote.ProposalId, voterPower.TruncateInt(), *vote.Conviction)
+		}
+
 		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
@@ -86,41 +165,8 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+		participation = participation.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = v1.NewTallyResultFromMap(results)
-
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
-	}
-
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
-	if percentVoting.LT(quorum) {
-		return false, false, tallyResults
-	}
-
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
-	}
-
-	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, true, tallyResults
-	}
-
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
-	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
-	}
-
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return results, totalVotingPower, participation
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -473,3 +474,84 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyConviction(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{10, 10, 10})
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ConvictionLevels = []v1.ConvictionLevel{
+		v1.NewConvictionLevel(time.Hour*24*7, sdk.NewDec(2)),
+		v1.NewConvictionLevel(time.Hour*24*28, sdk.NewDec(3)),
+	}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.Error(t, app.GovKeeper.AddConvictionVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "", 3))
+	require.NoError(t, app.GovKeeper.AddConvictionVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "", 2))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, &votingParams.ConvictionLevels[1], vote.Conviction)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	// the yes vote weighs three times the voting power of its voter
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+
+	expectedYes := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	expectedAbstain := app.StakingKeeper.TokensFromConsensusPower(ctx, 0)
+	expectedNo := app.StakingKeeper.TokensFromConsensusPower(ctx, 20)
+	expectedNoWithVeto := app.StakingKeeper.TokensFromConsensusPower(ctx, 0)
+	expectedTallyResult := v1.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+
+	// the tokens of the voter are locked for the duration of their conviction level
+	lock, found := app.GovKeeper.GetConvictionLock(ctx, addrs[0], proposalID)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)), lock.Amount)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour*24*28), lock.EndTime)
+
+	_, found = app.GovKeeper.GetConvictionLock(ctx, addrs[1], proposalID)
+	require.False(t, found)
+}
+
+func TestTallyConvictionQuorum(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{2, 5, 5})
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ConvictionLevels = []v1.ConvictionLevel{v1.NewConvictionLevel(time.Hour*24*28, sdk.NewDec(6))}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddConvictionVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "", 1))
+
+	// the conviction multiplier doesn't count towards the quorum
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
@@ -11,11 +11,29 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	return keeper.AddConvictionVote(ctx, proposalID, voterAddr, options, metadata, 0)
+}
+
+// AddConvictionVote adds a vote on a specific proposal, the voter locking their
+// tokens after the tally of the vote for the 1-based conviction level of the
+// voting params in exchange for a greater vote weight. No tokens are locked if
+// conviction is zero.
+func (keeper Keeper) AddConvictionVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string, conviction uint32,
+) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
-	if proposal.Status != v1.StatusVotingPeriod {
+	switch proposal.Status {
+	case v1.StatusVotingPeriod:
+	case v1.StatusPassedPendingExecution:
+		// during the execution delay of a passed proposal, only emergency
+		// vetoes can be cast
+		if len(options) != 1 || options[0].Option != v1.OptionNoWithVeto {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "only %s votes can be cast on proposal %d pending execution", v1.OptionNoWithVeto, proposalID)
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 	err := keeper.assertMetadataLength(metadata)
@@ -30,6 +48,13 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
+	if conviction != 0 {
+		level, ok := keeper.GetVotingParams(ctx).GetConvictionLevel(conviction)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "unknown conviction level %d", conviction)
+		}
+		vote.Conviction = &level
+	}
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -40,6 +65,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			types.EventTypeProposalVote,
 			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyConviction, fmt.Sprintf("%d", conviction)),
 		),
 	)
 
@@ -21,6 +21,12 @@ func ConvertToLegacyProposal(proposal v1.Proposal) (v1beta1.Proposal, error) {
 		TotalDeposit: types.NewCoins(proposal.TotalDeposit...),
 	}
 
+	// proposals pending execution have passed, the legacy format has no
+	// status for the execution delay
+	if proposal.Status == v1.StatusPassedPendingExecution {
+		legacyProposal.Status = v1beta1.StatusPassed
+	}
+
 	legacyProposal.FinalTallyResult, err = ConvertToLegacyTallyResult(proposal.FinalTallyResult)
 	if err != nil {
 		return v1beta1.Proposal{}, err
@@ -73,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about:
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
+	"conviction_locks": [],
 	"deposit_params": {
 		"max_deposit_period": "172800s",
 		"min_deposit": [
@@ -83,9 +84,11 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"execution_params": null,
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"execution_time": null,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -125,6 +128,7 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"votes": [
 		{
+			"conviction": null,
 			"metadata": "",
 			"options": [
 				{
@@ -136,6 +140,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"conviction": null,
 			"metadata": "",
 			"options": [
 				{
@@ -148,6 +153,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"conviction_levels": [],
 		"voting_period": "172800s"
 	}
 }`
@@ -23,6 +23,8 @@ const (
 	TallyParamsQuorum          = "tally_params_quorum"
 	TallyParamsThreshold       = "tally_params_threshold"
 	TallyParamsVeto            = "tally_params_veto"
+
+	ExecutionParamsExecutionDelay = "execution_params_execution_delay"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -55,6 +57,16 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenExecutionParamsExecutionDelay randomized ExecutionParamsExecutionDelay,
+// executing passed proposals immediately half of the time
+func GenExecutionParamsExecutionDelay(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*24)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -95,6 +107,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var executionDelay time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ExecutionParamsExecutionDelay, &executionDelay, simState.Rand,
+		func(r *rand.Rand) { executionDelay = GenExecutionParamsExecutionDelay(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
 		v1.NewDepositParams(minDeposit, depositPeriod),
@@ -102,6 +120,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		v1.NewTallyParams(quorum, threshold, veto),
 	)
 
+	executionParams := v1.DefaultExecutionParams()
+	executionParams.ExecutionDelay = executionDelay
+	govGenesis.ExecutionParams = &executionParams
+
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
 	if err != nil {
 		panic(err)
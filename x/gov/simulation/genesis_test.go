@@ -52,6 +52,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec1.String(), govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2.String(), govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3.String(), govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, float64(0), govGenesis.ExecutionParams.ExecutionDelay.Seconds())
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
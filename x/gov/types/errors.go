@@ -21,4 +21,6 @@ var (
 	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
+	ErrNotPendingExecution     = sdkerrors.Register(ModuleName, 16, "proposal is not pending execution")
+	ErrNoGuardian              = sdkerrors.Register(ModuleName, 17, "no guardian set in the execution params")
 )
@@ -9,6 +9,10 @@ const (
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
 
+	EventTypePendingExecutionProposal = "pending_execution_proposal"
+	EventTypeCancelProposalExecution  = "cancel_proposal_execution"
+	EventTypeConvictionLock           = "conviction_lock"
+
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
@@ -22,4 +26,14 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+
+	AttributeKeyExecutionTime                = "execution_time"
+	AttributeValueProposalPendingExecution   = "proposal_pending_execution"   // passed, waiting for its execution delay
+	AttributeValueProposalVetoed             = "proposal_vetoed"              // emergency vetoed during its execution delay
+	AttributeValueProposalExecutionCancelled = "proposal_execution_cancelled" // cancelled by the guardian
+
+	AttributeKeyConviction  = "conviction"
+	AttributeKeyVoter       = "voter"
+	AttributeKeyLockAmount  = "lock_amount"
+	AttributeKeyLockEndTime = "lock_end_time"
 )
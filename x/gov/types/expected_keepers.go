@@ -10,6 +10,7 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -25,6 +26,10 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	BondDenom(sdk.Context) string
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int    // total tokens bonded by a delegator
+	GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) math.Int // total tokens unbonding from a delegator
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -34,18 +34,29 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04<executionTime_Bytes><proposalID_Bytes>: pendingExecutionProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: ConvictionLock
+//
+// - 0x31<endTime_Bytes><proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: convictionLockQueue
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
 
+	PendingExecutionProposalQueuePrefix = []byte{0x04}
+
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ConvictionLocksKeyPrefix  = []byte{0x30}
+	ConvictionLockQueuePrefix = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -87,6 +98,16 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// PendingExecutionProposalByTimeKey gets the pending execution proposal queue key by executionTime
+func PendingExecutionProposalByTimeKey(executionTime time.Time) []byte {
+	return append(PendingExecutionProposalQueuePrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// PendingExecutionProposalQueueKey returns the key for a proposalID in the pendingExecutionProposalQueue
+func PendingExecutionProposalQueueKey(proposalID uint64, executionTime time.Time) []byte {
+	return append(PendingExecutionProposalByTimeKey(executionTime), GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -107,6 +128,26 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// ConvictionLocksKey gets the first part of the conviction locks key based on the voter address
+func ConvictionLocksKey(voterAddr sdk.AccAddress) []byte {
+	return append(ConvictionLocksKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// ConvictionLockKey key of the conviction lock of a voter on a specific proposal from the store
+func ConvictionLockKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(ConvictionLocksKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// ConvictionLockByTimeKey gets the conviction lock queue key by endTime
+func ConvictionLockByTimeKey(endTime time.Time) []byte {
+	return append(ConvictionLockQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// ConvictionLockQueueKey returns the key for a conviction lock in the convictionLockQueue
+func ConvictionLockQueueKey(voterAddr sdk.AccAddress, proposalID uint64, endTime time.Time) []byte {
+	return append(append(ConvictionLockByTimeKey(endTime), GetProposalIDBytes(proposalID)...), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -126,6 +167,11 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitPendingExecutionProposalQueueKey split the pending execution proposal key and returns the proposal id and executionTime
+func SplitPendingExecutionProposalQueueKey(key []byte) (proposalID uint64, executionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -136,6 +182,20 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitConvictionLockQueueKey split the conviction lock queue key and returns the voter address, the proposal id and endTime
+func SplitConvictionLockQueueKey(key []byte) (voterAddr sdk.AccAddress, proposalID uint64, endTime time.Time) {
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+8+2)
+
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	proposalID = GetProposalIDFromBytes(key[1+lenTime : 1+lenTime+8])
+	voterAddr = sdk.AccAddress(key[1+lenTime+8+1:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
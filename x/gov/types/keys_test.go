@@ -57,3 +57,19 @@ func TestVoteKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, voterAddr)
 }
+
+func TestConvictionLockKeys(t *testing.T) {
+	key := ConvictionLockKey(addr, 2)
+	require.Equal(t, ConvictionLocksKey(addr), key[:len(key)-8])
+	require.Equal(t, uint64(2), GetProposalIDFromBytes(key[len(key)-8:]))
+
+	now := time.Now()
+	key = ConvictionLockQueueKey(addr, 2, now)
+	voterAddr, proposalID, endTime := SplitConvictionLockQueueKey(key)
+	require.Equal(t, addr, voterAddr)
+	require.Equal(t, int(proposalID), 2)
+	require.True(t, now.Equal(endTime))
+
+	// invalid key
+	require.Panics(t, func() { SplitConvictionLockQueueKey([]byte("test")) })
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -19,12 +20,17 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 
 // DefaultGenesisState defines the default governance genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genState := NewGenesisState(
 		DefaultStartingProposalID,
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
 	)
+
+	executionParams := DefaultExecutionParams()
+	genState.ExecutionParams = &executionParams
+
+	return genState
 }
 
 // Empty returns true if a GenesisState is empty
@@ -53,6 +59,23 @@ func ValidateGenesis(data *GenesisState) error {
 		return fmt.Errorf("invalid deposit params: %w", err)
 	}
 
+	// execution params are optional, proposals being executed at the end of
+	// their voting period if they are not set
+	if data.ExecutionParams != nil {
+		if err := validateExecutionParams(*data.ExecutionParams); err != nil {
+			return fmt.Errorf("invalid execution params: %w", err)
+		}
+	}
+
+	for _, lock := range data.ConvictionLocks {
+		if _, err := sdk.AccAddressFromBech32(lock.Voter); err != nil {
+			return fmt.Errorf("invalid conviction lock voter address: %w", err)
+		}
+		if !lock.Amount.IsValid() || !lock.Amount.IsPositive() {
+			return fmt.Errorf("invalid conviction lock amount of %s on proposal %d: %s", lock.Voter, lock.ProposalId, lock.Amount)
+		}
+	}
+
 	return nil
 }
 
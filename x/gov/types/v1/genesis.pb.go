@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -38,6 +39,15 @@ type GenesisState struct {
 	VotingParams *VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
 	// params defines all the paramaters of related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// params defines all the paramaters of related to the execution of passed
+	// proposals.
+	//
+	// Since: cosmos-sdk 0.47
+	ExecutionParams *ExecutionParams `protobuf:"bytes,8,opt,name=execution_params,json=executionParams,proto3" json:"execution_params,omitempty"`
+	// conviction_locks defines all the conviction locks present at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	ConvictionLocks []ConvictionLock `protobuf:"bytes,9,rep,name=conviction_locks,json=convictionLocks,proto3" json:"conviction_locks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +132,20 @@ func (m *GenesisState) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *GenesisState) GetExecutionParams() *ExecutionParams {
+	if m != nil {
+		return m.ExecutionParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConvictionLocks() []ConvictionLock {
+	if m != nil {
+		return m.ConvictionLocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -129,29 +153,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0xa9, 0xfc, 0xa8, 0x03, 0xb8, 0x18, 0x8d, 0x34, 0x60, 0x1a, 0xe2, 0x0a, 0x63, 0x6c,
-	0x05, 0xe3, 0xd2, 0xc4, 0xf8, 0x13, 0xe3, 0x8e, 0x54, 0xe3, 0xc2, 0x0d, 0x29, 0x74, 0x52, 0x1b,
-	0x81, 0xdb, 0xf4, 0x5e, 0x27, 0xf2, 0x16, 0x3e, 0x80, 0x0f, 0xe4, 0x92, 0xa5, 0x4b, 0x03, 0x2f,
-	0x62, 0x98, 0x69, 0x05, 0xab, 0xab, 0xc9, 0xcd, 0xf9, 0xce, 0x99, 0x93, 0x7b, 0x59, 0x63, 0x00,
-	0x38, 0x02, 0x74, 0x02, 0x90, 0x8e, 0x6c, 0x3b, 0x81, 0x18, 0x0b, 0x0c, 0xd1, 0x8e, 0x62, 0x20,
-	0xe0, 0x55, 0x2d, 0xda, 0x01, 0x48, 0x5b, 0xb6, 0xeb, 0xb5, 0x0c, 0x0b, 0x52, 0x73, 0xfb, 0xef,
-	0x79, 0x56, 0xb9, 0xd1, 0xce, 0x3b, 0xf2, 0x48, 0xf0, 0x63, 0xb6, 0x83, 0xe4, 0xc5, 0x14, 0x8e,
-	0x83, 0x5e, 0x14, 0x43, 0x04, 0xe8, 0x0d, 0x7b, 0xa1, 0x6f, 0x1a, 0x4d, 0xa3, 0x55, 0x70, 0x79,
-	0xaa, 0x75, 0x13, 0xe9, 0xd6, 0xe7, 0x1d, 0xb6, 0xe1, 0x8b, 0x08, 0x30, 0x24, 0x34, 0xd7, 0x9a,
-	0xf9, 0x56, 0xb9, 0xb3, 0x6b, 0xff, 0xfa, 0xdd, 0xbe, 0xd2, 0xb2, 0xfb, 0xc3, 0xf1, 0x03, 0x56,
-	0x94, 0x40, 0x02, 0xcd, 0xbc, 0x32, 0x6c, 0x67, 0x0c, 0x0f, 0x40, 0xc2, 0xd5, 0x04, 0x3f, 0x65,
-	0x9b, 0x69, 0x0f, 0x34, 0x0b, 0x0a, 0xaf, 0x65, 0xf0, 0xb4, 0x8c, 0xbb, 0x24, 0xf9, 0x25, 0xdb,
-	0x4a, 0x7e, 0xeb, 0x45, 0x5e, 0xec, 0x8d, 0xd0, 0x2c, 0x36, 0x8d, 0x56, 0xb9, 0xb3, 0xf7, 0x7f,
-	0xb7, 0xae, 0x62, 0xdc, 0xaa, 0xbf, 0x3a, 0xf2, 0x73, 0x56, 0x95, 0xa0, 0x57, 0xa1, 0x33, 0x4a,
-	0x2a, 0xa3, 0xf1, 0xb7, 0xee, 0x62, 0x25, 0x3a, 0xa2, 0x22, 0x57, 0x26, 0x7e, 0xc6, 0x2a, 0xe4,
-	0x0d, 0x87, 0x93, 0x34, 0x60, 0x5d, 0x05, 0xd4, 0x33, 0x01, 0xf7, 0x0b, 0x24, 0xf1, 0x97, 0x69,
-	0x39, 0x5c, 0x5c, 0x7f, 0xcc, 0x2c, 0x63, 0x3a, 0xb3, 0x8c, 0xaf, 0x99, 0x65, 0xbc, 0xcd, 0xad,
-	0xdc, 0x74, 0x6e, 0xe5, 0x3e, 0xe7, 0x56, 0xee, 0xf1, 0x30, 0x08, 0xe9, 0xe9, 0xa5, 0x6f, 0x0f,
-	0x60, 0xe4, 0x24, 0xc7, 0xd5, 0xcf, 0x11, 0xfa, 0xcf, 0xce, 0xab, 0xba, 0x34, 0x4d, 0x22, 0x81,
-	0x8e, 0x6c, 0xf7, 0x4b, 0xea, 0xd8, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xec, 0x74,
-	0x03, 0x33, 0x02, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xce, 0xd2, 0x40,
+	0x10, 0xc0, 0x5b, 0xbf, 0x7e, 0x9f, 0xb0, 0x80, 0x90, 0x95, 0x48, 0x03, 0x5a, 0x89, 0x27, 0x8c,
+	0xb1, 0x15, 0x8c, 0x47, 0x13, 0x03, 0x12, 0x43, 0x62, 0x0c, 0xa9, 0xc6, 0x83, 0x17, 0x52, 0xb6,
+	0x9b, 0xda, 0x50, 0x3a, 0x4d, 0x77, 0xd9, 0xc0, 0x5b, 0xf8, 0x36, 0xbe, 0x02, 0x47, 0x8e, 0x9e,
+	0x8c, 0x81, 0x17, 0x31, 0xec, 0xb6, 0xfc, 0xa9, 0x9e, 0xba, 0x33, 0xf3, 0x9b, 0x5f, 0x27, 0x93,
+	0x41, 0x1d, 0x02, 0x6c, 0x09, 0xcc, 0x09, 0x40, 0x38, 0xa2, 0xef, 0x04, 0x34, 0xa6, 0x2c, 0x64,
+	0x76, 0x92, 0x02, 0x07, 0x5c, 0x53, 0x45, 0x3b, 0x00, 0x61, 0x8b, 0x7e, 0xbb, 0x55, 0x60, 0x41,
+	0x28, 0xae, 0xdd, 0x0c, 0x20, 0x00, 0xf9, 0x74, 0x8e, 0x2f, 0x95, 0x7d, 0xf6, 0xd3, 0x40, 0xd5,
+	0x0f, 0xca, 0xf7, 0x99, 0x7b, 0x9c, 0xe2, 0x57, 0xa8, 0xc9, 0xb8, 0x97, 0xf2, 0x30, 0x0e, 0x66,
+	0x49, 0x0a, 0x09, 0x30, 0x2f, 0x9a, 0x85, 0xbe, 0xa9, 0x77, 0xf5, 0x9e, 0xe1, 0xe2, 0xbc, 0x36,
+	0xcd, 0x4a, 0x13, 0x1f, 0x0f, 0x50, 0xc9, 0xa7, 0x09, 0xb0, 0x90, 0x33, 0xf3, 0x5e, 0xf7, 0xa6,
+	0x57, 0x19, 0x3c, 0xb2, 0xaf, 0x66, 0xb2, 0xdf, 0xab, 0xb2, 0x7b, 0xe2, 0xf0, 0x73, 0x74, 0x2b,
+	0x80, 0x53, 0x66, 0xde, 0xc8, 0x86, 0x87, 0x85, 0x86, 0xaf, 0xc0, 0xa9, 0xab, 0x08, 0xfc, 0x06,
+	0x95, 0xf3, 0x39, 0x98, 0x69, 0x48, 0xbc, 0x55, 0xc0, 0xf3, 0x61, 0xdc, 0x33, 0x89, 0x47, 0xe8,
+	0x41, 0xf6, 0xb7, 0x59, 0xe2, 0xa5, 0xde, 0x92, 0x99, 0xb7, 0x5d, 0xbd, 0x57, 0x19, 0x3c, 0xfe,
+	0xff, 0x6c, 0x53, 0xc9, 0xb8, 0x35, 0xff, 0x32, 0xc4, 0xef, 0x50, 0x4d, 0x80, 0x5a, 0x85, 0x72,
+	0xdc, 0x49, 0x47, 0xe7, 0xdf, 0x71, 0x8f, 0x2b, 0x51, 0x8a, 0xaa, 0xb8, 0x88, 0xf0, 0x5b, 0x54,
+	0xe5, 0x5e, 0x14, 0x6d, 0x72, 0xc1, 0x7d, 0x29, 0x68, 0x17, 0x04, 0x5f, 0x8e, 0x48, 0xd6, 0x5f,
+	0xe1, 0xe7, 0x00, 0x4f, 0x50, 0x83, 0xae, 0x29, 0x59, 0xf1, 0x10, 0xe2, 0x5c, 0x51, 0x92, 0x0a,
+	0xab, 0xa0, 0x18, 0xe7, 0x58, 0xa6, 0xa9, 0xd3, 0xeb, 0x04, 0xfe, 0x84, 0x1a, 0x04, 0x62, 0x11,
+	0x12, 0xe9, 0x8a, 0x80, 0x2c, 0x98, 0x59, 0x96, 0xeb, 0x7c, 0x52, 0x50, 0x8d, 0x4e, 0xd8, 0x47,
+	0x20, 0x8b, 0xa1, 0xb1, 0xfd, 0xfd, 0x54, 0x73, 0xeb, 0xe4, 0x2a, 0xcb, 0x86, 0xe3, 0xed, 0xde,
+	0xd2, 0x77, 0x7b, 0x4b, 0xff, 0xb3, 0xb7, 0xf4, 0x1f, 0x07, 0x4b, 0xdb, 0x1d, 0x2c, 0xed, 0xd7,
+	0xc1, 0xd2, 0xbe, 0xbd, 0x08, 0x42, 0xfe, 0x7d, 0x35, 0xb7, 0x09, 0x2c, 0x9d, 0xec, 0x1a, 0xd5,
+	0xe7, 0x25, 0xf3, 0x17, 0xce, 0x5a, 0x9e, 0x26, 0xdf, 0x24, 0x94, 0x39, 0xa2, 0x3f, 0xbf, 0x93,
+	0x77, 0xf8, 0xfa, 0xef, 0x00, 0x7b, 0xa5, 0xd0, 0xd5, 0xe4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +202,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConvictionLocks) > 0 {
+		for iNdEx := len(m.ConvictionLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvictionLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ExecutionParams != nil {
+		{
+			size, err := m.ExecutionParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -310,6 +364,16 @@ func (m *GenesisState) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.ExecutionParams != nil {
+		l = m.ExecutionParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ConvictionLocks) > 0 {
+		for _, e := range m.ConvictionLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -577,6 +641,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionParams == nil {
+				m.ExecutionParams = &ExecutionParams{}
+			}
+			if err := m.ExecutionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvictionLocks = append(m.ConvictionLocks, ConvictionLock{})
+			if err := m.ConvictionLocks[len(m.ConvictionLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
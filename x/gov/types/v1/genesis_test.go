@@ -2,7 +2,9 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 )
@@ -69,6 +71,61 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "no ExecutionParams",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+		},
+		{
+			name: "invalid ExecutionParams",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				ExecutionParams: &v1.ExecutionParams{
+					MsgExecutionDelays: []v1.MsgExecutionDelay{
+						{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Delay: time.Hour},
+						{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Delay: time.Minute},
+					},
+					EmergencyVetoThreshold: v1.DefaultEmergencyVetoThreshold.String(),
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid ConvictionLevels",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams: &v1.VotingParams{
+					VotingPeriod: votingParams.VotingPeriod,
+					ConvictionLevels: []v1.ConvictionLevel{
+						v1.NewConvictionLevel(time.Hour, sdk.NewDec(2)),
+						v1.NewConvictionLevel(time.Minute, sdk.NewDec(3)),
+					},
+				},
+				TallyParams: &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid ConvictionLocks",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+				ConvictionLocks: []v1.ConvictionLock{
+					{Voter: "invalid", ProposalId: 1, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)},
+				},
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
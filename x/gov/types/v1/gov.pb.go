@@ -92,6 +92,12 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_PASSED_PENDING_EXECUTION defines a proposal status of a
+	// proposal that has passed and whose messages are waiting for the end of
+	// the execution delay to be executed.
+	//
+	// Since: cosmos-sdk 0.47
+	ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,15 +107,17 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_PASSED_PENDING_EXECUTION",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_UNSPECIFIED":              0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":           1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":            2,
+	"PROPOSAL_STATUS_PASSED":                   3,
+	"PROPOSAL_STATUS_REJECTED":                 4,
+	"PROPOSAL_STATUS_FAILED":                   5,
+	"PROPOSAL_STATUS_PASSED_PENDING_EXECUTION": 6,
 }
 
 func (x ProposalStatus) String() string {
@@ -251,6 +259,11 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// execution_time is the time at which the messages of a proposal in the
+	// PROPOSAL_STATUS_PASSED_PENDING_EXECUTION status are executed.
+	//
+	// Since: cosmos-sdk 0.47
+	ExecutionTime *time.Time `protobuf:"bytes,11,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +369,13 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -433,6 +453,12 @@ type Vote struct {
 	Options    []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// conviction is the conviction level chosen by the voter, resolved from the
+	// voting params when the vote was cast. It is unset if the voter didn't
+	// lock their tokens.
+	//
+	// Since: cosmos-sdk 0.47
+	Conviction *ConvictionLevel `protobuf:"bytes,6,opt,name=conviction,proto3" json:"conviction,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -496,6 +522,13 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetConviction() *ConvictionLevel {
+	if m != nil {
+		return m.Conviction
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
@@ -556,6 +589,12 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Conviction levels voters can choose from to lock their tokens after the
+	//  end of the voting period in exchange for a greater vote weight, by
+	//  increasing lock duration. Conviction voting is disabled if empty.
+	//
+	//  Since: cosmos-sdk 0.47
+	ConvictionLevels []ConvictionLevel `protobuf:"bytes,2,rep,name=conviction_levels,json=convictionLevels,proto3" json:"conviction_levels"`
 }
 
 func (m *VotingParams) Reset()         { *m = VotingParams{} }
@@ -598,6 +637,146 @@ func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	return nil
 }
 
+func (m *VotingParams) GetConvictionLevels() []ConvictionLevel {
+	if m != nil {
+		return m.ConvictionLevels
+	}
+	return nil
+}
+
+// ConvictionLevel defines the vote weight multiplier granted to voters locking
+// their tokens for a given duration.
+//
+// Since: cosmos-sdk 0.47
+type ConvictionLevel struct {
+	//  Duration the tokens of the voter are locked for after the tally of their
+	//  vote.
+	LockDuration time.Duration `protobuf:"bytes,1,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	//  Multiplier of the voting power of the voter, at least 1.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (m *ConvictionLevel) Reset()         { *m = ConvictionLevel{} }
+func (m *ConvictionLevel) String() string { return proto.CompactTextString(m) }
+func (*ConvictionLevel) ProtoMessage()    {}
+func (*ConvictionLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
+}
+func (m *ConvictionLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionLevel.Merge(m, src)
+}
+func (m *ConvictionLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionLevel proto.InternalMessageInfo
+
+func (m *ConvictionLevel) GetLockDuration() time.Duration {
+	if m != nil {
+		return m.LockDuration
+	}
+	return 0
+}
+
+func (m *ConvictionLevel) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
+// ConvictionLock defines the tokens of a voter locked after the tally of their
+// conviction vote on a proposal.
+//
+// Since: cosmos-sdk 0.47
+type ConvictionLock struct {
+	Voter      string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	//  Amount of staking tokens which can't be spent by the voter until end_time.
+	//  Their bonded and unbonding tokens count towards the amount.
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	EndTime time.Time  `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *ConvictionLock) Reset()         { *m = ConvictionLock{} }
+func (m *ConvictionLock) String() string { return proto.CompactTextString(m) }
+func (*ConvictionLock) ProtoMessage()    {}
+func (*ConvictionLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
+}
+func (m *ConvictionLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionLock.Merge(m, src)
+}
+func (m *ConvictionLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionLock proto.InternalMessageInfo
+
+func (m *ConvictionLock) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ConvictionLock) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ConvictionLock) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ConvictionLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -614,7 +793,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,6 +843,147 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+// ExecutionParams defines the params for the execution of passed governance
+// proposals.
+//
+// Since: cosmos-sdk 0.47
+type ExecutionParams struct {
+	//  Delay between the end of the voting period of a passed proposal and the
+	//  execution of its messages. Proposals are executed at the end of their
+	//  voting period if zero.
+	ExecutionDelay time.Duration `protobuf:"bytes,1,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	//  Execution delays overriding execution_delay, shorter or longer, for the
+	//  messages of the given type. A proposal is delayed by the longest delay of
+	//  its messages.
+	MsgExecutionDelays []MsgExecutionDelay `protobuf:"bytes,2,rep,name=msg_execution_delays,json=msgExecutionDelays,proto3" json:"msg_execution_delays"`
+	//  Address allowed to cancel the execution of a passed proposal during its
+	//  execution delay. No guardian is set if empty.
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	//  Minimum proportion of the total bonded stake voting NoWithVeto during the
+	//  execution delay of a passed proposal for its execution to be cancelled.
+	//  Default value: 1/3.
+	EmergencyVetoThreshold string `protobuf:"bytes,4,opt,name=emergency_veto_threshold,json=emergencyVetoThreshold,proto3" json:"emergency_veto_threshold,omitempty"`
+}
+
+func (m *ExecutionParams) Reset()         { *m = ExecutionParams{} }
+func (m *ExecutionParams) String() string { return proto.CompactTextString(m) }
+func (*ExecutionParams) ProtoMessage()    {}
+func (*ExecutionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ExecutionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionParams.Merge(m, src)
+}
+func (m *ExecutionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionParams proto.InternalMessageInfo
+
+func (m *ExecutionParams) GetExecutionDelay() time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *ExecutionParams) GetMsgExecutionDelays() []MsgExecutionDelay {
+	if m != nil {
+		return m.MsgExecutionDelays
+	}
+	return nil
+}
+
+func (m *ExecutionParams) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *ExecutionParams) GetEmergencyVetoThreshold() string {
+	if m != nil {
+		return m.EmergencyVetoThreshold
+	}
+	return ""
+}
+
+// MsgExecutionDelay defines the execution delay of proposals containing a
+// message of a given type.
+//
+// Since: cosmos-sdk 0.47
+type MsgExecutionDelay struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade".
+	MsgTypeUrl string        `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Delay      time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *MsgExecutionDelay) Reset()         { *m = MsgExecutionDelay{} }
+func (m *MsgExecutionDelay) String() string { return proto.CompactTextString(m) }
+func (*MsgExecutionDelay) ProtoMessage()    {}
+func (*MsgExecutionDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *MsgExecutionDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecutionDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecutionDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecutionDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecutionDelay.Merge(m, src)
+}
+func (m *MsgExecutionDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecutionDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecutionDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecutionDelay proto.InternalMessageInfo
+
+func (m *MsgExecutionDelay) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgExecutionDelay) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -674,82 +994,107 @@ func init() {
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
+	proto.RegisterType((*ConvictionLevel)(nil), "cosmos.gov.v1.ConvictionLevel")
+	proto.RegisterType((*ConvictionLock)(nil), "cosmos.gov.v1.ConvictionLock")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*ExecutionParams)(nil), "cosmos.gov.v1.ExecutionParams")
+	proto.RegisterType((*MsgExecutionDelay)(nil), "cosmos.gov.v1.MsgExecutionDelay")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x65, 0x59, 0x96, 0xc7, 0xb6, 0xcc, 0x6c, 0xfc, 0x26, 0x8c, 0x93, 0x48, 0x8e, 0xf0,
+	0xbe, 0x2f, 0xdc, 0x7c, 0x48, 0x75, 0x92, 0xb6, 0x68, 0x03, 0xb4, 0x90, 0x2d, 0x26, 0x56, 0xe0,
+	0x5a, 0x0a, 0x45, 0x3b, 0x49, 0x2f, 0x04, 0x2d, 0x6e, 0x68, 0x22, 0x24, 0x57, 0xe5, 0xae, 0x14,
+	0xeb, 0x27, 0x14, 0xbd, 0xe4, 0x58, 0xa0, 0x87, 0x1e, 0xfb, 0x07, 0xf2, 0x23, 0x72, 0x0c, 0x72,
+	0x69, 0x8b, 0x02, 0x6e, 0x9b, 0xdc, 0x7c, 0xec, 0xa1, 0xa7, 0x1e, 0x0a, 0x72, 0x97, 0xfa, 0xa0,
+	0x6d, 0x48, 0x3d, 0x49, 0x9c, 0x79, 0x9e, 0xd9, 0xd9, 0xd9, 0x67, 0x66, 0x49, 0xb8, 0xd8, 0x22,
+	0xd4, 0x23, 0xb4, 0x6c, 0x93, 0x6e, 0xb9, 0xbb, 0x1e, 0xfe, 0x94, 0xda, 0x01, 0x61, 0x04, 0x2d,
+	0x72, 0x47, 0x29, 0xb4, 0x74, 0xd7, 0x57, 0xf2, 0x02, 0xb7, 0x6f, 0x52, 0x5c, 0xee, 0xae, 0xef,
+	0x63, 0x66, 0xae, 0x97, 0x5b, 0xc4, 0xf1, 0x39, 0x7c, 0x65, 0xd9, 0x26, 0x36, 0x89, 0xfe, 0x96,
+	0xc3, 0x7f, 0xc2, 0x5a, 0xb0, 0x09, 0xb1, 0x5d, 0x5c, 0x8e, 0x9e, 0xf6, 0x3b, 0xcf, 0xca, 0xcc,
+	0xf1, 0x30, 0x65, 0xa6, 0xd7, 0x16, 0x80, 0x4b, 0x49, 0x80, 0xe9, 0xf7, 0x84, 0x2b, 0x9f, 0x74,
+	0x59, 0x9d, 0xc0, 0x64, 0x0e, 0x89, 0x57, 0xbc, 0xc4, 0x33, 0x32, 0xf8, 0xa2, 0x22, 0xdb, 0xe8,
+	0xa1, 0x48, 0x00, 0x3d, 0xc6, 0x8e, 0x7d, 0xc0, 0xb0, 0xb5, 0x47, 0x18, 0xae, 0xb7, 0x43, 0x1a,
+	0x5a, 0x87, 0x0c, 0x89, 0xfe, 0x29, 0xd2, 0xaa, 0xb4, 0x96, 0xbb, 0x7d, 0xa9, 0x34, 0xb2, 0xc5,
+	0xd2, 0x00, 0xaa, 0x09, 0x20, 0xfa, 0x3f, 0x64, 0x5e, 0x44, 0x81, 0x94, 0xd4, 0xaa, 0xb4, 0x36,
+	0xb7, 0x91, 0x7b, 0xfb, 0xea, 0x16, 0x08, 0x56, 0x15, 0xb7, 0x34, 0xe1, 0x2d, 0x7e, 0x2f, 0xc1,
+	0x6c, 0x15, 0xb7, 0x09, 0x75, 0x18, 0x2a, 0xc0, 0x7c, 0x3b, 0x20, 0x6d, 0x42, 0x4d, 0xd7, 0x70,
+	0xac, 0x68, 0xad, 0xb4, 0x06, 0xb1, 0xa9, 0x66, 0xa1, 0x8f, 0x61, 0xce, 0xe2, 0x58, 0x12, 0x88,
+	0xb8, 0xca, 0xdb, 0x57, 0xb7, 0x96, 0x45, 0xdc, 0x8a, 0x65, 0x05, 0x98, 0xd2, 0x26, 0x0b, 0x1c,
+	0xdf, 0xd6, 0x06, 0x50, 0xf4, 0x09, 0x64, 0x4c, 0x8f, 0x74, 0x7c, 0xa6, 0x4c, 0xaf, 0x4e, 0xaf,
+	0xcd, 0x0f, 0xf2, 0x0f, 0xcf, 0xa4, 0x24, 0xce, 0xa4, 0xb4, 0x49, 0x1c, 0x7f, 0x23, 0xfd, 0xfa,
+	0xa8, 0x30, 0xa5, 0x09, 0x78, 0xf1, 0x87, 0x19, 0xc8, 0x36, 0xc4, 0xfa, 0x28, 0x07, 0xa9, 0x7e,
+	0x56, 0x29, 0xc7, 0x42, 0x1f, 0x42, 0xd6, 0xc3, 0x94, 0x9a, 0x36, 0xa6, 0x4a, 0x2a, 0x8a, 0xbb,
+	0x5c, 0xe2, 0x95, 0x2f, 0xc5, 0x95, 0x2f, 0x55, 0xfc, 0x9e, 0xd6, 0x47, 0xa1, 0x8f, 0x20, 0x43,
+	0x99, 0xc9, 0x3a, 0x54, 0x99, 0x8e, 0xea, 0x78, 0x35, 0x51, 0xc7, 0x78, 0xa9, 0x66, 0x04, 0xd2,
+	0x04, 0x18, 0x6d, 0x01, 0x7a, 0xe6, 0xf8, 0xa6, 0x6b, 0x30, 0xd3, 0x75, 0x7b, 0x46, 0x80, 0x69,
+	0xc7, 0x65, 0x4a, 0x7a, 0x55, 0x5a, 0x9b, 0xbf, 0xbd, 0x92, 0x08, 0xa1, 0x87, 0x10, 0x2d, 0x42,
+	0x68, 0x72, 0xc4, 0x1a, 0xb2, 0xa0, 0x0a, 0xcc, 0xd3, 0xce, 0xbe, 0xe7, 0x30, 0x23, 0x94, 0x93,
+	0x32, 0x23, 0x42, 0x24, 0xb3, 0xd6, 0x63, 0xad, 0x6d, 0xa4, 0x5f, 0xfe, 0x56, 0x90, 0x34, 0xe0,
+	0xa4, 0xd0, 0x8c, 0x1e, 0x82, 0x2c, 0x0a, 0x6b, 0x60, 0xdf, 0xe2, 0x71, 0x32, 0x13, 0xc6, 0xc9,
+	0x09, 0xa6, 0xea, 0x5b, 0x51, 0xac, 0x2a, 0x2c, 0x32, 0xc2, 0x4c, 0xd7, 0x10, 0x76, 0x65, 0x76,
+	0xb2, 0xe3, 0x59, 0x88, 0x58, 0xb1, 0x6c, 0xb6, 0xe1, 0x5c, 0x97, 0x30, 0xc7, 0xb7, 0x0d, 0xca,
+	0xcc, 0x40, 0x6c, 0x2d, 0x3b, 0x61, 0x4a, 0x4b, 0x9c, 0xda, 0x0c, 0x99, 0x51, 0x4e, 0x5b, 0x20,
+	0x4c, 0x83, 0xed, 0xcd, 0x4d, 0x18, 0x6b, 0x91, 0x13, 0xe3, 0xdd, 0xad, 0x84, 0xfa, 0x60, 0xa6,
+	0x65, 0x32, 0x53, 0x81, 0x50, 0xac, 0x5a, 0xff, 0x19, 0x3d, 0x80, 0x1c, 0x3e, 0xc4, 0xad, 0x4e,
+	0xd8, 0x2b, 0x7c, 0x91, 0xf9, 0x49, 0x17, 0xe9, 0xf3, 0x42, 0x4f, 0xf1, 0x27, 0x09, 0xe6, 0x87,
+	0x4f, 0xf8, 0x06, 0xcc, 0xf5, 0x30, 0x35, 0x5a, 0x91, 0xda, 0xa5, 0x13, 0xad, 0x57, 0xf3, 0x99,
+	0x96, 0xed, 0x61, 0xba, 0x19, 0xfa, 0xd1, 0x1d, 0x58, 0x34, 0xf7, 0x29, 0x33, 0x1d, 0x5f, 0x10,
+	0x52, 0xa7, 0x12, 0x16, 0x04, 0x88, 0x93, 0x3e, 0x80, 0xac, 0x4f, 0x04, 0x7e, 0xfa, 0x54, 0xfc,
+	0xac, 0x4f, 0x38, 0xf4, 0x1e, 0x20, 0x9f, 0x18, 0x2f, 0x1c, 0x76, 0x60, 0x74, 0x31, 0x8b, 0x49,
+	0xe9, 0x53, 0x49, 0x4b, 0x3e, 0x79, 0xec, 0xb0, 0x83, 0x3d, 0xcc, 0x38, 0xb9, 0xf8, 0x97, 0x04,
+	0xe9, 0x70, 0xb0, 0x8c, 0x1f, 0x0b, 0x25, 0x98, 0xe9, 0x12, 0x86, 0xc7, 0x8f, 0x04, 0x0e, 0x43,
+	0xf7, 0x60, 0x96, 0x4f, 0x29, 0xaa, 0xa4, 0x23, 0xc1, 0x5d, 0x4b, 0x34, 0xd1, 0xc9, 0x11, 0xa8,
+	0xc5, 0x8c, 0x91, 0x53, 0x9d, 0x49, 0x9c, 0xea, 0xe7, 0x00, 0x2d, 0xe2, 0x77, 0x9d, 0x56, 0x34,
+	0x2b, 0x79, 0x57, 0xe4, 0x13, 0xb1, 0x37, 0xfb, 0x80, 0x6d, 0xdc, 0xc5, 0xae, 0x36, 0xc4, 0x78,
+	0x98, 0xce, 0x4e, 0xcb, 0xe9, 0xe2, 0x2f, 0x12, 0x2c, 0x0a, 0x6d, 0x37, 0xcc, 0xc0, 0xf4, 0x28,
+	0x7a, 0x0a, 0xf3, 0x9e, 0xe3, 0xf7, 0xbb, 0x44, 0x1a, 0xd7, 0x25, 0x57, 0xc3, 0x2e, 0x39, 0x3e,
+	0x2a, 0xfc, 0x67, 0x88, 0x75, 0x93, 0x78, 0x0e, 0xc3, 0x5e, 0x9b, 0xf5, 0x34, 0xf0, 0x1c, 0x3f,
+	0x6e, 0x1e, 0x0f, 0x90, 0x67, 0x1e, 0xc6, 0x20, 0xa3, 0x8d, 0x03, 0x87, 0x58, 0x51, 0x21, 0xc3,
+	0x15, 0x92, 0x62, 0xac, 0x8a, 0x8b, 0x64, 0xe3, 0xbf, 0xc7, 0x47, 0x85, 0x2b, 0x27, 0x89, 0x83,
+	0x45, 0xbe, 0x0b, 0xb5, 0x2a, 0x7b, 0xe6, 0x61, 0xbc, 0x93, 0xc8, 0x5f, 0xfc, 0x51, 0x82, 0x85,
+	0xbd, 0xa8, 0x4b, 0xc4, 0xd6, 0xaa, 0x20, 0xba, 0x26, 0x5e, 0x5a, 0x1a, 0xb7, 0x74, 0x3a, 0x0a,
+	0xbd, 0xc0, 0x59, 0x3c, 0x2c, 0x7a, 0x04, 0xe7, 0x06, 0x65, 0x34, 0xdc, 0xb0, 0xb0, 0xf1, 0x4c,
+	0x1e, 0x53, 0x7f, 0x31, 0x51, 0xe4, 0xd6, 0xa8, 0x99, 0x16, 0xbf, 0x95, 0x60, 0x29, 0x81, 0x45,
+	0x5b, 0xb0, 0xe8, 0x92, 0xd6, 0x73, 0x23, 0xbe, 0x4f, 0xc7, 0x27, 0x9b, 0x0d, 0xa3, 0xf3, 0x84,
+	0x43, 0x66, 0x6c, 0x47, 0x25, 0x00, 0xaf, 0xe3, 0x32, 0xa7, 0xed, 0x3a, 0x7d, 0xdd, 0x26, 0xaf,
+	0xc8, 0x21, 0x44, 0xf1, 0x57, 0x09, 0x72, 0x43, 0xd9, 0x90, 0xd6, 0xf3, 0x81, 0xea, 0xa5, 0xc9,
+	0x54, 0x9f, 0x68, 0xa3, 0xd4, 0x89, 0x36, 0x1a, 0xbe, 0x25, 0xa5, 0x7f, 0x71, 0x4b, 0xa2, 0x2f,
+	0x20, 0xdb, 0x9f, 0x95, 0xe9, 0xb1, 0x63, 0x2c, 0x2a, 0x49, 0x34, 0xca, 0x66, 0x31, 0x9f, 0x94,
+	0xc5, 0x3f, 0xe2, 0x21, 0x26, 0x44, 0xf1, 0x19, 0x64, 0xbe, 0xee, 0x90, 0xa0, 0xe3, 0x89, 0xbd,
+	0x15, 0x8f, 0x8f, 0x0a, 0x32, 0xb7, 0x0c, 0x14, 0x96, 0x7c, 0xa1, 0xe0, 0x7e, 0xb4, 0x09, 0x73,
+	0xec, 0x20, 0xc0, 0xf4, 0x80, 0xb8, 0x96, 0x28, 0xec, 0xff, 0x8e, 0x8f, 0x0a, 0xe7, 0xfb, 0xc6,
+	0x33, 0x23, 0x0c, 0x78, 0xe8, 0x11, 0xe4, 0xa2, 0x81, 0x35, 0x88, 0xc4, 0x27, 0xdd, 0xf5, 0xe3,
+	0xa3, 0x82, 0x32, 0xea, 0x39, 0x33, 0xdc, 0x62, 0x88, 0xd3, 0x63, 0x58, 0xf1, 0xcf, 0x14, 0x2c,
+	0xa9, 0xf1, 0xe8, 0x16, 0xfb, 0x34, 0x60, 0x69, 0x70, 0x0b, 0x58, 0xd8, 0x35, 0x7b, 0xe3, 0x15,
+	0x75, 0x59, 0xf4, 0x76, 0x92, 0x19, 0x89, 0x6c, 0x70, 0xa9, 0x54, 0x43, 0x1b, 0x7a, 0x02, 0xcb,
+	0x1e, 0xb5, 0x8d, 0x04, 0x34, 0x6e, 0x8d, 0xd5, 0x44, 0x6b, 0x7c, 0x49, 0x6d, 0x75, 0x84, 0x2f,
+	0xce, 0x19, 0x79, 0x49, 0x07, 0x45, 0x77, 0x21, 0x6b, 0x77, 0xcc, 0xc0, 0x72, 0x4c, 0x5f, 0x99,
+	0x1e, 0x23, 0xc0, 0x3e, 0x12, 0xf9, 0xa0, 0x60, 0x0f, 0x07, 0x36, 0xf6, 0x5b, 0x3d, 0x23, 0x51,
+	0x61, 0x7e, 0x2d, 0xdc, 0x3d, 0x3e, 0x2a, 0x14, 0xcf, 0xc2, 0x9c, 0x59, 0xeb, 0x0b, 0x7d, 0xc6,
+	0xde, 0x48, 0xd1, 0xdb, 0x70, 0xee, 0xc4, 0xa6, 0xd0, 0x2a, 0x2c, 0x84, 0x45, 0x61, 0xbd, 0x36,
+	0x36, 0x3a, 0x81, 0xcb, 0x35, 0xa6, 0x81, 0x47, 0x6d, 0xbd, 0xd7, 0xc6, 0xbb, 0x81, 0x8b, 0x3e,
+	0x85, 0x19, 0x7e, 0x1a, 0xa9, 0xc9, 0xfb, 0x9b, 0x33, 0xae, 0x7f, 0x23, 0x01, 0x0c, 0xbd, 0x39,
+	0x5f, 0x86, 0x8b, 0x7b, 0x75, 0x5d, 0x35, 0xea, 0x0d, 0xbd, 0x56, 0xdf, 0x31, 0x76, 0x77, 0x9a,
+	0x0d, 0x75, 0xb3, 0x76, 0xbf, 0xa6, 0x56, 0xe5, 0x29, 0x74, 0x1e, 0x96, 0x86, 0x9d, 0x4f, 0xd5,
+	0xa6, 0x2c, 0xa1, 0x8b, 0x70, 0x7e, 0xd8, 0x58, 0xd9, 0x68, 0xea, 0x95, 0xda, 0x8e, 0x9c, 0x42,
+	0x08, 0x72, 0xc3, 0x8e, 0x9d, 0xba, 0x3c, 0x8d, 0xae, 0x80, 0x32, 0x6a, 0x33, 0x1e, 0xd7, 0xf4,
+	0x2d, 0x63, 0x4f, 0xd5, 0xeb, 0x72, 0xfa, 0xfa, 0xdf, 0x12, 0xe4, 0x46, 0x5f, 0x29, 0x51, 0x01,
+	0x2e, 0x37, 0xb4, 0x7a, 0xa3, 0xde, 0xac, 0x6c, 0x1b, 0x4d, 0xbd, 0xa2, 0xef, 0x36, 0x13, 0x39,
+	0x15, 0x21, 0x9f, 0x04, 0x54, 0xd5, 0x46, 0xbd, 0x59, 0xd3, 0x8d, 0x86, 0xaa, 0xd5, 0xea, 0x55,
+	0x59, 0x42, 0xd7, 0xe0, 0x6a, 0x12, 0xb3, 0x57, 0xd7, 0x6b, 0x3b, 0x0f, 0x62, 0x48, 0x0a, 0xad,
+	0xc0, 0x85, 0x24, 0xa4, 0x51, 0x69, 0x36, 0xd5, 0x2a, 0x4f, 0x3a, 0xe9, 0xd3, 0xd4, 0x87, 0xea,
+	0xa6, 0xae, 0x56, 0xe5, 0xf4, 0x69, 0xcc, 0xfb, 0x95, 0xda, 0xb6, 0x5a, 0x95, 0x67, 0xd0, 0x4d,
+	0x58, 0x3b, 0x3d, 0xaa, 0xd1, 0x50, 0x77, 0xaa, 0x61, 0x02, 0xea, 0x13, 0x75, 0x73, 0x37, 0xac,
+	0x85, 0x9c, 0xd9, 0x50, 0x5f, 0xbf, 0xcb, 0x4b, 0x6f, 0xde, 0xe5, 0xa5, 0xdf, 0xdf, 0xe5, 0xa5,
+	0x97, 0xef, 0xf3, 0x53, 0x6f, 0xde, 0xe7, 0xa7, 0x7e, 0x7e, 0x9f, 0x9f, 0xfa, 0xea, 0x86, 0xed,
+	0xb0, 0x83, 0xce, 0x7e, 0xa9, 0x45, 0x3c, 0xf1, 0xf9, 0x23, 0x7e, 0x6e, 0x51, 0xeb, 0x79, 0xf9,
+	0x30, 0xfa, 0xa4, 0x0b, 0xe5, 0x41, 0xc3, 0xef, 0xb5, 0x4c, 0x74, 0xea, 0x77, 0xfe, 0x19, 0x00,
+	0x43, 0x1a, 0x8d, 0x20, 0xf0, 0x0d, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -856,6 +1201,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -864,22 +1219,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -898,22 +1253,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1027,6 +1382,18 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Conviction != nil {
+		{
+			size, err := m.Conviction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1084,12 +1451,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1130,20 +1497,34 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConvictionLevels) > 0 {
+		for iNdEx := len(m.ConvictionLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvictionLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+func (m *ConvictionLevel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1153,54 +1534,242 @@ func (m *TallyParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConvictionLevel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConvictionLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Multiplier)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0xa
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err10 != nil {
+		return 0, err10
 	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConvictionLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ConvictionLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EmergencyVetoThreshold) > 0 {
+		i -= len(m.EmergencyVetoThreshold)
+		copy(dAtA[i:], m.EmergencyVetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.EmergencyVetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgExecutionDelays) > 0 {
+		for iNdEx := len(m.MsgExecutionDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgExecutionDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGov(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecutionDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecutionDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecutionDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGov(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1284,6 +1853,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExecutionTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1335,6 +1908,10 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Conviction != nil {
+		l = m.Conviction.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1367,6 +1944,47 @@ func (m *VotingParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.ConvictionLevels) > 0 {
+		for _, e := range m.ConvictionLevels {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvictionLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvictionLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1391,6 +2009,46 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
+func (m *ExecutionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.MsgExecutionDelays) > 0 {
+		for _, e := range m.MsgExecutionDelays {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.EmergencyVetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecutionDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1980,6 +2638,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2325,22 +3019,58 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conviction == nil {
+				m.Conviction = &ConvictionLevel{}
+			}
+			if err := m.Conviction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -2531,6 +3261,40 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvictionLevels = append(m.ConvictionLevels, ConvictionLevel{})
+			if err := m.ConvictionLevels[len(m.ConvictionLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2552,7 +3316,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *ConvictionLevel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2575,17 +3339,17 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvictionLevel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvictionLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2595,27 +3359,28 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2643,11 +3408,61 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvictionLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2675,7 +3490,534 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgExecutionDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgExecutionDelays = append(m.MsgExecutionDelays, MsgExecutionDelay{})
+			if err := m.MsgExecutionDelays[len(m.MsgExecutionDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyVetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyVetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecutionDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecutionDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecutionDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12,7 +12,7 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _ sdk.Msg                         = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgCancelProposalExecution{}
 	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
@@ -140,7 +140,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 // NewMsgVote creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option, metadata}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option, Metadata: metadata}
 }
 
 // Route implements Msg
@@ -176,7 +176,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, metadata string) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options, metadata}
+	return &MsgVoteWeighted{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
 // Route implements Msg
@@ -260,3 +260,37 @@ func (c MsgExecLegacyContent) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	var content v1beta1.Content
 	return unpacker.UnpackAny(c.Content, &content)
 }
+
+// NewMsgCancelProposalExecution creates a message to cancel the execution of a
+// passed proposal pending execution.
+//nolint:interfacer
+func NewMsgCancelProposalExecution(guardian sdk.AccAddress, proposalID uint64) *MsgCancelProposalExecution {
+	return &MsgCancelProposalExecution{ProposalId: proposalID, Guardian: guardian.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposalExecution) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposalExecution) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposalExecution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid guardian address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposalExecution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposalExecution) GetSigners() []sdk.AccAddress {
+	guardian, _ := sdk.AccAddressFromBech32(msg.Guardian)
+	return []sdk.AccAddress{guardian}
+}
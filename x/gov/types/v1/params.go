@@ -20,6 +20,8 @@ var (
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+
+	DefaultEmergencyVetoThreshold = sdk.NewDecWithPrec(334, 3)
 )
 
 // Parameter store key
@@ -27,6 +29,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyExecutionParams = []byte("executionparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -35,6 +39,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyExecutionParams, ExecutionParams{}, validateExecutionParams),
 	)
 }
 
@@ -167,31 +172,159 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	var prevLockDuration time.Duration
+	for i, level := range v.ConvictionLevels {
+		if level.LockDuration <= prevLockDuration {
+			return fmt.Errorf("conviction level %d lock duration must be positive and greater than the previous level's: %s", i+1, level.LockDuration)
+		}
+		prevLockDuration = level.LockDuration
+
+		multiplier, err := sdk.NewDecFromStr(level.Multiplier)
+		if err != nil {
+			return fmt.Errorf("invalid conviction level %d multiplier string: %w", i+1, err)
+		}
+		if multiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("conviction level %d multiplier must be at least 1: %s", i+1, multiplier)
+		}
+	}
+
+	return nil
+}
+
+// NewConvictionLevel creates a new ConvictionLevel object
+func NewConvictionLevel(lockDuration time.Duration, multiplier sdk.Dec) ConvictionLevel {
+	return ConvictionLevel{
+		LockDuration: lockDuration,
+		Multiplier:   multiplier.String(),
+	}
+}
+
+// GetConvictionLevel returns the conviction level of the given 1-based index,
+// and false if conviction is zero or there is no such level.
+func (vp VotingParams) GetConvictionLevel(conviction uint32) (ConvictionLevel, bool) {
+	if conviction == 0 || int(conviction) > len(vp.ConvictionLevels) {
+		return ConvictionLevel{}, false
+	}
+
+	return vp.ConvictionLevels[conviction-1], true
+}
+
+// NewExecutionParams creates a new ExecutionParams object
+func NewExecutionParams(
+	executionDelay time.Duration, msgExecutionDelays []MsgExecutionDelay, guardian string, emergencyVetoThreshold sdk.Dec,
+) ExecutionParams {
+	return ExecutionParams{
+		ExecutionDelay:         executionDelay,
+		MsgExecutionDelays:     msgExecutionDelays,
+		Guardian:               guardian,
+		EmergencyVetoThreshold: emergencyVetoThreshold.String(),
+	}
+}
+
+// DefaultExecutionParams default parameters for the execution of passed
+// proposals, executing them at the end of their voting period.
+func DefaultExecutionParams() ExecutionParams {
+	return NewExecutionParams(0, nil, "", DefaultEmergencyVetoThreshold)
+}
+
+// ExecutionDelayFor returns the execution delay of a proposal containing the
+// given messages, that is the longest of their delays. The delay of a message
+// is the one of its MsgExecutionDelays override, which may be shorter than
+// ExecutionDelay, or ExecutionDelay if it has none. Proposals without messages
+// are delayed by ExecutionDelay.
+func (ep ExecutionParams) ExecutionDelayFor(msgs []sdk.Msg) time.Duration {
+	if len(msgs) == 0 {
+		return ep.ExecutionDelay
+	}
+
+	var delay time.Duration
+	for _, msg := range msgs {
+		msgDelay := ep.ExecutionDelay
+		typeURL := sdk.MsgTypeURL(msg)
+		for _, d := range ep.MsgExecutionDelays {
+			if d.MsgTypeUrl == typeURL {
+				msgDelay = d.Delay
+				break
+			}
+		}
+
+		if msgDelay > delay {
+			delay = msgDelay
+		}
+	}
+
+	return delay
+}
+
+func validateExecutionParams(i interface{}) error {
+	v, ok := i.(ExecutionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.ExecutionDelay < 0 {
+		return fmt.Errorf("execution delay cannot be negative: %s", v.ExecutionDelay)
+	}
+
+	typeURLs := make(map[string]bool, len(v.MsgExecutionDelays))
+	for _, d := range v.MsgExecutionDelays {
+		if d.MsgTypeUrl == "" {
+			return errors.New("msg execution delay type URL cannot be empty")
+		}
+		if typeURLs[d.MsgTypeUrl] {
+			return fmt.Errorf("duplicate msg execution delay for %s", d.MsgTypeUrl)
+		}
+		if d.Delay < 0 {
+			return fmt.Errorf("msg execution delay for %s cannot be negative: %s", d.MsgTypeUrl, d.Delay)
+		}
+		typeURLs[d.MsgTypeUrl] = true
+	}
+
+	if v.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(v.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %w", err)
+		}
+	}
+
+	emergencyVetoThreshold, err := sdk.NewDecFromStr(v.EmergencyVetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid emergency veto threshold string: %w", err)
+	}
+	if !emergencyVetoThreshold.IsPositive() {
+		return fmt.Errorf("emergency veto threshold must be positive: %s", emergencyVetoThreshold)
+	}
+	if emergencyVetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("emergency veto threshold too large: %s", emergencyVetoThreshold)
+	}
+
 	return nil
 }
 
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams    VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams     TallyParams     `json:"tally_params" yaml:"tally_params"`
+	DepositParams   DepositParams   `json:"deposit_params" yaml:"deposit_params"`
+	ExecutionParams ExecutionParams `json:"execution_params" yaml:"execution_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ExecutionParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ep ExecutionParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:    vp,
+		DepositParams:   dp,
+		TallyParams:     tp,
+		ExecutionParams: ep,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), DefaultExecutionParams())
 }
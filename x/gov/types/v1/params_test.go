@@ -0,0 +1,43 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestExecutionDelayFor(t *testing.T) {
+	vote, deposit, cancel := &v1.MsgVote{}, &v1.MsgDeposit{}, &v1.MsgCancelProposalExecution{}
+	ep := v1.NewExecutionParams(
+		time.Hour,
+		[]v1.MsgExecutionDelay{
+			{MsgTypeUrl: sdk.MsgTypeURL(vote), Delay: time.Minute},
+			{MsgTypeUrl: sdk.MsgTypeURL(deposit), Delay: 2 * time.Hour},
+		},
+		"",
+		v1.DefaultEmergencyVetoThreshold,
+	)
+
+	testCases := []struct {
+		name  string
+		msgs  []sdk.Msg
+		delay time.Duration
+	}{
+		{"no msgs", nil, time.Hour},
+		{"no override", []sdk.Msg{cancel}, time.Hour},
+		{"shorter override", []sdk.Msg{vote}, time.Minute},
+		{"longer override", []sdk.Msg{deposit}, 2 * time.Hour},
+		{"shorter override and no override", []sdk.Msg{vote, cancel}, time.Hour},
+		{"shorter and longer overrides", []sdk.Msg{vote, deposit}, 2 * time.Hour},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.delay, ep.ExecutionDelayFor(tc.msgs))
+		})
+	}
+}
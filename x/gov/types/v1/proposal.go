@@ -14,12 +14,13 @@ const (
 	// DefaultStartingProposalID is 1
 	DefaultStartingProposalID uint64 = 1
 
-	StatusNil           = ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
-	StatusDepositPeriod = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
-	StatusVotingPeriod  = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
-	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
-	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
-	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusNil                    = ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	StatusDepositPeriod          = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
+	StatusVotingPeriod           = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
+	StatusPassed                 = ProposalStatus_PROPOSAL_STATUS_PASSED
+	StatusRejected               = ProposalStatus_PROPOSAL_STATUS_REJECTED
+	StatusFailed                 = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusPassedPendingExecution = ProposalStatus_PROPOSAL_STATUS_PASSED_PENDING_EXECUTION
 )
 
 // NewProposal creates a new Proposal instance
@@ -124,7 +125,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusPassedPendingExecution {
 		return true
 	}
 	return false
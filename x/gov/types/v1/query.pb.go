@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -463,7 +464,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "execution".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -515,6 +516,11 @@ type QueryParamsResponse struct {
 	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
 	// tally_params defines the parameters related to tally.
 	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	// execution_params defines the parameters related to the execution of
+	// passed proposals.
+	//
+	// Since: cosmos-sdk 0.47
+	ExecutionParams *ExecutionParams `protobuf:"bytes,4,opt,name=execution_params,json=executionParams,proto3" json:"execution_params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -571,6 +577,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetExecutionParams() *ExecutionParams {
+	if m != nil {
+		return m.ExecutionParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -873,6 +886,123 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryConvictionLocksRequest is the request type for the Query/ConvictionLocks
+// RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryConvictionLocksRequest struct {
+	// voter defines the address of the voter.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConvictionLocksRequest) Reset()         { *m = QueryConvictionLocksRequest{} }
+func (m *QueryConvictionLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvictionLocksRequest) ProtoMessage()    {}
+func (*QueryConvictionLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{16}
+}
+func (m *QueryConvictionLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvictionLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvictionLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvictionLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvictionLocksRequest.Merge(m, src)
+}
+func (m *QueryConvictionLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvictionLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvictionLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConvictionLocksRequest proto.InternalMessageInfo
+
+func (m *QueryConvictionLocksRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *QueryConvictionLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConvictionLocksResponse is the response type for the
+// Query/ConvictionLocks RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryConvictionLocksResponse struct {
+	// locks defines the conviction locks of the voter, including the expired
+	// ones which haven't been pruned yet.
+	Locks []ConvictionLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConvictionLocksResponse) Reset()         { *m = QueryConvictionLocksResponse{} }
+func (m *QueryConvictionLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvictionLocksResponse) ProtoMessage()    {}
+func (*QueryConvictionLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{17}
+}
+func (m *QueryConvictionLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvictionLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvictionLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConvictionLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvictionLocksResponse.Merge(m, src)
+}
+func (m *QueryConvictionLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvictionLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvictionLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConvictionLocksResponse proto.InternalMessageInfo
+
+func (m *QueryConvictionLocksResponse) GetLocks() []ConvictionLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *QueryConvictionLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
@@ -890,72 +1020,82 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryConvictionLocksRequest)(nil), "cosmos.gov.v1.QueryConvictionLocksRequest")
+	proto.RegisterType((*QueryConvictionLocksResponse)(nil), "cosmos.gov.v1.QueryConvictionLocksResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1076 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x38, 0x76, 0x1b, 0xbf, 0x34, 0x49, 0x99, 0xa6, 0x8d, 0xd9, 0x06, 0x27, 0x6c, 0x68,
+	0x92, 0x36, 0x74, 0x17, 0xa7, 0xff, 0x04, 0xb4, 0x12, 0x24, 0x34, 0x50, 0xa9, 0x87, 0xe0, 0x56,
+	0x1c, 0xb8, 0x58, 0x1b, 0x7b, 0xb5, 0xac, 0xea, 0xec, 0x6c, 0x3d, 0xeb, 0x55, 0x43, 0x1a, 0x21,
+	0x55, 0x42, 0x70, 0x02, 0x24, 0x8a, 0x40, 0xe2, 0xc6, 0x81, 0x4f, 0xc0, 0x87, 0xe8, 0xb1, 0x82,
+	0x0b, 0x27, 0x84, 0x12, 0x3e, 0x04, 0x47, 0xb4, 0x33, 0x6f, 0x36, 0xbb, 0x9b, 0xb5, 0xe3, 0x44,
+	0x11, 0x27, 0x7b, 0x66, 0x7e, 0xef, 0xf7, 0x7e, 0xef, 0xbd, 0x99, 0xf7, 0x6c, 0x78, 0xb5, 0xc9,
+	0xf8, 0x26, 0xe3, 0xa6, 0xc3, 0x42, 0x33, 0xac, 0x99, 0x8f, 0xbb, 0x76, 0x67, 0xcb, 0xf0, 0x3b,
+	0x2c, 0x60, 0x74, 0x4c, 0x1e, 0x19, 0x0e, 0x0b, 0x8d, 0xb0, 0xa6, 0x5d, 0x41, 0xe4, 0x86, 0xc5,
+	0x6d, 0x89, 0x33, 0xc3, 0xda, 0x86, 0x1d, 0x58, 0x35, 0xd3, 0xb7, 0x1c, 0xd7, 0xb3, 0x02, 0x97,
+	0x79, 0xd2, 0x54, 0x9b, 0x76, 0x18, 0x73, 0xda, 0xb6, 0x69, 0xf9, 0xae, 0x69, 0x79, 0x1e, 0x0b,
+	0xc4, 0x21, 0xc7, 0xd3, 0xa9, 0xb4, 0xcf, 0x88, 0x5f, 0x1e, 0xa0, 0x98, 0x86, 0x58, 0x99, 0xe8,
+	0x5e, 0x1e, 0x4d, 0x3a, 0xcc, 0x61, 0x72, 0x3f, 0xfa, 0x26, 0x77, 0xf5, 0x5b, 0x30, 0xf9, 0x71,
+	0xa4, 0x64, 0xbd, 0xc3, 0x7c, 0xc6, 0xad, 0x76, 0xdd, 0x7e, 0xdc, 0xb5, 0x79, 0x40, 0x67, 0x60,
+	0xd4, 0xc7, 0xad, 0x86, 0xdb, 0xaa, 0x90, 0x59, 0xb2, 0x58, 0xac, 0x83, 0xda, 0xba, 0xd7, 0xd2,
+	0xef, 0xc3, 0xf9, 0x8c, 0x21, 0xf7, 0x99, 0xc7, 0x6d, 0x7a, 0x0d, 0x46, 0x14, 0x4c, 0x98, 0x8d,
+	0x2e, 0x4f, 0x19, 0xa9, 0x3c, 0x18, 0xb1, 0x49, 0x0c, 0xd4, 0xbf, 0x2d, 0x64, 0xe8, 0xb8, 0x12,
+	0xb2, 0x06, 0x13, 0xb1, 0x10, 0x1e, 0x58, 0x41, 0x97, 0x0b, 0xd6, 0xf1, 0xe5, 0xd7, 0x7a, 0xb0,
+	0x3e, 0x10, 0xa0, 0xfa, 0xb8, 0x9f, 0x5a, 0x53, 0x03, 0x4a, 0x21, 0x0b, 0xec, 0x4e, 0xa5, 0x30,
+	0x4b, 0x16, 0xcb, 0x2b, 0x95, 0xdf, 0x7f, 0xbb, 0x3a, 0x89, 0x04, 0xef, 0xb7, 0x5a, 0x1d, 0x9b,
+	0xf3, 0x07, 0x41, 0xc7, 0xf5, 0x9c, 0xba, 0x84, 0xd1, 0x9b, 0x50, 0x6e, 0xd9, 0x3e, 0xe3, 0x6e,
+	0xc0, 0x3a, 0x95, 0xe1, 0x43, 0x6c, 0xf6, 0xa1, 0x74, 0x0d, 0x60, 0xbf, 0x98, 0x95, 0xa2, 0x48,
+	0xc0, 0xbc, 0x92, 0x1a, 0x55, 0xde, 0x90, 0x37, 0x04, 0x2b, 0x6f, 0xac, 0x5b, 0x8e, 0x8d, 0xb1,
+	0xd6, 0x13, 0x96, 0xfa, 0x4f, 0x04, 0x2e, 0x64, 0x33, 0x82, 0x19, 0xbe, 0x01, 0x65, 0x15, 0x5c,
+	0x94, 0x8c, 0xe1, 0x7e, 0x29, 0xde, 0x47, 0xd2, 0x0f, 0x53, 0xca, 0x0a, 0x42, 0xd9, 0xc2, 0xa1,
+	0xca, 0xa4, 0xcf, 0x94, 0xb4, 0x26, 0x9c, 0x15, 0xca, 0x3e, 0x61, 0x81, 0x3d, 0xe8, 0x7d, 0x39,
+	0x6a, 0xfe, 0xf5, 0xdb, 0xf0, 0x4a, 0xc2, 0x09, 0x46, 0xbe, 0x00, 0xc5, 0xe8, 0x14, 0xef, 0xd5,
+	0xb9, 0x4c, 0xd0, 0x02, 0x2a, 0x00, 0xfa, 0xd3, 0x84, 0x35, 0x1f, 0x58, 0xe3, 0x5a, 0x4e, 0x86,
+	0x8e, 0x53, 0xbb, 0xaf, 0x09, 0xd0, 0xa4, 0x7b, 0x54, 0x7f, 0x59, 0xa6, 0x40, 0xd5, 0x2c, 0x57,
+	0xbe, 0x44, 0x9c, 0x5c, 0xad, 0x6e, 0xa0, 0x92, 0x75, 0xab, 0x63, 0x6d, 0xa6, 0x32, 0x21, 0x36,
+	0x1a, 0xc1, 0x96, 0x2f, 0xd3, 0x59, 0xae, 0x83, 0xdc, 0x7a, 0xb8, 0xe5, 0xdb, 0xfa, 0xaf, 0x05,
+	0x38, 0x97, 0xb2, 0xc3, 0x10, 0xde, 0x83, 0xb1, 0x90, 0x05, 0xae, 0xe7, 0x34, 0x24, 0x18, 0x2b,
+	0x71, 0xf1, 0x60, 0x28, 0xae, 0xe7, 0xa0, 0xed, 0x99, 0x30, 0xb1, 0xa2, 0xab, 0x30, 0x8e, 0x8f,
+	0x45, 0x51, 0xc8, 0xe8, 0xa6, 0x33, 0x14, 0x1f, 0x48, 0x10, 0x72, 0x8c, 0xb5, 0x92, 0x4b, 0x7a,
+	0x07, 0xce, 0x04, 0x56, 0xbb, 0xbd, 0xa5, 0x28, 0x86, 0x05, 0x85, 0x96, 0xa1, 0x78, 0x18, 0x41,
+	0x90, 0x60, 0x34, 0xd8, 0x5f, 0xd0, 0x7b, 0x70, 0xd6, 0x7e, 0x62, 0x37, 0xbb, 0x51, 0x86, 0x14,
+	0x85, 0x7c, 0xa9, 0xd5, 0x0c, 0xc5, 0x5d, 0x05, 0x43, 0x9a, 0x09, 0x3b, 0xbd, 0xa1, 0x7b, 0x98,
+	0x27, 0x94, 0x3b, 0xf0, 0x55, 0x4b, 0xb5, 0x97, 0xc2, 0xc0, 0xed, 0x45, 0xff, 0x08, 0x26, 0xd3,
+	0xfe, 0xb0, 0x30, 0x6f, 0xc1, 0x69, 0x04, 0x61, 0x49, 0x2e, 0xe4, 0xe7, 0xb3, 0xae, 0x60, 0xfa,
+	0x17, 0x69, 0xa6, 0xff, 0xff, 0x95, 0x3c, 0x27, 0x70, 0x3e, 0xa3, 0x00, 0x83, 0x59, 0x86, 0x11,
+	0x54, 0xa9, 0xde, 0x4a, 0xaf, 0x68, 0x62, 0xdc, 0xc9, 0xbd, 0x98, 0x77, 0x60, 0x4a, 0xa8, 0x12,
+	0xb7, 0xa7, 0x6e, 0xf3, 0x6e, 0x3b, 0x38, 0xc2, 0x50, 0xac, 0x1c, 0xb4, 0x8d, 0x2b, 0x54, 0x12,
+	0x77, 0xb0, 0x42, 0x7a, 0x5f, 0x56, 0x34, 0x91, 0x40, 0xfd, 0x07, 0x02, 0x17, 0x05, 0xdd, 0x2a,
+	0xf3, 0x42, 0xb7, 0x19, 0xa9, 0xbb, 0xcf, 0x9a, 0x8f, 0xe2, 0x4a, 0xc5, 0x2d, 0x95, 0x0c, 0x36,
+	0xd2, 0x4e, 0xaa, 0x70, 0xbf, 0x10, 0x98, 0xce, 0xd7, 0x85, 0xa1, 0xbe, 0x0d, 0xa5, 0x76, 0xb4,
+	0x81, 0xc5, 0xcb, 0x4e, 0xea, 0xb4, 0xd9, 0x4a, 0xf1, 0xc5, 0x5f, 0x33, 0x43, 0x75, 0x69, 0x71,
+	0x62, 0x65, 0x5c, 0xfe, 0xb7, 0x0c, 0x25, 0x21, 0x92, 0x7e, 0x49, 0x60, 0x44, 0xcd, 0x43, 0x3a,
+	0x97, 0xd1, 0x92, 0xf7, 0xe3, 0x47, 0x7b, 0xa3, 0x3f, 0x48, 0x7a, 0xd3, 0x8d, 0x67, 0x7f, 0xfc,
+	0xf3, 0x7d, 0x61, 0x91, 0xce, 0x9b, 0xe9, 0x5f, 0x63, 0xf1, 0xc4, 0x35, 0xb7, 0x13, 0xb7, 0x65,
+	0x87, 0x7e, 0x0e, 0x65, 0xc5, 0xc1, 0x69, 0x5f, 0x17, 0xaa, 0xc2, 0xda, 0xa5, 0x43, 0x50, 0xa8,
+	0x64, 0x56, 0x28, 0xd1, 0x68, 0xa5, 0x97, 0x12, 0xfa, 0x15, 0x81, 0x62, 0x34, 0x5f, 0xe8, 0x4c,
+	0x1e, 0x63, 0x62, 0x90, 0x6b, 0xb3, 0xbd, 0x01, 0xe8, 0xed, 0xb6, 0xf0, 0x76, 0x93, 0x5e, 0x1f,
+	0x2c, 0x6e, 0x53, 0x4c, 0x34, 0x73, 0x3b, 0xfa, 0xe8, 0xec, 0xd0, 0x67, 0x04, 0x4a, 0x11, 0x1d,
+	0xa7, 0x3d, 0x3d, 0xc5, 0xe1, 0xbf, 0xde, 0x07, 0x81, 0x62, 0xae, 0x0b, 0x31, 0x06, 0x7d, 0xf3,
+	0x28, 0x62, 0xe8, 0x53, 0x38, 0x85, 0xa3, 0x20, 0xd7, 0x45, 0x6a, 0x58, 0x6a, 0x7a, 0x3f, 0x08,
+	0xca, 0x58, 0x12, 0x32, 0x2e, 0xd1, 0xb9, 0xac, 0x0c, 0x01, 0x33, 0xb7, 0x13, 0xd3, 0x76, 0x87,
+	0xfe, 0x48, 0xe0, 0x34, 0x36, 0x30, 0x9a, 0x4b, 0x9e, 0x1e, 0x26, 0xda, 0x5c, 0x5f, 0x0c, 0x2a,
+	0x58, 0x15, 0x0a, 0xee, 0xd0, 0x77, 0x07, 0x4c, 0x84, 0x6a, 0x9c, 0xe6, 0x76, 0x3c, 0x5c, 0x76,
+	0xe8, 0x37, 0x04, 0x46, 0x90, 0x98, 0xd3, 0x7e, 0x6e, 0x79, 0xdf, 0xa7, 0x92, 0x6d, 0xe8, 0xfa,
+	0x2d, 0x21, 0xae, 0x46, 0xcd, 0x23, 0x8a, 0xa3, 0xcf, 0x09, 0x8c, 0x26, 0x3a, 0x23, 0x9d, 0xcf,
+	0x73, 0x77, 0xb0, 0x53, 0x6b, 0x0b, 0x87, 0xe2, 0x8e, 0x79, 0x7f, 0x44, 0x67, 0xa6, 0x3f, 0x13,
+	0x98, 0xc8, 0x34, 0x3f, 0x7a, 0x25, 0xcf, 0x65, 0x7e, 0xe7, 0xd6, 0x96, 0x06, 0xc2, 0xa2, 0x44,
+	0x53, 0x48, 0xbc, 0x4c, 0x17, 0x32, 0x12, 0x9b, 0x31, 0xbe, 0x21, 0x7a, 0xa7, 0x7a, 0x62, 0x2b,
+	0x77, 0x5f, 0xec, 0x56, 0xc9, 0xcb, 0xdd, 0x2a, 0xf9, 0x7b, 0xb7, 0x4a, 0xbe, 0xdb, 0xab, 0x0e,
+	0xbd, 0xdc, 0xab, 0x0e, 0xfd, 0xb9, 0x57, 0x1d, 0xfa, 0x74, 0xc9, 0x71, 0x83, 0xcf, 0xba, 0x1b,
+	0x46, 0x93, 0x6d, 0x2a, 0x32, 0xf9, 0x71, 0x95, 0xb7, 0x1e, 0x99, 0x4f, 0x04, 0x73, 0x74, 0x47,
+	0x79, 0xf4, 0xc7, 0xf4, 0x94, 0xf8, 0x87, 0x78, 0xed, 0xbf, 0x01, 0x00, 0xc6, 0x03, 0xc0, 0xf0,
+	0xe1, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1126,10 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ConvictionLocks queries the conviction locks of a voter.
+	//
+	// Since: cosmos-sdk 0.47
+	ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1212,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ConvictionLocks(ctx context.Context, in *QueryConvictionLocksRequest, opts ...grpc.CallOption) (*QueryConvictionLocksResponse, error) {
+	out := new(QueryConvictionLocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ConvictionLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1239,10 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ConvictionLocks queries the conviction locks of a voter.
+	//
+	// Since: cosmos-sdk 0.47
+	ConvictionLocks(context.Context, *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1273,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ConvictionLocks(ctx context.Context, req *QueryConvictionLocksRequest) (*QueryConvictionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvictionLocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1425,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConvictionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvictionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvictionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ConvictionLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvictionLocks(ctx, req.(*QueryConvictionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1479,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ConvictionLocks",
+			Handler:    _Query_ConvictionLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1681,6 +1863,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionParams != nil {
+		{
+			size, err := m.ExecutionParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1942,6 +2136,97 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConvictionLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvictionLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvictionLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvictionLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvictionLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvictionLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2116,6 +2401,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ExecutionParams != nil {
+		l = m.ExecutionParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2208,6 +2497,42 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConvictionLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvictionLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3289,6 +3614,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionParams == nil {
+				m.ExecutionParams = &ExecutionParams{}
+			}
+			if err := m.ExecutionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,6 +4238,244 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConvictionLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConvictionLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConvictionLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConvictionLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConvictionLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConvictionLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, ConvictionLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -247,6 +247,11 @@ type MsgVote struct {
 	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
 	Metadata   string     `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// conviction is the 1-based index of the conviction level of the voting
+	// params the voter locks their tokens for. No tokens are locked if zero.
+	//
+	// Since: cosmos-sdk 0.47
+	Conviction uint32 `protobuf:"varint,5,opt,name=conviction,proto3" json:"conviction,omitempty"`
 }
 
 func (m *MsgVote) Reset()         { *m = MsgVote{} }
@@ -310,6 +315,13 @@ func (m *MsgVote) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgVote) GetConviction() uint32 {
+	if m != nil {
+		return m.Conviction
+	}
+	return 0
+}
+
 // MsgVoteResponse defines the Msg/Vote response type.
 type MsgVoteResponse struct {
 }
@@ -353,6 +365,11 @@ type MsgVoteWeighted struct {
 	Voter      string                `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Options    []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
 	Metadata   string                `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// conviction is the 1-based index of the conviction level of the voting
+	// params the voter locks their tokens for. No tokens are locked if zero.
+	//
+	// Since: cosmos-sdk 0.47
+	Conviction uint32 `protobuf:"varint,5,opt,name=conviction,proto3" json:"conviction,omitempty"`
 }
 
 func (m *MsgVoteWeighted) Reset()         { *m = MsgVoteWeighted{} }
@@ -416,6 +433,13 @@ func (m *MsgVoteWeighted) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgVoteWeighted) GetConviction() uint32 {
+	if m != nil {
+		return m.Conviction
+	}
+	return 0
+}
+
 // MsgVoteWeightedResponse defines the Msg/VoteWeighted response type.
 type MsgVoteWeightedResponse struct {
 }
@@ -551,6 +575,103 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgCancelProposalExecution defines a message to cancel the execution of a
+// passed proposal pending execution.
+//
+// Since: cosmos-sdk 0.47
+type MsgCancelProposalExecution struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// guardian is the guardian set in the execution params.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgCancelProposalExecution) Reset()         { *m = MsgCancelProposalExecution{} }
+func (m *MsgCancelProposalExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalExecution) ProtoMessage()    {}
+func (*MsgCancelProposalExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
+}
+func (m *MsgCancelProposalExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalExecution.Merge(m, src)
+}
+func (m *MsgCancelProposalExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalExecution proto.InternalMessageInfo
+
+func (m *MsgCancelProposalExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposalExecution) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgCancelProposalExecutionResponse defines the Msg/CancelProposalExecution
+// response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgCancelProposalExecutionResponse struct {
+}
+
+func (m *MsgCancelProposalExecutionResponse) Reset()         { *m = MsgCancelProposalExecutionResponse{} }
+func (m *MsgCancelProposalExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalExecutionResponse) ProtoMessage()    {}
+func (*MsgCancelProposalExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
+}
+func (m *MsgCancelProposalExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalExecutionResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalExecutionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -562,58 +683,66 @@ func init() {
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1.MsgDepositResponse")
+	proto.RegisterType((*MsgCancelProposalExecution)(nil), "cosmos.gov.v1.MsgCancelProposalExecution")
+	proto.RegisterType((*MsgCancelProposalExecutionResponse)(nil), "cosmos.gov.v1.MsgCancelProposalExecutionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6a, 0xdb, 0x4a,
-	0x14, 0xc6, 0xad, 0xd8, 0x37, 0x4e, 0x4e, 0x6e, 0x1c, 0x32, 0x98, 0x44, 0x16, 0x41, 0x71, 0x7c,
-	0xe1, 0x62, 0x6e, 0x88, 0x14, 0xe7, 0x96, 0x16, 0x92, 0x52, 0x88, 0xd3, 0xd0, 0x16, 0x6a, 0x5a,
-	0x14, 0x48, 0xa1, 0x14, 0x82, 0x6c, 0x4d, 0x27, 0xa2, 0x91, 0x46, 0x68, 0xc6, 0x26, 0x5e, 0xb6,
-	0x4f, 0xd0, 0xf7, 0xe8, 0xa6, 0x8b, 0xec, 0xbb, 0x2b, 0xa1, 0xab, 0xd0, 0x55, 0x56, 0xa1, 0x24,
-	0x8b, 0x42, 0x57, 0x7d, 0x84, 0x22, 0xcd, 0x48, 0x4e, 0xac, 0xfc, 0xeb, 0xa6, 0x2b, 0x4b, 0xe7,
-	0x7c, 0xdf, 0x99, 0xf3, 0xd3, 0xcc, 0xf1, 0xc0, 0x4c, 0x87, 0x32, 0x8f, 0x32, 0x93, 0xd0, 0x9e,
-	0xd9, 0x6b, 0x98, 0x7c, 0xdf, 0x08, 0x42, 0xca, 0x29, 0x9a, 0x14, 0x71, 0x83, 0xd0, 0x9e, 0xd1,
-	0x6b, 0x68, 0xba, 0x94, 0xb5, 0x6d, 0x86, 0xcd, 0x5e, 0xa3, 0x8d, 0xb9, 0xdd, 0x30, 0x3b, 0xd4,
-	0xf5, 0x85, 0x5c, 0x9b, 0xbd, 0x58, 0x26, 0x72, 0x89, 0x44, 0x99, 0x50, 0x42, 0xe3, 0x47, 0x33,
-	0x7a, 0x92, 0xd1, 0x8a, 0x90, 0xef, 0x88, 0x84, 0x5c, 0x4a, 0xa6, 0x08, 0xa5, 0x64, 0x0f, 0x9b,
-	0xf1, 0x5b, 0xbb, 0xfb, 0xda, 0xb4, 0xfd, 0xfe, 0xd0, 0x22, 0x1e, 0x23, 0xd1, 0x22, 0x1e, 0x23,
-	0x22, 0x51, 0xfb, 0xa9, 0xc0, 0x74, 0x8b, 0x91, 0xad, 0x6e, 0xdb, 0x73, 0xf9, 0xf3, 0x90, 0x06,
-	0x94, 0xd9, 0x7b, 0x68, 0x19, 0xc6, 0x3c, 0xcc, 0x98, 0x4d, 0x30, 0x53, 0x95, 0x6a, 0xbe, 0x3e,
-	0xb1, 0x52, 0x36, 0x44, 0x71, 0x23, 0x29, 0x6e, 0xac, 0xfb, 0x7d, 0x2b, 0x55, 0xa1, 0xc7, 0x30,
-	0xe5, 0xfa, 0x2e, 0x77, 0xed, 0xbd, 0x1d, 0x07, 0x07, 0x94, 0xb9, 0x5c, 0x1d, 0x89, 0x8d, 0x15,
-	0x43, 0xf6, 0x18, 0xf1, 0x1b, 0x92, 0xdf, 0xd8, 0xa0, 0xae, 0xdf, 0x2c, 0x1c, 0x9e, 0xcc, 0xe7,
-	0xac, 0x92, 0xf4, 0x3d, 0x14, 0x36, 0x74, 0x07, 0xc6, 0x82, 0xb8, 0x0f, 0x1c, 0xaa, 0xf9, 0xaa,
-	0x52, 0x1f, 0x6f, 0xaa, 0x5f, 0x0f, 0x96, 0xca, 0xb2, 0xca, 0xba, 0xe3, 0x84, 0x98, 0xb1, 0x2d,
-	0x1e, 0xba, 0x3e, 0xb1, 0x52, 0x25, 0xd2, 0xa2, 0x8e, 0xb9, 0xed, 0xd8, 0xdc, 0x56, 0x0b, 0x91,
-	0xcb, 0x4a, 0xdf, 0x57, 0x27, 0xdf, 0x7d, 0xff, 0xf8, 0x5f, 0x2a, 0xad, 0xdd, 0x87, 0x4a, 0x86,
-	0xd8, 0xc2, 0x2c, 0xa0, 0x3e, 0xc3, 0x68, 0x1e, 0x26, 0x02, 0x19, 0xdb, 0x71, 0x1d, 0x55, 0xa9,
-	0x2a, 0xf5, 0x82, 0x05, 0x49, 0xe8, 0x89, 0x53, 0x7b, 0xab, 0x40, 0xb9, 0xc5, 0xc8, 0xe6, 0x3e,
-	0xee, 0x3c, 0xc5, 0xc4, 0xee, 0xf4, 0x37, 0xa8, 0xcf, 0xb1, 0xcf, 0xd1, 0x1a, 0x14, 0x3b, 0xe2,
-	0x31, 0x76, 0x5d, 0xf1, 0xc9, 0x9a, 0x13, 0x5f, 0x0e, 0x96, 0x8a, 0xd2, 0x63, 0x25, 0x0e, 0x34,
-	0x07, 0xe3, 0x76, 0x97, 0xef, 0xd2, 0xd0, 0xe5, 0x7d, 0x75, 0x24, 0xee, 0x7f, 0x10, 0x58, 0x2d,
-	0x45, 0x00, 0x83, 0xf7, 0x9a, 0x0e, 0x73, 0x97, 0xb5, 0x90, 0x40, 0xd4, 0x3e, 0x2b, 0x50, 0x6c,
-	0x31, 0xb2, 0x4d, 0x39, 0x46, 0xcb, 0x97, 0x00, 0x35, 0xa7, 0x7e, 0x9c, 0xcc, 0x9f, 0x0f, 0x9f,
-	0x27, 0x44, 0x06, 0xfc, 0xd5, 0xa3, 0x1c, 0x87, 0xa2, 0x8f, 0x6b, 0xbe, 0xbe, 0x90, 0xa1, 0x06,
-	0x8c, 0xd2, 0x80, 0xbb, 0xd4, 0x8f, 0xb7, 0xab, 0x34, 0xd8, 0x71, 0x31, 0x00, 0x46, 0xd4, 0xc6,
-	0xb3, 0x58, 0x60, 0x49, 0xe1, 0xb5, 0xbb, 0x05, 0x11, 0xac, 0x28, 0x5d, 0x9b, 0x86, 0x29, 0xc9,
-	0x91, 0xb2, 0x1d, 0x2b, 0x69, 0xec, 0x05, 0x76, 0xc9, 0x2e, 0xc7, 0xce, 0x1f, 0x60, 0x5c, 0x83,
-	0xa2, 0x68, 0x9d, 0xa9, 0xf9, 0xf8, 0x58, 0x2f, 0x0c, 0x41, 0x26, 0xbd, 0x9c, 0x83, 0x4d, 0x1c,
-	0xb7, 0xa6, 0xad, 0xc0, 0xec, 0x10, 0x59, 0x4a, 0xfd, 0x49, 0x01, 0x68, 0x31, 0x92, 0xcc, 0xc8,
-	0xef, 0x03, 0xdf, 0x85, 0x71, 0x39, 0x97, 0xf4, 0x66, 0xe8, 0x81, 0x14, 0xdd, 0x83, 0x51, 0xdb,
-	0xa3, 0x5d, 0x9f, 0x4b, 0xee, 0x1b, 0xc7, 0x59, 0xca, 0xe5, 0x99, 0x4d, 0x0b, 0xd5, 0xca, 0x80,
-	0x06, 0x00, 0x09, 0xd7, 0xca, 0x87, 0x3c, 0xe4, 0x5b, 0x8c, 0xa0, 0x57, 0x50, 0x1a, 0xfa, 0x0b,
-	0xaa, 0x0e, 0x7d, 0xe0, 0xcc, 0xc8, 0x6a, 0xf5, 0x9b, 0x14, 0xe9, 0x50, 0x63, 0x98, 0xce, 0xce,
-	0xeb, 0x3f, 0x59, 0x7b, 0x46, 0xa4, 0x2d, 0xde, 0x42, 0x94, 0x2e, 0xf3, 0x00, 0x0a, 0xf1, 0xc8,
-	0xcd, 0x64, 0x4d, 0x51, 0x5c, 0xd3, 0x2f, 0x8f, 0xa7, 0xfe, 0x6d, 0xf8, 0xfb, 0xc2, 0xb1, 0xbe,
-	0x42, 0x9f, 0xe4, 0xb5, 0x7f, 0xaf, 0xcf, 0xa7, 0x75, 0x1f, 0x41, 0x31, 0x39, 0x38, 0x95, 0xac,
-	0x45, 0xa6, 0xb4, 0x85, 0x2b, 0x53, 0x49, 0xa1, 0xe6, 0xe6, 0xe1, 0xa9, 0xae, 0x1c, 0x9d, 0xea,
-	0xca, 0xb7, 0x53, 0x5d, 0x79, 0x7f, 0xa6, 0xe7, 0x8e, 0xce, 0xf4, 0xdc, 0xf1, 0x99, 0x9e, 0x7b,
-	0xb9, 0x48, 0x5c, 0xbe, 0xdb, 0x6d, 0x1b, 0x1d, 0xea, 0xc9, 0x3b, 0x49, 0xfe, 0x2c, 0x31, 0xe7,
-	0x8d, 0xb9, 0x1f, 0x5f, 0x6e, 0xbc, 0x1f, 0x60, 0x16, 0xdd, 0x80, 0xa3, 0xf1, 0x1f, 0xe2, 0xff,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xdb, 0x52, 0x42, 0x41, 0x07, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6b, 0xdb, 0x58,
+	0x10, 0xb6, 0x62, 0x27, 0x4e, 0x26, 0x1b, 0x87, 0x08, 0x93, 0xc8, 0x22, 0x28, 0x8e, 0x76, 0x59,
+	0xbc, 0x1b, 0x22, 0xc5, 0xd9, 0xb0, 0x0b, 0xc9, 0xb2, 0x10, 0x67, 0xc3, 0x6e, 0xa1, 0xa6, 0x45,
+	0x81, 0x14, 0x4a, 0x21, 0xc8, 0xd2, 0xeb, 0x8b, 0xa8, 0xad, 0x27, 0xf4, 0x9e, 0xdd, 0xf8, 0xd8,
+	0xfe, 0x82, 0x42, 0xff, 0x42, 0x7f, 0x40, 0x0f, 0xb9, 0xf7, 0x1a, 0x7a, 0x0a, 0x3d, 0xf5, 0x14,
+	0x4a, 0x02, 0x2d, 0xf4, 0xd4, 0x63, 0x8f, 0x45, 0xd2, 0x93, 0xec, 0x58, 0x71, 0x9c, 0x40, 0xe9,
+	0xc9, 0x7a, 0x33, 0xdf, 0x37, 0x6f, 0xbe, 0xd1, 0xcc, 0x58, 0x30, 0x6f, 0x11, 0xda, 0x22, 0x54,
+	0xc7, 0xa4, 0xa3, 0x77, 0xaa, 0x3a, 0x3b, 0xd2, 0x3c, 0x9f, 0x30, 0x22, 0xce, 0x44, 0x76, 0x0d,
+	0x93, 0x8e, 0xd6, 0xa9, 0xca, 0x0a, 0x87, 0x35, 0x4c, 0x8a, 0xf4, 0x4e, 0xb5, 0x81, 0x98, 0x59,
+	0xd5, 0x2d, 0xe2, 0xb8, 0x11, 0x5c, 0x5e, 0xb8, 0x1c, 0x26, 0x60, 0x45, 0x8e, 0x22, 0x26, 0x98,
+	0x84, 0x8f, 0x7a, 0xf0, 0xc4, 0xad, 0xa5, 0x08, 0x7e, 0x10, 0x39, 0xf8, 0x55, 0xdc, 0x85, 0x09,
+	0xc1, 0x4d, 0xa4, 0x87, 0xa7, 0x46, 0xfb, 0xb1, 0x6e, 0xba, 0xdd, 0x81, 0x4b, 0x5a, 0x14, 0x07,
+	0x97, 0xb4, 0x28, 0x8e, 0x1c, 0xea, 0x17, 0x01, 0xe6, 0xea, 0x14, 0xef, 0xb5, 0x1b, 0x2d, 0x87,
+	0xdd, 0xf7, 0x89, 0x47, 0xa8, 0xd9, 0x14, 0xd7, 0x60, 0xb2, 0x85, 0x28, 0x35, 0x31, 0xa2, 0x92,
+	0x50, 0xce, 0x56, 0xa6, 0xd7, 0x8b, 0x5a, 0x14, 0x5c, 0x8b, 0x83, 0x6b, 0xdb, 0x6e, 0xd7, 0x48,
+	0x50, 0xe2, 0xff, 0x30, 0xeb, 0xb8, 0x0e, 0x73, 0xcc, 0xe6, 0x81, 0x8d, 0x3c, 0x42, 0x1d, 0x26,
+	0x8d, 0x85, 0xc4, 0x92, 0xc6, 0x73, 0x0c, 0xf4, 0x6b, 0x5c, 0xbf, 0xb6, 0x43, 0x1c, 0xb7, 0x96,
+	0x3b, 0x39, 0x5b, 0xca, 0x18, 0x05, 0xce, 0xfb, 0x37, 0xa2, 0x89, 0x1b, 0x30, 0xe9, 0x85, 0x79,
+	0x20, 0x5f, 0xca, 0x96, 0x85, 0xca, 0x54, 0x4d, 0x7a, 0x77, 0xbc, 0x5a, 0xe4, 0x51, 0xb6, 0x6d,
+	0xdb, 0x47, 0x94, 0xee, 0x31, 0xdf, 0x71, 0xb1, 0x91, 0x20, 0x45, 0x39, 0xc8, 0x98, 0x99, 0xb6,
+	0xc9, 0x4c, 0x29, 0x17, 0xb0, 0x8c, 0xe4, 0xbc, 0x39, 0xf3, 0xfc, 0xd3, 0xeb, 0xdf, 0x13, 0xa8,
+	0xfa, 0x37, 0x94, 0x52, 0x8a, 0x0d, 0x44, 0x3d, 0xe2, 0x52, 0x24, 0x2e, 0xc1, 0xb4, 0xc7, 0x6d,
+	0x07, 0x8e, 0x2d, 0x09, 0x65, 0xa1, 0x92, 0x33, 0x20, 0x36, 0xdd, 0xb1, 0xd5, 0x67, 0x02, 0x14,
+	0xeb, 0x14, 0xef, 0x1e, 0x21, 0xeb, 0x2e, 0xc2, 0xa6, 0xd5, 0xdd, 0x21, 0x2e, 0x43, 0x2e, 0x13,
+	0xb7, 0x20, 0x6f, 0x45, 0x8f, 0x21, 0x6b, 0x48, 0xc9, 0x6a, 0xd3, 0x6f, 0x8f, 0x57, 0xf3, 0x9c,
+	0x63, 0xc4, 0x0c, 0x71, 0x11, 0xa6, 0xcc, 0x36, 0x3b, 0x24, 0xbe, 0xc3, 0xba, 0xd2, 0x58, 0x98,
+	0x7f, 0xcf, 0xb0, 0x59, 0x08, 0x04, 0xf4, 0xce, 0xaa, 0x02, 0x8b, 0x57, 0xa5, 0x10, 0x8b, 0x50,
+	0x3f, 0x0a, 0x90, 0xaf, 0x53, 0xbc, 0x4f, 0x18, 0x12, 0xd7, 0xae, 0x10, 0x54, 0x9b, 0xfd, 0x7c,
+	0xb6, 0xd4, 0x6f, 0xee, 0x57, 0x28, 0x6a, 0x30, 0xde, 0x21, 0x0c, 0xf9, 0xd2, 0xd8, 0x88, 0xea,
+	0x47, 0x30, 0xb1, 0x0a, 0x13, 0xc4, 0x63, 0x0e, 0x71, 0xc3, 0xd7, 0x55, 0xe8, 0xbd, 0xf1, 0x68,
+	0x00, 0xb4, 0x20, 0x8d, 0x7b, 0x21, 0xc0, 0xe0, 0xc0, 0xeb, 0xde, 0x96, 0xa8, 0x00, 0x58, 0xc4,
+	0xed, 0x38, 0x56, 0x18, 0x72, 0xbc, 0x2c, 0x54, 0x66, 0x8c, 0x3e, 0xcb, 0x26, 0x04, 0xc5, 0x88,
+	0xae, 0x56, 0xe7, 0x60, 0x96, 0xeb, 0x4c, 0xb4, 0x7f, 0x15, 0x12, 0xdb, 0x03, 0xe4, 0xe0, 0x43,
+	0x86, 0xec, 0x1f, 0x50, 0x83, 0x2d, 0xc8, 0x47, 0xd2, 0xa8, 0x94, 0x0d, 0xdb, 0x7e, 0x79, 0xa0,
+	0x08, 0x71, 0x2e, 0x7d, 0xc5, 0x88, 0x19, 0xdf, 0xad, 0x1a, 0x25, 0x58, 0x18, 0x50, 0x9e, 0x54,
+	0xe5, 0x8d, 0x00, 0x50, 0xa7, 0x38, 0x9e, 0xb1, 0xdb, 0x17, 0xe4, 0x4f, 0x98, 0xe2, 0x73, 0x4d,
+	0x46, 0x17, 0xa5, 0x07, 0x15, 0xff, 0x82, 0x09, 0xb3, 0x45, 0xda, 0x2e, 0xe3, 0x75, 0x19, 0xb9,
+	0x0e, 0x38, 0x9c, 0xf7, 0x7c, 0x12, 0x48, 0x2d, 0x82, 0xd8, 0x13, 0x90, 0xe8, 0x7a, 0x29, 0x80,
+	0x5c, 0xa7, 0x78, 0xc7, 0x74, 0x2d, 0xd4, 0x8c, 0x87, 0x39, 0x18, 0x8c, 0x76, 0xd8, 0x67, 0xb7,
+	0xd7, 0xb9, 0x01, 0x93, 0xb8, 0x6d, 0xfa, 0xb6, 0x63, 0xba, 0x23, 0x65, 0x26, 0x48, 0xbe, 0x61,
+	0xe2, 0xa3, 0xfa, 0x0b, 0xa8, 0xc3, 0x93, 0x8a, 0x73, 0x5f, 0x7f, 0x95, 0x83, 0x6c, 0x9d, 0x62,
+	0xf1, 0x11, 0x14, 0x06, 0xd6, 0x6f, 0x79, 0xa0, 0x79, 0x52, 0xeb, 0x4a, 0xae, 0x8c, 0x42, 0x24,
+	0x0b, 0x0d, 0xc1, 0x5c, 0x7a, 0x57, 0xfd, 0x9c, 0xa6, 0xa7, 0x40, 0xf2, 0xca, 0x0d, 0x40, 0xc9,
+	0x35, 0xff, 0x40, 0x2e, 0x5c, 0x37, 0xf3, 0x69, 0x52, 0x60, 0x97, 0x95, 0xab, 0xed, 0x09, 0x7f,
+	0x1f, 0x7e, 0xba, 0x34, 0xb2, 0x43, 0xf0, 0xb1, 0x5f, 0xfe, 0xf5, 0x7a, 0x7f, 0x12, 0xf7, 0x3f,
+	0xc8, 0xc7, 0x4d, 0x5f, 0x4a, 0x53, 0xb8, 0x4b, 0x5e, 0x1e, 0xea, 0x4a, 0x02, 0x3d, 0x85, 0x85,
+	0x61, 0x5d, 0xf6, 0x5b, 0x9a, 0x3d, 0x04, 0x2a, 0x57, 0x6f, 0x0c, 0x8d, 0x2f, 0xae, 0xed, 0x9e,
+	0x9c, 0x2b, 0xc2, 0xe9, 0xb9, 0x22, 0x7c, 0x38, 0x57, 0x84, 0x17, 0x17, 0x4a, 0xe6, 0xf4, 0x42,
+	0xc9, 0xbc, 0xbf, 0x50, 0x32, 0x0f, 0x57, 0xb0, 0xc3, 0x0e, 0xdb, 0x0d, 0xcd, 0x22, 0x2d, 0xfe,
+	0x21, 0xc0, 0x7f, 0x56, 0xa9, 0xfd, 0x44, 0x3f, 0x0a, 0xbf, 0x28, 0x58, 0xd7, 0x43, 0x34, 0xf8,
+	0xec, 0x98, 0x08, 0xff, 0x85, 0xfe, 0xf8, 0x36, 0x00, 0x9d, 0xe9, 0xf8, 0xcb, 0xb6, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -639,6 +768,11 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// CancelProposalExecution defines a method for the guardian to cancel the
+	// execution of a passed proposal during its execution delay.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposalExecution(ctx context.Context, in *MsgCancelProposalExecution, opts ...grpc.CallOption) (*MsgCancelProposalExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -694,6 +828,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CancelProposalExecution(ctx context.Context, in *MsgCancelProposalExecution, opts ...grpc.CallOption) (*MsgCancelProposalExecutionResponse, error) {
+	out := new(MsgCancelProposalExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelProposalExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -707,6 +850,11 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// CancelProposalExecution defines a method for the guardian to cancel the
+	// execution of a passed proposal during its execution delay.
+	//
+	// Since: cosmos-sdk 0.47
+	CancelProposalExecution(context.Context, *MsgCancelProposalExecution) (*MsgCancelProposalExecutionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -728,6 +876,9 @@ func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWei
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposalExecution(ctx context.Context, req *MsgCancelProposalExecution) (*MsgCancelProposalExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposalExecution not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -823,6 +974,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposalExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposalExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposalExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelProposalExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposalExecution(ctx, req.(*MsgCancelProposalExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -847,6 +1016,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "CancelProposalExecution",
+			Handler:    _Msg_CancelProposalExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1030,6 +1203,11 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Conviction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Conviction))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1100,6 +1278,11 @@ func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Conviction != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Conviction))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1231,6 +1414,64 @@ func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposalExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1329,6 +1570,9 @@ func (m *MsgVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Conviction != 0 {
+		n += 1 + sovTx(uint64(m.Conviction))
+	}
 	return n
 }
 
@@ -1364,6 +1608,9 @@ func (m *MsgVoteWeighted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Conviction != 0 {
+		n += 1 + sovTx(uint64(m.Conviction))
+	}
 	return n
 }
 
@@ -1407,6 +1654,31 @@ func (m *MsgDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposalExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1963,6 +2235,25 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
+			}
+			m.Conviction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conviction |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2180,6 +2471,25 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conviction", wireType)
+			}
+			m.Conviction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conviction |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2436,6 +2746,157 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposalExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
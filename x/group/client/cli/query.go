@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/spf13/cobra"
 )
@@ -51,14 +53,13 @@ func QueryGroupsByMemberCmd() *cobra.Command {
 			}
 
 			queryClient := group.NewQueryClient(clientCtx)
-			res, err := queryClient.GroupsByMember(cmd.Context(), &group.QueryGroupsByMemberRequest{
-				Address: args[0],
-			})
-			if err != nil {
-				return err
-			}
 
-			return clientCtx.PrintProto(res)
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GroupsByMember(ctx, &group.QueryGroupsByMemberRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				})
+			})
 		},
 	}
 
@@ -149,26 +150,19 @@ func QueryGroupMembersCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.GroupMembers(cmd.Context(), &group.QueryGroupMembersRequest{
-				GroupId:    groupID,
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+					GroupId:    groupID,
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group members")
 
 	return cmd
 }
@@ -185,26 +179,19 @@ func QueryGroupsByAdminCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.GroupsByAdmin(cmd.Context(), &group.QueryGroupsByAdminRequest{
-				Admin:      args[0],
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GroupsByAdmin(ctx, &group.QueryGroupsByAdminRequest{
+					Admin:      args[0],
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "groups")
 
 	return cmd
 }
@@ -226,26 +213,19 @@ func QueryGroupPoliciesByGroupCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.GroupPoliciesByGroup(cmd.Context(), &group.QueryGroupPoliciesByGroupRequest{
-				GroupId:    groupID,
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GroupPoliciesByGroup(ctx, &group.QueryGroupPoliciesByGroupRequest{
+					GroupId:    groupID,
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group policies")
 
 	return cmd
 }
@@ -262,26 +242,19 @@ func QueryGroupPoliciesByAdminCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.GroupPoliciesByAdmin(cmd.Context(), &group.QueryGroupPoliciesByAdminRequest{
-				Admin:      args[0],
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.GroupPoliciesByAdmin(ctx, &group.QueryGroupPoliciesByAdminRequest{
+					Admin:      args[0],
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "group policies")
 
 	return cmd
 }
@@ -333,26 +306,19 @@ func QueryProposalsByGroupPolicyCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.ProposalsByGroupPolicy(cmd.Context(), &group.QueryProposalsByGroupPolicyRequest{
-				Address:    args[0],
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.ProposalsByGroupPolicy(ctx, &group.QueryProposalsByGroupPolicyRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 
 	return cmd
 }
@@ -410,26 +376,19 @@ func QueryVotesByProposalCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.VotesByProposal(cmd.Context(), &group.QueryVotesByProposalRequest{
-				ProposalId: proposalID,
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{
+					ProposalId: proposalID,
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
 
 	return cmd
 }
@@ -481,26 +440,19 @@ func QueryVotesByVoterCmd() *cobra.Command {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			queryClient := group.NewQueryClient(clientCtx)
 
-			res, err := queryClient.VotesByVoter(cmd.Context(), &group.QueryVotesByVoterRequest{
-				Voter:      args[0],
-				Pagination: pageReq,
+			return client.PrintOptionalPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.VotesByVoter(ctx, &group.QueryVotesByVoterRequest{
+					Voter:      args[0],
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
 
 	return cmd
 }
@@ -43,6 +43,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgUpdateGroupPolicyMetadataCmd(),
 		MsgSubmitProposalCmd(),
 		MsgVoteCmd(),
+		MsgSignVoteCmd(),
+		MsgSubmitVotesCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
 	)
@@ -746,6 +748,157 @@ Parameters:
 	return cmd
 }
 
+// MsgSignVoteCmd creates a CLI command signing an off-chain vote for Msg/SubmitVotes.
+func MsgSignVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-vote [proposal-id] [voter] [vote-option] [metadata] [group-version]",
+		Short: "Sign an off-chain vote on a proposal",
+		Long: `Sign an off-chain vote on a proposal, and print it as JSON. The signed votes
+can then be submitted on-chain by anyone with the submit-votes command.
+
+Parameters:
+			proposal-id: unique ID of the proposal
+			voter: voter key name or address.
+			vote-option: choice of the voter
+				VOTE_OPTION_UNSPECIFIED: no-op
+				VOTE_OPTION_NO: no
+				VOTE_OPTION_YES: yes
+				VOTE_OPTION_ABSTAIN: abstain
+				VOTE_OPTION_NO_WITH_VETO: no-with-veto
+			metadata: metadata for the vote
+			group-version: current version of the group voting on the proposal
+`,
+		Example: fmt.Sprintf(
+			"%s tx group sign-vote 1 alice VOTE_OPTION_YES \"\" 1 --chain-id my-chain > vote.json", version.AppName,
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voteOption, err := group.VoteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			groupVersion, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("the chain ID must be set with the --%s flag", flags.FlagChainID)
+			}
+
+			signDoc := group.NewVoteSignDoc(clientCtx.GetFromAddress().String(), proposalID, voteOption, args[3], clientCtx.ChainID, groupVersion)
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signDoc.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			vote := &group.OffChainVote{
+				Voter:     clientCtx.GetFromAddress().String(),
+				Option:    voteOption,
+				Metadata:  args[3],
+				Signature: sig,
+			}
+			if err = vote.ValidateBasic(); err != nil {
+				return fmt.Errorf("vote validation failed: %w", err)
+			}
+
+			return clientCtx.PrintProto(vote)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgSubmitVotesCmd creates a CLI command for Msg/SubmitVotes.
+func MsgSubmitVotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-votes [submitter] [proposal-id] [votes-json-file]",
+		Short: "Submit a batch of off-chain votes on a proposal",
+		Long: `Submit a batch of off-chain votes on a proposal, signed by the voters with the
+sign-vote command.
+
+Parameters:
+			submitter: submitter account address, which doesn't need to be a group member.
+			proposal-id: unique ID of the proposal
+			votes-json-file: path to json file with the signed votes
+`,
+		Example: fmt.Sprintf(`
+%s tx group submit-votes [submitter] [proposal-id] votes.json
+
+where votes.json contains:
+
+[
+	{
+		"voter": "cosmos1...",
+		"option": "VOTE_OPTION_YES",
+		"metadata": "",
+		"signature": "..."
+	}
+]
+`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			votes, err := parseOffChainVotes(clientCtx.Codec, args[2])
+			if err != nil {
+				return err
+			}
+
+			execStr, _ := cmd.Flags().GetString(FlagExec)
+
+			msg := &group.MsgSubmitVotes{
+				ProposalId: proposalID,
+				Submitter:  clientCtx.GetFromAddress().String(),
+				Votes:      votes,
+				Exec:       execFromString(execStr),
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute proposal immediately after submitting the votes")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgExecCmd creates a CLI command for Msg/MsgExec.
 func MsgExecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -80,3 +80,26 @@ func parseMsgs(cdc codec.Codec, p Proposal) ([]sdk.Msg, error) {
 
 	return msgs, nil
 }
+
+// parseOffChainVotes reads a json file with an array of proto-JSON-encoded
+// signed off-chain votes.
+func parseOffChainVotes(cdc codec.Codec, votesFile string) ([]group.OffChainVote, error) {
+	contents, err := os.ReadFile(votesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var votesJSON []json.RawMessage
+	if err := json.Unmarshal(contents, &votesJSON); err != nil {
+		return nil, err
+	}
+
+	votes := make([]group.OffChainVote, len(votesJSON))
+	for i, voteJSON := range votesJSON {
+		if err := cdc.UnmarshalJSON(voteJSON, &votes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return votes, nil
+}
@@ -14,6 +14,9 @@ type AccountKeeper interface {
 
 	// Set an account in the store.
 	SetAccount(sdk.Context, authtypes.AccountI)
+
+	// Retrieve the x/auth params, for the signature verification costs.
+	GetParams(sdk.Context) authtypes.Params
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
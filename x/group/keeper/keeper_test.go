@@ -12,6 +12,9 @@ import (
 	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -2198,6 +2201,260 @@ func (s *TestSuite) TestVote() {
 	require.NotEqual(tallyResult.String(), tallyResult1.String())
 }
 
+func (s *TestSuite) TestSubmitVotes() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr5 := addrs[4]
+
+	const chainID = "test-chain"
+	ctx := s.sdkCtx.WithChainID(chainID)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// the off-chain votes are verified with the public keys of the voters
+	// stored in x/auth
+	privKeys := make([]cryptotypes.PrivKey, 3)
+	voters := make([]sdk.AccAddress, 3)
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		voters[i] = sdk.AccAddress(privKeys[i].PubKey().Address())
+		acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, voters[i])
+		s.Require().NoError(acc.SetPubKey(privKeys[i].PubKey()))
+		s.app.AccountKeeper.SetAccount(ctx, acc)
+	}
+	nonMemberKey := secp256k1.GenPrivKey()
+	nonMember := sdk.AccAddress(nonMemberKey.PubKey().Address())
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, nonMember)
+	s.Require().NoError(acc.SetPubKey(nonMemberKey.PubKey()))
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	multisigKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey()})
+	multisigVoter := sdk.AccAddress(multisigKey.Address())
+	acc = s.app.AccountKeeper.NewAccountWithAddress(ctx, multisigVoter)
+	s.Require().NoError(acc.SetPubKey(multisigKey))
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+
+	members := []group.MemberRequest{
+		{Address: voters[0].String(), Weight: "1"},
+		{Address: voters[1].String(), Weight: "2"},
+		{Address: voters[2].String(), Weight: "3"},
+		{Address: addr5.String(), Weight: "1"},
+		{Address: multisigVoter.String(), Weight: "1"},
+	}
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addr1, members, group.NewThresholdDecisionPolicy("3", time.Hour, 0))
+	policy, err := sdk.AccAddressFromBech32(policyAddr)
+	s.Require().NoError(err)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, policy, sdk.Coins{sdk.NewInt64Coin("test", 10000)}))
+
+	submitProposal := func() uint64 {
+		req := &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addr5.String()},
+		}
+		s.Require().NoError(req.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+			FromAddress: policyAddr,
+			ToAddress:   addr2.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		}}))
+		res, err := s.keeper.SubmitProposal(goCtx, req)
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	signVote := func(priv cryptotypes.PrivKey, proposalID uint64, option group.VoteOption, chainID string, groupVersion uint64) group.OffChainVote {
+		signDoc := group.NewVoteSignDoc(sdk.AccAddress(priv.PubKey().Address()).String(), proposalID, option, "", chainID, groupVersion)
+		sig, err := priv.Sign(signDoc.GetSignBytes())
+		s.Require().NoError(err)
+		return group.OffChainVote{
+			Voter:     sdk.AccAddress(priv.PubKey().Address()).String(),
+			Option:    option,
+			Signature: sig,
+		}
+	}
+
+	specs := map[string]struct {
+		srcVotes    func(proposalID uint64) []group.OffChainVote
+		preRun      func(proposalID uint64)
+		exec        group.Exec
+		expErr      bool
+		expTally    group.TallyResult
+		expExecuted bool
+	}{
+		"valid votes are counted": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{
+					signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+					signVote(privKeys[1], proposalID, group.VOTE_OPTION_NO, chainID, 1),
+					signVote(privKeys[2], proposalID, group.VOTE_OPTION_ABSTAIN, chainID, 1),
+				}
+			},
+			expTally: group.TallyResult{
+				YesCount:        "1",
+				NoCount:         "2",
+				AbstainCount:    "3",
+				NoWithVetoCount: "0",
+			},
+		},
+		"with try exec": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{
+					signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+					signVote(privKeys[2], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+				}
+			},
+			exec:        group.Exec_EXEC_TRY,
+			expExecuted: true,
+		},
+		"invalid signature": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				vote := signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)
+				vote.Option = group.VOTE_OPTION_NO
+				return []group.OffChainVote{vote}
+			},
+			expErr: true,
+		},
+		"signed by another key": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				vote := signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)
+				vote.Voter = voters[1].String()
+				return []group.OffChainVote{vote}
+			},
+			expErr: true,
+		},
+		"signed for another proposal": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{signVote(privKeys[0], proposalID+1, group.VOTE_OPTION_YES, chainID, 1)}
+			},
+			expErr: true,
+		},
+		"signed for another chain": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, "other-chain", 1)}
+			},
+			expErr: true,
+		},
+		"signed for another voter": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				signDoc := group.NewVoteSignDoc(voters[1].String(), proposalID, group.VOTE_OPTION_YES, "", chainID, 1)
+				sig, err := privKeys[0].Sign(signDoc.GetSignBytes())
+				s.Require().NoError(err)
+				return []group.OffChainVote{{Voter: voters[0].String(), Option: group.VOTE_OPTION_YES, Signature: sig}}
+			},
+			expErr: true,
+		},
+		"multisig voter": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				vote := signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)
+				vote.Voter = multisigVoter.String()
+				return []group.OffChainVote{vote}
+			},
+			expErr: true,
+		},
+		"signed for another group version": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 2)}
+			},
+			expErr: true,
+		},
+		"voter without public key": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				vote := signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)
+				vote.Voter = addr5.String()
+				return []group.OffChainVote{vote}
+			},
+			expErr: true,
+		},
+		"voter not a member": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{signVote(nonMemberKey, proposalID, group.VOTE_OPTION_YES, chainID, 1)}
+			},
+			expErr: true,
+		},
+		"member already voted": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				return []group.OffChainVote{signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)}
+			},
+			preRun: func(proposalID uint64) {
+				_, err := s.keeper.Vote(goCtx, &group.MsgVote{
+					ProposalId: proposalID,
+					Voter:      voters[0].String(),
+					Option:     group.VOTE_OPTION_NO,
+				})
+				s.Require().NoError(err)
+			},
+			expErr: true,
+		},
+		"duplicate votes": {
+			srcVotes: func(proposalID uint64) []group.OffChainVote {
+				vote := signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)
+				return []group.OffChainVote{vote, vote}
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			proposalID := submitProposal()
+			if spec.preRun != nil {
+				spec.preRun(proposalID)
+			}
+
+			fromBalanceBefore := s.app.BankKeeper.GetBalance(ctx, policy, "test")
+			_, err := s.keeper.SubmitVotes(goCtx, &group.MsgSubmitVotes{
+				ProposalId: proposalID,
+				Submitter:  addr2.String(),
+				Votes:      spec.srcVotes(proposalID),
+				Exec:       spec.exec,
+			})
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			if spec.expExecuted {
+				// executed proposals are pruned
+				_, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+				s.Require().Error(err)
+				fromBalanceAfter := s.app.BankKeeper.GetBalance(ctx, policy, "test")
+				s.Require().Equal(fromBalanceBefore.SubAmount(sdk.NewInt(100)), fromBalanceAfter)
+				return
+			}
+
+			res, err := s.keeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, res.Proposal.Status)
+			tally, err := s.keeper.Tally(ctx, *res.Proposal, groupID)
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expTally, tally)
+		})
+	}
+
+	// the verification of every signature is charged
+	submitVotesGas := func(votes func(proposalID uint64) []group.OffChainVote) sdk.Gas {
+		proposalID := submitProposal()
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := s.keeper.SubmitVotes(sdk.WrapSDKContext(gasCtx), &group.MsgSubmitVotes{
+			ProposalId: proposalID,
+			Submitter:  addr2.String(),
+			Votes:      votes(proposalID),
+		})
+		s.Require().NoError(err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	oneVoteGas := submitVotesGas(func(proposalID uint64) []group.OffChainVote {
+		return []group.OffChainVote{signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1)}
+	})
+	threeVotesGas := submitVotesGas(func(proposalID uint64) []group.OffChainVote {
+		return []group.OffChainVote{
+			signVote(privKeys[0], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+			signVote(privKeys[1], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+			signVote(privKeys[2], proposalID, group.VOTE_OPTION_YES, chainID, 1),
+		}
+	})
+	s.Require().GreaterOrEqual(threeVotesGas-oneVoteGas, 2*s.app.AccountKeeper.GetParams(ctx).SigVerifyCostSecp256k1)
+}
+
 func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
 	addr1 := addrs[0]
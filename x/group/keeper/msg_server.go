@@ -5,6 +5,11 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -610,65 +615,163 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 		return nil, err
 	}
 
-	proposal, err := k.getProposal(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-	// Ensure that we can still accept votes for this proposal.
-	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
-		return nil, sdkerrors.Wrap(errors.ErrInvalid, "proposal not open for voting")
-	}
-	if ctx.BlockTime().After(proposal.VotingPeriodEnd) {
-		return nil, sdkerrors.Wrap(errors.ErrExpired, "voting period has ended already")
-	}
-
-	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "load group policy")
-	}
-
-	electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+	_, electorate, err := k.getVotingProposal(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	// Count and store votes.
 	voterAddr := req.Voter
-	voter := group.GroupMember{GroupId: electorate.Id, Member: &group.Member{Address: voterAddr}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
-		return nil, sdkerrors.Wrapf(err, "voter address: %s", voterAddr)
-	}
-	newVote := group.Vote{
+	if err := k.storeVote(ctx, electorate, group.Vote{
 		ProposalId: id,
 		Voter:      voterAddr,
 		Option:     voteOption,
 		Metadata:   metadata,
 		SubmitTime: ctx.BlockTime(),
+	}); err != nil {
+		return nil, err
 	}
 
-	// The ORM will return an error if the vote already exists,
-	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(ctx.KVStore(k.key), &newVote); err != nil {
-		return nil, sdkerrors.Wrap(err, "store vote")
+	// Try to execute proposal immediately
+	if req.Exec == group.Exec_EXEC_TRY {
+		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
+			ProposalId: id,
+			Executor:   voterAddr,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&group.EventVote{ProposalId: id})
+	return &group.MsgVoteResponse{}, nil
+}
+
+// SubmitVotes implements the MsgServer/SubmitVotes method.
+func (k Keeper) SubmitVotes(goCtx context.Context, req *group.MsgSubmitVotes) (*group.MsgSubmitVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+
+	_, electorate, err := k.getVotingProposal(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	for _, vote := range req.Votes {
+		if err := k.assertMetadataLength(vote.Metadata, "metadata"); err != nil {
+			return nil, err
+		}
+
+		voterAddr, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			return nil, err
+		}
+
+		// The vote is signed by the voter's account public key, which must be
+		// known by x/auth.
+		acc := k.accKeeper.GetAccount(ctx, voterAddr)
+		if acc == nil || acc.GetPubKey() == nil {
+			return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "no public key found for voter %s", vote.Voter)
+		}
+
+		signDoc := group.NewVoteSignDoc(vote.Voter, id, vote.Option, vote.Metadata, ctx.ChainID(), electorate.Version)
+		if err := k.verifyVoteSignature(ctx, acc.GetPubKey(), signDoc, vote.Signature); err != nil {
+			return nil, sdkerrors.Wrapf(err, "voter %s", vote.Voter)
+		}
+
+		if err := k.storeVote(ctx, electorate, group.Vote{
+			ProposalId: id,
+			Voter:      vote.Voter,
+			Option:     vote.Option,
+			Metadata:   vote.Metadata,
+			SubmitTime: ctx.BlockTime(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to execute proposal immediately
 	if req.Exec == group.Exec_EXEC_TRY {
 		_, err = k.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
 			ProposalId: id,
-			Executor:   voterAddr,
+			Executor:   req.Submitter,
 		})
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &group.MsgVoteResponse{}, nil
+	return &group.MsgSubmitVotesResponse{}, nil
+}
+
+// verifyVoteSignature verifies the signature of an off-chain vote, consuming
+// the gas of the signature verification set in the x/auth params, like the ante
+// handler does for the transaction signatures. The multisig public keys are not
+// supported, as the votes carry a single signature.
+func (k Keeper) verifyVoteSignature(ctx sdk.Context, pubKey cryptotypes.PubKey, signDoc group.VoteSignDoc, sig []byte) error {
+	params := k.accKeeper.GetParams(ctx)
+	switch pubKey.(type) {
+	case *ed25519.PubKey:
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostED25519, "group vote verify: ed25519")
+	case *secp256k1.PubKey:
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256k1, "group vote verify: secp256k1")
+	case *secp256r1.PubKey:
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256r1(), "group vote verify: secp256r1")
+	case multisig.PubKey:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "multisig accounts can't vote off-chain, they must vote with MsgVote")
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubKey)
+	}
+
+	if !pubKey.VerifySignature(signDoc.GetSignBytes(), sig) {
+		return sdkerrors.Wrap(errors.ErrUnauthorized, "invalid signature")
+	}
+
+	return nil
+}
+
+// getVotingProposal returns a proposal which can still accept votes, along
+// with the group voting on it.
+func (k Keeper) getVotingProposal(ctx sdk.Context, id uint64) (group.Proposal, group.GroupInfo, error) {
+	proposal, err := k.getProposal(ctx, id)
+	if err != nil {
+		return group.Proposal{}, group.GroupInfo{}, err
+	}
+	// Ensure that we can still accept votes for this proposal.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return group.Proposal{}, group.GroupInfo{}, sdkerrors.Wrap(errors.ErrInvalid, "proposal not open for voting")
+	}
+	if ctx.BlockTime().After(proposal.VotingPeriodEnd) {
+		return group.Proposal{}, group.GroupInfo{}, sdkerrors.Wrap(errors.ErrExpired, "voting period has ended already")
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return group.Proposal{}, group.GroupInfo{}, sdkerrors.Wrap(err, "load group policy")
+	}
+
+	electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return group.Proposal{}, group.GroupInfo{}, err
+	}
+
+	return proposal, electorate, nil
+}
+
+// storeVote stores the vote of a group member, to be counted in the tally of
+// the proposal.
+func (k Keeper) storeVote(ctx sdk.Context, electorate group.GroupInfo, vote group.Vote) error {
+	voter := group.GroupMember{GroupId: electorate.Id, Member: &group.Member{Address: vote.Voter}}
+	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
+		return sdkerrors.Wrapf(err, "voter address: %s", vote.Voter)
+	}
+
+	// The ORM will return an error if the vote already exists,
+	// making sure than a voter hasn't already voted.
+	if err := k.voteTable.Create(ctx.KVStore(k.key), &vote); err != nil {
+		return sdkerrors.Wrap(err, "store vote")
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventVote{ProposalId: vote.ProposalId})
 }
 
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
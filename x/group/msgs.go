@@ -801,6 +801,73 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSubmitVotes{}
+
+// Route Implements Msg.
+func (m MsgSubmitVotes) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg.
+func (m MsgSubmitVotes) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgSubmitVotes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitVotes.
+func (m MsgSubmitVotes) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Submitter)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgSubmitVotes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Submitter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "submitter")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "proposal id")
+	}
+	if len(m.Votes) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "votes")
+	}
+
+	index := make(map[string]struct{}, len(m.Votes))
+	for i, vote := range m.Votes {
+		if err := vote.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "vote %d", i)
+		}
+		if _, exists := index[vote.Voter]; exists {
+			return sdkerrors.Wrapf(errors.ErrDuplicate, "voter %s", vote.Voter)
+		}
+		index[vote.Voter] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (v OffChainVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(v.Voter)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if v.Option == VOTE_OPTION_UNSPECIFIED {
+		return sdkerrors.Wrap(errors.ErrEmpty, "vote option")
+	}
+	if _, ok := VoteOption_name[int32(v.Option)]; !ok {
+		return sdkerrors.Wrap(errors.ErrInvalid, "vote option")
+	}
+	if len(v.Signature) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "signature")
+	}
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
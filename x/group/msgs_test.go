@@ -1021,6 +1021,114 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitVotes(t *testing.T) {
+	validVote := group.OffChainVote{
+		Voter:     member1.String(),
+		Option:    group.VOTE_OPTION_YES,
+		Signature: []byte("signature"),
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *group.MsgSubmitVotes
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid submitter address",
+			&group.MsgSubmitVotes{
+				Submitter: "submitter",
+			},
+			true,
+			"submitter: decoding bech32 failed",
+		},
+		{
+			"proposal id is required",
+			&group.MsgSubmitVotes{
+				Submitter: admin.String(),
+			},
+			true,
+			"proposal id: value is empty",
+		},
+		{
+			"votes are required",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+			},
+			true,
+			"votes: value is empty",
+		},
+		{
+			"invalid voter address",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+				Votes:      []group.OffChainVote{{Voter: "voter", Option: group.VOTE_OPTION_YES, Signature: []byte("signature")}},
+			},
+			true,
+			"voter: decoding bech32 failed",
+		},
+		{
+			"unspecified vote option",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+				Votes:      []group.OffChainVote{{Voter: member1.String(), Signature: []byte("signature")}},
+			},
+			true,
+			"vote option: value is empty",
+		},
+		{
+			"signature is required",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+				Votes:      []group.OffChainVote{{Voter: member1.String(), Option: group.VOTE_OPTION_YES}},
+			},
+			true,
+			"signature: value is empty",
+		},
+		{
+			"duplicate voters",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+				Votes:      []group.OffChainVote{validVote, validVote},
+			},
+			true,
+			"duplicate",
+		},
+		{
+			"valid test case",
+			&group.MsgSubmitVotes{
+				Submitter:  admin.String(),
+				ProposalId: 1,
+				Votes: []group.OffChainVote{
+					validVote,
+					{Voter: member2.String(), Option: group.VOTE_OPTION_NO, Signature: []byte("signature")},
+				},
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgSubmitVotes{}))
+			}
+		})
+	}
+}
+
 func TestMsgWithdrawProposal(t *testing.T) {
 	testCases := []struct {
 		name   string
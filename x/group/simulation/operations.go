@@ -37,6 +37,7 @@ var (
 	TypeMsgSubmitProposal                  = sdk.MsgTypeURL(&group.MsgSubmitProposal{})
 	TypeMsgWithdrawProposal                = sdk.MsgTypeURL(&group.MsgWithdrawProposal{})
 	TypeMsgVote                            = sdk.MsgTypeURL(&group.MsgVote{})
+	TypeMsgSubmitVotes                     = sdk.MsgTypeURL(&group.MsgSubmitVotes{})
 	TypeMsgExec                            = sdk.MsgTypeURL(&group.MsgExec{})
 	TypeMsgLeaveGroup                      = sdk.MsgTypeURL(&group.MsgLeaveGroup{})
 )
@@ -56,6 +57,7 @@ const (
 	OpMsgSubmitProposal                  = "op_weight_msg_submit_proposal"
 	OpMsgWithdrawProposal                = "op_weight_msg_withdraw_proposal"
 	OpMsgVote                            = "op_weight_msg_vote"
+	OpMsgSubmitVotes                     = "op_weight_msg_submit_votes"
 	OpMsgExec                            = "ops_weight_msg_exec"
 	OpMsgLeaveGroup                      = "ops_weight_msg_leave_group"
 )
@@ -67,6 +69,7 @@ const (
 	WeightMsgCreateGroupPolicy               = 50
 	WeightMsgSubmitProposal                  = 90
 	WeightMsgVote                            = 90
+	WeightMsgSubmitVotes                     = 20
 	WeightMsgExec                            = 90
 	WeightMsgLeaveGroup                      = 5
 	WeightMsgUpdateGroupMetadata             = 5
@@ -95,6 +98,7 @@ func WeightedOperations(
 		weightMsgUpdateGroupPolicyMetadata       int
 		weightMsgSubmitProposal                  int
 		weightMsgVote                            int
+		weightMsgSubmitVotes                     int
 		weightMsgExec                            int
 		weightMsgLeaveGroup                      int
 		weightMsgWithdrawProposal                int
@@ -131,6 +135,11 @@ func WeightedOperations(
 			weightMsgVote = WeightMsgVote
 		},
 	)
+	appParams.GetOrGenerate(cdc, OpMsgSubmitVotes, &weightMsgSubmitVotes, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitVotes = WeightMsgSubmitVotes
+		},
+	)
 	appParams.GetOrGenerate(cdc, OpMsgExec, &weightMsgExec, nil,
 		func(_ *rand.Rand) {
 			weightMsgExec = WeightMsgExec
@@ -237,6 +246,10 @@ func WeightedOperations(
 			weightMsgLeaveGroup,
 			SimulateMsgLeaveGroup(k, ak, bk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitVotes,
+			SimulateMsgSubmitVotes(ak, bk, k),
+		),
 	}
 
 	return append(wPreCreateProposalOps, append(createProposalOps, wPostCreateProposalOps...)...)
@@ -1047,6 +1060,115 @@ func SimulateMsgVote(ak group.AccountKeeper,
 	}
 }
 
+// SimulateMsgSubmitVotes generates a MsgSubmitVotes with a random member's
+// off-chain vote relayed by the group policy admin
+func SimulateMsgSubmitVotes(ak group.AccountKeeper,
+	bk group.BankKeeper, k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		g, groupPolicy, submitterAcc, submitter, err := randomGroupPolicy(r, k, ak, sdkCtx, accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, ""), nil, err
+		}
+		if g == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "no group found"), nil, nil
+		}
+		if groupPolicy == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "no group policy found"), nil, nil
+		}
+
+		// Pick a random member from the group
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		acc, account, err := randomMember(ctx, r, k, ak, accounts, g.Id)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, ""), nil, err
+		}
+		if account == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "no group member found"), nil, nil
+		}
+		if account.GetPubKey() == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "no public key found for member"), nil, nil
+		}
+
+		spendableCoins := bk.SpendableCoins(sdkCtx, submitter.GetAddress())
+		fees, err := simtypes.RandomFees(r, sdkCtx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "fee error"), nil, err
+		}
+
+		proposalsResult, err := k.ProposalsByGroupPolicy(ctx, &group.QueryProposalsByGroupPolicyRequest{Address: groupPolicy.Address})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "fail to query group info"), nil, err
+		}
+
+		proposalID := uint64(0)
+		for _, p := range proposalsResult.GetProposals() {
+			if p.Status == group.PROPOSAL_STATUS_SUBMITTED && p.VotingPeriodEnd.After(sdkCtx.BlockTime()) {
+				proposalID = p.Id
+				break
+			}
+		}
+
+		// return no-op if no proposal found
+		if proposalID == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "no proposals found"), nil, nil
+		}
+
+		// Ensure member hasn't already voted
+		res, _ := k.VoteByProposalVoter(ctx, &group.QueryVoteByProposalVoterRequest{
+			Voter:      acc.Address.String(),
+			ProposalId: proposalID,
+		})
+		if res != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "member has already voted"), nil, nil
+		}
+
+		metadata := simtypes.RandStringOfLength(r, 10)
+		signDoc := group.NewVoteSignDoc(acc.Address.String(), proposalID, group.VOTE_OPTION_YES, metadata, chainID, g.Version)
+		sig, err := acc.PrivKey.Sign(signDoc.GetSignBytes())
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "unable to sign vote"), nil, err
+		}
+
+		msg := group.MsgSubmitVotes{
+			ProposalId: proposalID,
+			Submitter:  submitterAcc.Address.String(),
+			Votes: []group.OffChainVote{{
+				Voter:     acc.Address.String(),
+				Option:    group.VOTE_OPTION_YES,
+				Metadata:  metadata,
+				Signature: sig,
+			}},
+		}
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenSignedMockTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{submitter.GetAccountNumber()},
+			[]uint64{submitter.GetSequence()},
+			submitterAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitVotes, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			if strings.Contains(err.Error(), "group was modified") || strings.Contains(err.Error(), "group policy was modified") {
+				return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "no-op:group/group-policy was modified"), nil, nil
+			}
+			return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, "", nil), nil, err
+	}
+}
+
 // // SimulateMsgExec generates a MsgExec with random values
 func SimulateMsgExec(ak group.AccountKeeper,
 	bk group.BankKeeper, k keeper.Keeper,
@@ -64,6 +64,8 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simulation.WeightMsgUpdateGroupPolicyDecisionPolicy, group.MsgUpdateGroupPolicyDecisionPolicy{}.Route(), simulation.TypeMsgUpdateGroupPolicyDecisionPolicy},
 		{simulation.WeightMsgUpdateGroupPolicyMetadata, group.MsgUpdateGroupPolicyMetadata{}.Route(), simulation.TypeMsgUpdateGroupPolicyMetadata},
 		{simulation.WeightMsgLeaveGroup, group.MsgLeaveGroup{}.Route(), simulation.TypeMsgLeaveGroup},
+		// no-op: no group member is found after the previous operations
+		{simulation.WeightMsgSubmitVotes, group.ModuleName, simulation.TypeMsgSubmitVotes},
 	}
 
 	for i, w := range weightedOps {
@@ -379,6 +381,81 @@ func (suite *SimTestSuite) TestSimulateVote() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateSubmitVotes() {
+	// setup 2 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	acc := accounts[0]
+	submitter := accounts[1]
+
+	// the off-chain vote is verified with the public key of the member
+	account := suite.app.AccountKeeper.GetAccount(suite.ctx, acc.Address)
+	suite.Require().NoError(account.SetPubKey(acc.PubKey))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+
+	// setup a group
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	addr := acc.Address.String()
+	groupRes, err := suite.app.GroupKeeper.CreateGroup(ctx,
+		&group.MsgCreateGroup{
+			Admin: submitter.Address.String(),
+			Members: []group.MemberRequest{
+				{
+					Address: addr,
+					Weight:  "1",
+				},
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	// setup a group account
+	accountReq := &group.MsgCreateGroupPolicy{
+		Admin:    submitter.Address.String(),
+		GroupId:  groupRes.GroupId,
+		Metadata: "",
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	suite.Require().NoError(err)
+	groupPolicyRes, err := suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
+	suite.Require().NoError(err)
+
+	// setup a proposal
+	proposalReq, err := group.NewMsgSubmitProposal(groupPolicyRes.Address, []string{addr}, []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: groupPolicyRes.Address,
+			ToAddress:   addr,
+			Amount:      sdk.Coins{sdk.NewInt64Coin("token", 100)},
+		},
+	}, "", 0)
+	suite.Require().NoError(err)
+	_, err = suite.app.GroupKeeper.SubmitProposal(ctx, proposalReq)
+	suite.Require().NoError(err)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	op := simulation.SimulateMsgSubmitVotes(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.GroupKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg group.MsgSubmitVotes
+	err = group.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(submitter.Address.String(), msg.Submitter)
+	suite.Require().Len(msg.Votes, 1)
+	suite.Require().Equal(addr, msg.Votes[0].Voter)
+	suite.Require().Len(futureOperations, 0)
+}
+
 func (suite *SimTestSuite) TestSimulateExec() {
 	// setup 1 account
 	s := rand.NewSource(1)
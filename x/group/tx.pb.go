@@ -1267,6 +1267,198 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// OffChainVote is a vote on a proposal signed off-chain by a group member.
+//
+// Since: cosmos-sdk 0.47
+type OffChainVote struct {
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,2,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata to attached to the vote.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signature is the signature of the VoteSignDoc of the vote by the voter's
+	// account public key.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *OffChainVote) Reset()         { *m = OffChainVote{} }
+func (m *OffChainVote) String() string { return proto.CompactTextString(m) }
+func (*OffChainVote) ProtoMessage()    {}
+func (*OffChainVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{24}
+}
+func (m *OffChainVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffChainVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffChainVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffChainVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffChainVote.Merge(m, src)
+}
+func (m *OffChainVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffChainVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffChainVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffChainVote proto.InternalMessageInfo
+
+func (m *OffChainVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *OffChainVote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *OffChainVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *OffChainVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgSubmitVotes is the Msg/SubmitVotes request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubmitVotes struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// submitter is the account address relaying the votes, it does not need to
+	// be a group member.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// votes are the votes signed off-chain by the group members.
+	Votes []OffChainVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+	// exec defines whether the proposal should be executed
+	// immediately after voting or not.
+	Exec Exec `protobuf:"varint,4,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
+}
+
+func (m *MsgSubmitVotes) Reset()         { *m = MsgSubmitVotes{} }
+func (m *MsgSubmitVotes) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVotes) ProtoMessage()    {}
+func (*MsgSubmitVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{25}
+}
+func (m *MsgSubmitVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVotes.Merge(m, src)
+}
+func (m *MsgSubmitVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitVotes proto.InternalMessageInfo
+
+func (m *MsgSubmitVotes) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgSubmitVotes) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitVotes) GetVotes() []OffChainVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *MsgSubmitVotes) GetExec() Exec {
+	if m != nil {
+		return m.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
+}
+
+// MsgSubmitVotesResponse is the Msg/SubmitVotes response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgSubmitVotesResponse struct {
+}
+
+func (m *MsgSubmitVotesResponse) Reset()         { *m = MsgSubmitVotesResponse{} }
+func (m *MsgSubmitVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVotesResponse) ProtoMessage()    {}
+func (*MsgSubmitVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{26}
+}
+func (m *MsgSubmitVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVotesResponse.Merge(m, src)
+}
+func (m *MsgSubmitVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitVotesResponse proto.InternalMessageInfo
+
 // MsgExec is the Msg/Exec request type.
 type MsgExec struct {
 	// proposal is the unique ID of the proposal.
@@ -1279,7 +1471,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1524,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1572,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,7 +1623,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{30}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,6 +1678,9 @@ func init() {
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
+	proto.RegisterType((*OffChainVote)(nil), "cosmos.group.v1.OffChainVote")
+	proto.RegisterType((*MsgSubmitVotes)(nil), "cosmos.group.v1.MsgSubmitVotes")
+	proto.RegisterType((*MsgSubmitVotesResponse)(nil), "cosmos.group.v1.MsgSubmitVotesResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
@@ -1495,87 +1690,94 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0xb6, 0x6c, 0x37, 0x71, 0x9e, 0x1b, 0x27, 0x51, 0x9c, 0xd6, 0x51, 0x5a, 0xdb, 0x88, 0x34,
-	0x49, 0x3d, 0x8d, 0x4c, 0x1c, 0x7a, 0x09, 0x4c, 0x99, 0x24, 0x35, 0x4c, 0x00, 0x43, 0x46, 0x69,
-	0x29, 0x70, 0x31, 0x8a, 0xb5, 0x55, 0x35, 0xd8, 0x96, 0xd1, 0xca, 0x89, 0x73, 0x84, 0x13, 0xd0,
-	0x0b, 0x33, 0xfd, 0x03, 0xcc, 0x70, 0xe1, 0xc8, 0xa1, 0x37, 0x6e, 0x70, 0xe9, 0x70, 0xea, 0x70,
-	0x62, 0x38, 0x30, 0x4c, 0x72, 0xe0, 0xca, 0x4f, 0x60, 0xb4, 0x2b, 0xad, 0x2d, 0x5b, 0x8e, 0x14,
-	0xe3, 0xc2, 0xa9, 0xb5, 0xde, 0xf7, 0xde, 0xfb, 0xbe, 0xb7, 0x6f, 0xf7, 0xed, 0x06, 0x32, 0x35,
-	0x03, 0x37, 0x0c, 0x5c, 0xd4, 0x4c, 0xa3, 0xdd, 0x2a, 0x1e, 0x6d, 0x14, 0xad, 0x8e, 0xd4, 0x32,
-	0x0d, 0xcb, 0xe0, 0x67, 0xa8, 0x45, 0x22, 0x16, 0xe9, 0x68, 0x43, 0x48, 0x6b, 0x86, 0x66, 0x10,
-	0x5b, 0xd1, 0xfe, 0x1f, 0x85, 0x09, 0x8b, 0x14, 0x56, 0xa5, 0x06, 0xc7, 0xc7, 0x31, 0x69, 0x86,
-	0xa1, 0xd5, 0x51, 0x91, 0xfc, 0x3a, 0x6c, 0x3f, 0x2c, 0x2a, 0xcd, 0x13, 0xc7, 0xb4, 0x34, 0x90,
-	0xf6, 0xa4, 0x85, 0x5c, 0xbf, 0xab, 0x8e, 0xb1, 0x81, 0x35, 0xdb, 0xd4, 0xc0, 0x1a, 0x35, 0x88,
-	0xdf, 0x73, 0x90, 0xaa, 0x60, 0x6d, 0xd7, 0x44, 0x8a, 0x85, 0xde, 0xb2, 0x5d, 0x79, 0x09, 0x2e,
-	0x29, 0x6a, 0x43, 0x6f, 0x66, 0xb8, 0x3c, 0xb7, 0x36, 0xb5, 0x93, 0xf9, 0xf5, 0xe9, 0x7a, 0xda,
-	0x21, 0xb1, 0xad, 0xaa, 0x26, 0xc2, 0xf8, 0xc0, 0x32, 0xf5, 0xa6, 0x26, 0x53, 0x18, 0x7f, 0x07,
-	0x26, 0x1b, 0xa8, 0x71, 0x88, 0x4c, 0x9c, 0x89, 0xe6, 0x63, 0x6b, 0xc9, 0x52, 0x56, 0xea, 0xd3,
-	0x29, 0x55, 0x88, 0x5d, 0x46, 0x9f, 0xb5, 0x11, 0xb6, 0x76, 0xe2, 0xcf, 0xfe, 0xc8, 0x45, 0x64,
-	0xd7, 0x89, 0x17, 0x20, 0xd1, 0x40, 0x96, 0xa2, 0x2a, 0x96, 0x92, 0x89, 0xd9, 0x29, 0x65, 0xf6,
-	0x7b, 0x0b, 0xbe, 0xf8, 0xeb, 0x87, 0x02, 0xcd, 0x23, 0x6e, 0xc2, 0x15, 0x2f, 0x53, 0x19, 0xe1,
-	0x96, 0xd1, 0xc4, 0x88, 0x5f, 0x84, 0x04, 0x49, 0x55, 0xd5, 0x55, 0x42, 0x3a, 0x2e, 0x4f, 0x92,
-	0xdf, 0x7b, 0xaa, 0xf8, 0x23, 0x07, 0x0b, 0x15, 0xac, 0xdd, 0x6f, 0xa9, 0xae, 0x57, 0xc5, 0x49,
-	0x7b, 0x51, 0x99, 0xbd, 0x49, 0xa2, 0x9e, 0x24, 0xfc, 0x3b, 0x90, 0xa2, 0x62, 0xaa, 0x6d, 0x92,
-	0x07, 0x67, 0x62, 0x17, 0x28, 0xc4, 0x34, 0xf5, 0xa5, 0x14, 0xb1, 0x47, 0x72, 0x0e, 0xae, 0xfb,
-	0x92, 0x77, 0x95, 0x8b, 0xdf, 0x71, 0x30, 0xef, 0x45, 0x6c, 0x13, 0xb2, 0x63, 0x14, 0x77, 0x1b,
-	0xa6, 0x9a, 0xe8, 0xb8, 0x4a, 0xc3, 0xc5, 0x02, 0xc2, 0x25, 0x9a, 0xe8, 0x98, 0x30, 0xf0, 0xc8,
-	0xb8, 0x0e, 0x4b, 0x3e, 0x24, 0x99, 0x88, 0xc7, 0x1c, 0x59, 0x59, 0x8f, 0x4c, 0xba, 0xfe, 0xe3,
-	0xd4, 0x11, 0xb6, 0xcd, 0xf2, 0x90, 0xf5, 0x27, 0xc3, 0xf8, 0xfe, 0xcd, 0x41, 0xda, 0xdb, 0x89,
-	0xfb, 0x46, 0x5d, 0xaf, 0x9d, 0xfc, 0x47, 0x6c, 0x79, 0x05, 0x66, 0x54, 0x54, 0xd3, 0xb1, 0x6e,
-	0x34, 0xab, 0x2d, 0x92, 0x39, 0x13, 0xcf, 0x73, 0x6b, 0xc9, 0x52, 0x5a, 0xa2, 0xc7, 0x83, 0xe4,
-	0x1e, 0x0f, 0xd2, 0x76, 0xf3, 0x64, 0x47, 0xfc, 0xe5, 0xe9, 0x7a, 0xb6, 0xbf, 0x11, 0xef, 0x3a,
-	0x01, 0x28, 0x73, 0x39, 0xa5, 0x7a, 0x7e, 0x6f, 0xa5, 0xbe, 0xfc, 0x36, 0x17, 0xe9, 0x29, 0x8a,
-	0x0c, 0xd7, 0xfc, 0x14, 0xb3, 0x1d, 0x58, 0x82, 0x49, 0x85, 0x2a, 0x0c, 0xd4, 0xee, 0x02, 0xc5,
-	0xdf, 0x39, 0x58, 0xf4, 0x56, 0x9a, 0x06, 0x1d, 0xad, 0x83, 0xdf, 0x86, 0x34, 0xad, 0x25, 0xad,
-	0x48, 0xd5, 0xa5, 0x13, 0x0d, 0x70, 0xe7, 0xb5, 0xde, 0xcc, 0xc4, 0x32, 0x8e, 0x96, 0x7f, 0x1c,
-	0x83, 0x8c, 0xb7, 0x62, 0x0f, 0x74, 0xeb, 0xd1, 0x88, 0x7d, 0xf2, 0x6f, 0x4f, 0xd8, 0x1b, 0x90,
-	0xa2, 0xb5, 0xe9, 0x6b, 0xa9, 0x69, 0xcd, 0xb3, 0xd9, 0x4a, 0xb0, 0xe0, 0x29, 0x21, 0x43, 0xc7,
-	0x09, 0x7a, 0xbe, 0xa7, 0x52, 0xcc, 0x67, 0xa3, 0xcf, 0x47, 0xc1, 0x4e, 0xd9, 0x2e, 0xe5, 0xb9,
-	0xb5, 0x84, 0xb7, 0xba, 0x98, 0xae, 0xac, 0x4f, 0xfb, 0x4e, 0xbc, 0xe0, 0xf6, 0xfd, 0x8a, 0x83,
-	0xfc, 0xb0, 0xd5, 0x08, 0x31, 0x45, 0xc6, 0xd9, 0x5c, 0xe2, 0xcb, 0xf0, 0xd2, 0xd0, 0xae, 0x67,
-	0x47, 0xcc, 0x93, 0x28, 0x88, 0x7e, 0x28, 0xaf, 0xee, 0xff, 0x75, 0x93, 0xf8, 0x2c, 0x63, 0xec,
-	0x05, 0x2f, 0xe3, 0x2d, 0x28, 0x04, 0x17, 0x85, 0xd5, 0xf0, 0x27, 0x8e, 0x1c, 0x5a, 0x03, 0xf0,
-	0x91, 0x87, 0xcb, 0x38, 0xab, 0x17, 0x76, 0x1a, 0xad, 0xc0, 0xf2, 0x79, 0x1a, 0x98, 0xd8, 0xaf,
-	0xa3, 0x30, 0x57, 0xc1, 0xda, 0x41, 0xfb, 0xb0, 0xa1, 0x5b, 0xfb, 0xa6, 0xd1, 0x32, 0xb0, 0x52,
-	0x1f, 0xca, 0x98, 0x1b, 0x81, 0xf1, 0x35, 0x98, 0x6a, 0x91, 0xb8, 0xee, 0x31, 0x34, 0x25, 0x77,
-	0x3f, 0x9c, 0x3b, 0xaf, 0x5e, 0xb1, 0x6d, 0x18, 0x2b, 0x1a, 0xc2, 0x99, 0x38, 0x39, 0xbf, 0x7c,
-	0x5b, 0x44, 0x66, 0x28, 0xfe, 0x26, 0xc4, 0x51, 0x07, 0xd5, 0xc8, 0x21, 0x92, 0x2a, 0x2d, 0x0c,
-	0x9c, 0x76, 0xe5, 0x0e, 0xaa, 0xc9, 0x04, 0xb2, 0xc5, 0xbb, 0x3d, 0xd2, 0x25, 0x23, 0xbe, 0x4e,
-	0x06, 0x8b, 0xb7, 0x16, 0x6c, 0x9b, 0xe7, 0x20, 0xd9, 0x72, 0xbe, 0x75, 0x77, 0x3a, 0xb8, 0x9f,
-	0xf6, 0x54, 0xb1, 0x43, 0xae, 0x54, 0xf6, 0x01, 0xa1, 0x9a, 0xca, 0x31, 0xab, 0x65, 0x90, 0x5f,
-	0xef, 0x0c, 0x8c, 0x86, 0x9c, 0x81, 0x5b, 0x97, 0x6d, 0xe6, 0x6c, 0x22, 0xd2, 0x7b, 0x52, 0x7f,
-	0x66, 0xb6, 0xc6, 0xa7, 0x1c, 0x4c, 0x56, 0xb0, 0xf6, 0x81, 0x61, 0x05, 0xab, 0xb0, 0x9b, 0xfb,
-	0xc8, 0xb0, 0x90, 0x19, 0xc8, 0x85, 0xc2, 0xf8, 0x4d, 0x98, 0x30, 0x5a, 0x96, 0x6e, 0xd0, 0x81,
-	0x97, 0x2a, 0x2d, 0x0d, 0x14, 0xdd, 0xce, 0xfb, 0x3e, 0x81, 0xc8, 0x0e, 0xd4, 0xb3, 0xea, 0xf1,
-	0xbe, 0x55, 0xbf, 0xc0, 0x1a, 0xd2, 0x86, 0x27, 0x3c, 0xc4, 0x39, 0x98, 0x71, 0x34, 0x32, 0xdd,
-	0x0d, 0x22, 0xdb, 0xc6, 0x07, 0xcb, 0x7e, 0x15, 0x12, 0x76, 0xc8, 0xb6, 0x65, 0x04, 0x2b, 0x67,
-	0xc8, 0xad, 0xa4, 0x4d, 0x60, 0x02, 0xeb, 0x5a, 0x13, 0x99, 0xa2, 0x4c, 0x18, 0x10, 0x7a, 0x6e,
-	0xcf, 0xbc, 0x01, 0x13, 0x26, 0xc2, 0xed, 0xba, 0x45, 0x62, 0xa6, 0x4a, 0xab, 0x03, 0x6a, 0xdc,
-	0xc5, 0x2a, 0x3b, 0x21, 0x65, 0x02, 0x97, 0x1d, 0x37, 0xb1, 0x0e, 0xd3, 0x15, 0xac, 0xbd, 0x8b,
-	0x94, 0x23, 0xe7, 0x91, 0x35, 0xc2, 0x85, 0xe9, 0x9c, 0xeb, 0x62, 0x5f, 0x1f, 0x5d, 0x25, 0x6f,
-	0x9e, 0x6e, 0x36, 0x57, 0x47, 0xa1, 0x00, 0x71, 0x52, 0xc6, 0x34, 0xcc, 0x96, 0x3f, 0x2c, 0xef,
-	0x56, 0xef, 0xbf, 0x77, 0xb0, 0x5f, 0xde, 0xdd, 0x7b, 0x73, 0xaf, 0x7c, 0x77, 0x36, 0xc2, 0x5f,
-	0x86, 0x04, 0xf9, 0x7a, 0x4f, 0xfe, 0x68, 0x96, 0x2b, 0xfd, 0x9c, 0x84, 0x58, 0x05, 0x6b, 0xfc,
-	0x03, 0x48, 0xf6, 0xbe, 0x0e, 0x73, 0x83, 0x57, 0x0f, 0xcf, 0x60, 0x15, 0x56, 0x03, 0x00, 0xac,
-	0xa8, 0x75, 0xe0, 0x7d, 0x9e, 0x65, 0x2b, 0x7e, 0xee, 0x83, 0x38, 0x41, 0x0a, 0x87, 0x63, 0xd9,
-	0x1e, 0xc2, 0xec, 0xc0, 0x2b, 0x69, 0x39, 0x20, 0x06, 0x41, 0x09, 0xb7, 0xc2, 0xa0, 0x58, 0x1e,
-	0x03, 0xe6, 0xfd, 0x1e, 0x32, 0xab, 0x81, 0x74, 0x29, 0x50, 0x28, 0x86, 0x04, 0xb2, 0x84, 0x3a,
-	0xcc, 0x0d, 0xbe, 0x44, 0x6e, 0x04, 0x2c, 0x02, 0x85, 0x09, 0xeb, 0xa1, 0x60, 0x2c, 0x55, 0x1b,
-	0x16, 0xfc, 0x2f, 0xb4, 0x37, 0x03, 0xe2, 0x74, 0xa1, 0xc2, 0x46, 0x68, 0x28, 0x4b, 0xdb, 0x81,
-	0x2b, 0x43, 0x1e, 0x09, 0x85, 0x80, 0x62, 0xf5, 0x60, 0x85, 0x52, 0x78, 0x2c, 0xcb, 0xfc, 0x84,
-	0x83, 0x5c, 0xd0, 0x1d, 0x6c, 0x33, 0x54, 0x5c, 0xaf, 0x93, 0xf0, 0xda, 0x08, 0x4e, 0x8c, 0xd5,
-	0xe7, 0x1c, 0x2c, 0x0e, 0xbf, 0xd5, 0xac, 0x87, 0x0a, 0xcd, 0xfa, 0xed, 0xf6, 0x85, 0xe0, 0x8c,
-	0xc3, 0x27, 0x90, 0xea, 0xbb, 0x6b, 0x88, 0x7e, 0x81, 0xbc, 0x18, 0xa1, 0x10, 0x8c, 0xe9, 0xdd,
-	0xb0, 0x03, 0x33, 0xd8, 0x77, 0xc3, 0xf6, 0xa3, 0xfc, 0x37, 0xec, 0xb0, 0xa9, 0xca, 0xef, 0x40,
-	0x9c, 0x4c, 0xd4, 0x8c, 0x9f, 0x97, 0x6d, 0x11, 0xf2, 0xc3, 0x2c, 0xbd, 0x31, 0xc8, 0xb9, 0xea,
-	0x1b, 0xc3, 0xb6, 0xf8, 0xc7, 0xf0, 0xcc, 0x98, 0x7b, 0x00, 0x3d, 0xf3, 0x21, 0xeb, 0x87, 0xef,
-	0xda, 0x85, 0x95, 0xf3, 0xed, 0x6e, 0xd4, 0x9d, 0x3b, 0xcf, 0x4e, 0xb3, 0xdc, 0xf3, 0xd3, 0x2c,
-	0xf7, 0xe7, 0x69, 0x96, 0xfb, 0xe6, 0x2c, 0x1b, 0x79, 0x7e, 0x96, 0x8d, 0xfc, 0x76, 0x96, 0x8d,
-	0x7c, 0xbc, 0xac, 0xe9, 0xd6, 0xa3, 0xf6, 0xa1, 0x54, 0x33, 0x1a, 0xce, 0xdf, 0x18, 0x9d, 0x7f,
-	0xd6, 0xb1, 0xfa, 0x69, 0xb1, 0x43, 0xff, 0x8e, 0x78, 0x38, 0x41, 0x6e, 0x68, 0x9b, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x01, 0x59, 0xf1, 0xd5, 0x14, 0x00, 0x00,
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0xb7, 0x6c, 0x37, 0xb1, 0x9f, 0x53, 0x27, 0x55, 0x9d, 0xd4, 0x51, 0x53, 0xdb, 0x5f, 0x7d,
+	0xdb, 0x24, 0xf5, 0x24, 0x36, 0x71, 0x28, 0x33, 0x04, 0xa6, 0x4c, 0x92, 0x1a, 0x26, 0x80, 0x69,
+	0x46, 0x69, 0x09, 0x70, 0x31, 0x8a, 0xb5, 0x51, 0x34, 0xd8, 0x96, 0xd1, 0xca, 0x89, 0x73, 0x84,
+	0x13, 0xd0, 0x0b, 0x33, 0xbd, 0x72, 0x60, 0x86, 0x0b, 0x17, 0x66, 0x38, 0xf4, 0xc6, 0x8d, 0x53,
+	0x87, 0x53, 0x87, 0x13, 0xc3, 0x01, 0x98, 0xe4, 0xc0, 0x95, 0x3f, 0x81, 0xd1, 0xae, 0xb4, 0xb6,
+	0x6c, 0x39, 0x52, 0x4c, 0x0a, 0xa7, 0x44, 0xfb, 0x3e, 0xef, 0xc7, 0xe7, 0xbd, 0xb7, 0xbb, 0x6f,
+	0x0d, 0xe9, 0x9a, 0x8e, 0x1b, 0x3a, 0x2e, 0xaa, 0x86, 0xde, 0x6e, 0x15, 0x0f, 0x57, 0x8a, 0x66,
+	0xa7, 0xd0, 0x32, 0x74, 0x53, 0xe7, 0x27, 0xa9, 0xa4, 0x40, 0x24, 0x85, 0xc3, 0x15, 0x21, 0xa5,
+	0xea, 0xaa, 0x4e, 0x64, 0x45, 0xeb, 0x3f, 0x0a, 0x13, 0x66, 0x29, 0xac, 0x4a, 0x05, 0xb6, 0x8e,
+	0x2d, 0x52, 0x75, 0x5d, 0xad, 0xa3, 0x22, 0xf9, 0xda, 0x6b, 0xef, 0x17, 0xe5, 0xe6, 0xb1, 0x2d,
+	0xba, 0x3e, 0xe0, 0xf6, 0xb8, 0x85, 0x1c, 0xbd, 0x6b, 0xb6, 0xb0, 0x81, 0x55, 0x4b, 0xd4, 0xc0,
+	0x2a, 0x15, 0x88, 0xdf, 0x72, 0x90, 0xac, 0x60, 0x75, 0xd3, 0x40, 0xb2, 0x89, 0xde, 0xb0, 0x54,
+	0xf9, 0x02, 0x5c, 0x92, 0x95, 0x86, 0xd6, 0x4c, 0x73, 0x39, 0x6e, 0x31, 0xbe, 0x91, 0xfe, 0xf9,
+	0xc9, 0x72, 0xca, 0x0e, 0x62, 0x5d, 0x51, 0x0c, 0x84, 0xf1, 0x8e, 0x69, 0x68, 0x4d, 0x55, 0xa2,
+	0x30, 0xfe, 0x2e, 0x8c, 0x37, 0x50, 0x63, 0x0f, 0x19, 0x38, 0x1d, 0xce, 0x45, 0x16, 0x13, 0xa5,
+	0x4c, 0xa1, 0x8f, 0x67, 0xa1, 0x42, 0xe4, 0x12, 0xfa, 0xb8, 0x8d, 0xb0, 0xb9, 0x11, 0x7d, 0xfa,
+	0x5b, 0x36, 0x24, 0x39, 0x4a, 0xbc, 0x00, 0xb1, 0x06, 0x32, 0x65, 0x45, 0x36, 0xe5, 0x74, 0xc4,
+	0x72, 0x29, 0xb1, 0xef, 0x35, 0xf8, 0xf4, 0xcf, 0xef, 0xf3, 0xd4, 0x8f, 0xb8, 0x0a, 0x33, 0xee,
+	0x48, 0x25, 0x84, 0x5b, 0x7a, 0x13, 0x23, 0x7e, 0x16, 0x62, 0xc4, 0x55, 0x55, 0x53, 0x48, 0xd0,
+	0x51, 0x69, 0x9c, 0x7c, 0x6f, 0x29, 0xe2, 0x0f, 0x1c, 0x4c, 0x57, 0xb0, 0xfa, 0xb0, 0xa5, 0x38,
+	0x5a, 0x15, 0xdb, 0xed, 0x79, 0x69, 0xf6, 0x3a, 0x09, 0xbb, 0x9c, 0xf0, 0x6f, 0x41, 0x92, 0x92,
+	0xa9, 0xb6, 0x89, 0x1f, 0x9c, 0x8e, 0x9c, 0x23, 0x11, 0x97, 0xa9, 0x2e, 0x0d, 0x11, 0xbb, 0x28,
+	0x67, 0xe1, 0x86, 0x67, 0xf0, 0x0e, 0x73, 0xf1, 0x1b, 0x0e, 0xae, 0xba, 0x11, 0xeb, 0x24, 0xd8,
+	0x0b, 0x24, 0x77, 0x07, 0xe2, 0x4d, 0x74, 0x54, 0xa5, 0xe6, 0x22, 0x3e, 0xe6, 0x62, 0x4d, 0x74,
+	0x44, 0x22, 0x70, 0xd1, 0xb8, 0x01, 0xd7, 0x3d, 0x82, 0x64, 0x24, 0x1e, 0x71, 0x30, 0xe3, 0x96,
+	0x57, 0xec, 0xfa, 0x5f, 0x24, 0x8f, 0xa0, 0x6d, 0x96, 0x83, 0x8c, 0x77, 0x30, 0x2c, 0xde, 0xbf,
+	0x38, 0x48, 0xb9, 0x3b, 0x71, 0x5b, 0xaf, 0x6b, 0xb5, 0xe3, 0x7f, 0x29, 0x5a, 0x5e, 0x86, 0x49,
+	0x05, 0xd5, 0x34, 0xac, 0xe9, 0xcd, 0x6a, 0x8b, 0x78, 0x4e, 0x47, 0x73, 0xdc, 0x62, 0xa2, 0x94,
+	0x2a, 0xd0, 0xe3, 0xa1, 0xe0, 0x1c, 0x0f, 0x85, 0xf5, 0xe6, 0xf1, 0x86, 0xf8, 0xd3, 0x93, 0xe5,
+	0x4c, 0x7f, 0x23, 0xde, 0xb3, 0x0d, 0xd0, 0xc8, 0xa5, 0xa4, 0xe2, 0xfa, 0x5e, 0x4b, 0x7e, 0xf6,
+	0x75, 0x36, 0xd4, 0x93, 0x14, 0x09, 0xe6, 0xbc, 0x18, 0xb3, 0x1d, 0x58, 0x82, 0x71, 0x99, 0x32,
+	0xf4, 0xe5, 0xee, 0x00, 0xc5, 0x5f, 0x39, 0x98, 0x75, 0x67, 0x9a, 0x1a, 0x1d, 0xad, 0x83, 0xdf,
+	0x84, 0x14, 0xcd, 0x25, 0xcd, 0x48, 0xd5, 0x09, 0x27, 0xec, 0xa3, 0xce, 0xab, 0xbd, 0x9e, 0x89,
+	0xe4, 0x22, 0x5a, 0xfe, 0x51, 0x04, 0xd2, 0xee, 0x8c, 0xed, 0x6a, 0xe6, 0xc1, 0x88, 0x7d, 0xf2,
+	0x4f, 0x4f, 0xd8, 0x5b, 0x90, 0xa4, 0xb9, 0xe9, 0x6b, 0xa9, 0xcb, 0xaa, 0x6b, 0xb3, 0x95, 0x60,
+	0xda, 0x95, 0x42, 0x86, 0x8e, 0x12, 0xf4, 0xd5, 0x9e, 0x4c, 0x31, 0x9d, 0x95, 0x3e, 0x1d, 0x19,
+	0xdb, 0x69, 0xbb, 0x94, 0xe3, 0x16, 0x63, 0xee, 0xec, 0x62, 0x5a, 0x59, 0x8f, 0xf6, 0x1d, 0x7b,
+	0xce, 0xed, 0xfb, 0x39, 0x07, 0xb9, 0x61, 0xd5, 0x08, 0x70, 0x8b, 0x5c, 0x64, 0x73, 0x89, 0xff,
+	0x87, 0xff, 0x0d, 0xed, 0x7a, 0x76, 0xc4, 0x3c, 0x0e, 0x83, 0xe8, 0x85, 0x72, 0xf3, 0xfe, 0x4f,
+	0x37, 0x89, 0x47, 0x19, 0x23, 0xcf, 0xb9, 0x8c, 0x4b, 0x90, 0xf7, 0x4f, 0x0a, 0xcb, 0xe1, 0x8f,
+	0x1c, 0xcc, 0x79, 0xc1, 0x47, 0xbe, 0x5c, 0x2e, 0x32, 0x7b, 0x41, 0x6f, 0xa3, 0x79, 0xb8, 0x79,
+	0x16, 0x07, 0x46, 0xf6, 0x8b, 0x30, 0x5c, 0xa9, 0x60, 0x75, 0xa7, 0xbd, 0xd7, 0xd0, 0xcc, 0x6d,
+	0x43, 0x6f, 0xe9, 0x58, 0xae, 0x0f, 0x8d, 0x98, 0x1b, 0x21, 0xe2, 0x39, 0x88, 0xb7, 0x88, 0x5d,
+	0xe7, 0x18, 0x8a, 0x4b, 0xdd, 0x85, 0x33, 0xef, 0xab, 0x17, 0x2c, 0x19, 0xc6, 0xb2, 0x8a, 0x70,
+	0x3a, 0x9a, 0x8b, 0x0c, 0x6b, 0x11, 0x89, 0xa1, 0xf8, 0xdb, 0x10, 0x45, 0x1d, 0x54, 0x23, 0x87,
+	0x48, 0xb2, 0x34, 0x3d, 0x70, 0xda, 0x95, 0x3b, 0xa8, 0x26, 0x11, 0xc8, 0x1a, 0xef, 0xf4, 0x48,
+	0x37, 0x18, 0xf1, 0x55, 0x98, 0x1d, 0xc8, 0x05, 0xdb, 0xe6, 0x59, 0x48, 0xb4, 0xec, 0xb5, 0xee,
+	0x4e, 0x07, 0x67, 0x69, 0x4b, 0x11, 0x3b, 0x64, 0xa4, 0xb2, 0x0e, 0x08, 0xc5, 0x90, 0x8f, 0x58,
+	0x2e, 0xfd, 0xf4, 0x7a, 0xef, 0xc0, 0x70, 0xc0, 0x3b, 0x70, 0x6d, 0xc2, 0x8a, 0xdc, 0xf9, 0xb2,
+	0xe7, 0xa4, 0x7e, 0xcf, 0xac, 0xc6, 0x27, 0x1c, 0x8c, 0x57, 0xb0, 0xfa, 0xae, 0x6e, 0xfa, 0xb3,
+	0xb0, 0x9a, 0xfb, 0x50, 0x37, 0x91, 0xe1, 0x1b, 0x0b, 0x85, 0xf1, 0xab, 0x30, 0xa6, 0xb7, 0x4c,
+	0x4d, 0xa7, 0x17, 0x5e, 0xb2, 0x74, 0x7d, 0x20, 0xe9, 0x96, 0xdf, 0xfb, 0x04, 0x22, 0xd9, 0x50,
+	0x57, 0xd5, 0xa3, 0x7d, 0x55, 0x3f, 0x47, 0x0d, 0x69, 0xc3, 0x93, 0x38, 0xc4, 0x2b, 0x30, 0x69,
+	0x73, 0x64, 0xbc, 0xbf, 0xe3, 0x60, 0xe2, 0xfe, 0xfe, 0xfe, 0xe6, 0x81, 0xac, 0x35, 0x09, 0x79,
+	0xc6, 0x8d, 0x3b, 0x2f, 0xb7, 0xf0, 0x68, 0xdc, 0xfa, 0x3b, 0x7a, 0x0e, 0xe2, 0x58, 0x53, 0x9b,
+	0xb2, 0xd9, 0x36, 0x10, 0x21, 0x3e, 0x21, 0x75, 0x17, 0xc4, 0xdf, 0xe9, 0x9b, 0x8a, 0xf6, 0x9f,
+	0x65, 0x19, 0xfb, 0x97, 0xeb, 0x25, 0x88, 0x63, 0x82, 0x0f, 0x52, 0xb2, 0x2e, 0x94, 0x7f, 0x99,
+	0xa6, 0xc2, 0x79, 0x71, 0xdc, 0x18, 0x60, 0xd6, 0x9b, 0x38, 0x7b, 0x2e, 0xa0, 0x1a, 0xac, 0x40,
+	0x51, 0xff, 0x02, 0x25, 0xc9, 0x06, 0x63, 0x5e, 0xc5, 0x34, 0xcc, 0xb8, 0x09, 0xb2, 0x5a, 0x35,
+	0x48, 0x8b, 0x5a, 0xaa, 0xfe, 0x9c, 0x5f, 0x84, 0x98, 0x65, 0xbd, 0x6d, 0xea, 0xfe, 0x94, 0x19,
+	0x72, 0x2d, 0x61, 0xc5, 0x32, 0x66, 0x65, 0x1b, 0x19, 0xa2, 0x04, 0x93, 0xb6, 0x3b, 0xb6, 0xbf,
+	0x5f, 0x83, 0x31, 0x03, 0xe1, 0x76, 0xdd, 0xb4, 0x8b, 0xbd, 0x30, 0x40, 0xcc, 0xd9, 0x58, 0x65,
+	0xdb, 0xa4, 0x44, 0xe0, 0x92, 0xad, 0x26, 0xd6, 0xe1, 0x72, 0x05, 0xab, 0x6f, 0x23, 0xf9, 0xd0,
+	0x7e, 0x10, 0x8f, 0x30, 0xdc, 0x9e, 0x31, 0xda, 0xf7, 0xed, 0xf9, 0x6b, 0x30, 0xed, 0xf2, 0xe6,
+	0xf0, 0xc8, 0xe7, 0x21, 0x4a, 0xd2, 0x98, 0x82, 0xa9, 0xf2, 0x7b, 0xe5, 0xcd, 0xea, 0xc3, 0x77,
+	0x76, 0xb6, 0xcb, 0x9b, 0x5b, 0xaf, 0x6f, 0x95, 0xef, 0x4d, 0x85, 0xf8, 0x09, 0x88, 0x91, 0xd5,
+	0x07, 0xd2, 0xfb, 0x53, 0x5c, 0xe9, 0xab, 0x09, 0x88, 0x54, 0xb0, 0xca, 0xef, 0x42, 0xa2, 0xf7,
+	0x25, 0x9f, 0x1d, 0x1c, 0x13, 0x5d, 0x43, 0x90, 0xb0, 0xe0, 0x03, 0x60, 0x49, 0xad, 0x03, 0xef,
+	0xf1, 0x84, 0x9e, 0xf7, 0x52, 0x1f, 0xc4, 0x09, 0x85, 0x60, 0x38, 0xe6, 0x6d, 0x1f, 0xa6, 0x06,
+	0x5e, 0xb4, 0x37, 0x7d, 0x6c, 0x10, 0x94, 0xb0, 0x14, 0x04, 0xc5, 0xfc, 0xe8, 0x70, 0xd5, 0xeb,
+	0xd1, 0xb9, 0xe0, 0x1b, 0x2e, 0x05, 0x0a, 0xc5, 0x80, 0x40, 0xe6, 0x50, 0x83, 0x2b, 0x83, 0xaf,
+	0xc6, 0x5b, 0x3e, 0x45, 0xa0, 0x30, 0x61, 0x39, 0x10, 0x8c, 0xb9, 0x6a, 0xc3, 0xb4, 0xf7, 0xe3,
+	0xe3, 0xb6, 0x8f, 0x9d, 0x2e, 0x54, 0x58, 0x09, 0x0c, 0x65, 0x6e, 0x3b, 0x30, 0x33, 0xe4, 0x41,
+	0x97, 0xf7, 0x49, 0x56, 0x0f, 0x56, 0x28, 0x05, 0xc7, 0x32, 0xcf, 0x8f, 0x39, 0xc8, 0xfa, 0xcd,
+	0xcb, 0xab, 0x81, 0xec, 0xba, 0x95, 0x84, 0x57, 0x46, 0x50, 0x62, 0x51, 0x7d, 0xc2, 0xc1, 0xec,
+	0xf0, 0x09, 0x74, 0x39, 0x90, 0x69, 0xd6, 0x6f, 0x77, 0xce, 0x05, 0x67, 0x31, 0x7c, 0x08, 0xc9,
+	0xbe, 0xb9, 0x50, 0xf4, 0x32, 0xe4, 0xc6, 0x08, 0x79, 0x7f, 0x4c, 0xef, 0x86, 0x1d, 0x98, 0x97,
+	0x3c, 0x37, 0x6c, 0x3f, 0x4a, 0x58, 0x0a, 0x82, 0x62, 0x7e, 0x36, 0x20, 0x4a, 0x06, 0x80, 0xb4,
+	0x97, 0x96, 0x25, 0x11, 0x72, 0xc3, 0x24, 0xbd, 0x36, 0xc8, 0xb9, 0xea, 0x69, 0xc3, 0x92, 0x08,
+	0xb9, 0x61, 0x12, 0x66, 0xe3, 0x01, 0x40, 0xcf, 0xfd, 0x90, 0xf1, 0xc2, 0x77, 0xe5, 0xc2, 0xfc,
+	0xd9, 0x72, 0x66, 0x75, 0x17, 0x12, 0xae, 0x99, 0x61, 0x78, 0x01, 0x08, 0x40, 0x58, 0xf0, 0x01,
+	0x38, 0x86, 0x37, 0xee, 0x3e, 0x3d, 0xc9, 0x70, 0xcf, 0x4e, 0x32, 0xdc, 0x1f, 0x27, 0x19, 0xee,
+	0xcb, 0xd3, 0x4c, 0xe8, 0xd9, 0x69, 0x26, 0xf4, 0xcb, 0x69, 0x26, 0xf4, 0xc1, 0x4d, 0x55, 0x33,
+	0x0f, 0xda, 0x7b, 0x85, 0x9a, 0xde, 0xb0, 0x7f, 0x68, 0xb6, 0xff, 0x2c, 0x63, 0xe5, 0xa3, 0x62,
+	0x87, 0xfe, 0x98, 0xbc, 0x37, 0x46, 0xc6, 0xf4, 0xd5, 0xbf, 0x07, 0x00, 0x12, 0xcf, 0xb4, 0xbf,
+	0xda, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +1820,11 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// SubmitVotes submits a batch of votes on a proposal signed off-chain by
+	// the group members.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitVotes(ctx context.Context, in *MsgSubmitVotes, opts ...grpc.CallOption) (*MsgSubmitVotesResponse, error)
 }
 
 type msgClient struct {
@@ -1754,6 +1961,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SubmitVotes(ctx context.Context, in *MsgSubmitVotes, opts ...grpc.CallOption) (*MsgSubmitVotesResponse, error) {
+	out := new(MsgSubmitVotesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1784,6 +2000,11 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// SubmitVotes submits a batch of votes on a proposal signed off-chain by
+	// the group members.
+	//
+	// Since: cosmos-sdk 0.47
+	SubmitVotes(context.Context, *MsgSubmitVotes) (*MsgSubmitVotesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1832,6 +2053,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) SubmitVotes(ctx context.Context, req *MsgSubmitVotes) (*MsgSubmitVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVotes not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2089,6 +2313,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitVotes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitVotes(ctx, req.(*MsgSubmitVotes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2391,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "SubmitVotes",
+			Handler:    _Msg_SubmitVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3066,6 +3312,132 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OffChainVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffChainVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffChainVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3603,13 +3975,71 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExec) Size() (n int) {
+func (m *OffChainVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Exec != 0 {
+		n += 1 + sovTx(uint64(m.Exec))
+	}
+	return n
+}
+
+func (m *MsgSubmitVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
 		n += 1 + sovTx(uint64(m.ProposalId))
 	}
 	l = len(m.Executor)
@@ -6250,6 +6680,377 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OffChainVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffChainVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffChainVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, OffChainVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
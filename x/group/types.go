@@ -422,6 +422,30 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+// NewVoteSignDoc returns the document signed off-chain by a group member to
+// vote on a proposal with MsgSubmitVotes.
+func NewVoteSignDoc(voter string, proposalID uint64, option VoteOption, metadata, chainID string, groupVersion uint64) VoteSignDoc {
+	return VoteSignDoc{
+		ProposalId:   proposalID,
+		Option:       option,
+		Metadata:     metadata,
+		ChainId:      chainID,
+		GroupVersion: groupVersion,
+		Voter:        voter,
+	}
+}
+
+// GetSignBytes returns the bytes of the sign doc signed by the voter, its
+// protobuf binary encoding.
+func (d VoteSignDoc) GetSignBytes() []byte {
+	bz, err := d.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -297,10 +297,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +358,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -804,6 +804,101 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// VoteSignDoc is the document signed off-chain by a group member to vote on
+// a proposal with MsgSubmitVotes, in its protobuf binary encoding.
+//
+// Since: cosmos-sdk 0.47
+type VoteSignDoc struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,2,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata to attached to the vote.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// chain_id is the ID of the chain the vote is submitted to.
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// group_version is the version of the group when the vote is signed. The
+	// vote is rejected if the group has been modified since.
+	GroupVersion uint64 `protobuf:"varint,5,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,6,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *VoteSignDoc) Reset()         { *m = VoteSignDoc{} }
+func (m *VoteSignDoc) String() string { return proto.CompactTextString(m) }
+func (*VoteSignDoc) ProtoMessage()    {}
+func (*VoteSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+}
+func (m *VoteSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSignDoc.Merge(m, src)
+}
+func (m *VoteSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSignDoc proto.InternalMessageInfo
+
+func (m *VoteSignDoc) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteSignDoc) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *VoteSignDoc) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *VoteSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *VoteSignDoc) GetGroupVersion() uint64 {
+	if m != nil {
+		return m.GroupVersion
+	}
+	return 0
+}
+
+func (m *VoteSignDoc) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
 // Vote represents a vote for a proposal.
 type Vote struct {
 	// proposal is the unique ID of the proposal.
@@ -822,7 +917,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,95 +995,99 @@ func init() {
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
+	proto.RegisterType((*VoteSignDoc)(nil), "cosmos.group.v1.VoteSignDoc")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	// 1346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xfa, 0xdb, 0xaf, 0x53, 0xdb, 0xbf, 0x69, 0x7e, 0xcd, 0x26, 0x29, 0x76, 0x30, 0x15,
+	0x44, 0x45, 0xb1, 0xdb, 0x54, 0x02, 0xa9, 0x07, 0xc0, 0x76, 0xb6, 0xd4, 0x55, 0x6b, 0x5b, 0xbb,
+	0xeb, 0x84, 0x72, 0x59, 0x6d, 0xbc, 0x53, 0x7b, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x1f,
+	0xf4, 0x82, 0xe8, 0x91, 0x0b, 0x52, 0x25, 0xfe, 0x02, 0xa4, 0x1e, 0x10, 0x17, 0xae, 0x55, 0x0f,
+	0xa8, 0xe2, 0xc4, 0x09, 0x50, 0x7b, 0x81, 0x13, 0x57, 0x8e, 0x68, 0x67, 0x66, 0x1d, 0x7f, 0x24,
+	0x6e, 0x53, 0x01, 0xa7, 0x64, 0xe6, 0x79, 0xde, 0x77, 0x9e, 0xf7, 0x73, 0x65, 0xd8, 0x68, 0x13,
+	0xaf, 0x4f, 0xbc, 0x52, 0xc7, 0x25, 0xc3, 0x41, 0xe9, 0xf0, 0x6a, 0x89, 0x8e, 0x06, 0xd8, 0x2b,
+	0x0e, 0x5c, 0x42, 0x09, 0xca, 0x70, 0xb0, 0xc8, 0xc0, 0xe2, 0xe1, 0xd5, 0xf5, 0x95, 0x0e, 0xe9,
+	0x10, 0x86, 0x95, 0xfc, 0xff, 0x38, 0x6d, 0x3d, 0xd7, 0x21, 0xa4, 0xd3, 0xc3, 0x25, 0x76, 0x3a,
+	0x18, 0xde, 0x2b, 0x59, 0x43, 0xd7, 0xa4, 0x36, 0x71, 0x04, 0x9e, 0x9f, 0xc5, 0xa9, 0xdd, 0xc7,
+	0x1e, 0x35, 0xfb, 0x03, 0x41, 0x58, 0xe3, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xb3, 0xb6,
+	0xa6, 0x33, 0xe2, 0x50, 0xe1, 0x5b, 0x09, 0x62, 0x77, 0x70, 0xff, 0x00, 0xbb, 0x68, 0x07, 0xe2,
+	0xa6, 0x65, 0xb9, 0xd8, 0xf3, 0x64, 0x69, 0x53, 0xda, 0x4a, 0x56, 0xe4, 0x9f, 0x1e, 0x6f, 0xaf,
+	0x08, 0x47, 0x65, 0x8e, 0x68, 0xd4, 0xb5, 0x9d, 0x8e, 0x1a, 0x10, 0xd1, 0x05, 0x88, 0x1d, 0x61,
+	0xbb, 0xd3, 0xa5, 0x72, 0xc8, 0x37, 0x51, 0xc5, 0x09, 0xad, 0x43, 0xa2, 0x8f, 0xa9, 0x69, 0x99,
+	0xd4, 0x94, 0xc3, 0x0c, 0x19, 0x9f, 0xd1, 0x87, 0x90, 0x30, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5,
+	0xc8, 0xa6, 0xb4, 0x95, 0xda, 0x59, 0x2f, 0x72, 0x81, 0xc5, 0x40, 0x60, 0x51, 0x0f, 0x82, 0xab,
+	0x24, 0x9e, 0xfc, 0x92, 0x5f, 0x7a, 0xf8, 0x6b, 0x5e, 0x62, 0x8f, 0x62, 0xab, 0x4c, 0x0b, 0x47,
+	0x70, 0x8e, 0x4b, 0x56, 0xf1, 0xe7, 0x43, 0xec, 0xd1, 0xff, 0x4a, 0x79, 0xe1, 0x0b, 0x09, 0x56,
+	0xf5, 0xae, 0x8b, 0xbd, 0x2e, 0xe9, 0x59, 0xbb, 0xb8, 0x6d, 0x7b, 0x36, 0x71, 0x9a, 0xa4, 0x67,
+	0xb7, 0x47, 0xe8, 0x22, 0x24, 0x69, 0x00, 0x71, 0x15, 0xea, 0xf1, 0x05, 0xfa, 0x08, 0xe2, 0x47,
+	0xb6, 0x63, 0x91, 0x23, 0x8f, 0x3d, 0x97, 0xda, 0x79, 0xbb, 0x38, 0xd3, 0x16, 0xc5, 0x69, 0x7f,
+	0xfb, 0x9c, 0xad, 0x06, 0x66, 0xd7, 0xd1, 0xd3, 0xc7, 0xdb, 0xe9, 0x69, 0x4e, 0xe1, 0xa1, 0x04,
+	0x72, 0x13, 0xbb, 0x6d, 0xec, 0x50, 0xb3, 0x83, 0x67, 0x04, 0xe5, 0x00, 0x06, 0x63, 0x4c, 0x28,
+	0x9a, 0xb8, 0xf9, 0x97, 0x24, 0x7d, 0x27, 0xc1, 0xff, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x21,
+	0xa1, 0xb6, 0xd3, 0x31, 0x06, 0xd8, 0xb5, 0x09, 0x4f, 0x52, 0x6a, 0x67, 0x6d, 0xae, 0xf6, 0xbb,
+	0xa2, 0xf1, 0x79, 0xe9, 0xbf, 0xf2, 0x4b, 0xbf, 0xcc, 0x2d, 0x9b, 0xcc, 0x10, 0xb5, 0x60, 0xa5,
+	0x6f, 0x3b, 0x06, 0xbe, 0x8f, 0xdb, 0x43, 0x9f, 0x18, 0x38, 0x0c, 0xbd, 0xba, 0x43, 0xd4, 0xb7,
+	0x1d, 0x25, 0xb0, 0xe7, 0x6e, 0x0b, 0x7f, 0x48, 0x90, 0xfc, 0xd8, 0x0f, 0xbd, 0xe6, 0xdc, 0x23,
+	0x28, 0x0d, 0x21, 0x9b, 0x6b, 0x8c, 0xa8, 0x21, 0xdb, 0x42, 0x45, 0x88, 0x9a, 0x56, 0xdf, 0x76,
+	0xe4, 0xd0, 0x4b, 0x3a, 0x8c, 0xd3, 0x16, 0x4e, 0x80, 0x0c, 0xf1, 0x43, 0xec, 0xfa, 0x29, 0x62,
+	0x03, 0x10, 0x51, 0x83, 0x23, 0x7a, 0x13, 0x96, 0x29, 0xa1, 0x66, 0xcf, 0x10, 0xbd, 0x19, 0x65,
+	0x96, 0x29, 0x76, 0xb7, 0xcf, 0x1b, 0xb4, 0x0a, 0xd0, 0x76, 0xb1, 0x49, 0xf9, 0x00, 0xc5, 0xce,
+	0x30, 0x40, 0x49, 0x61, 0x57, 0xa6, 0x85, 0xbb, 0x90, 0x62, 0xa1, 0x8a, 0xd1, 0x5f, 0x83, 0x04,
+	0x2b, 0xba, 0x31, 0x0e, 0x39, 0xce, 0xce, 0x35, 0x0b, 0x95, 0x20, 0xd6, 0x67, 0x24, 0x91, 0xde,
+	0xd5, 0xb9, 0x2e, 0x11, 0xb3, 0x28, 0x68, 0x85, 0xbf, 0x42, 0x90, 0x61, 0xbe, 0x79, 0xf9, 0x59,
+	0x32, 0x5f, 0x67, 0x40, 0x27, 0x35, 0x85, 0xa6, 0x35, 0x8d, 0x6b, 0x11, 0x3e, 0x7b, 0x2d, 0x22,
+	0xa7, 0xd7, 0x22, 0x3a, 0x5d, 0x0b, 0x13, 0x32, 0x96, 0xe8, 0x64, 0x63, 0xc0, 0x62, 0x11, 0xd9,
+	0x5e, 0x99, 0xcb, 0x76, 0xd9, 0x19, 0x55, 0x0a, 0x4f, 0x1f, 0x6f, 0xe7, 0x16, 0x4f, 0x90, 0x9a,
+	0xb6, 0xa6, 0x67, 0x74, 0xba, 0x96, 0xf1, 0xd7, 0xaa, 0xe5, 0xf5, 0xc4, 0x83, 0x47, 0xf9, 0xa5,
+	0xdf, 0x1f, 0xe5, 0xa5, 0xc2, 0x0f, 0x51, 0x48, 0x34, 0x5d, 0x32, 0x20, 0x9e, 0xd9, 0x9b, 0x6b,
+	0xe0, 0x5b, 0xb0, 0xc2, 0xf3, 0xc9, 0x63, 0x31, 0x82, 0x82, 0xbc, 0xac, 0x9f, 0x51, 0xe7, 0xb8,
+	0x98, 0x02, 0x59, 0xd8, 0xdc, 0xef, 0x41, 0x72, 0xc0, 0x34, 0x60, 0xd7, 0x93, 0x23, 0x9b, 0xe1,
+	0x85, 0xce, 0x8f, 0xa9, 0x48, 0x81, 0x94, 0x37, 0x3c, 0xe8, 0xdb, 0xd4, 0xf0, 0xbf, 0x6c, 0x72,
+	0xf4, 0x0c, 0xc9, 0x00, 0x6e, 0xe8, 0x43, 0xe8, 0x2d, 0x38, 0xc7, 0xc3, 0x0c, 0xaa, 0x1a, 0x63,
+	0x19, 0x58, 0x66, 0x97, 0x7b, 0xa2, 0xb4, 0x57, 0x66, 0x72, 0x11, 0x70, 0xe3, 0x8c, 0x3b, 0x19,
+	0x71, 0x60, 0xf1, 0x3e, 0xc4, 0x3c, 0x6a, 0xd2, 0xa1, 0x27, 0x27, 0x36, 0xa5, 0xad, 0xf4, 0x4e,
+	0x7e, 0x6e, 0x0c, 0x82, 0xc4, 0x6b, 0x8c, 0xa6, 0x0a, 0x3a, 0x6a, 0x02, 0xba, 0x67, 0x3b, 0x66,
+	0xcf, 0xa0, 0x66, 0xaf, 0x37, 0x32, 0x5c, 0xec, 0x0d, 0x7b, 0x54, 0x4e, 0xb2, 0xe8, 0x2e, 0xce,
+	0x39, 0xd1, 0x7d, 0x92, 0xca, 0x38, 0x95, 0x88, 0x1f, 0x9f, 0x9a, 0x65, 0xd6, 0x13, 0xf7, 0xa8,
+	0x09, 0xff, 0x9b, 0x5a, 0xa4, 0x06, 0x76, 0x2c, 0x19, 0xce, 0x90, 0xae, 0xcc, 0xe4, 0x36, 0x55,
+	0x1c, 0x0b, 0x35, 0x21, 0xc3, 0x97, 0x29, 0x71, 0x03, 0x81, 0x29, 0x16, 0xe5, 0x3b, 0xa7, 0x46,
+	0xa9, 0x08, 0x3e, 0xd7, 0xa4, 0xa6, 0xf1, 0xd4, 0x19, 0x5d, 0xf1, 0x1b, 0xc4, 0xf3, 0xcc, 0x0e,
+	0xf6, 0xe4, 0xe5, 0xcd, 0xf0, 0x69, 0x43, 0xa3, 0x8e, 0x59, 0xd7, 0x23, 0x7e, 0x17, 0x17, 0xbe,
+	0x96, 0x20, 0x35, 0x19, 0xeb, 0x06, 0x24, 0x47, 0xd8, 0x33, 0xda, 0x64, 0xe8, 0x50, 0xf1, 0x0d,
+	0x4b, 0x8c, 0xb0, 0x57, 0xf5, 0xcf, 0x7e, 0xa9, 0xcd, 0x03, 0x8f, 0x9a, 0xb6, 0x23, 0x08, 0xfc,
+	0x4b, 0xbe, 0x2c, 0x2e, 0x39, 0x69, 0x0d, 0x12, 0x0e, 0x11, 0x38, 0x6f, 0xd5, 0xb8, 0x43, 0x38,
+	0xf4, 0x2e, 0x20, 0x87, 0x18, 0x47, 0x36, 0xed, 0x1a, 0x87, 0x98, 0x06, 0x24, 0xbe, 0x20, 0x32,
+	0x0e, 0xd9, 0xb7, 0x69, 0x77, 0x0f, 0x53, 0x4e, 0x16, 0xfa, 0xfe, 0x94, 0x20, 0xb5, 0x47, 0x28,
+	0xd6, 0xec, 0x8e, 0xb3, 0x4b, 0xda, 0x28, 0x0f, 0xa9, 0x81, 0xc8, 0xc8, 0xf1, 0xee, 0x84, 0xe0,
+	0xaa, 0x66, 0xa1, 0x6b, 0x10, 0x23, 0x03, 0xff, 0x23, 0xc3, 0xc4, 0xa5, 0x77, 0x36, 0xe6, 0x32,
+	0xea, 0xbb, 0x6b, 0x30, 0x8a, 0x2a, 0xa8, 0x0b, 0xc7, 0x6b, 0x0d, 0x12, 0xed, 0xae, 0x1f, 0xb2,
+	0x6d, 0x09, 0xa9, 0x71, 0x76, 0xae, 0x59, 0xf3, 0xad, 0x1f, 0x3d, 0xa1, 0xf5, 0x8b, 0x10, 0x3d,
+	0x24, 0x14, 0xbb, 0x6c, 0x2e, 0x16, 0xee, 0x4e, 0x46, 0xf3, 0x23, 0x8e, 0xf8, 0x12, 0x5f, 0x1e,
+	0xea, 0xd8, 0x73, 0xe8, 0x95, 0x3c, 0x4f, 0xa4, 0x26, 0xfc, 0x7a, 0xa9, 0x99, 0x5d, 0xe5, 0xff,
+	0xcc, 0x06, 0xb9, 0xfc, 0xa5, 0x04, 0x70, 0xfc, 0x32, 0xda, 0x80, 0xd5, 0xbd, 0x86, 0xae, 0x18,
+	0x8d, 0xa6, 0x5e, 0x6b, 0xd4, 0x8d, 0x56, 0x5d, 0x6b, 0x2a, 0xd5, 0xda, 0x8d, 0x9a, 0xb2, 0x9b,
+	0x5d, 0x42, 0xe7, 0x21, 0x33, 0x09, 0xde, 0x55, 0xb4, 0xac, 0x84, 0x56, 0xe1, 0xfc, 0xe4, 0x65,
+	0xb9, 0xa2, 0xe9, 0xe5, 0x5a, 0x3d, 0x1b, 0x42, 0x08, 0xd2, 0x93, 0x40, 0xbd, 0x91, 0x0d, 0xa3,
+	0x8b, 0x20, 0x4f, 0xdf, 0x19, 0xfb, 0x35, 0xfd, 0xa6, 0xb1, 0xa7, 0xe8, 0x8d, 0x6c, 0x64, 0x3d,
+	0xf2, 0xe0, 0x9b, 0xdc, 0xd2, 0xe5, 0x1f, 0x25, 0x48, 0x4f, 0xaf, 0x17, 0x94, 0x87, 0x8d, 0xa6,
+	0xda, 0x68, 0x36, 0xb4, 0xf2, 0x6d, 0x43, 0xd3, 0xcb, 0x7a, 0x4b, 0x9b, 0x51, 0xf6, 0x06, 0xac,
+	0xcd, 0x12, 0xb4, 0x56, 0xe5, 0x4e, 0x4d, 0xd7, 0x95, 0xdd, 0xac, 0xe4, 0x3f, 0x3b, 0x0b, 0x97,
+	0xab, 0x55, 0xa5, 0xe9, 0xa3, 0xa1, 0x93, 0x50, 0x55, 0xb9, 0xa5, 0x54, 0x7d, 0x34, 0xec, 0x67,
+	0x64, 0xce, 0xb6, 0xd2, 0x50, 0x7d, 0x30, 0x72, 0xd2, 0xbb, 0x7e, 0x40, 0xbb, 0x6a, 0x79, 0xbf,
+	0x9e, 0x8d, 0x8a, 0x80, 0xbe, 0x97, 0xe0, 0xc2, 0xc9, 0x9b, 0x04, 0x6d, 0xc1, 0xa5, 0xb1, 0xbd,
+	0xf2, 0x89, 0x52, 0x6d, 0xe9, 0x0d, 0xd5, 0x50, 0x15, 0xad, 0x75, 0x5b, 0x9f, 0x89, 0xf0, 0x12,
+	0x6c, 0x9e, 0xca, 0xac, 0x37, 0x74, 0x43, 0x6d, 0xd5, 0xb3, 0xd2, 0x42, 0x96, 0xd6, 0xaa, 0x56,
+	0x15, 0x4d, 0xcb, 0x86, 0x16, 0xb2, 0x6e, 0x94, 0x6b, 0xb7, 0x5b, 0xaa, 0x92, 0x0d, 0x73, 0xf1,
+	0x95, 0x0f, 0x9e, 0x3c, 0xcf, 0x49, 0xcf, 0x9e, 0xe7, 0xa4, 0xdf, 0x9e, 0xe7, 0xa4, 0x87, 0x2f,
+	0x72, 0x4b, 0xcf, 0x5e, 0xe4, 0x96, 0x7e, 0x7e, 0x91, 0x5b, 0xfa, 0xf4, 0x52, 0xc7, 0xa6, 0xdd,
+	0xe1, 0x41, 0xb1, 0x4d, 0xfa, 0xe2, 0x07, 0x98, 0xf8, 0xb3, 0xed, 0x59, 0x9f, 0x95, 0xee, 0xf3,
+	0xdf, 0x87, 0x07, 0x31, 0xd6, 0x89, 0xd7, 0xfe, 0x1e, 0x00, 0x0b, 0x52, 0x76, 0x06, 0x36, 0x0e,
+	0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1590,6 +1689,65 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1900,6 +2058,36 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *VoteSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3735,6 +3923,209 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
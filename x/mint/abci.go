@@ -33,8 +33,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// distribute the minted coins between the fee collector account, the
+	// community pool and the weighted addresses
+	_, err = k.DistributeMintedCoins(ctx, mintedCoins, params.DistributionProportions)
 	if err != nil {
 		panic(err)
 	}
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryLastDistribution(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +116,32 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryLastDistribution implements a command to return how the coins
+// minted at the last block were distributed.
+func GetCmdQueryLastDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-distribution",
+		Short: "Query how the coins minted at the last block were distributed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLastDistributionRequest{}
+			res, err := queryClient.LastDistribution(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Distribution)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
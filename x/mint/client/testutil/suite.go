@@ -11,6 +11,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/client/cli"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -67,12 +68,16 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","distribution_proportions":{"staking":"1.000000000000000000","community_pool":"0.000000000000000000","weighted_addresses":[]}}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+distribution_proportions:
+  community_pool: "0.000000000000000000"
+  staking: "1.000000000000000000"
+  weighted_addresses: []
 goal_bonded: "0.670000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
@@ -162,3 +167,22 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryLastDistribution() {
+	val := s.network.Validators[0]
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	cmd := cli.GetCmdQueryLastDistribution()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{
+		fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var distribution minttypes.MintDistribution
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &distribution))
+	s.Require().Equal(int64(1), distribution.Height)
+	s.Require().Equal([]minttypes.MintPayout{{
+		Recipient: feeCollector.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 79)),
+	}}, distribution.Payouts)
+}
@@ -32,3 +32,11 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// LastDistribution returns how the coins minted at the last block were distributed.
+func (k Keeper) LastDistribution(c context.Context, _ *types.QueryLastDistributionRequest) (*types.QueryLastDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	distribution := k.GetLastDistribution(ctx)
+
+	return &types.QueryLastDistributionResponse{Distribution: distribution}, nil
+}
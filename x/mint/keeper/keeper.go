@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -17,7 +19,9 @@ type Keeper struct {
 	storeKey         storetypes.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 }
 
@@ -25,7 +29,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	dk types.DistributionKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -42,7 +46,9 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -71,6 +77,27 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// GetLastDistribution returns how the coins minted at the last block were
+// distributed.
+func (k Keeper) GetLastDistribution(ctx sdk.Context) (distribution types.MintDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastDistributionKey)
+	if b == nil {
+		return distribution
+	}
+
+	k.cdc.MustUnmarshal(b, &distribution)
+	return
+}
+
+// SetLastDistribution sets how the coins minted at the last block were
+// distributed.
+func (k Keeper) SetLastDistribution(ctx sdk.Context, distribution types.MintDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&distribution)
+	store.Set(types.LastDistributionKey, b)
+}
+
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -110,3 +137,95 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoins splits the minted coins held by the mint module account
+// according to the given distribution proportions. The shares of the weighted
+// addresses and of the community pool are truncated, and the staking rewards
+// get the remainder, along with the shares which cannot be paid out, such as
+// the ones of unknown module accounts or of addresses not allowed to receive
+// funds. The resulting payouts are emitted as events, stored as the last
+// distribution and returned.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, mintedCoins sdk.Coins, proportions types.DistributionProportions) (types.MintDistribution, error) {
+	distribution := types.MintDistribution{Height: ctx.BlockHeight()}
+	minted := sdk.NewDecCoinsFromCoins(mintedCoins...)
+	remaining := mintedCoins
+
+	var weightedPayouts []types.MintPayout
+	for _, wa := range proportions.WeightedAddresses {
+		amount, _ := minted.MulDecTruncate(wa.Weight).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		recipient, err := k.payWeightedAddress(ctx, wa, amount)
+		if err != nil {
+			k.Logger(ctx).Error("failed to pay out minted coins, adding them to the staking rewards", "recipient", wa.String(), "err", err)
+			continue
+		}
+
+		remaining = remaining.Sub(amount...)
+		weightedPayouts = append(weightedPayouts, types.MintPayout{Recipient: recipient.String(), Amount: amount})
+		emitPayoutEvent(ctx, types.AttributeValuePayoutWeighted, recipient, amount)
+	}
+
+	communityPool, _ := minted.MulDecTruncate(proportions.CommunityPool).TruncateDecimal()
+	var communityPoolPayout *types.MintPayout
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, k.authKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return distribution, err
+		}
+
+		remaining = remaining.Sub(communityPool...)
+		recipient := k.authKeeper.GetModuleAddress(distrtypes.ModuleName)
+		communityPoolPayout = &types.MintPayout{Recipient: recipient.String(), Amount: communityPool}
+		emitPayoutEvent(ctx, types.AttributeValuePayoutCommunityPool, recipient, communityPool)
+	}
+
+	if !remaining.IsZero() {
+		if err := k.AddCollectedFees(ctx, remaining); err != nil {
+			return distribution, err
+		}
+
+		recipient := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+		distribution.Payouts = append(distribution.Payouts, types.MintPayout{Recipient: recipient.String(), Amount: remaining})
+		emitPayoutEvent(ctx, types.AttributeValuePayoutStaking, recipient, remaining)
+	}
+
+	if communityPoolPayout != nil {
+		distribution.Payouts = append(distribution.Payouts, *communityPoolPayout)
+	}
+	distribution.Payouts = append(distribution.Payouts, weightedPayouts...)
+
+	k.SetLastDistribution(ctx, distribution)
+
+	return distribution, nil
+}
+
+func (k Keeper) payWeightedAddress(ctx sdk.Context, wa types.WeightedAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+	if wa.ModuleName != "" {
+		recipient := k.authKeeper.GetModuleAddress(wa.ModuleName)
+		if recipient == nil {
+			return nil, sdkerrors.ErrUnknownAddress.Wrapf("module account %s does not exist", wa.ModuleName)
+		}
+
+		return recipient, k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, wa.ModuleName, amount)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(wa.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipient, k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount)
+}
+
+func emitPayoutEvent(ctx sdk.Context, payoutType string, recipient sdk.AccAddress, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintPayout,
+			sdk.NewAttribute(types.AttributeKeyPayoutType, payoutType),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (suite *MintTestSuite) TestDistributeMintedCoins() {
+	app, ctx := suite.app, suite.ctx
+	ctx = ctx.WithBlockHeight(10)
+
+	dev := sdk.AccAddress([]byte("dev_fund____________"))
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	nftAddr := authtypes.NewModuleAddress(nft.ModuleName)
+
+	proportions := types.NewDistributionProportions(
+		sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 1),
+		types.WeightedAddress{Address: dev.String(), Weight: sdk.NewDecWithPrec(2, 1)},
+		types.WeightedAddress{ModuleName: govtypes.ModuleName, Weight: sdk.NewDecWithPrec(5, 2)},
+		// blocked addresses cannot receive the minted coins, their share goes to staking
+		types.WeightedAddress{Address: nftAddr.String(), Weight: sdk.NewDecWithPrec(5, 2)},
+	)
+	suite.Require().NoError(proportions.Validate())
+
+	minted := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001))
+	suite.Require().NoError(app.MintKeeper.MintCoins(ctx, minted))
+
+	feesBefore := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+	govBefore := app.BankKeeper.GetAllBalances(ctx, govAddr)
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	distribution, err := app.MintKeeper.DistributeMintedCoins(ctx, minted, proportions)
+	suite.Require().NoError(err)
+
+	stakingAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 551))
+	communityPoolAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	devAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	govAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+
+	suite.Require().Equal(types.MintDistribution{
+		Height: 10,
+		Payouts: []types.MintPayout{
+			{Recipient: feeCollector.String(), Amount: stakingAmount},
+			{Recipient: distrAddr.String(), Amount: communityPoolAmount},
+			{Recipient: dev.String(), Amount: devAmount},
+			{Recipient: govAddr.String(), Amount: govAmount},
+		},
+	}, distribution)
+
+	suite.Require().Equal(feesBefore.Add(stakingAmount...), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(devAmount, app.BankKeeper.GetAllBalances(ctx, dev))
+	suite.Require().Equal(govBefore.Add(govAmount...), app.BankKeeper.GetAllBalances(ctx, govAddr))
+	suite.Require().Equal(
+		communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(communityPoolAmount...)...),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	var payoutEvents int
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeMintPayout {
+			payoutEvents++
+		}
+	}
+	suite.Require().Equal(4, payoutEvents)
+
+	res, err := suite.queryClient.LastDistribution(gocontext.Background(), &types.QueryLastDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(distribution, res.Distribution)
+}
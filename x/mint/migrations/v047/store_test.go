@@ -0,0 +1,30 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.KeyDistributionProportions))
+
+	require.NoError(t, v047.MigrateStore(ctx, paramstore))
+
+	var proportions types.DistributionProportions
+	paramstore.Get(ctx, types.KeyDistributionProportions, &proportions)
+	require.Equal(t, types.DefaultDistributionProportions(), proportions)
+}
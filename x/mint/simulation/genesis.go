@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -19,6 +20,8 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+
+	DistributionProportions = "distribution_proportions"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +49,24 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenDistributionProportions randomized DistributionProportions. At least
+// half of the minted coins go to the staking rewards, the rest being split
+// between the community pool and, half of the time, one of the given accounts.
+func GenDistributionProportions(r *rand.Rand, accs []simtypes.Account) types.DistributionProportions {
+	staking := sdk.NewDecWithPrec(int64(50+r.Intn(51)), 2)
+	communityPool := sdk.OneDec().Sub(staking)
+
+	var weightedAddresses []types.WeightedAddress
+	if len(accs) > 0 && communityPool.IsPositive() && r.Intn(2) == 0 {
+		weight := communityPool.QuoInt64(2)
+		communityPool = communityPool.Sub(weight)
+		acc, _ := simtypes.RandomAcc(r, accs)
+		weightedAddresses = append(weightedAddresses, types.WeightedAddress{Address: acc.Address.String(), Weight: weight})
+	}
+
+	return types.NewDistributionProportions(staking, communityPool, weightedAddresses...)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +101,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var distributionProportions types.DistributionProportions
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionProportions, &distributionProportions, simState.Rand,
+		func(r *rand.Rand) { distributionProportions = GenDistributionProportions(r, simState.Accounts) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear, distributionProportions)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -2,9 +2,16 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint       = ModuleName
+	EventTypeMintPayout = "mint_payout"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyPayoutType       = "payout_type"
+
+	AttributeValuePayoutStaking       = "staking"
+	AttributeValuePayoutCommunityPool = "community_pool"
+	AttributeValuePayoutWeighted      = "weighted_address"
 )
@@ -1,7 +1,13 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// LastDistributionKey is the key of the distribution of the coins minted
+	// at the last block.
+	LastDistributionKey = []byte{0x01}
+)
 
 const (
 	// module name
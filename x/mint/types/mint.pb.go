@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -80,6 +81,11 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// distribution of the minted coins between the staking rewards, the
+	// community pool and weighted addresses.
+	//
+	// Since: cosmos-sdk 0.47
+	DistributionProportions DistributionProportions `protobuf:"bytes,7,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +134,303 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+// DistributionProportions defines how the minted coins are split. The staking,
+// community pool and weighted addresses proportions must sum to one.
+//
+// Since: cosmos-sdk 0.47
+type DistributionProportions struct {
+	// staking is the proportion of the minted coins sent to the fee collector to
+	// be distributed as staking rewards.
+	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
+	// community_pool is the proportion of the minted coins sent to the community
+	// pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+	// weighted_addresses are the addresses or module accounts receiving a
+	// proportion of the minted coins.
+	WeightedAddresses []WeightedAddress `protobuf:"bytes,3,rep,name=weighted_addresses,json=weightedAddresses,proto3" json:"weighted_addresses"`
+}
+
+func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
+func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportions) ProtoMessage()    {}
+func (*DistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportions.Merge(m, src)
+}
+func (m *DistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+
+func (m *DistributionProportions) GetWeightedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.WeightedAddresses
+	}
+	return nil
+}
+
+// WeightedAddress defines an account receiving a proportion of the minted
+// coins. Exactly one of address and module_name must be set.
+//
+// Since: cosmos-sdk 0.47
+type WeightedAddress struct {
+	// address is the bech32 address of the receiving account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// module_name is the name of the receiving module account.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// weight is the proportion of the minted coins sent to the account.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
+func (m *WeightedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WeightedAddress) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// MintPayout defines the coins sent to a recipient of the minted coins.
+//
+// Since: cosmos-sdk 0.47
+type MintPayout struct {
+	// recipient is the bech32 address of the recipient. The staking rewards are
+	// reported with the fee collector address and the community pool with the
+	// distribution module address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of minted coins sent to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MintPayout) Reset()         { *m = MintPayout{} }
+func (m *MintPayout) String() string { return proto.CompactTextString(m) }
+func (*MintPayout) ProtoMessage()    {}
+func (*MintPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *MintPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintPayout.Merge(m, src)
+}
+func (m *MintPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintPayout proto.InternalMessageInfo
+
+func (m *MintPayout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintPayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MintDistribution defines how the coins minted at a given height were
+// distributed.
+//
+// Since: cosmos-sdk 0.47
+type MintDistribution struct {
+	// height is the height at which the coins were minted.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// payouts are the coins sent to each recipient.
+	Payouts []MintPayout `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts"`
+}
+
+func (m *MintDistribution) Reset()         { *m = MintDistribution{} }
+func (m *MintDistribution) String() string { return proto.CompactTextString(m) }
+func (*MintDistribution) ProtoMessage()    {}
+func (*MintDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{5}
+}
+func (m *MintDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDistribution.Merge(m, src)
+}
+func (m *MintDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDistribution proto.InternalMessageInfo
+
+func (m *MintDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintDistribution) GetPayouts() []MintPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*DistributionProportions)(nil), "cosmos.mint.v1beta1.DistributionProportions")
+	proto.RegisterType((*WeightedAddress)(nil), "cosmos.mint.v1beta1.WeightedAddress")
+	proto.RegisterType((*MintPayout)(nil), "cosmos.mint.v1beta1.MintPayout")
+	proto.RegisterType((*MintDistribution)(nil), "cosmos.mint.v1beta1.MintDistribution")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0x36, 0xf9, 0x12, 0xf2, 0xe6, 0xeb, 0xd7, 0x76, 0xda, 0xcf, 0x6e, 0x0b, 0x26, 0x21,
+	0x48, 0x89, 0x60, 0x13, 0x5b, 0xc1, 0x83, 0x08, 0x62, 0x9a, 0x6b, 0x25, 0xac, 0xa2, 0xb4, 0x20,
+	0xcb, 0x64, 0x77, 0xdc, 0x0c, 0xc9, 0xce, 0x2c, 0x33, 0x93, 0xb6, 0xf9, 0x2f, 0x3c, 0x7a, 0xf4,
+	0xaa, 0x37, 0xa1, 0x47, 0xff, 0x80, 0xde, 0x2c, 0x3d, 0x89, 0x42, 0x95, 0xf6, 0x1f, 0x91, 0xdd,
+	0x99, 0x24, 0xb5, 0xc4, 0x1f, 0x87, 0x3d, 0x25, 0xfb, 0xfe, 0x78, 0x9e, 0xe7, 0x7d, 0x76, 0xde,
+	0x1d, 0x28, 0x7b, 0x5c, 0x86, 0x5c, 0x36, 0x43, 0xca, 0x54, 0xf3, 0x60, 0xab, 0x4b, 0x14, 0xde,
+	0x4a, 0x1e, 0x1a, 0x91, 0xe0, 0x8a, 0xa3, 0x65, 0x9d, 0x6f, 0x24, 0x21, 0x93, 0x5f, 0x5f, 0x09,
+	0x78, 0xc0, 0x93, 0x7c, 0x33, 0xfe, 0xa7, 0x4b, 0xd7, 0xd7, 0x74, 0xa9, 0xab, 0x13, 0xa6, 0x4f,
+	0xa7, 0xc6, 0x2c, 0x5d, 0x2c, 0xc9, 0x84, 0xc5, 0xe3, 0x94, 0xe9, 0x7c, 0xed, 0x93, 0x05, 0xf9,
+	0x5d, 0xca, 0x14, 0x11, 0x68, 0x1f, 0x8a, 0x94, 0xbd, 0x1a, 0x60, 0x45, 0x39, 0xb3, 0xad, 0xaa,
+	0x55, 0x2f, 0xb6, 0x1e, 0x9e, 0x9c, 0x57, 0x32, 0x5f, 0xce, 0x2b, 0x1b, 0x01, 0x55, 0xbd, 0x61,
+	0xb7, 0xe1, 0xf1, 0xd0, 0xc0, 0x9b, 0x9f, 0x4d, 0xe9, 0xf7, 0x9b, 0x6a, 0x14, 0x11, 0xd9, 0x68,
+	0x13, 0xef, 0xec, 0x78, 0x13, 0x0c, 0x7b, 0x9b, 0x78, 0xce, 0x14, 0x0e, 0x51, 0x58, 0xc2, 0x8c,
+	0x0d, 0xf1, 0x20, 0xd6, 0x78, 0x40, 0x25, 0xe5, 0x4c, 0xda, 0x73, 0x29, 0x70, 0x2c, 0x6a, 0xd8,
+	0xce, 0x04, 0xb5, 0xf6, 0x35, 0x07, 0xf9, 0x0e, 0x16, 0x38, 0x94, 0xe8, 0x26, 0x40, 0xec, 0x9e,
+	0xeb, 0x13, 0xc6, 0x43, 0x3d, 0x92, 0x53, 0x8c, 0x23, 0xed, 0x38, 0x80, 0x22, 0xf8, 0x7f, 0xa2,
+	0xd0, 0x15, 0x58, 0x11, 0xd7, 0xeb, 0x61, 0x16, 0x90, 0x54, 0x84, 0x2d, 0x4f, 0xa0, 0x1d, 0xac,
+	0xc8, 0x4e, 0x02, 0x8c, 0x30, 0xcc, 0x4f, 0x19, 0x43, 0x7c, 0x64, 0x67, 0x53, 0x60, 0xfa, 0x77,
+	0x02, 0xb9, 0x8b, 0x8f, 0xae, 0x51, 0x50, 0x66, 0xe7, 0xd2, 0xa5, 0xa0, 0x0c, 0xbd, 0x84, 0x52,
+	0xc0, 0xf1, 0xc0, 0xed, 0x72, 0xe6, 0x13, 0xdf, 0xfe, 0x27, 0x05, 0x02, 0x88, 0x01, 0x5b, 0x09,
+	0x1e, 0xda, 0x80, 0x85, 0xee, 0x80, 0x7b, 0x7d, 0xe9, 0x46, 0x44, 0xb8, 0x23, 0x82, 0x85, 0x9d,
+	0xaf, 0x5a, 0xf5, 0x9c, 0x33, 0xaf, 0xc3, 0x1d, 0x22, 0xf6, 0x08, 0x16, 0x28, 0x04, 0xdb, 0xa7,
+	0x52, 0x09, 0xda, 0x1d, 0x26, 0xc3, 0x46, 0x82, 0x47, 0x5c, 0xa8, 0xe4, 0x68, 0x15, 0xaa, 0x56,
+	0xbd, 0xb4, 0x7d, 0xa7, 0x31, 0x63, 0x87, 0x1a, 0xed, 0x2b, 0x4d, 0x9d, 0x69, 0x4f, 0x2b, 0x17,
+	0x4f, 0xe0, 0xac, 0xfa, 0xb3, 0xd3, 0x0f, 0x72, 0x6f, 0xde, 0x56, 0x32, 0xb5, 0x0f, 0x73, 0xb0,
+	0xfa, 0x0b, 0x00, 0xf4, 0x1c, 0x0a, 0x52, 0xe1, 0x3e, 0x65, 0x41, 0x2a, 0xeb, 0x33, 0x06, 0x43,
+	0x1e, 0xfc, 0xe7, 0xf1, 0x30, 0x1c, 0x32, 0xaa, 0x46, 0x6e, 0xc4, 0xf9, 0x20, 0x95, 0x03, 0x3a,
+	0x3f, 0xc1, 0xec, 0x70, 0x3e, 0x40, 0x7b, 0x80, 0x0e, 0x09, 0x0d, 0x7a, 0x8a, 0xf8, 0x2e, 0xf6,
+	0x7d, 0x41, 0xa4, 0x24, 0xd2, 0xce, 0x56, 0xb3, 0xf5, 0xd2, 0xf6, 0xad, 0x99, 0x3e, 0xbe, 0x30,
+	0xe5, 0x8f, 0x75, 0xb5, 0xf1, 0x6f, 0xe9, 0xf0, 0xe7, 0x30, 0x91, 0xb5, 0x8f, 0x16, 0x2c, 0x5c,
+	0x2b, 0x46, 0xdb, 0x50, 0x30, 0x2c, 0xc6, 0x2b, 0xfb, 0xec, 0x78, 0x73, 0xc5, 0xd0, 0x98, 0xa2,
+	0xa7, 0x4a, 0x50, 0x16, 0x38, 0xe3, 0x42, 0x54, 0x81, 0x52, 0xc8, 0xfd, 0xe1, 0x80, 0xb8, 0x0c,
+	0x87, 0x66, 0x4b, 0x1d, 0xd0, 0xa1, 0x27, 0x38, 0x24, 0xe8, 0x19, 0xe4, 0x35, 0x7b, 0x2a, 0x7b,
+	0x65, 0xb0, 0x6a, 0xef, 0x2c, 0x80, 0xf8, 0x13, 0xd9, 0xc1, 0x23, 0x3e, 0x54, 0xe8, 0x3e, 0x14,
+	0x05, 0xf1, 0x68, 0x44, 0x09, 0x53, 0x7f, 0xd4, 0x3e, 0x2d, 0x45, 0x1e, 0xe4, 0x71, 0xc8, 0x87,
+	0x4c, 0xd9, 0x73, 0x89, 0xa9, 0x6b, 0x63, 0x53, 0xe3, 0x4f, 0xf3, 0xc4, 0xd4, 0x1d, 0x4e, 0x59,
+	0xeb, 0x6e, 0xac, 0xfb, 0xfd, 0xb7, 0x4a, 0xfd, 0x2f, 0x74, 0xc7, 0x0d, 0xd2, 0x31, 0xd0, 0xb5,
+	0x3e, 0x2c, 0xc6, 0x52, 0xaf, 0x9e, 0x50, 0x74, 0x03, 0xf2, 0x3d, 0xed, 0x4a, 0xac, 0x36, 0xeb,
+	0x98, 0x27, 0xf4, 0x08, 0x0a, 0x51, 0x32, 0x92, 0x34, 0x8a, 0x2a, 0x33, 0x5f, 0xf3, 0x74, 0x74,
+	0xf3, 0x86, 0xc7, 0x5d, 0xad, 0x9d, 0x93, 0x8b, 0xb2, 0x75, 0x7a, 0x51, 0xb6, 0xbe, 0x5f, 0x94,
+	0xad, 0xd7, 0x97, 0xe5, 0xcc, 0xe9, 0x65, 0x39, 0xf3, 0xf9, 0xb2, 0x9c, 0xd9, 0xbf, 0xfd, 0x5b,
+	0xe1, 0x47, 0xfa, 0xce, 0x4b, 0xf4, 0x77, 0xf3, 0xc9, 0x3d, 0x74, 0xef, 0xc7, 0x00, 0x0a, 0x5f,
+	0xee, 0x40, 0x0f, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +496,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -268,75 +547,730 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WeightedAddresses) > 0 {
+		for iNdEx := len(m.WeightedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedAddresses) > 0 {
+		for _, e := range m.WeightedAddresses {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	l = m.InflationRateChange.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -359,15 +1293,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -395,13 +1329,13 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +1363,41 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedAddresses = append(m.WeightedAddresses, WeightedAddress{})
+			if err := m.WeightedAddresses[len(m.WeightedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -454,7 +1422,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -477,15 +1445,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -513,11 +1481,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -545,13 +1513,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -579,13 +1545,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -613,15 +1629,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -631,31 +1645,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.BlocksPerYear = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -665,11 +1729,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, MintPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -8,7 +8,10 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Parameter store keys
@@ -19,8 +22,20 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+
+	// Since: cosmos-sdk 0.47
+	KeyDistributionProportions = []byte("DistributionProportions")
 )
 
+// reservedRecipients are the module accounts that cannot receive minted coins
+// through a weighted address: their balances are tracked by the staking and
+// distribution modules and funding them directly breaks their invariants.
+var reservedRecipients = []string{
+	distrtypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+}
+
 // ParamTable for minting module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -28,26 +43,47 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	distributionProportions DistributionProportions,
 ) Params {
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		BlocksPerYear:           blocksPerYear,
+		DistributionProportions: distributionProportions,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: sdk.NewDecWithPrec(13, 2),
-		InflationMax:        sdk.NewDecWithPrec(20, 2),
-		InflationMin:        sdk.NewDecWithPrec(7, 2),
-		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:               sdk.DefaultBondDenom,
+		InflationRateChange:     sdk.NewDecWithPrec(13, 2),
+		InflationMax:            sdk.NewDecWithPrec(20, 2),
+		InflationMin:            sdk.NewDecWithPrec(7, 2),
+		GoalBonded:              sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:           uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		DistributionProportions: DefaultDistributionProportions(),
+	}
+}
+
+// DefaultDistributionProportions returns the default distribution of the
+// minted coins, which are all sent to the fee collector as staking rewards.
+func DefaultDistributionProportions() DistributionProportions {
+	return DistributionProportions{
+		Staking:       sdk.OneDec(),
+		CommunityPool: sdk.ZeroDec(),
+	}
+}
+
+// NewDistributionProportions creates a new DistributionProportions instance.
+func NewDistributionProportions(staking, communityPool sdk.Dec, weightedAddresses ...WeightedAddress) DistributionProportions {
+	return DistributionProportions{
+		Staking:           staking,
+		CommunityPool:     communityPool,
+		WeightedAddresses: weightedAddresses,
 	}
 }
 
@@ -71,6 +107,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -96,6 +135,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 	}
 }
 
@@ -191,3 +231,73 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.(DistributionProportions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Staking.IsNil() || v.Staking.IsNegative() {
+		return fmt.Errorf("staking distribution proportion must be non-negative: %s", v.Staking)
+	}
+	if v.CommunityPool.IsNil() || v.CommunityPool.IsNegative() {
+		return fmt.Errorf("community pool distribution proportion must be non-negative: %s", v.CommunityPool)
+	}
+
+	total := v.Staking.Add(v.CommunityPool)
+	recipients := make(map[string]bool, len(v.WeightedAddresses))
+	for _, wa := range v.WeightedAddresses {
+		if err := wa.Validate(); err != nil {
+			return err
+		}
+
+		recipient := wa.Address
+		if wa.ModuleName != "" {
+			recipient = "module:" + wa.ModuleName
+		}
+		if recipients[recipient] {
+			return fmt.Errorf("duplicate distribution recipient %s", recipient)
+		}
+		recipients[recipient] = true
+
+		total = total.Add(wa.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+// Validate checks that the distribution proportions are valid and sum to one.
+func (dp DistributionProportions) Validate() error {
+	return validateDistributionProportions(dp)
+}
+
+// Validate performs a stateless validation of the weighted address.
+func (wa WeightedAddress) Validate() error {
+	switch {
+	case wa.Address != "" && wa.ModuleName != "":
+		return errors.New("weighted address cannot have both an address and a module name")
+	case wa.Address != "":
+		if _, err := sdk.AccAddressFromBech32(wa.Address); err != nil {
+			return fmt.Errorf("invalid weighted address %s: %w", wa.Address, err)
+		}
+	case strings.TrimSpace(wa.ModuleName) == "":
+		return errors.New("weighted address must have an address or a module name")
+	}
+
+	for _, name := range reservedRecipients {
+		if wa.ModuleName == name || wa.Address == authtypes.NewModuleAddress(name).String() {
+			return fmt.Errorf("module account %s cannot be a weighted address", name)
+		}
+	}
+
+	if wa.Weight.IsNil() || !wa.Weight.IsPositive() {
+		return fmt.Errorf("weight must be positive: %s", wa.Weight)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDistributionProportionsValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr________________")).String()
+
+	testCases := []struct {
+		name        string
+		proportions types.DistributionProportions
+		expectErr   bool
+	}{
+		{"default", types.DefaultDistributionProportions(), false},
+		{
+			"with weighted addresses",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(1, 1),
+				types.WeightedAddress{Address: addr, Weight: sdk.NewDecWithPrec(2, 1)},
+				types.WeightedAddress{ModuleName: "gov", Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			false,
+		},
+		{"sum lower than one", types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec()), true},
+		{"sum greater than one", types.NewDistributionProportions(sdk.OneDec(), sdk.NewDecWithPrec(1, 1)), true},
+		{"negative staking", types.NewDistributionProportions(sdk.NewDec(-1), sdk.NewDec(2)), true},
+		{"nil community pool", types.DistributionProportions{Staking: sdk.OneDec()}, true},
+		{
+			"zero weight",
+			types.NewDistributionProportions(sdk.OneDec(), sdk.ZeroDec(),
+				types.WeightedAddress{Address: addr, Weight: sdk.ZeroDec()},
+			),
+			true,
+		},
+		{
+			"invalid address",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{Address: "invalid", Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"address and module name",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{Address: addr, ModuleName: "gov", Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"no recipient",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"bonded pool module name",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{ModuleName: stakingtypes.BondedPoolName, Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"not bonded pool address",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{Address: authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(), Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"distribution module name",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(9, 1), sdk.ZeroDec(),
+				types.WeightedAddress{ModuleName: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+		{
+			"duplicate recipient",
+			types.NewDistributionProportions(sdk.NewDecWithPrec(8, 1), sdk.ZeroDec(),
+				types.WeightedAddress{Address: addr, Weight: sdk.NewDecWithPrec(1, 1)},
+				types.WeightedAddress{Address: addr, Weight: sdk.NewDecWithPrec(1, 1)},
+			),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proportions.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			params := types.DefaultParams()
+			params.DistributionProportions = tc.proportions
+			require.Equal(t, err == nil, params.Validate() == nil)
+		})
+	}
+}
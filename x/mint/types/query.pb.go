@@ -268,6 +268,95 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryLastDistributionRequest is the request type for the
+// Query/LastDistribution RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryLastDistributionRequest struct {
+}
+
+func (m *QueryLastDistributionRequest) Reset()         { *m = QueryLastDistributionRequest{} }
+func (m *QueryLastDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastDistributionRequest) ProtoMessage()    {}
+func (*QueryLastDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryLastDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastDistributionRequest.Merge(m, src)
+}
+func (m *QueryLastDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastDistributionRequest proto.InternalMessageInfo
+
+// QueryLastDistributionResponse is the response type for the
+// Query/LastDistribution RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryLastDistributionResponse struct {
+	// distribution is how the coins minted at the last block were distributed.
+	Distribution MintDistribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *QueryLastDistributionResponse) Reset()         { *m = QueryLastDistributionResponse{} }
+func (m *QueryLastDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastDistributionResponse) ProtoMessage()    {}
+func (*QueryLastDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryLastDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastDistributionResponse.Merge(m, src)
+}
+func (m *QueryLastDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryLastDistributionResponse) GetDistribution() MintDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return MintDistribution{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +364,46 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryLastDistributionRequest)(nil), "cosmos.mint.v1beta1.QueryLastDistributionRequest")
+	proto.RegisterType((*QueryLastDistributionResponse)(nil), "cosmos.mint.v1beta1.QueryLastDistributionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x34, 0x2a, 0xed, 0x65, 0x87, 0xe1, 0x8d, 0x3f, 0xca, 0x56, 0x77, 0x0a, 0xa2,
+	0x14, 0x10, 0xb6, 0x5a, 0x4e, 0x1c, 0x29, 0xbb, 0x20, 0x0d, 0x51, 0x7a, 0x84, 0xc3, 0xe4, 0x76,
+	0x59, 0xb0, 0x68, 0xed, 0x2c, 0x76, 0x26, 0x26, 0x71, 0x40, 0x9c, 0x39, 0x20, 0xf1, 0x29, 0xf8,
+	0x26, 0x3b, 0x4e, 0xe2, 0x82, 0x38, 0x4c, 0xa8, 0xe5, 0x0b, 0xf0, 0x0d, 0x50, 0x1c, 0x37, 0xb4,
+	0x59, 0x32, 0xd6, 0x9d, 0x5a, 0xf9, 0x79, 0xfd, 0x3c, 0xbf, 0xe6, 0x7d, 0x52, 0xa8, 0x0f, 0xa4,
+	0x1a, 0x49, 0x45, 0x47, 0x5c, 0x68, 0x7a, 0xd8, 0xea, 0xfb, 0x9a, 0xb5, 0xe8, 0x41, 0xec, 0x47,
+	0x47, 0x24, 0x8c, 0xa4, 0x96, 0x68, 0x2d, 0x1d, 0x20, 0xc9, 0x00, 0xb1, 0x03, 0xee, 0x7a, 0x20,
+	0x03, 0x69, 0x74, 0x9a, 0x7c, 0x4b, 0x47, 0xdd, 0xcd, 0x40, 0xca, 0x60, 0xe8, 0x53, 0x16, 0x72,
+	0xca, 0x84, 0x90, 0x9a, 0x69, 0x2e, 0x85, 0xb2, 0x2a, 0x2e, 0x4a, 0x32, 0xae, 0x46, 0xf7, 0xd6,
+	0x01, 0xbd, 0x4a, 0x72, 0xbb, 0x2c, 0x62, 0x23, 0xd5, 0xf3, 0x0f, 0x62, 0x5f, 0x69, 0xaf, 0x0b,
+	0x6b, 0x73, 0xa7, 0x2a, 0x94, 0x42, 0xf9, 0xe8, 0x09, 0x54, 0x43, 0x73, 0x72, 0xdb, 0xd9, 0x72,
+	0x9a, 0xd7, 0xda, 0x1b, 0xa4, 0x00, 0x93, 0xa4, 0x97, 0x3a, 0x4b, 0xc7, 0xa7, 0xf5, 0x4a, 0xcf,
+	0x5e, 0xf0, 0x6e, 0xc1, 0x0d, 0xe3, 0xf8, 0x5c, 0xec, 0x0f, 0x0d, 0xe0, 0x34, 0x6a, 0x1f, 0x6e,
+	0xe6, 0x05, 0x9b, 0xb6, 0x03, 0xcb, 0x7c, 0x7a, 0x68, 0x02, 0x57, 0x3a, 0x24, 0xf1, 0xfc, 0x79,
+	0x5a, 0x6f, 0x04, 0x5c, 0xbf, 0x8d, 0xfb, 0x64, 0x20, 0x47, 0xd4, 0xfe, 0xc0, 0xf4, 0xe3, 0x91,
+	0xda, 0x7b, 0x47, 0xf5, 0x51, 0xe8, 0x2b, 0xb2, 0xed, 0x0f, 0x7a, 0xff, 0x0c, 0x3c, 0x0c, 0x9b,
+	0x26, 0xe7, 0xa9, 0x10, 0x31, 0x1b, 0x76, 0x23, 0x79, 0xc8, 0x55, 0xf2, 0x9c, 0xa6, 0x1c, 0x1f,
+	0xa0, 0x56, 0xa2, 0x5b, 0x9c, 0x37, 0x70, 0x9d, 0x19, 0x6d, 0x37, 0xcc, 0xc4, 0x4b, 0x62, 0xad,
+	0xb2, 0x5c, 0x48, 0x46, 0xb7, 0xc3, 0x94, 0xde, 0xe6, 0x4a, 0x47, 0xbc, 0x1f, 0xcf, 0x3e, 0xa5,
+	0x10, 0x6a, 0x25, 0xba, 0xa5, 0x7b, 0x09, 0x2b, 0x7b, 0x33, 0xe7, 0x76, 0x41, 0x77, 0x0b, 0x17,
+	0xf4, 0x82, 0x8b, 0x39, 0x13, 0xbb, 0xaa, 0x39, 0x83, 0xf6, 0x9f, 0x25, 0xb8, 0x6a, 0x22, 0xd1,
+	0x47, 0x07, 0xaa, 0xe9, 0x4e, 0xd1, 0xbd, 0x42, 0xbf, 0xb3, 0x05, 0x72, 0x9b, 0xff, 0x1f, 0x4c,
+	0xc1, 0xbd, 0x3b, 0x9f, 0xbe, 0xff, 0xfe, 0x7a, 0xa5, 0x86, 0x36, 0x68, 0x51, 0x53, 0xd3, 0xf6,
+	0xa0, 0xcf, 0x0e, 0x2c, 0x67, 0x05, 0x41, 0x0f, 0xca, 0xcd, 0xf3, 0xf5, 0x72, 0x1f, 0x5e, 0x68,
+	0xd6, 0xb2, 0x34, 0x0c, 0xcb, 0x16, 0xc2, 0x85, 0x2c, 0x59, 0x97, 0xd0, 0x37, 0x07, 0x56, 0xf3,
+	0x3d, 0x41, 0xad, 0xf2, 0xa4, 0x92, 0xce, 0xb9, 0xed, 0x45, 0xae, 0x58, 0x46, 0x62, 0x18, 0x9b,
+	0xa8, 0x51, 0xc8, 0x78, 0xa6, 0xa1, 0x86, 0x35, 0xdf, 0x9a, 0xf3, 0x58, 0x4b, 0x1a, 0xe8, 0xb6,
+	0x17, 0xb9, 0x72, 0x21, 0xd6, 0x21, 0x53, 0x7a, 0x77, 0xb6, 0x73, 0x9d, 0x67, 0xc7, 0x63, 0xec,
+	0x9c, 0x8c, 0xb1, 0xf3, 0x6b, 0x8c, 0x9d, 0x2f, 0x13, 0x5c, 0x39, 0x99, 0xe0, 0xca, 0x8f, 0x09,
+	0xae, 0xbc, 0xbe, 0x7f, 0xee, 0x9b, 0xf5, 0x3e, 0x35, 0x36, 0x2f, 0x58, 0xbf, 0x6a, 0xfe, 0xd8,
+	0x1e, 0xff, 0x1d, 0x00, 0x48, 0x58, 0x13, 0x0b, 0x64, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +424,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// LastDistribution returns how the coins minted at the last block were
+	// distributed.
+	//
+	// Since: cosmos-sdk 0.47
+	LastDistribution(ctx context.Context, in *QueryLastDistributionRequest, opts ...grpc.CallOption) (*QueryLastDistributionResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +466,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) LastDistribution(ctx context.Context, in *QueryLastDistributionRequest, opts ...grpc.CallOption) (*QueryLastDistributionResponse, error) {
+	out := new(QueryLastDistributionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/LastDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +483,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// LastDistribution returns how the coins minted at the last block were
+	// distributed.
+	//
+	// Since: cosmos-sdk 0.47
+	LastDistribution(context.Context, *QueryLastDistributionRequest) (*QueryLastDistributionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +503,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) LastDistribution(ctx context.Context, req *QueryLastDistributionRequest) (*QueryLastDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastDistribution not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +565,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LastDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/LastDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastDistribution(ctx, req.(*QueryLastDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +599,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "LastDistribution",
+			Handler:    _Query_LastDistribution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +776,62 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLastDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +903,26 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLastDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1328,139 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLastDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
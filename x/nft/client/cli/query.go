@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
@@ -39,6 +41,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryApproved(),
+		GetCmdQueryIsApprovedForAll(),
+		GetCmdQueryOperators(),
 	)
 	return nftQueryCmd
 }
@@ -81,17 +86,11 @@ func GetCmdQueryClasses() *cobra.Command {
 				return err
 			}
 			queryClient := nft.NewQueryClient(clientCtx)
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-			res, err := queryClient.Classes(cmd.Context(), &nft.QueryClassesRequest{
-				Pagination: pageReq,
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Classes(ctx, &nft.QueryClassesRequest{
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-			return clientCtx.PrintProto(res)
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -145,11 +144,6 @@ $ %s query %s nfts <class-id> --owner=<owner>
 				return err
 			}
 			queryClient := nft.NewQueryClient(clientCtx)
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
 			owner, err := cmd.Flags().GetString(FlagOwner)
 			if err != nil {
 				return err
@@ -176,16 +170,14 @@ $ %s query %s nfts <class-id> --owner=<owner>
 				return errors.ErrInvalidRequest.Wrap("must provide at least one of classID or owner")
 			}
 
-			request := &nft.QueryNFTsRequest{
-				ClassId:    classID,
-				Owner:      owner,
-				Pagination: pageReq,
-			}
-			res, err := queryClient.NFTs(cmd.Context(), request)
-			if err != nil {
-				return err
-			}
-			return clientCtx.PrintProto(res)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				request := &nft.QueryNFTsRequest{
+					ClassId:    classID,
+					Owner:      owner,
+					Pagination: pageReq,
+				}
+				return queryClient.NFTs(ctx, request)
+			})
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -274,3 +266,85 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryApproved implements the query approved command.
+func GetCmdQueryApproved() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the account approved to send the NFT based on its class and id.",
+		Example: fmt.Sprintf(`$ %s query %s approved <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Approved(cmd.Context(), &nft.QueryApprovedRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIsApprovedForAll implements the query is-approved-for-all command.
+func GetCmdQueryIsApprovedForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "is-approved-for-all [class-id] [owner] [operator]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query whether an operator is approved to send all the NFTs of a given class owned by the owner.",
+		Example: fmt.Sprintf(`$ %s query %s is-approved-for-all <class-id> <owner> <operator>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.IsApprovedForAll(cmd.Context(), &nft.QueryIsApprovedForAllRequest{
+				ClassId:  args[0],
+				Owner:    args[1],
+				Operator: args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryOperators implements the query operators command.
+func GetCmdQueryOperators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "operators [class-id] [owner]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the operators approved to send the NFTs of a given class owned by the owner.",
+		Example: fmt.Sprintf(`$ %s query %s operators <class-id> <owner>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Operators(ctx, &nft.QueryOperatorsRequest{
+					ClassId:    args[0],
+					Owner:      args[1],
+					Pagination: pageReq,
+				})
+			})
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operators")
+	return cmd
+}
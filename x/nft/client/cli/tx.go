@@ -26,6 +26,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdApprove(),
+		NewCmdRevoke(),
+		NewCmdSetApprovalForAll(),
+		NewCmdRevokeApprovalForAll(),
 	)
 
 	return nftTxCmd
@@ -58,3 +62,112 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [class-id] [nft-id] [spender] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "approve an account to send a nft on behalf of its owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <class-id> <nft-id> <spender> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgApprove{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+				Spender: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "revoke the approval of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke <class-id> <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgRevoke{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSetApprovalForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-for-all [class-id] [operator] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "approve an operator to send all the nfts of a class on behalf of their owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-approval-for-all <class-id> <operator> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgSetApprovalForAll{
+				ClassId:  args[0],
+				Owner:    clientCtx.GetFromAddress().String(),
+				Operator: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdRevokeApprovalForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval-for-all [class-id] [operator] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "revoke the approval of an operator for all the nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s revoke-approval-for-all <class-id> <operator> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgRevokeApprovalForAll{
+				ClassId:  args[0],
+				Owner:    clientCtx.GetFromAddress().String(),
+				Operator: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
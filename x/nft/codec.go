@@ -9,6 +9,10 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgApprove{},
+		&MsgRevoke{},
+		&MsgSetApprovalForAll{},
+		&MsgRevokeApprovalForAll{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -13,4 +13,6 @@ var (
 	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
 	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
 	ErrInvalidClassID = sdkerrors.Register(ModuleName, 8, "invalid class id")
+	ErrNotApproved    = sdkerrors.Register(ModuleName, 9, "nft approval does not exist")
+	ErrNotOperator    = sdkerrors.Register(ModuleName, 10, "nft operator approval does not exist")
 )
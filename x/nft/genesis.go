@@ -2,6 +2,7 @@ package nft
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateGenesis check the given genesis state has no integrity issues
@@ -21,6 +22,28 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	approved := make(map[[2]string]bool, len(data.Approvals))
+	for _, approval := range data.Approvals {
+		if err := ValidateClassID(approval.ClassId); err != nil {
+			return err
+		}
+		if err := ValidateNFTID(approval.Id); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Spender); err != nil {
+			return err
+		}
+		key := [2]string{approval.ClassId, approval.Id}
+		if approved[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate approval for nft %s of class %s", approval.Id, approval.ClassId)
+		}
+		approved[key] = true
+	}
+	for _, approval := range data.OperatorApprovals {
+		if err := validateOperatorApproval(approval.ClassId, approval.Owner, approval.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
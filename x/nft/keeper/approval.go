@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SetApproval defines a method for approving an account to send a nft on behalf of its owner.
+// A nft has at most one approved account: the previous approval, if any, is replaced.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) SetApproval(ctx sdk.Context, classID, nftID string, spender sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(approvalStoreKey(classID, nftID), spender.Bytes())
+	return nil
+}
+
+// RemoveApproval defines a method for revoking the approval of a nft.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) RemoveApproval(ctx sdk.Context, classID, nftID string) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if k.GetApproval(ctx, classID, nftID).Empty() {
+		return sdkerrors.Wrap(nft.ErrNotApproved, nftID)
+	}
+
+	k.deleteApproval(ctx, classID, nftID)
+	return nil
+}
+
+// GetApproval returns the account approved to send the specified nft, or an
+// empty address if there is none
+func (k Keeper) GetApproval(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(approvalStoreKey(classID, nftID))
+	return sdk.AccAddress(bz)
+}
+
+// SetOperator defines a method for approving an operator to send all the
+// nfts of the specified class owned by owner, including the ones owner receives later.
+// Note: When the upper module uses this method, it needs to authenticate owner
+func (k Keeper) SetOperator(ctx sdk.Context, classID string, owner, operator sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(operatorApprovalStoreKey(owner, classID, operator), Placeholder)
+	return nil
+}
+
+// RemoveOperator defines a method for revoking the approval of an
+// operator for the nfts of the specified class owned by owner.
+// Note: When the upper module uses this method, it needs to authenticate owner
+func (k Keeper) RemoveOperator(ctx sdk.Context, classID string, owner, operator sdk.AccAddress) error {
+	if !k.IsOperator(ctx, classID, owner, operator) {
+		return sdkerrors.Wrapf(nft.ErrNotOperator, "%s is not an operator of %s for class %s", operator, owner, classID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(operatorApprovalStoreKey(owner, classID, operator))
+	return nil
+}
+
+// IsOperator determines whether operator is approved to send all the nfts
+// of the specified class owned by owner
+func (k Keeper) IsOperator(ctx sdk.Context, classID string, owner, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(operatorApprovalStoreKey(owner, classID, operator))
+}
+
+// IsApprovedOrOwner determines whether spender is the owner of the specified nft,
+// the account approved to send it, or an operator of its owner for its class
+func (k Keeper) IsApprovedOrOwner(ctx sdk.Context, classID, nftID string, spender sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return false
+	}
+
+	return owner.Equals(spender) ||
+		k.GetApproval(ctx, classID, nftID).Equals(spender) ||
+		k.IsOperator(ctx, classID, owner, spender)
+}
+
+// GetApprovals returns the accounts approved to send single nfts
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []*nft.Approval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ApprovalKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseApprovalStoreKey(iterator.Key())
+		approvals = append(approvals, &nft.Approval{
+			ClassId: classID,
+			Id:      nftID,
+			Spender: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return approvals
+}
+
+// GetOperatorApprovals returns the operators approved to send all the nfts of
+// a class on behalf of their owner
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context) (approvals []*nft.OperatorApproval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), OperatorApprovalKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owner, classID, operator := parseOperatorApprovalStoreKey(iterator.Key())
+		approvals = append(approvals, &nft.OperatorApproval{
+			ClassId:  classID,
+			Owner:    owner.String(),
+			Operator: operator.String(),
+		})
+	}
+	return approvals
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, classID, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(approvalStoreKey(classID, nftID))
+}
+
+func (k Keeper) getOperatorStore(ctx sdk.Context, owner sdk.AccAddress, classID string) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, prefixOperatorApprovalStoreKey(owner, classID))
+}
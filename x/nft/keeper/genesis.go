@@ -23,6 +23,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		spender := sdk.MustAccAddressFromBech32(approval.Spender)
+
+		if err := k.SetApproval(ctx, approval.ClassId, approval.Id, spender); err != nil {
+			panic(err)
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		owner := sdk.MustAccAddressFromBech32(approval.Owner)
+		operator := sdk.MustAccAddressFromBech32(approval.Operator)
+
+		if err := k.SetOperator(ctx, approval.ClassId, owner, operator); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -55,7 +70,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:           classes,
+		Entries:           entries,
+		Approvals:         k.GetApprovals(ctx),
+		OperatorApprovals: k.GetOperatorApprovals(ctx),
 	}
 }
@@ -198,3 +198,85 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// Approved return the account approved to send an NFT based on its class and id, same as getApproved in ERC721
+func (k Keeper) Approved(goCtx context.Context, r *nft.QueryApprovedRequest) (*nft.QueryApprovedResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasNFT(ctx, r.ClassId, r.Id) {
+		return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+	}
+
+	var spender string
+	if approved := k.GetApproval(ctx, r.ClassId, r.Id); !approved.Empty() {
+		spender = approved.String()
+	}
+	return &nft.QueryApprovedResponse{Spender: spender}, nil
+}
+
+// IsApprovedForAll return whether an operator is approved to send all the NFTs of a class owned by the owner,
+// same as isApprovedForAll in ERC721
+func (k Keeper) IsApprovedForAll(goCtx context.Context, r *nft.QueryIsApprovedForAllRequest) (*nft.QueryIsApprovedForAllResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(r.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	approved := k.IsOperator(ctx, r.ClassId, owner, operator)
+	return &nft.QueryIsApprovedForAllResponse{Approved: approved}, nil
+}
+
+// Operators return all the operators approved to send the NFTs of a class owned by the owner
+func (k Keeper) Operators(goCtx context.Context, r *nft.QueryOperatorsRequest) (*nft.QueryOperatorsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var operators []string
+	pageRes, err := query.Paginate(k.getOperatorStore(ctx, owner, r.ClassId), r.Pagination, func(key []byte, _ []byte) error {
+		operators = append(operators, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryOperatorsResponse{
+		Operators:  operators,
+		Pagination: pageRes,
+	}, nil
+}
@@ -318,12 +318,28 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	err = s.app.NFTKeeper.SetApproval(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+
+	err = s.app.NFTKeeper.SetOperator(s.ctx, testClassID, s.addrs[0], s.addrs[2])
+	s.Require().NoError(err)
+
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Approvals: []*nft.Approval{{
+			ClassId: testClassID,
+			Id:      testID,
+			Spender: s.addrs[1].String(),
+		}},
+		OperatorApprovals: []*nft.OperatorApproval{{
+			ClassId:  testClassID,
+			Owner:    s.addrs[0].String(),
+			Operator: s.addrs[2].String(),
+		}},
 	}
 	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -349,6 +365,16 @@ func (s *TestSuite) TestInitGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Approvals: []*nft.Approval{{
+			ClassId: testClassID,
+			Id:      testID,
+			Spender: s.addrs[1].String(),
+		}},
+		OperatorApprovals: []*nft.OperatorApproval{{
+			ClassId:  testClassID,
+			Owner:    s.addrs[0].String(),
+			Operator: s.addrs[2].String(),
+		}},
 	}
 	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -360,4 +386,8 @@ func (s *TestSuite) TestInitGenesis() {
 	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	// test approvals
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetApproval(s.ctx, testClassID, testID))
+	s.Require().True(s.app.NFTKeeper.IsOperator(s.ctx, testClassID, s.addrs[0], s.addrs[2]))
 }
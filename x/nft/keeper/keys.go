@@ -15,6 +15,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ApprovalKey          = []byte{0x06}
+	OperatorApprovalKey  = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -104,3 +106,69 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// approvalStoreKey returns the byte representation of the nft approval
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID>
+func approvalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(ApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, ApprovalKey)
+	copy(key[len(ApprovalKey):], classIDBz)
+	copy(key[len(ApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// parseApprovalStoreKey parses the key of an approval, without the 0x06 prefix
+func parseApprovalStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.SplitN(key, Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid approvalStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// operatorApprovalStoreKey returns the byte representation of the nft operator approval
+// Items are stored with the following key: values
+// 0x07<owner><classID><Delimiter(1 Byte)><operator>
+func operatorApprovalStoreKey(owner sdk.AccAddress, classID string, operator sdk.AccAddress) []byte {
+	prefix := prefixOperatorApprovalStoreKey(owner, classID)
+	operator = address.MustLengthPrefix(operator)
+
+	key := make([]byte, len(prefix)+len(operator))
+	copy(key, prefix)
+	copy(key[len(prefix):], operator)
+	return key
+}
+
+// prefixOperatorApprovalStoreKey returns the prefix of the result of the method operatorApprovalStoreKey
+// Items are stored with the following key: values
+// 0x07<owner><classID><Delimiter>
+func prefixOperatorApprovalStoreKey(owner sdk.AccAddress, classID string) []byte {
+	owner = address.MustLengthPrefix(owner)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+
+	key := make([]byte, len(OperatorApprovalKey)+len(owner)+len(classIDBz)+len(Delimiter))
+	copy(key, OperatorApprovalKey)
+	copy(key[len(OperatorApprovalKey):], owner)
+	copy(key[len(OperatorApprovalKey)+len(owner):], classIDBz)
+	copy(key[len(OperatorApprovalKey)+len(owner)+len(classIDBz):], Delimiter)
+	return key
+}
+
+// parseOperatorApprovalStoreKey parses the key of an operator approval, without the 0x07 prefix
+func parseOperatorApprovalStoreKey(key []byte) (owner sdk.AccAddress, classID string, operator sdk.AccAddress) {
+	ownerLen := int(key[0])
+	owner = sdk.AccAddress(key[1 : 1+ownerLen])
+
+	ret := bytes.SplitN(key[1+ownerLen:], Delimiter, 2)
+	if len(ret) != 2 || len(ret[1]) == 0 {
+		panic("invalid operatorApprovalStoreKey")
+	}
+	classID = string(ret[0])
+	operator = sdk.AccAddress(ret[1][1:])
+	return
+}
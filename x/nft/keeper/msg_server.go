@@ -18,9 +18,8 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, err
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	if !k.IsApprovedOrOwner(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor approved to send it", sender, msg.Id)
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
@@ -40,3 +39,128 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// Approve implement Approve method of the types.MsgServer.
+func (k Keeper) Approve(goCtx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.authorizeApproval(ctx, msg.ClassId, msg.Id, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if spender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already the owner of nft %s", spender, msg.Id)
+	}
+
+	if err := k.SetApproval(ctx, msg.ClassId, msg.Id, spender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprove{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   owner.String(),
+		Spender: msg.Spender,
+	})
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// Revoke implement Revoke method of the types.MsgServer.
+func (k Keeper) Revoke(goCtx context.Context, msg *nft.MsgRevoke) (*nft.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.authorizeApproval(ctx, msg.ClassId, msg.Id, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	spender := k.GetApproval(ctx, msg.ClassId, msg.Id)
+	if err := k.RemoveApproval(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventRevoke{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   owner.String(),
+		Spender: spender.String(),
+	})
+	return &nft.MsgRevokeResponse{}, nil
+}
+
+// SetApprovalForAll implement SetApprovalForAll method of the types.MsgServer.
+func (k Keeper) SetApprovalForAll(goCtx context.Context, msg *nft.MsgSetApprovalForAll) (*nft.MsgSetApprovalForAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetOperator(ctx, msg.ClassId, owner, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprovalForAll{
+		ClassId:  msg.ClassId,
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		Approved: true,
+	})
+	return &nft.MsgSetApprovalForAllResponse{}, nil
+}
+
+// RevokeApprovalForAll implement RevokeApprovalForAll method of the types.MsgServer.
+func (k Keeper) RevokeApprovalForAll(goCtx context.Context, msg *nft.MsgRevokeApprovalForAll) (*nft.MsgRevokeApprovalForAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveOperator(ctx, msg.ClassId, owner, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprovalForAll{
+		ClassId:  msg.ClassId,
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		Approved: false,
+	})
+	return &nft.MsgRevokeApprovalForAllResponse{}, nil
+}
+
+// authorizeApproval checks that sender is allowed to manage the approval of the
+// specified nft, that is it is its owner or an operator of its owner, and returns
+// the owner.
+func (k Keeper) authorizeApproval(ctx sdk.Context, classID, nftID string, sender sdk.AccAddress) (sdk.AccAddress, error) {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() || !(owner.Equals(sender) || k.IsOperator(ctx, classID, owner, sender)) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor an operator of its owner", sender, nftID)
+	}
+	return owner, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) TestApprove() {
+	s.saveClassAndMint(s.addrs[0])
+	owner, spender, operator := s.addrs[0], s.addrs[1], s.addrs[2]
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// only the owner or an operator can approve
+	_, err := s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		ClassId: testClassID,
+		Id:      testID,
+		Sender:  spender.String(),
+		Spender: operator.String(),
+	})
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		ClassId: testClassID,
+		Id:      testID,
+		Sender:  owner.String(),
+		Spender: spender.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(spender, s.app.NFTKeeper.GetApproval(s.ctx, testClassID, testID))
+
+	res, err := s.queryClient.Approved(goCtx, &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(spender.String(), res.Spender)
+
+	// the approved account can send the nft, which clears the approval
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   spender.String(),
+		Receiver: operator.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(operator, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().True(s.app.NFTKeeper.GetApproval(s.ctx, testClassID, testID).Empty())
+
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   spender.String(),
+		Receiver: spender.String(),
+	})
+	s.Require().Error(err)
+
+	// revoke
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		ClassId: testClassID,
+		Id:      testID,
+		Sender:  operator.String(),
+		Spender: spender.String(),
+	})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.Revoke(goCtx, &nft.MsgRevoke{ClassId: testClassID, Id: testID, Sender: spender.String()})
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.Revoke(goCtx, &nft.MsgRevoke{ClassId: testClassID, Id: testID, Sender: operator.String()})
+	s.Require().NoError(err)
+	s.Require().True(s.app.NFTKeeper.GetApproval(s.ctx, testClassID, testID).Empty())
+
+	_, err = s.app.NFTKeeper.Revoke(goCtx, &nft.MsgRevoke{ClassId: testClassID, Id: testID, Sender: operator.String()})
+	s.Require().ErrorIs(err, nft.ErrNotApproved)
+}
+
+func (s *TestSuite) TestSetApprovalForAll() {
+	s.saveClassAndMint(s.addrs[0])
+	owner, operator, receiver := s.addrs[0], s.addrs[1], s.addrs[2]
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.app.NFTKeeper.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		ClassId:  "unknown",
+		Owner:    owner.String(),
+		Operator: operator.String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	_, err = s.app.NFTKeeper.SetApprovalForAll(goCtx, &nft.MsgSetApprovalForAll{
+		ClassId:  testClassID,
+		Owner:    owner.String(),
+		Operator: operator.String(),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.IsApprovedForAll(goCtx, &nft.QueryIsApprovedForAllRequest{
+		ClassId:  testClassID,
+		Owner:    owner.String(),
+		Operator: operator.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Approved)
+
+	operators, err := s.queryClient.Operators(goCtx, &nft.QueryOperatorsRequest{ClassId: testClassID, Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{operator.String()}, operators.Operators)
+
+	// the operator can approve a spender, but not the owner
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		ClassId: testClassID,
+		Id:      testID,
+		Sender:  operator.String(),
+		Spender: owner.String(),
+	})
+	s.Require().Error(err)
+
+	// the operator can send the nft of the owner
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   operator.String(),
+		Receiver: receiver.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(receiver, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// revoke
+	_, err = s.app.NFTKeeper.RevokeApprovalForAll(goCtx, &nft.MsgRevokeApprovalForAll{
+		ClassId:  testClassID,
+		Owner:    owner.String(),
+		Operator: operator.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.IsOperator(s.ctx, testClassID, owner, operator))
+
+	_, err = s.app.NFTKeeper.RevokeApprovalForAll(goCtx, &nft.MsgRevokeApprovalForAll{
+		ClassId:  testClassID,
+		Owner:    owner.String(),
+		Operator: operator.String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNotOperator)
+}
+
+func (s *TestSuite) saveClassAndMint(owner sdk.AccAddress) {
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+	})
+	s.Require().NoError(err)
+
+	err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+	}, owner)
+	s.Require().NoError(err)
+}
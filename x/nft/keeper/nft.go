@@ -45,6 +45,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 	nftStore.Delete([]byte(nftID))
 
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.decrTotalSupply(ctx, classID)
 	ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
 		ClassId: classID,
@@ -69,7 +70,9 @@ func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
-// Note: When the upper module uses this method, it needs to authenticate nft
+// The account approved to send the nft, if any, is cleared.
+// Note: When the upper module uses this method, it needs to authenticate nft,
+// for instance with IsApprovedOrOwner
 func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
 	nftID string,
@@ -86,6 +89,7 @@ func (k Keeper) Transfer(ctx sdk.Context,
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+	k.deleteApproval(ctx, classID, nftID)
 	return nil
 }
 
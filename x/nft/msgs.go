@@ -7,10 +7,20 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend                 = "send"
+	TypeMsgApprove              = "approve"
+	TypeMsgRevoke               = "revoke"
+	TypeMsgSetApprovalForAll    = "set_approval_for_all"
+	TypeMsgRevokeApprovalForAll = "revoke_approval_for_all"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgApprove{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgSetApprovalForAll{}
+	_ sdk.Msg = &MsgRevokeApprovalForAll{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +49,101 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgApprove) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	spender, err := sdk.AccAddressFromBech32(m.Spender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid spender address (%s)", m.Spender)
+	}
+
+	if sender.Equals(spender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sender and spender cannot be the same")
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgRevoke) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgRevoke) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSetApprovalForAll) ValidateBasic() error {
+	return validateOperatorApproval(m.ClassId, m.Owner, m.Operator)
+}
+
+// GetSigners implements Msg
+func (m MsgSetApprovalForAll) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgRevokeApprovalForAll) ValidateBasic() error {
+	return validateOperatorApproval(m.ClassId, m.Owner, m.Operator)
+}
+
+// GetSigners implements Msg
+func (m MsgRevokeApprovalForAll) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+func validateOperatorApproval(classID, ownerStr, operatorStr string) error {
+	if err := ValidateClassID(classID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", classID)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(ownerStr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", ownerStr)
+	}
+
+	operator, err := sdk.AccAddressFromBech32(operatorStr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", operatorStr)
+	}
+
+	if owner.Equals(operator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "owner and operator cannot be the same")
+	}
+	return nil
+}
@@ -44,6 +44,8 @@ func TestDecodeStore(t *testing.T) {
 
 	nftOfClassByOwnerValue := []byte{0x01}
 
+	operatorApprovalValue := []byte{0x01}
+
 	totalSupply := 1
 	totalSupplyBz := sdk.Uint64ToBigEndian(1)
 
@@ -54,6 +56,8 @@ func TestDecodeStore(t *testing.T) {
 			{Key: []byte(keeper.NFTOfClassByOwnerKey), Value: nftOfClassByOwnerValue},
 			{Key: []byte(keeper.OwnerKey), Value: ownerAddr1},
 			{Key: []byte(keeper.ClassTotalSupply), Value: totalSupplyBz},
+			{Key: []byte(keeper.ApprovalKey), Value: ownerAddr1},
+			{Key: []byte(keeper.OperatorApprovalKey), Value: operatorApprovalValue},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -68,6 +72,8 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ApprovalKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
+		{"OperatorApprovalKey", false, fmt.Sprintf("%v\n%v", operatorApprovalValue, operatorApprovalValue)},
 		{"other", true, ""},
 	}
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -64,18 +65,33 @@ func (k Keeper) GetPubkey(ctx sdk.Context, a cryptotypes.Address) (cryptotypes.P
 }
 
 // Slash attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes.
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
-	coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+// module to make the necessary validator changes. It returns the amount of
+// tokens slashed from the validator.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) math.Int {
+	slashed, _ := k.SlashWithBounty(ctx, consAddr, fraction, power, distributionHeight, nil, sdk.ZeroDec())
+	return slashed
+}
+
+// SlashWithBounty attempts to slash a validator like Slash, paying the
+// bountyFraction of the tokens slashed from the validator to bountyRecipient
+// instead of burning them. It returns the amount of tokens slashed from the
+// validator and the bounty paid out of it.
+func (k Keeper) SlashWithBounty(
+	ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64,
+	bountyRecipient sdk.AccAddress, bountyFraction sdk.Dec,
+) (slashed math.Int, bounty math.Int) {
+	slashed, bounty = k.sk.SlashWithBounty(ctx, consAddr, distributionHeight, power, fraction, bountyRecipient, bountyFraction)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
-			sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedCoins, slashed.Sub(bounty).String()),
 		),
 	)
+
+	return slashed, bounty
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
@@ -44,6 +44,8 @@ type StakingKeeper interface {
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) math.Int
+	// slash the validator like Slash, paying a fraction of the tokens slashed from the validator to a recipient
+	SlashWithBounty(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec, sdk.AccAddress, sdk.Dec) (math.Int, math.Int)
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
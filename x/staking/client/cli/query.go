@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -109,20 +111,12 @@ $ %s query staking validators
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			result, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
-				// Leaving status empty on purpose to query all validators.
-				Pagination: pageReq,
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				return queryClient.Validators(ctx, &types.QueryValidatorsRequest{
+					// Leaving status empty on purpose to query all validators.
+					Pagination: pageReq,
+				})
 			})
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(result)
 		},
 	}
 
@@ -161,22 +155,14 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryValidatorUnbondingDelegationsRequest{
+					ValidatorAddr: valAddr.String(),
+					Pagination:    pageReq,
+				}
 
-			params := &types.QueryValidatorUnbondingDelegationsRequest{
-				ValidatorAddr: valAddr.String(),
-				Pagination:    pageReq,
-			}
-
-			res, err := queryClient.ValidatorUnbondingDelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+				return queryClient.ValidatorUnbondingDelegations(ctx, params)
+			})
 		},
 	}
 
@@ -216,22 +202,14 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			params := &types.QueryRedelegationsRequest{
-				SrcValidatorAddr: valSrcAddr.String(),
-				Pagination:       pageReq,
-			}
-
-			res, err := queryClient.Redelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryRedelegationsRequest{
+					SrcValidatorAddr: valSrcAddr.String(),
+					Pagination:       pageReq,
+				}
 
-			return clientCtx.PrintProto(res)
+				return queryClient.Redelegations(ctx, params)
+			})
 		},
 	}
 
@@ -325,22 +303,14 @@ $ %s query staking delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			params := &types.QueryDelegatorDelegationsRequest{
-				DelegatorAddr: delAddr.String(),
-				Pagination:    pageReq,
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryDelegatorDelegationsRequest{
+					DelegatorAddr: delAddr.String(),
+					Pagination:    pageReq,
+				}
 
-			res, err := queryClient.DelegatorDelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+				return queryClient.DelegatorDelegations(ctx, params)
+			})
 		},
 	}
 
@@ -380,22 +350,14 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryValidatorDelegationsRequest{
+					ValidatorAddr: valAddr.String(),
+					Pagination:    pageReq,
+				}
 
-			params := &types.QueryValidatorDelegationsRequest{
-				ValidatorAddr: valAddr.String(),
-				Pagination:    pageReq,
-			}
-
-			res, err := queryClient.ValidatorDelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+				return queryClient.ValidatorDelegations(ctx, params)
+			})
 		},
 	}
 
@@ -490,22 +452,14 @@ $ %s query staking unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			params := &types.QueryDelegatorUnbondingDelegationsRequest{
-				DelegatorAddr: delegatorAddr.String(),
-				Pagination:    pageReq,
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryDelegatorUnbondingDelegationsRequest{
+					DelegatorAddr: delegatorAddr.String(),
+					Pagination:    pageReq,
+				}
 
-			res, err := queryClient.DelegatorUnbondingDelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
-
-			return clientCtx.PrintProto(res)
+				return queryClient.DelegatorUnbondingDelegations(ctx, params)
+			})
 		},
 	}
 
@@ -606,22 +560,14 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(cmd.Flags())
-			if err != nil {
-				return err
-			}
-
-			params := &types.QueryRedelegationsRequest{
-				DelegatorAddr: delAddr.String(),
-				Pagination:    pageReq,
-			}
-
-			res, err := queryClient.Redelegations(cmd.Context(), params)
-			if err != nil {
-				return err
-			}
+			return client.PrintPaginatedProto(cmd, clientCtx, func(ctx context.Context, pageReq *query.PageRequest) (client.PaginatedResponse, error) {
+				params := &types.QueryRedelegationsRequest{
+					DelegatorAddr: delAddr.String(),
+					Pagination:    pageReq,
+				}
 
-			return clientCtx.PrintProto(res)
+				return queryClient.Redelegations(ctx, params)
+			})
 		},
 	}
 
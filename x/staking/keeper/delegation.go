@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -763,6 +765,174 @@ func (k Keeper) Unbond(
 	return amount, nil
 }
 
+// TransferDelegation moves delegation shares of a validator from a delegator
+// to a recipient, without unbonding the tokens backing them. The shares backed
+// by vesting coins that are still vesting cannot be transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	if delAddr.Equals(recipientAddr) {
+		return types.ErrTransferDelegationToSelf
+	}
+
+	// like bank sends, the addresses not allowed to receive funds and the
+	// module accounts can't receive delegations
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return sdkerrors.Wrapf(types.ErrTransferToBlockedAddress, "%s is not allowed to receive funds", recipientAddr)
+	}
+	if _, ok := k.authKeeper.GetAccount(ctx, recipientAddr).(authtypes.ModuleAccountI); ok {
+		return sdkerrors.Wrapf(types.ErrTransferToBlockedAddress, "%s is a module account", recipientAddr)
+	}
+
+	// the redelegated shares must stay with the delegator so that they can be
+	// slashed for an infraction of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.ErrTransferRedelegatedDelegation
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	// ensure that we have enough shares to transfer
+	if delegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if validator.InvalidExRate() {
+		return types.ErrDelegatorShareExRateInvalid
+	}
+
+	amount := validator.TokensFromShares(shares).TruncateInt()
+	if err := k.transferVestingDelegation(ctx, delAddr, recipientAddr, amount); err != nil {
+		return err
+	}
+	if err := k.checkTransferUnlocked(ctx, delegation, validator, shares, amount); err != nil {
+		return err
+	}
+
+	// call the before-delegation-modified hook of the delegator
+	if err := k.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	// If the delegation is the operator of the validator and transferring will
+	// decrease the validator's self-delegation below their minimum, we jail the
+	// validator.
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	var err error
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		err = k.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// get or create the delegation of the recipient and call the appropriate
+	// hook
+	recipientDelegation, found := k.GetDelegation(ctx, recipientAddr, valAddr)
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, recipientAddr, valAddr)
+	} else {
+		recipientDelegation = types.NewDelegation(recipientAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, recipientAddr, valAddr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	recipientDelegation.Shares = recipientDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, recipientDelegation)
+
+	return k.AfterDelegationModified(ctx, recipientAddr, valAddr)
+}
+
+// transferVestingDelegation performs the vesting accounting of a delegation
+// transfer. The delegator may only transfer the delegated coins which are not
+// vesting anymore, and the recipient, created if needed, cannot be a vesting
+// account as its delegated coins would not be tracked.
+func (k Keeper) transferVestingDelegation(ctx sdk.Context, delAddr, recipientAddr sdk.AccAddress, amount math.Int) error {
+	recipient := k.authKeeper.GetAccount(ctx, recipientAddr)
+	if recipient == nil {
+		recipient = k.authKeeper.NewAccountWithAddress(ctx, recipientAddr)
+		k.authKeeper.SetAccount(ctx, recipient)
+	}
+
+	if _, ok := recipient.(vestexported.VestingAccount); ok {
+		return types.ErrTransferToVestingAccount
+	}
+
+	delegator, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	if !ok || !amount.IsPositive() {
+		return nil
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	stillVesting := delegator.GetVestingCoins(ctx.BlockTime()).AmountOf(bondDenom)
+	delegatedVesting := delegator.GetDelegatedVesting().AmountOf(bondDenom)
+	transferable := delegator.GetDelegatedFree().AmountOf(bondDenom)
+	if delegatedVesting.GT(stillVesting) {
+		transferable = transferable.Add(delegatedVesting.Sub(stillVesting))
+	}
+
+	if amount.GT(transferable) {
+		return sdkerrors.Wrapf(types.ErrTransferVestingDelegation, "%s%s is transferable", transferable, bondDenom)
+	}
+
+	delegator.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+	k.authKeeper.SetAccount(ctx, delegator)
+
+	return nil
+}
+
+// checkTransferUnlocked checks that the tokens of a delegation transfer are not
+// locked by the bank keeper, e.g. by locks counting the delegated tokens of the
+// delegator like the conviction locks of x/gov. The transfer is checked as an
+// undelegation of the tokens followed by a send, on a branch of the state which
+// is discarded.
+func (k Keeper) checkTransferUnlocked(ctx sdk.Context, delegation types.Delegation, validator types.Validator, shares sdk.Dec, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	delegation.Shares = delegation.Shares.Sub(shares)
+	k.SetDelegation(cacheCtx, delegation)
+
+	pool := types.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = types.BondedPoolName
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	delAddr := delegation.GetDelegatorAddr()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, pool, delAddr, sdk.NewCoins(sdk.NewCoin(bondDenom, amount))); err != nil {
+		return err
+	}
+
+	if spendable := k.bankKeeper.SpendableCoins(cacheCtx, delAddr).AmountOf(bondDenom); spendable.LT(amount) {
+		return sdkerrors.Wrapf(types.ErrTransferLockedDelegation, "%s%s is transferable", spendable, bondDenom)
+	}
+
+	return nil
+}
+
 // getBeginInfo returns the completion time and height of a redelegation, along
 // with a boolean signaling if the redelegation is complete based on the source
 // validator.
@@ -1000,3 +1000,64 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegationInvariants(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	require.True(t, validator.IsBonded())
+	valAddr := validator.GetOperator()
+
+	for _, addr := range addrDels[:2] {
+		_, err := app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(1000), types.Unbonded, validator, true)
+		require.NoError(t, err)
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	}
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	bondedBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+
+	newAddr := sdk.AccAddress("new_recipient_______")
+	transfers := []struct {
+		from, to sdk.AccAddress
+		fraction sdk.Dec
+	}{
+		// to a delegator of the validator
+		{addrDels[0], addrDels[1], sdk.NewDecWithPrec(3, 1)},
+		// to an account without delegation
+		{addrDels[0], addrDels[2], sdk.NewDecWithPrec(2, 1)},
+		// to a new account
+		{addrDels[1], newAddr, sdk.OneDec()},
+		// the whole delegation
+		{addrDels[0], newAddr, sdk.OneDec()},
+	}
+
+	for _, transfer := range transfers {
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, transfer.from, valAddr)
+		require.True(t, found)
+		shares := delegation.Shares.Mul(transfer.fraction)
+		require.NoError(t, app.StakingKeeper.TransferDelegation(ctx, transfer.from, transfer.to, valAddr, shares))
+
+		// the delegations add up to the delegator shares of the validator
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+		totalShares := sdk.ZeroDec()
+		for _, delegation := range app.StakingKeeper.GetValidatorDelegations(ctx, valAddr) {
+			totalShares = totalShares.Add(delegation.Shares)
+		}
+		require.Equal(t, validator.DelegatorShares, totalShares)
+
+		// the tokens stay in the bonded pool
+		require.Equal(t, bondedBalances, app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress()))
+
+		msg, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, newAddr, valAddr)
+	require.True(t, found)
+}
@@ -6,7 +6,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -144,3 +149,210 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferDelegation(t *testing.T) {
+	// setup the app
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// accounts
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(10000))
+	delegatorAddr, recipientAddr := delAddrs[0], delAddrs[1]
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Equal(t, len(validators), 1)
+	validator := validators[0]
+
+	shares, err := app.StakingKeeper.Delegate(ctx, delegatorAddr, sdk.NewInt(1000), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the recipient account does not exist yet
+	newRecipientAddr := sdk.AccAddress("new_recipient_______")
+
+	// a module account which is not blocked by the bank module
+	moduleAcc := app.AccountKeeper.NewAccount(ctx, authtypes.NewEmptyModuleAccount("recipient"))
+	app.AccountKeeper.SetAccount(ctx, moduleAcc)
+	require.False(t, app.BankKeeper.BlockedAddr(moduleAcc.GetAddress()))
+
+	// a vesting account delegating vesting coins
+	vestingAddr := delAddrs[2]
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10000)),
+		ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix(),
+	)
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+	validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	_, err = app.StakingKeeper.Delegate(ctx, vestingAddr, sdk.NewInt(10000), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name      string
+		Enabled   bool
+		ExceptErr error
+		req       types.MsgTransferDelegation
+	}{
+		{
+			Name:      "transfers disabled",
+			ExceptErr: types.ErrTransferDelegationDisabled,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 100),
+			},
+		},
+		{
+			Name:      "invalid coin",
+			Enabled:   true,
+			ExceptErr: sdkerrors.ErrInvalidRequest,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin("dump_coin", 100),
+			},
+		},
+		{
+			Name:      "amount greater than the delegation",
+			Enabled:   true,
+			ExceptErr: sdkerrors.ErrInvalidRequest,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 1001),
+			},
+		},
+		{
+			Name:      "vesting coins",
+			Enabled:   true,
+			ExceptErr: types.ErrTransferVestingDelegation,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: vestingAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 100),
+			},
+		},
+		{
+			Name:      "vesting recipient",
+			Enabled:   true,
+			ExceptErr: types.ErrTransferToVestingAccount,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: vestingAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 100),
+			},
+		},
+		{
+			Name:      "blocked recipient",
+			Enabled:   true,
+			ExceptErr: types.ErrTransferToBlockedAddress,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 100),
+			},
+		},
+		{
+			Name:      "module account recipient",
+			Enabled:   true,
+			ExceptErr: types.ErrTransferToBlockedAddress,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: moduleAcc.GetAddress().String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 100),
+			},
+		},
+		{
+			Name:    "success",
+			Enabled: true,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 400),
+			},
+		},
+		{
+			Name:    "success to a new account",
+			Enabled: true,
+			req: types.MsgTransferDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				RecipientAddress: newRecipientAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(bondDenom, 600),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			params := app.StakingKeeper.GetParams(ctx)
+			params.TransferDelegationEnabled = testCase.Enabled
+			app.StakingKeeper.SetParams(ctx, params)
+
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := msgServer.TransferDelegation(cacheCtx, &testCase.req)
+			if testCase.ExceptErr != nil {
+				require.ErrorIs(t, err, testCase.ExceptErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, broken := keeper.AllInvariants(app.StakingKeeper)(cacheCtx)
+			require.False(t, broken)
+		})
+	}
+
+	// the whole delegation is transferred
+	params := app.StakingKeeper.GetParams(ctx)
+	params.TransferDelegationEnabled = true
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(delegatorAddr, newRecipientAddr, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validator.GetOperator())
+	require.False(t, found)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, newRecipientAddr, validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, shares, delegation.Shares)
+	require.NotNil(t, app.AccountKeeper.GetAccount(ctx, newRecipientAddr))
+
+	// the vested coins can be transferred once vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(vestingAddr, recipientAddr, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 5001)))
+	require.ErrorIs(t, err, types.ErrTransferVestingDelegation)
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(vestingAddr, recipientAddr, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 5000)))
+	require.NoError(t, err)
+
+	vestingAcc = app.AccountKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.ContinuousVestingAccount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000)), vestingAcc.DelegatedVesting)
+
+	// the coins locked by a conviction lock can't be transferred, the delegated
+	// coins counting towards the lock
+	lockedAddr := delAddrs[3]
+	validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	_, err = app.StakingKeeper.Delegate(ctx, lockedAddr, sdk.NewInt(1000), types.Unbonded, validator, true)
+	require.NoError(t, err)
+	app.GovKeeper.SetConvictionLock(ctx, govv1.ConvictionLock{
+		Voter:      lockedAddr.String(),
+		ProposalId: 1,
+		Amount:     sdk.NewInt64Coin(bondDenom, 9500),
+		EndTime:    ctx.BlockTime().Add(time.Hour),
+	})
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(lockedAddr, recipientAddr, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 501)))
+	require.ErrorIs(t, err, types.ErrTransferLockedDelegation)
+	_, err = msgServer.TransferDelegation(ctx, types.NewMsgTransferDelegation(lockedAddr, recipientAddr, validator.GetOperator(), sdk.NewInt64Coin(bondDenom, 500)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 9000)), app.BankKeeper.LockedCoins(ctx, lockedAddr))
+
+	_, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
@@ -55,6 +55,13 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// TransferDelegationEnabled - Whether the delegations can be transferred to
+// other accounts
+func (k Keeper) TransferDelegationEnabled(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyTransferDelegationEnabled, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -64,6 +71,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MinCommissionRate(ctx),
+		k.TransferDelegationEnabled(ctx),
 	)
 }
 
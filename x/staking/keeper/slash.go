@@ -24,6 +24,22 @@ import (
 //    Infraction was committed at the current height or at a past height,
 //    not at a height in the future
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) math.Int {
+	slashed, _ := k.SlashWithBounty(ctx, consAddr, infractionHeight, power, slashFactor, nil, sdk.ZeroDec())
+	return slashed
+}
+
+// SlashWithBounty slashes a validator like Slash, but pays the bountyFraction of
+// the tokens slashed from the validator to bountyRecipient instead of burning
+// them. The tokens slashed from unbonding delegations and redelegations are
+// always burned. It returns the amount of tokens slashed from the validator and
+// the bounty paid out of it.
+//
+// CONTRACT:
+//    bountyFraction is between zero and one
+func (k Keeper) SlashWithBounty(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec,
+	bountyRecipient sdk.AccAddress, bountyFraction sdk.Dec,
+) (slashed math.Int, bounty math.Int) {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -47,7 +63,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			"WARNING: ignored attempt to slash a nonexistent validator; we recommend you investigate immediately",
 			"validator", consAddr.String(),
 		)
-		return sdk.NewInt(0)
+		return sdk.NewInt(0), sdk.NewInt(0)
 	}
 
 	// should not be slashing an unbonded validator
@@ -120,29 +136,64 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
-	// Burn the slashed tokens from the pool account and decrease the total supply.
+	// Pay the bounty and burn the rest of the slashed tokens from the pool
+	// account, decreasing the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	var poolName string
 	switch validator.GetStatus() {
 	case types.Bonded:
-		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+		poolName = types.BondedPoolName
+	case types.Unbonding, types.Unbonded:
+		poolName = types.NotBondedPoolName
+	default:
+		panic("invalid validator status")
+	}
+
+	bounty = sdk.ZeroInt()
+	if bountyRecipient != nil && bountyFraction.IsPositive() {
+		bounty = sdk.NewDecFromInt(tokensToBurn).Mul(bountyFraction).TruncateInt()
+		if err := k.payBounty(ctx, poolName, bountyRecipient, bounty); err != nil {
+			logger.Error(
+				"failed to pay slashing bounty; burning it instead",
+				"validator", validator.GetOperator().String(),
+				"recipient", bountyRecipient.String(),
+				"err", err,
+			)
+			bounty = sdk.ZeroInt()
+		}
+	}
+
+	burned := tokensToBurn.Sub(bounty)
+	if poolName == types.BondedPoolName {
+		if err := k.burnBondedTokens(ctx, burned); err != nil {
 			panic(err)
 		}
-	case types.Unbonding, types.Unbonded:
-		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+	} else {
+		if err := k.burnNotBondedTokens(ctx, burned); err != nil {
 			panic(err)
 		}
-	default:
-		panic("invalid validator status")
 	}
 
 	logger.Info(
 		"validator slashed by slash factor",
 		"validator", validator.GetOperator().String(),
 		"slash_factor", slashFactor.String(),
-		"burned", tokensToBurn,
+		"burned", burned,
+		"bounty", bounty,
 	)
-	return tokensToBurn
+	return tokensToBurn, bounty
+}
+
+// payBounty sends the bounty from the given pool to the recipient.
+func (k Keeper) payBounty(ctx sdk.Context, poolName string, recipient sdk.AccAddress, amt math.Int) error {
+	if !amt.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, poolName, recipient, coins)
 }
 
 // jail a validator
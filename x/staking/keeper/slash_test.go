@@ -615,3 +615,28 @@ func TestSlashAmount(t *testing.T) {
 	noBurned := app.StakingKeeper.Slash(ctx, sdk.ConsAddress(addrVals[0]), ctx.BlockHeight(), 10, fraction)
 	require.True(t, sdk.NewInt(0).Equal(noBurned))
 }
+
+// tests SlashWithBounty paying a fraction of the slashed tokens to a recipient
+func TestSlashWithBounty(t *testing.T) {
+	app, ctx, addrDels, _ := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	recipient := addrDels[0]
+
+	oldBalance := app.BankKeeper.GetBalance(ctx, recipient, bondDenom)
+	oldSupply := app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	slashed, bounty := app.StakingKeeper.SlashWithBounty(ctx, consAddr, ctx.BlockHeight(), 10, fraction, recipient, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), slashed)
+	require.Equal(t, slashed.QuoRaw(10), bounty)
+
+	// the bounty is paid to the recipient and the rest is burned
+	require.Equal(t, oldBalance.AddAmount(bounty), app.BankKeeper.GetBalance(ctx, recipient, bondDenom))
+	require.Equal(t, oldSupply.SubAmount(slashed.Sub(bounty)), app.BankKeeper.GetSupply(ctx, bondDenom))
+
+	// no bounty is paid without recipient
+	slashed, bounty = app.StakingKeeper.SlashWithBounty(ctx, consAddr, ctx.BlockHeight(), 10, fraction, nil, sdk.NewDecWithPrec(1, 1))
+	require.True(t, slashed.IsPositive())
+	require.True(t, bounty.IsZero())
+}
@@ -46,6 +46,7 @@ func TestMigrateJSON(t *testing.T) {
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
+		"transfer_delegation_enabled": false,
 		"unbonding_time": "1814400s"
 	},
 	"redelegations": [],
@@ -0,0 +1,31 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, types.ModuleName)
+
+	require.False(t, paramstore.Has(ctx, types.KeyTransferDelegationEnabled))
+
+	require.NoError(t, v047.MigrateStore(ctx, paramstore))
+
+	var enabled bool
+	paramstore.Get(ctx, types.KeyTransferDelegationEnabled, &enabled)
+	require.True(t, paramstore.Has(ctx, types.KeyTransferDelegationEnabled))
+	require.Equal(t, types.DefaultTransferDelegationEnabled, enabled)
+}
@@ -19,6 +19,8 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+
+	transferDelegationEnabled = "transfer_delegation_enabled"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +38,11 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genTransferDelegationEnabled returns randomized TransferDelegationEnabled
+func genTransferDelegationEnabled(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +51,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdk.Dec
+		transferEnabled   bool
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +69,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, transferDelegationEnabled, &transferEnabled, simState.Rand,
+		func(r *rand.Rand) { transferEnabled = genTransferDelegationEnabled(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, transferEnabled)
 
 	// validators & delegations
 	var (
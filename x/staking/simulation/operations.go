@@ -9,6 +9,7 @@ import (
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -23,6 +24,7 @@ const (
 	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
 	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTransferDelegation        = "op_weight_msg_transfer_delegation"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -37,6 +39,7 @@ func WeightedOperations(
 		weightMsgUndelegate                int
 		weightMsgBeginRedelegate           int
 		weightMsgCancelUnbondingDelegation int
+		weightMsgTransferDelegation        int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -75,6 +78,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgTransferDelegation, &weightMsgTransferDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferDelegation = simappparams.DefaultWeightMsgTransferDelegation
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -100,6 +109,10 @@ func WeightedOperations(
 			weightMsgCancelUnbondingDelegation,
 			SimulateMsgCancelUnbondingDelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferDelegation,
+			SimulateMsgTransferDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -552,3 +565,104 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k *
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgTransferDelegation generates a MsgTransferDelegation with random values
+func SimulateMsgTransferDelegation(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.TransferDelegationEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "delegation transfers are disabled"), nil, nil
+		}
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "validator is not ok"), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "validator has an invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "receiving redelegation is not allowed"), nil, nil
+		}
+
+		// the transferable amount of vesting accounts is only checked on delivery
+		if _, ok := ak.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "delegator is a vesting account"), nil, nil
+		}
+
+		if len(accs) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "not enough accounts"), nil, nil
+		}
+
+		// get random recipient, other than the delegator
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		for recipient.Address.Equals(delAddr) {
+			recipient, _ = simtypes.RandomAcc(r, accs)
+		}
+
+		if _, ok := ak.GetAccount(ctx, recipient.Address).(vestexported.VestingAccount); ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "recipient is a vesting account"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "total bond is negative"), nil, nil
+		}
+
+		transferAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferDelegation, "invalid transfer amount"), nil, err
+		}
+
+		msg := types.NewMsgTransferDelegation(
+			delAddr, recipient.Address, valAddr, sdk.NewCoin(k.BondDenom(ctx), transferAmt),
+		)
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		spendable := bk.SpendableCoins(ctx, delAddr)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -30,5 +30,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", getHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTransferDelegationEnabled),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", genTransferDelegationEnabled(r))
+			},
+		),
 	}
 }
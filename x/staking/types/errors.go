@@ -50,4 +50,11 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrTransferDelegationDisabled      = sdkerrors.Register(ModuleName, 41, "delegation transfers are disabled")
+	ErrTransferDelegationToSelf        = sdkerrors.Register(ModuleName, 42, "cannot transfer a delegation to the same delegator")
+	ErrTransferVestingDelegation       = sdkerrors.Register(ModuleName, 43, "cannot transfer a delegation of vesting coins")
+	ErrTransferToVestingAccount        = sdkerrors.Register(ModuleName, 44, "cannot transfer a delegation to a vesting account")
+	ErrTransferRedelegatedDelegation   = sdkerrors.Register(ModuleName, 45, "cannot transfer a delegation receiving a redelegation")
+	ErrTransferLockedDelegation        = sdkerrors.Register(ModuleName, 46, "cannot transfer a delegation of locked coins")
+	ErrTransferToBlockedAddress        = sdkerrors.Register(ModuleName, 47, "cannot transfer a delegation to a blocked address or a module account")
 )
@@ -848,6 +848,11 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// transfer_delegation_enabled defines whether delegators can transfer their
+	// delegations to other accounts with MsgTransferDelegation.
+	//
+	// Since: cosmos-sdk 0.47
+	TransferDelegationEnabled bool `protobuf:"varint,7,opt,name=transfer_delegation_enabled,json=transferDelegationEnabled,proto3" json:"transfer_delegation_enabled,omitempty" yaml:"transfer_delegation_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -917,6 +922,13 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetTransferDelegationEnabled() bool {
+	if m != nil {
+		return m.TransferDelegationEnabled
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1142,112 +1154,114 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 1705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x63, 0x57,
+	0x15, 0xf6, 0x73, 0x5c, 0xc7, 0x39, 0x4e, 0xe2, 0xe4, 0x4e, 0x5a, 0x3c, 0x06, 0x62, 0x63, 0xca,
+	0x74, 0x8a, 0x3a, 0x0e, 0x13, 0xa4, 0x4a, 0x44, 0x48, 0x68, 0x1c, 0xbb, 0x4c, 0x98, 0x76, 0x70,
+	0x9f, 0x33, 0x41, 0xfc, 0x88, 0xa7, 0xeb, 0xf7, 0x6e, 0x9c, 0x4b, 0xfc, 0xee, 0xb3, 0xde, 0xbd,
+	0x1e, 0x62, 0x09, 0x24, 0x24, 0x36, 0x65, 0x56, 0x5d, 0x76, 0x33, 0xd2, 0x48, 0x65, 0xd9, 0x65,
+	0xc5, 0x86, 0x05, 0xdb, 0xd2, 0xd5, 0xa8, 0x2b, 0x5a, 0x50, 0x40, 0x33, 0x1b, 0xc4, 0x0a, 0x75,
+	0x0f, 0x42, 0xf7, 0xe7, 0xfd, 0xc4, 0x8e, 0xd3, 0x04, 0xa5, 0x52, 0xa5, 0xd9, 0x24, 0xef, 0xde,
+	0x73, 0xce, 0xf7, 0xce, 0xf9, 0xee, 0x39, 0xc7, 0xe7, 0x3e, 0x78, 0xd1, 0x0d, 0xb8, 0x1f, 0xf0,
+	0x0d, 0x2e, 0xf0, 0x21, 0x65, 0xfd, 0x8d, 0xfb, 0x37, 0x7b, 0x44, 0xe0, 0x9b, 0xd1, 0xba, 0x31,
+	0x0c, 0x03, 0x11, 0xa0, 0x17, 0xb4, 0x56, 0x23, 0xda, 0x35, 0x5a, 0x95, 0xb5, 0x7e, 0xd0, 0x0f,
+	0x94, 0xca, 0x86, 0x7c, 0xd2, 0xda, 0x95, 0xab, 0xfd, 0x20, 0xe8, 0x0f, 0xc8, 0x86, 0x5a, 0xf5,
+	0x46, 0xfb, 0x1b, 0x98, 0x8d, 0x8d, 0x68, 0x7d, 0x52, 0xe4, 0x8d, 0x42, 0x2c, 0x68, 0xc0, 0x8c,
+	0xbc, 0x3a, 0x29, 0x17, 0xd4, 0x27, 0x5c, 0x60, 0x7f, 0x18, 0x61, 0x6b, 0x4f, 0x1c, 0xfd, 0x52,
+	0xe3, 0x96, 0xc1, 0x36, 0xa1, 0xf4, 0x30, 0x27, 0x71, 0x1c, 0x6e, 0x40, 0x23, 0xec, 0xaf, 0x08,
+	0xc2, 0x3c, 0x12, 0xfa, 0x94, 0x89, 0x0d, 0x31, 0x1e, 0x12, 0xae, 0xff, 0x6a, 0x69, 0xfd, 0x77,
+	0x16, 0x2c, 0xdf, 0xa6, 0x5c, 0x04, 0x21, 0x75, 0xf1, 0x60, 0x87, 0xed, 0x07, 0xe8, 0x55, 0xc8,
+	0x1f, 0x10, 0xec, 0x91, 0xb0, 0x6c, 0xd5, 0xac, 0xeb, 0xc5, 0xcd, 0x72, 0x23, 0x41, 0x68, 0x68,
+	0xdb, 0xdb, 0x4a, 0xde, 0xcc, 0x7d, 0x70, 0x5c, 0xcd, 0xd8, 0x46, 0x1b, 0x7d, 0x0f, 0xf2, 0xf7,
+	0xf1, 0x80, 0x13, 0x51, 0xce, 0xd6, 0xe6, 0xae, 0x17, 0x37, 0xbf, 0xd6, 0x38, 0x9d, 0xbe, 0xc6,
+	0x1e, 0x1e, 0x50, 0x0f, 0x8b, 0x20, 0x06, 0xd0, 0x66, 0xf5, 0xf7, 0xb2, 0x50, 0xda, 0x0e, 0x7c,
+	0x9f, 0x72, 0x4e, 0x03, 0x66, 0x63, 0x41, 0x38, 0xea, 0x40, 0x2e, 0xc4, 0x82, 0x28, 0x57, 0x16,
+	0x9a, 0xdf, 0x95, 0xfa, 0x9f, 0x1c, 0x57, 0xaf, 0xf5, 0xa9, 0x38, 0x18, 0xf5, 0x1a, 0x6e, 0xe0,
+	0x1b, 0x32, 0xcc, 0xbf, 0x1b, 0xdc, 0x3b, 0x34, 0xf1, 0xb5, 0x88, 0xfb, 0xd1, 0xfb, 0x37, 0xc0,
+	0xf8, 0xd0, 0x22, 0xae, 0xad, 0x90, 0xd0, 0x8f, 0xa0, 0xe0, 0xe3, 0x23, 0x47, 0xa1, 0x66, 0x2f,
+	0x01, 0x75, 0xde, 0xc7, 0x47, 0xd2, 0x57, 0xe4, 0x41, 0x49, 0x02, 0xbb, 0x07, 0x98, 0xf5, 0x89,
+	0xc6, 0x9f, 0xbb, 0x04, 0xfc, 0x25, 0x1f, 0x1f, 0x6d, 0x2b, 0x4c, 0xf9, 0x96, 0xad, 0xc2, 0x3b,
+	0x8f, 0xaa, 0x99, 0x7f, 0x3e, 0xaa, 0x5a, 0xf5, 0x3f, 0x5a, 0x00, 0x09, 0x5d, 0xe8, 0x67, 0xb0,
+	0xe2, 0xc6, 0x2b, 0xf5, 0x7a, 0x6e, 0x0e, 0xf0, 0xa5, 0x59, 0x07, 0x31, 0x41, 0x76, 0xb3, 0x20,
+	0x1d, 0x7d, 0x7c, 0x5c, 0xb5, 0xec, 0x92, 0x3b, 0x71, 0x0e, 0x6d, 0x28, 0x8e, 0x86, 0x1e, 0x16,
+	0xc4, 0x91, 0xa9, 0xa9, 0x88, 0x2b, 0x6e, 0x56, 0x1a, 0x3a, 0x6f, 0x1b, 0x51, 0xde, 0x36, 0x76,
+	0xa3, 0xbc, 0xd5, 0x58, 0x6f, 0xff, 0xbd, 0x6a, 0xd9, 0xa0, 0x0d, 0xa5, 0x28, 0xe5, 0xfd, 0x7b,
+	0x16, 0x14, 0x5b, 0x84, 0xbb, 0x21, 0x1d, 0xca, 0x42, 0x40, 0x65, 0x98, 0xf7, 0x03, 0x46, 0x0f,
+	0x4d, 0xda, 0x2d, 0xd8, 0xd1, 0x12, 0x55, 0xa0, 0x40, 0x3d, 0xc2, 0x04, 0x15, 0x63, 0x7d, 0x60,
+	0x76, 0xbc, 0x96, 0x56, 0xbf, 0x24, 0x3d, 0x4e, 0x23, 0xae, 0xed, 0x68, 0x89, 0x5e, 0x86, 0x15,
+	0x4e, 0xdc, 0x51, 0x48, 0xc5, 0xd8, 0x71, 0x03, 0x26, 0xb0, 0x2b, 0xca, 0x39, 0xa5, 0x52, 0x8a,
+	0xf6, 0xb7, 0xf5, 0xb6, 0x04, 0xf1, 0x88, 0xc0, 0x74, 0xc0, 0xcb, 0xcf, 0x69, 0x10, 0xb3, 0x4c,
+	0xb9, 0xfb, 0xe7, 0x3c, 0x2c, 0xc4, 0x79, 0x8b, 0xb6, 0x61, 0x25, 0x18, 0x92, 0x50, 0x3e, 0x3b,
+	0xd8, 0xf3, 0x42, 0xc2, 0xb9, 0xc9, 0xd0, 0xf2, 0x47, 0xef, 0xdf, 0x58, 0x33, 0x74, 0xdf, 0xd2,
+	0x92, 0xae, 0x08, 0x29, 0xeb, 0xdb, 0xa5, 0xc8, 0xc2, 0x6c, 0xa3, 0x1f, 0xcb, 0x03, 0x63, 0x9c,
+	0x30, 0x3e, 0xe2, 0xce, 0x70, 0xd4, 0x3b, 0x24, 0x63, 0xc3, 0xeb, 0xda, 0x14, 0xaf, 0xb7, 0xd8,
+	0xb8, 0x59, 0xfe, 0x30, 0x81, 0x76, 0xc3, 0xf1, 0x50, 0x04, 0x8d, 0xce, 0xa8, 0x77, 0x87, 0x8c,
+	0xed, 0x52, 0x8c, 0xd3, 0x51, 0x30, 0xe8, 0x05, 0xc8, 0xff, 0x02, 0xd3, 0x01, 0xf1, 0x14, 0x2b,
+	0x05, 0xdb, 0xac, 0xd0, 0x16, 0xe4, 0xb9, 0xc0, 0x62, 0xc4, 0x15, 0x15, 0xcb, 0x9b, 0xf5, 0x59,
+	0x99, 0xd1, 0x0c, 0x98, 0xd7, 0x55, 0x9a, 0xb6, 0xb1, 0x40, 0xbb, 0x90, 0x17, 0xc1, 0x21, 0x61,
+	0x86, 0xa4, 0x0b, 0x65, 0xf5, 0x0e, 0x13, 0xa9, 0xac, 0xde, 0x61, 0xc2, 0x36, 0x58, 0xa8, 0x0f,
+	0x2b, 0x1e, 0x19, 0x90, 0xbe, 0xa2, 0x92, 0x1f, 0xe0, 0x90, 0xf0, 0x72, 0xfe, 0x12, 0xaa, 0xa6,
+	0x14, 0xa3, 0x76, 0x15, 0x28, 0xba, 0x03, 0x45, 0x2f, 0x49, 0xb7, 0xf2, 0xbc, 0x22, 0xfa, 0xeb,
+	0xb3, 0xe2, 0x4f, 0x65, 0xa6, 0x69, 0x52, 0x69, 0x6b, 0x99, 0x5c, 0x23, 0xd6, 0x0b, 0x98, 0x47,
+	0x59, 0xdf, 0x39, 0x20, 0xb4, 0x7f, 0x20, 0xca, 0x85, 0x9a, 0x75, 0x7d, 0xce, 0x2e, 0xc5, 0xfb,
+	0xb7, 0xd5, 0x36, 0xba, 0x03, 0xcb, 0x89, 0xaa, 0xaa, 0x9d, 0x85, 0x0b, 0xd4, 0xce, 0x52, 0x6c,
+	0x2b, 0xa5, 0xe8, 0x36, 0x40, 0x52, 0x98, 0x65, 0x50, 0x40, 0xf5, 0xcf, 0xae, 0x6e, 0x13, 0x42,
+	0xca, 0x16, 0x0d, 0xe0, 0x8a, 0x4f, 0x99, 0xc3, 0xc9, 0x60, 0xdf, 0x31, 0x54, 0x49, 0xc8, 0xe2,
+	0x25, 0x1c, 0xed, 0xaa, 0x4f, 0x59, 0x97, 0x0c, 0xf6, 0x5b, 0x31, 0xec, 0xd6, 0xe2, 0x5b, 0x8f,
+	0xaa, 0x19, 0x53, 0x4b, 0x99, 0x7a, 0x07, 0x16, 0xf7, 0xf0, 0xc0, 0x94, 0x01, 0xe1, 0xe8, 0x55,
+	0x58, 0xc0, 0xd1, 0xa2, 0x6c, 0xd5, 0xe6, 0xce, 0x2c, 0xa3, 0x44, 0x55, 0x57, 0xe7, 0x6f, 0xfe,
+	0x56, 0xb3, 0xea, 0xbf, 0xb7, 0x20, 0xdf, 0xda, 0xeb, 0x60, 0x1a, 0xa2, 0x36, 0xac, 0x26, 0x09,
+	0x75, 0xde, 0xda, 0x4c, 0x72, 0x30, 0x2a, 0xce, 0x36, 0xac, 0xde, 0x8f, 0xca, 0x3d, 0x86, 0xc9,
+	0x7e, 0x16, 0x4c, 0x6c, 0x62, 0xf6, 0x27, 0x02, 0x6f, 0xc3, 0xbc, 0xf6, 0x92, 0xa3, 0x2d, 0x78,
+	0x6e, 0x28, 0x1f, 0x54, 0xbc, 0xc5, 0xcd, 0xf5, 0x99, 0x89, 0xa8, 0xf4, 0xcd, 0x01, 0x6a, 0x93,
+	0xfa, 0x7f, 0x2c, 0x80, 0xd6, 0xde, 0xde, 0x6e, 0x48, 0x87, 0x03, 0x22, 0x2e, 0x2b, 0xe2, 0xd7,
+	0xe1, 0xf9, 0x24, 0x62, 0x1e, 0xba, 0xe7, 0x8e, 0xfa, 0x4a, 0x6c, 0xd6, 0x0d, 0xdd, 0x53, 0xd1,
+	0x3c, 0x2e, 0x62, 0xb4, 0xb9, 0x73, 0xa3, 0xb5, 0xb8, 0x38, 0x9d, 0xc6, 0x2e, 0x14, 0x93, 0xf0,
+	0x39, 0x6a, 0x41, 0x41, 0x98, 0x67, 0xc3, 0x66, 0x7d, 0x36, 0x9b, 0x91, 0x99, 0x61, 0x34, 0xb6,
+	0xac, 0xff, 0x57, 0x92, 0x1a, 0x67, 0xec, 0x17, 0x2b, 0x8d, 0x64, 0xef, 0x35, 0xbd, 0xf1, 0x32,
+	0x26, 0x0a, 0x83, 0x35, 0xc1, 0xea, 0x6f, 0xb3, 0x70, 0xe5, 0x5e, 0xd4, 0x6d, 0xbe, 0xb0, 0x4c,
+	0x74, 0x60, 0x9e, 0x30, 0x11, 0x52, 0x45, 0x85, 0x3c, 0xeb, 0x6f, 0xcd, 0x3a, 0xeb, 0x53, 0x62,
+	0x69, 0x33, 0x11, 0x8e, 0xcd, 0xc9, 0x47, 0x30, 0x13, 0x2c, 0xfc, 0x35, 0x0b, 0xe5, 0x59, 0x96,
+	0xe8, 0x25, 0x28, 0xb9, 0x21, 0x51, 0x1b, 0x51, 0xd7, 0xb7, 0x54, 0xd7, 0x5f, 0x8e, 0xb6, 0x4d,
+	0xd3, 0x7f, 0x03, 0xe4, 0x00, 0x25, 0x13, 0x4b, 0xaa, 0x5e, 0x78, 0x62, 0x5a, 0x4e, 0x8c, 0xa5,
+	0x18, 0x11, 0x28, 0x51, 0x46, 0x05, 0xc5, 0x03, 0xa7, 0x87, 0x07, 0x98, 0xb9, 0xff, 0xcf, 0x64,
+	0x39, 0xdd, 0xa8, 0x97, 0x0d, 0x68, 0x53, 0x63, 0xa2, 0x3d, 0x98, 0x8f, 0xe0, 0x73, 0x97, 0x00,
+	0x1f, 0x81, 0xa5, 0xa6, 0xa8, 0x8f, 0xb3, 0xb0, 0x6a, 0x13, 0xef, 0xd9, 0xa2, 0xf5, 0xa7, 0x00,
+	0xba, 0xe0, 0x64, 0x1f, 0x2c, 0xe7, 0x2e, 0xa1, 0x80, 0x17, 0x34, 0x5e, 0x8b, 0x8b, 0x14, 0xb7,
+	0x1f, 0x66, 0x61, 0x31, 0xcd, 0xed, 0x33, 0xf0, 0xbb, 0x80, 0x76, 0x92, 0x6e, 0x90, 0x53, 0xdd,
+	0xe0, 0xe5, 0x59, 0xdd, 0x60, 0x2a, 0xeb, 0xce, 0x6e, 0x03, 0x9f, 0xcc, 0x41, 0xbe, 0x83, 0x43,
+	0xec, 0x73, 0xf4, 0x83, 0xa9, 0x01, 0x4e, 0xdf, 0xaa, 0xae, 0x4e, 0xe5, 0x5c, 0xcb, 0x5c, 0xea,
+	0x75, 0xca, 0xbd, 0x73, 0xca, 0xfc, 0xf6, 0x0d, 0x58, 0x96, 0x57, 0xc4, 0x38, 0x14, 0x4d, 0xe2,
+	0x92, 0xba, 0xe3, 0xc5, 0xb7, 0x0b, 0x8e, 0xaa, 0x50, 0x94, 0x6a, 0x49, 0xa3, 0x93, 0x3a, 0xe0,
+	0xe3, 0xa3, 0xb6, 0xde, 0x41, 0x37, 0x00, 0x1d, 0xc4, 0x97, 0x76, 0x27, 0xa1, 0x40, 0xea, 0xad,
+	0x26, 0x92, 0x48, 0xfd, 0xab, 0x00, 0xd2, 0x0b, 0xc7, 0x23, 0x2c, 0xf0, 0xcd, 0x1d, 0x67, 0x41,
+	0xee, 0xb4, 0xe4, 0x06, 0xfa, 0x95, 0x9e, 0x05, 0x27, 0x6e, 0x8f, 0x66, 0x0c, 0x7f, 0xfd, 0x62,
+	0x99, 0xfa, 0xe9, 0x71, 0xb5, 0x32, 0xc6, 0xfe, 0x60, 0xab, 0x7e, 0x0a, 0x64, 0x5d, 0xcd, 0x86,
+	0x27, 0x6f, 0x9d, 0x68, 0x1f, 0xbe, 0x2c, 0x42, 0xcc, 0xf8, 0x3e, 0x09, 0x53, 0x93, 0xa8, 0x43,
+	0x18, 0xee, 0xc9, 0x0b, 0x8c, 0x1c, 0xd4, 0x0b, 0xcd, 0x6b, 0x9f, 0x1e, 0x57, 0xeb, 0x1a, 0xf7,
+	0x0c, 0xe5, 0xba, 0x7d, 0x35, 0x92, 0xa6, 0x5b, 0xb7, 0x92, 0xa5, 0x2a, 0xe5, 0x5d, 0x0b, 0x50,
+	0x22, 0xb7, 0x09, 0x1f, 0x06, 0x8c, 0xab, 0xe1, 0x3a, 0x81, 0x34, 0x87, 0x3c, 0x7b, 0x92, 0x88,
+	0x35, 0xa3, 0xe1, 0x3a, 0x55, 0x79, 0xdf, 0x49, 0x1a, 0x69, 0xd6, 0xe4, 0x8a, 0x81, 0x91, 0x1f,
+	0x69, 0x52, 0x03, 0x3a, 0x8d, 0xac, 0xa7, 0x7a, 0x65, 0xa6, 0xfe, 0xb1, 0x05, 0x57, 0xa7, 0xb2,
+	0x36, 0x76, 0xf6, 0xe7, 0x80, 0x42, 0x72, 0x82, 0x01, 0x11, 0x8e, 0x8d, 0xd3, 0x17, 0x2e, 0x82,
+	0xd5, 0x70, 0x52, 0xf0, 0xb9, 0xfd, 0x16, 0xe4, 0xd4, 0x09, 0xfc, 0xc9, 0x82, 0xb5, 0xb4, 0x33,
+	0x71, 0x58, 0x77, 0x61, 0x31, 0xed, 0x8b, 0x09, 0xe8, 0xc5, 0xf3, 0x04, 0x64, 0x62, 0x39, 0x61,
+	0x8f, 0xde, 0x4c, 0x1a, 0x84, 0xfe, 0x28, 0x75, 0xf3, 0xdc, 0xdc, 0x44, 0x3e, 0x4d, 0x36, 0x8a,
+	0x5c, 0x34, 0x2d, 0xe5, 0x3a, 0x41, 0x30, 0x40, 0xbf, 0x86, 0x55, 0x16, 0x08, 0x47, 0x56, 0x13,
+	0xf1, 0x1c, 0x73, 0x43, 0xd6, 0x5d, 0xf6, 0xcd, 0x8b, 0x51, 0xf6, 0xaf, 0xe3, 0xea, 0x34, 0xd4,
+	0x04, 0x8f, 0x25, 0x16, 0x88, 0xa6, 0x92, 0xef, 0x2a, 0x31, 0x0a, 0x61, 0xe9, 0xe4, 0xab, 0x75,
+	0x57, 0x7e, 0xe3, 0xc2, 0xaf, 0x5e, 0x3a, 0xeb, 0xb5, 0x8b, 0xbd, 0xd4, 0x3b, 0xb7, 0x0a, 0xf2,
+	0x0c, 0xff, 0xfd, 0xa8, 0x6a, 0x7d, 0xf3, 0x0f, 0x16, 0x40, 0xf2, 0xa9, 0x00, 0xbd, 0x02, 0x5f,
+	0x6a, 0xfe, 0xf0, 0x6e, 0xcb, 0xe9, 0xee, 0xde, 0xda, 0xbd, 0xd7, 0x75, 0xee, 0xdd, 0xed, 0x76,
+	0xda, 0xdb, 0x3b, 0xaf, 0xed, 0xb4, 0x5b, 0x2b, 0x99, 0x4a, 0xe9, 0xc1, 0xc3, 0x5a, 0xf1, 0x1e,
+	0xe3, 0x43, 0xe2, 0xd2, 0x7d, 0x4a, 0x3c, 0x74, 0x0d, 0xd6, 0x4e, 0x6a, 0xcb, 0x55, 0xbb, 0xb5,
+	0x62, 0x55, 0x16, 0x1f, 0x3c, 0xac, 0x15, 0xf4, 0x14, 0x46, 0x3c, 0x74, 0x1d, 0x9e, 0x9f, 0xd6,
+	0xdb, 0xb9, 0xfb, 0xfd, 0x95, 0x6c, 0x65, 0xe9, 0xc1, 0xc3, 0xda, 0x42, 0x3c, 0xae, 0xa1, 0x3a,
+	0xa0, 0xb4, 0xa6, 0xc1, 0x9b, 0xab, 0xc0, 0x83, 0x87, 0xb5, 0xbc, 0xa6, 0xad, 0x92, 0x7b, 0xeb,
+	0xdd, 0xf5, 0x4c, 0xf3, 0xb5, 0x0f, 0x9e, 0xac, 0x5b, 0x8f, 0x9f, 0xac, 0x5b, 0xff, 0x78, 0xb2,
+	0x6e, 0xbd, 0xfd, 0x74, 0x3d, 0xf3, 0xf8, 0xe9, 0x7a, 0xe6, 0x2f, 0x4f, 0xd7, 0x33, 0x3f, 0x79,
+	0xe5, 0x4c, 0xc6, 0x8e, 0xe2, 0x2f, 0xc6, 0x8a, 0xbb, 0x5e, 0x5e, 0x35, 0xff, 0x6f, 0xff, 0x6f,
+	0x00, 0xfd, 0x92, 0xbb, 0x25, 0x50, 0x16, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,476 +1270,478 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7499 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbc, 0x6b, 0x70, 0x24, 0xd7,
-		0x75, 0x1f, 0x8e, 0x79, 0x60, 0x30, 0x73, 0x66, 0x30, 0x68, 0x5c, 0x60, 0x77, 0x67, 0x41, 0x12,
-		0x00, 0x87, 0x8f, 0x5d, 0xbe, 0xb0, 0xe4, 0x92, 0xbb, 0xcb, 0x9d, 0xb5, 0xc4, 0xff, 0xbc, 0x16,
-		0xc4, 0x2e, 0x1e, 0xc3, 0x1e, 0x60, 0xf9, 0xf0, 0xdf, 0xe9, 0x6a, 0xf4, 0x5c, 0x0c, 0x9a, 0xe8,
-		0xe9, 0x6e, 0x77, 0xf7, 0xec, 0x2e, 0x58, 0x4e, 0x8a, 0x2e, 0xe5, 0x21, 0x6d, 0x2a, 0x8e, 0x1c,
-		0xa7, 0x62, 0x59, 0xd6, 0x2a, 0x94, 0xe5, 0x44, 0x8e, 0xa2, 0x3c, 0x6c, 0x29, 0x4a, 0x1c, 0x57,
-		0x12, 0x25, 0xa9, 0x24, 0xb2, 0x3e, 0xa4, 0x64, 0x7f, 0x88, 0xed, 0x3c, 0x18, 0x87, 0x52, 0x25,
-		0x8c, 0xa2, 0xc4, 0x8e, 0xc2, 0x54, 0x25, 0xa5, 0x52, 0x2a, 0x75, 0x5f, 0xfd, 0x98, 0x07, 0x66,
-		0xb0, 0x59, 0xca, 0xae, 0xf2, 0x27, 0x4c, 0x9f, 0x7b, 0x7e, 0xbf, 0x3e, 0xf7, 0xdc, 0x73, 0xcf,
-		0x3d, 0xf7, 0x76, 0x37, 0xe0, 0x9f, 0x5e, 0x81, 0xe5, 0xb6, 0x65, 0xb5, 0x0d, 0x7c, 0xce, 0x76,
-		0x2c, 0xcf, 0xda, 0xed, 0xee, 0x9d, 0x6b, 0x61, 0x57, 0x73, 0x74, 0xdb, 0xb3, 0x9c, 0x15, 0x2a,
-		0x43, 0x33, 0x4c, 0x63, 0x45, 0x68, 0x14, 0x37, 0x60, 0xf6, 0xaa, 0x6e, 0xe0, 0x9a, 0xaf, 0xd8,
-		0xc4, 0x1e, 0x7a, 0x11, 0x92, 0x7b, 0xba, 0x81, 0x0b, 0xb1, 0xe5, 0xc4, 0xd9, 0xec, 0xf9, 0x47,
-		0x57, 0x7a, 0x40, 0x2b, 0x51, 0x44, 0x83, 0x88, 0x65, 0x8a, 0x28, 0x7e, 0x3b, 0x09, 0x73, 0x03,
-		0x5a, 0x11, 0x82, 0xa4, 0xa9, 0x76, 0x08, 0x63, 0xec, 0x6c, 0x46, 0xa6, 0xbf, 0x51, 0x01, 0xa6,
-		0x6c, 0x55, 0x3b, 0x50, 0xdb, 0xb8, 0x10, 0xa7, 0x62, 0x71, 0x89, 0x16, 0x01, 0x5a, 0xd8, 0xc6,
-		0x66, 0x0b, 0x9b, 0xda, 0x61, 0x21, 0xb1, 0x9c, 0x38, 0x9b, 0x91, 0x43, 0x12, 0xf4, 0x14, 0xcc,
-		0xda, 0xdd, 0x5d, 0x43, 0xd7, 0x94, 0x90, 0x1a, 0x2c, 0x27, 0xce, 0x4e, 0xca, 0x12, 0x6b, 0xa8,
-		0x05, 0xca, 0x67, 0x60, 0xe6, 0x16, 0x56, 0x0f, 0xc2, 0xaa, 0x59, 0xaa, 0x9a, 0x27, 0xe2, 0x90,
-		0x62, 0x15, 0x72, 0x1d, 0xec, 0xba, 0x6a, 0x1b, 0x2b, 0xde, 0xa1, 0x8d, 0x0b, 0x49, 0xda, 0xfb,
-		0xe5, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xcb, 0x51, 0xdb, 0x87, 0x36, 0x46, 0x65, 0xc8, 0x60, 0xb3,
-		0xdb, 0x61, 0x0c, 0x93, 0x43, 0xfc, 0x57, 0x37, 0xbb, 0x9d, 0x5e, 0x96, 0x34, 0x81, 0x71, 0x8a,
-		0x29, 0x17, 0x3b, 0x37, 0x75, 0x0d, 0x17, 0x52, 0x94, 0xe0, 0x4c, 0x1f, 0x41, 0x93, 0xb5, 0xf7,
-		0x72, 0x08, 0x1c, 0xaa, 0x42, 0x06, 0xdf, 0xf6, 0xb0, 0xe9, 0xea, 0x96, 0x59, 0x98, 0xa2, 0x24,
-		0x8f, 0x0d, 0x18, 0x45, 0x6c, 0xb4, 0x7a, 0x29, 0x02, 0x1c, 0xba, 0x08, 0x53, 0x96, 0xed, 0xe9,
-		0x96, 0xe9, 0x16, 0xd2, 0xcb, 0xb1, 0xb3, 0xd9, 0xf3, 0x0f, 0x0e, 0x0c, 0x84, 0x2d, 0xa6, 0x23,
-		0x0b, 0x65, 0xb4, 0x06, 0x92, 0x6b, 0x75, 0x1d, 0x0d, 0x2b, 0x9a, 0xd5, 0xc2, 0x8a, 0x6e, 0xee,
-		0x59, 0x85, 0x0c, 0x25, 0x58, 0xea, 0xef, 0x08, 0x55, 0xac, 0x5a, 0x2d, 0xbc, 0x66, 0xee, 0x59,
-		0x72, 0xde, 0x8d, 0x5c, 0xa3, 0x93, 0x90, 0x72, 0x0f, 0x4d, 0x4f, 0xbd, 0x5d, 0xc8, 0xd1, 0x08,
-		0xe1, 0x57, 0xc5, 0x5f, 0x4d, 0xc1, 0xcc, 0x38, 0x21, 0x76, 0x05, 0x26, 0xf7, 0x48, 0x2f, 0x0b,
-		0xf1, 0xe3, 0xf8, 0x80, 0x61, 0xa2, 0x4e, 0x4c, 0xdd, 0xa3, 0x13, 0xcb, 0x90, 0x35, 0xb1, 0xeb,
-		0xe1, 0x16, 0x8b, 0x88, 0xc4, 0x98, 0x31, 0x05, 0x0c, 0xd4, 0x1f, 0x52, 0xc9, 0x7b, 0x0a, 0xa9,
-		0xd7, 0x60, 0xc6, 0x37, 0x49, 0x71, 0x54, 0xb3, 0x2d, 0x62, 0xf3, 0xdc, 0x28, 0x4b, 0x56, 0xea,
-		0x02, 0x27, 0x13, 0x98, 0x9c, 0xc7, 0x91, 0x6b, 0x54, 0x03, 0xb0, 0x4c, 0x6c, 0xed, 0x29, 0x2d,
-		0xac, 0x19, 0x85, 0xf4, 0x10, 0x2f, 0x6d, 0x11, 0x95, 0x3e, 0x2f, 0x59, 0x4c, 0xaa, 0x19, 0xe8,
-		0x72, 0x10, 0x6a, 0x53, 0x43, 0x22, 0x65, 0x83, 0x4d, 0xb2, 0xbe, 0x68, 0xdb, 0x81, 0xbc, 0x83,
-		0x49, 0xdc, 0xe3, 0x16, 0xef, 0x59, 0x86, 0x1a, 0xb1, 0x32, 0xb2, 0x67, 0x32, 0x87, 0xb1, 0x8e,
-		0x4d, 0x3b, 0xe1, 0x4b, 0xf4, 0x08, 0xf8, 0x02, 0x85, 0x86, 0x15, 0xd0, 0x2c, 0x94, 0x13, 0xc2,
-		0x4d, 0xb5, 0x83, 0x17, 0xde, 0x82, 0x7c, 0xd4, 0x3d, 0x68, 0x1e, 0x26, 0x5d, 0x4f, 0x75, 0x3c,
-		0x1a, 0x85, 0x93, 0x32, 0xbb, 0x40, 0x12, 0x24, 0xb0, 0xd9, 0xa2, 0x59, 0x6e, 0x52, 0x26, 0x3f,
-		0xd1, 0xff, 0x17, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd,
-		0x70, 0x09, 0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x13, 0x03, 0xa9, 0xd1, 0x6b,
-		0x30, 0xdf, 0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x34,
-		0x35, 0x24, 0xe6, 0x76, 0xc2, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf,
-		0x9f, 0x92, 0xde, 0x7e, 0xfb, 0xed, 0xb7, 0xe3, 0xc5, 0x7f, 0x9c, 0x82, 0xf9, 0x41, 0x73, 0x66,
-		0xe0, 0xf4, 0x3d, 0x09, 0x29, 0xb3, 0xdb, 0xd9, 0xc5, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50,
-		0x19, 0x26, 0x0d, 0x75, 0x17, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x6c, 0xfe, 0xfc, 0x53, 0x63, 0xcd,
-		0xca, 0x95, 0x75, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7,
-		0x63, 0x20, 0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73,
-		0x9a, 0x08, 0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49,
-		0x60, 0xb5, 0xf0, 0x9e, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c,
-		0xe3, 0xc2, 0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d,
-		0x27, 0x65, 0x36, 0xd1, 0xd6, 0x88, 0x84, 0xdc, 0xfe, 0x4d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b,
-		0x10, 0x01, 0xbd, 0xfd, 0xa5, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x33, 0x30,
-		0x43, 0x35, 0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xd9, 0xb4, 0x9c, 0x67, 0xe2,
-		0x2d, 0x2e, 0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xfd, 0x7a, 0xa3, 0xae,
-		0xd4, 0xb6, 0x76, 0x2a, 0xeb, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x75, 0x7d, 0xab, 0xbc,
-		0x2d, 0xc5, 0xfd, 0xeb, 0xb5, 0xcd, 0xed, 0x8b, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xc3, 0x04, 0xc9,
-		0xb0, 0xc2, 0xf3, 0xe7, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbd, 0x56, 0xaf, 0x5d, 0x7c,
-		0x41, 0x4a, 0x45, 0x25, 0xcf, 0x9f, 0x97, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb5, 0xb5,
-		0x2e, 0xa5, 0x7d, 0xce, 0xe6, 0xb6, 0xbc, 0xb6, 0xb9, 0x2a, 0x65, 0x7c, 0xce, 0x55, 0x79, 0x6b,
-		0xa7, 0x21, 0x81, 0xcf, 0xb0, 0x51, 0x6f, 0x36, 0xcb, 0xab, 0x75, 0x29, 0xeb, 0x6b, 0x54, 0x5e,
-		0xdf, 0xae, 0x37, 0xa5, 0x5c, 0xc4, 0xac, 0xe7, 0xcf, 0x4b, 0xd3, 0xfe, 0x2d, 0xea, 0x9b, 0x3b,
-		0x1b, 0x52, 0x1e, 0xcd, 0xc2, 0x34, 0xbb, 0x85, 0x30, 0x62, 0xa6, 0x47, 0x74, 0xf1, 0x05, 0x49,
-		0x0a, 0x0c, 0x61, 0x2c, 0xb3, 0x11, 0xc1, 0xc5, 0x17, 0x24, 0x54, 0xac, 0xc2, 0x24, 0x0d, 0x43,
-		0x84, 0x20, 0xbf, 0x5e, 0xae, 0xd4, 0xd7, 0x95, 0xad, 0xc6, 0xf6, 0xda, 0xd6, 0x66, 0x79, 0x5d,
-		0x8a, 0x05, 0x32, 0xb9, 0xfe, 0xca, 0xce, 0x9a, 0x5c, 0xaf, 0x49, 0xf1, 0xb0, 0xac, 0x51, 0x2f,
-		0x6f, 0xd7, 0x6b, 0x52, 0xa2, 0xa8, 0xc1, 0xfc, 0xa0, 0x84, 0x3a, 0x70, 0x0a, 0x85, 0x62, 0x21,
-		0x3e, 0x24, 0x16, 0x28, 0x57, 0x6f, 0x2c, 0x14, 0xbf, 0x15, 0x87, 0xb9, 0x01, 0x8b, 0xca, 0xc0,
-		0x9b, 0xbc, 0x04, 0x93, 0x2c, 0x96, 0xd9, 0x32, 0xfb, 0xc4, 0xc0, 0xd5, 0x89, 0x46, 0x76, 0xdf,
-		0x52, 0x4b, 0x71, 0xe1, 0x52, 0x23, 0x31, 0xa4, 0xd4, 0x20, 0x14, 0x7d, 0x01, 0xfb, 0x63, 0x7d,
-		0xc9, 0x9f, 0xad, 0x8f, 0x17, 0xc7, 0x59, 0x1f, 0xa9, 0xec, 0x78, 0x8b, 0xc0, 0xe4, 0x80, 0x45,
-		0xe0, 0x0a, 0xcc, 0xf6, 0x11, 0x8d, 0x9d, 0x8c, 0x3f, 0x16, 0x83, 0xc2, 0x30, 0xe7, 0x8c, 0x48,
-		0x89, 0xf1, 0x48, 0x4a, 0xbc, 0xd2, 0xeb, 0xc1, 0x87, 0x87, 0x0f, 0x42, 0xdf, 0x58, 0x7f, 0x21,
-		0x06, 0x27, 0x07, 0x97, 0x94, 0x03, 0x6d, 0xf8, 0x28, 0xa4, 0x3a, 0xd8, 0xdb, 0xb7, 0x44, 0x59,
-		0xf5, 0xf8, 0x80, 0xc5, 0x9a, 0x34, 0xf7, 0x0e, 0x36, 0x47, 0x85, 0x57, 0xfb, 0xc4, 0xb0, 0xba,
-		0x90, 0x59, 0xd3, 0x67, 0xe9, 0x27, 0xe2, 0x70, 0x62, 0x20, 0xf9, 0x40, 0x43, 0x1f, 0x02, 0xd0,
-		0x4d, 0xbb, 0xeb, 0xb1, 0xd2, 0x89, 0x65, 0xe2, 0x0c, 0x95, 0xd0, 0xe4, 0x45, 0xb2, 0x6c, 0xd7,
-		0xf3, 0xdb, 0x13, 0xb4, 0x1d, 0x98, 0x88, 0x2a, 0xbc, 0x18, 0x18, 0x9a, 0xa4, 0x86, 0x2e, 0x0e,
-		0xe9, 0x69, 0x5f, 0x60, 0x3e, 0x0b, 0x92, 0x66, 0xe8, 0xd8, 0xf4, 0x14, 0xd7, 0x73, 0xb0, 0xda,
-		0xd1, 0xcd, 0x36, 0x5d, 0x6a, 0xd2, 0xa5, 0xc9, 0x3d, 0xd5, 0x70, 0xb1, 0x3c, 0xc3, 0x9a, 0x9b,
-		0xa2, 0x95, 0x20, 0x68, 0x00, 0x39, 0x21, 0x44, 0x2a, 0x82, 0x60, 0xcd, 0x3e, 0xa2, 0xf8, 0xd3,
-		0x19, 0xc8, 0x86, 0x0a, 0x70, 0xf4, 0x30, 0xe4, 0xde, 0x54, 0x6f, 0xaa, 0x8a, 0xd8, 0x54, 0x31,
-		0x4f, 0x64, 0x89, 0xac, 0xc1, 0x37, 0x56, 0xcf, 0xc2, 0x3c, 0x55, 0xb1, 0xba, 0x1e, 0x76, 0x14,
-		0xcd, 0x50, 0x5d, 0x97, 0x3a, 0x2d, 0x4d, 0x55, 0x11, 0x69, 0xdb, 0x22, 0x4d, 0x55, 0xd1, 0x82,
-		0x2e, 0xc0, 0x1c, 0x45, 0x74, 0xba, 0x86, 0xa7, 0xdb, 0x06, 0x56, 0xc8, 0x36, 0xcf, 0xa5, 0x4b,
-		0x8e, 0x6f, 0xd9, 0x2c, 0xd1, 0xd8, 0xe0, 0x0a, 0xc4, 0x22, 0x17, 0xd5, 0xe0, 0x21, 0x0a, 0x6b,
-		0x63, 0x13, 0x3b, 0xaa, 0x87, 0x15, 0xfc, 0xe3, 0x5d, 0xd5, 0x70, 0x15, 0xd5, 0x6c, 0x29, 0xfb,
-		0xaa, 0xbb, 0x5f, 0x98, 0x27, 0x04, 0x95, 0x78, 0x21, 0x26, 0x9f, 0x26, 0x8a, 0xab, 0x5c, 0xaf,
-		0x4e, 0xd5, 0xca, 0x66, 0xeb, 0x65, 0xd5, 0xdd, 0x47, 0x25, 0x38, 0x49, 0x59, 0x5c, 0xcf, 0xd1,
-		0xcd, 0xb6, 0xa2, 0xed, 0x63, 0xed, 0x40, 0xe9, 0x7a, 0x7b, 0x2f, 0x16, 0x1e, 0x08, 0xdf, 0x9f,
-		0x5a, 0xd8, 0xa4, 0x3a, 0x55, 0xa2, 0xb2, 0xe3, 0xed, 0xbd, 0x88, 0x9a, 0x90, 0x23, 0x83, 0xd1,
-		0xd1, 0xdf, 0xc2, 0xca, 0x9e, 0xe5, 0xd0, 0x35, 0x34, 0x3f, 0x20, 0x35, 0x85, 0x3c, 0xb8, 0xb2,
-		0xc5, 0x01, 0x1b, 0x56, 0x0b, 0x97, 0x26, 0x9b, 0x8d, 0x7a, 0xbd, 0x26, 0x67, 0x05, 0xcb, 0x55,
-		0xcb, 0x21, 0x01, 0xd5, 0xb6, 0x7c, 0x07, 0x67, 0x59, 0x40, 0xb5, 0x2d, 0xe1, 0xde, 0x0b, 0x30,
-		0xa7, 0x69, 0xac, 0xcf, 0xba, 0xa6, 0xf0, 0xcd, 0x98, 0x5b, 0x90, 0x22, 0xce, 0xd2, 0xb4, 0x55,
-		0xa6, 0xc0, 0x63, 0xdc, 0x45, 0x97, 0xe1, 0x44, 0xe0, 0xac, 0x30, 0x70, 0xb6, 0xaf, 0x97, 0xbd,
-		0xd0, 0x0b, 0x30, 0x67, 0x1f, 0xf6, 0x03, 0x51, 0xe4, 0x8e, 0xf6, 0x61, 0x2f, 0xec, 0x12, 0xcc,
-		0xdb, 0xfb, 0x76, 0x3f, 0xee, 0xc9, 0x30, 0x0e, 0xd9, 0xfb, 0x76, 0x2f, 0xf0, 0x31, 0xba, 0x33,
-		0x77, 0xb0, 0xa6, 0x7a, 0xb8, 0x55, 0x38, 0x15, 0x56, 0x0f, 0x35, 0xa0, 0x15, 0x90, 0x34, 0x4d,
-		0xc1, 0xa6, 0xba, 0x6b, 0x60, 0x45, 0x75, 0xb0, 0xa9, 0xba, 0x85, 0x25, 0xaa, 0x9c, 0xf4, 0x9c,
-		0x2e, 0x96, 0xf3, 0x9a, 0x56, 0xa7, 0x8d, 0x65, 0xda, 0x86, 0x9e, 0x84, 0x59, 0x6b, 0xf7, 0x4d,
-		0x8d, 0x45, 0xa4, 0x62, 0x3b, 0x78, 0x4f, 0xbf, 0x5d, 0x78, 0x94, 0xba, 0x77, 0x86, 0x34, 0xd0,
-		0x78, 0x6c, 0x50, 0x31, 0x7a, 0x02, 0x24, 0xcd, 0xdd, 0x57, 0x1d, 0x9b, 0xa6, 0x64, 0xd7, 0x56,
-		0x35, 0x5c, 0x78, 0x8c, 0xa9, 0x32, 0xf9, 0xa6, 0x10, 0x93, 0x19, 0xe1, 0xde, 0xd2, 0xf7, 0x3c,
-		0xc1, 0x78, 0x86, 0xcd, 0x08, 0x2a, 0xe3, 0x6c, 0x67, 0x41, 0x22, 0x9e, 0x88, 0xdc, 0xf8, 0x2c,
-		0x55, 0xcb, 0xdb, 0xfb, 0x76, 0xf8, 0xbe, 0x8f, 0xc0, 0x34, 0xd1, 0x0c, 0x6e, 0xfa, 0x04, 0x2b,
-		0xdc, 0xec, 0xfd, 0xd0, 0x1d, 0x5f, 0x80, 0x93, 0x44, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a, 0x6a,
-		0x48, 0xfb, 0x69, 0xaa, 0x4d, 0xdc, 0xbe, 0xc1, 0x1b, 0x23, 0x76, 0x3a, 0xdd, 0xdd, 0x43, 0x3f,
-		0xb0, 0x9e, 0x61, 0x76, 0x12, 0x99, 0x08, 0xad, 0x0f, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17, 0x8e,
-		0x7b, 0x94, 0x01, 0x16, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xad, 0x1a, 0x29, 0x5f, 0xde, 0xa8,
-		0x4b, 0x71, 0x52, 0x46, 0xad, 0xaf, 0x6d, 0xd7, 0x15, 0x79, 0x67, 0x73, 0x7b, 0x6d, 0xa3, 0x2e,
-		0x25, 0x42, 0x85, 0xfd, 0xb5, 0x64, 0xfa, 0x71, 0xe9, 0x0c, 0xa9, 0x1a, 0xf2, 0xd1, 0x9d, 0x1a,
-		0xfa, 0x11, 0x38, 0x25, 0x8e, 0x55, 0x5c, 0xec, 0x29, 0xb7, 0x74, 0x87, 0x4e, 0xc8, 0x8e, 0xca,
-		0x16, 0x47, 0x3f, 0x7e, 0xe6, 0xb9, 0x56, 0x13, 0x7b, 0xaf, 0xea, 0x0e, 0x99, 0x6e, 0x1d, 0xd5,
-		0x43, 0xeb, 0xb0, 0x64, 0x5a, 0x8a, 0xeb, 0xa9, 0x66, 0x4b, 0x75, 0x5a, 0x4a, 0x70, 0xa0, 0xa5,
-		0xa8, 0x9a, 0x86, 0x5d, 0xd7, 0x62, 0x0b, 0xa1, 0xcf, 0xf2, 0xa0, 0x69, 0x35, 0xb9, 0x72, 0xb0,
-		0x42, 0x94, 0xb9, 0x6a, 0x4f, 0xf8, 0x26, 0x86, 0x85, 0xef, 0x03, 0x90, 0xe9, 0xa8, 0xb6, 0x82,
-		0x4d, 0xcf, 0x39, 0xa4, 0xf5, 0x79, 0x5a, 0x4e, 0x77, 0x54, 0xbb, 0x4e, 0xae, 0x7f, 0x28, 0xdb,
-		0xa4, 0x6b, 0xc9, 0x74, 0x52, 0x9a, 0xbc, 0x96, 0x4c, 0x4f, 0x4a, 0xa9, 0x6b, 0xc9, 0x74, 0x4a,
-		0x9a, 0xba, 0x96, 0x4c, 0xa7, 0xa5, 0xcc, 0xb5, 0x64, 0x3a, 0x23, 0x41, 0xf1, 0xbd, 0x04, 0xe4,
-		0xc2, 0x15, 0x3c, 0xd9, 0x10, 0x69, 0x74, 0x0d, 0x8b, 0xd1, 0x2c, 0xf7, 0xc8, 0x91, 0xf5, 0xfe,
-		0x4a, 0x95, 0x2c, 0x6e, 0xa5, 0x14, 0x2b, 0x97, 0x65, 0x86, 0x24, 0x85, 0x05, 0x09, 0x3f, 0xcc,
-		0xca, 0x93, 0xb4, 0xcc, 0xaf, 0xd0, 0x2a, 0xa4, 0xde, 0x74, 0x29, 0x77, 0x8a, 0x72, 0x3f, 0x7a,
-		0x34, 0xf7, 0xb5, 0x26, 0x25, 0xcf, 0x5c, 0x6b, 0x2a, 0x9b, 0x5b, 0xf2, 0x46, 0x79, 0x5d, 0xe6,
-		0x70, 0x74, 0x1a, 0x92, 0x86, 0xfa, 0xd6, 0x61, 0x74, 0x19, 0xa4, 0xa2, 0x71, 0x87, 0xe5, 0x34,
-		0x24, 0x6f, 0x61, 0xf5, 0x20, 0xba, 0xf8, 0x50, 0xd1, 0x87, 0x38, 0x3d, 0xce, 0xc1, 0x24, 0xf5,
-		0x17, 0x02, 0xe0, 0x1e, 0x93, 0x26, 0x50, 0x1a, 0x92, 0xd5, 0x2d, 0x99, 0x4c, 0x11, 0x09, 0x72,
-		0x4c, 0xaa, 0x34, 0xd6, 0xea, 0xd5, 0xba, 0x14, 0x2f, 0x5e, 0x80, 0x14, 0x73, 0x02, 0x99, 0x3e,
-		0xbe, 0x1b, 0xa4, 0x09, 0x7e, 0xc9, 0x39, 0x62, 0xa2, 0x75, 0x67, 0xa3, 0x52, 0x97, 0xa5, 0x78,
-		0xdf, 0xe0, 0x17, 0x5d, 0xc8, 0x85, 0x2b, 0xf3, 0x1f, 0xce, 0xf6, 0xfc, 0x6b, 0x31, 0xc8, 0x86,
-		0x2a, 0x6d, 0x52, 0x22, 0xa9, 0x86, 0x61, 0xdd, 0x52, 0x54, 0x43, 0x57, 0x5d, 0x1e, 0x1a, 0x40,
-		0x45, 0x65, 0x22, 0x19, 0x77, 0xe8, 0x7e, 0x48, 0x93, 0x66, 0x52, 0x4a, 0x15, 0x3f, 0x1b, 0x03,
-		0xa9, 0xb7, 0xd4, 0xed, 0x31, 0x33, 0xf6, 0x07, 0x69, 0x66, 0xf1, 0x33, 0x31, 0xc8, 0x47, 0xeb,
-		0xdb, 0x1e, 0xf3, 0x1e, 0xfe, 0x03, 0x35, 0xef, 0x77, 0xe3, 0x30, 0x1d, 0xa9, 0x6a, 0xc7, 0xb5,
-		0xee, 0xc7, 0x61, 0x56, 0x6f, 0xe1, 0x8e, 0x6d, 0x79, 0xd8, 0xd4, 0x0e, 0x15, 0x03, 0xdf, 0xc4,
-		0x46, 0xa1, 0x48, 0x93, 0xc6, 0xb9, 0xa3, 0xeb, 0xe6, 0x95, 0xb5, 0x00, 0xb7, 0x4e, 0x60, 0xa5,
-		0xb9, 0xb5, 0x5a, 0x7d, 0xa3, 0xb1, 0xb5, 0x5d, 0xdf, 0xac, 0xbe, 0xae, 0xec, 0x6c, 0x5e, 0xdf,
-		0xdc, 0x7a, 0x75, 0x53, 0x96, 0xf4, 0x1e, 0xb5, 0x0f, 0x71, 0xda, 0x37, 0x40, 0xea, 0x35, 0x0a,
-		0x9d, 0x82, 0x41, 0x66, 0x49, 0x13, 0x68, 0x0e, 0x66, 0x36, 0xb7, 0x94, 0xe6, 0x5a, 0xad, 0xae,
-		0xd4, 0xaf, 0x5e, 0xad, 0x57, 0xb7, 0x9b, 0xec, 0x24, 0xc4, 0xd7, 0xde, 0x8e, 0x4c, 0xf0, 0xe2,
-		0xa7, 0x13, 0x30, 0x37, 0xc0, 0x12, 0x54, 0xe6, 0x7b, 0x18, 0xb6, 0xad, 0x7a, 0x66, 0x1c, 0xeb,
-		0x57, 0x48, 0x15, 0xd1, 0x50, 0x1d, 0x8f, 0x6f, 0x79, 0x9e, 0x00, 0xe2, 0x25, 0xd3, 0xd3, 0xf7,
-		0x74, 0xec, 0xf0, 0x13, 0x26, 0xb6, 0xb1, 0x99, 0x09, 0xe4, 0xec, 0x90, 0xe9, 0x69, 0x40, 0xb6,
-		0xe5, 0xea, 0x9e, 0x7e, 0x13, 0x2b, 0xba, 0x29, 0x8e, 0xa3, 0xc8, 0x46, 0x27, 0x29, 0x4b, 0xa2,
-		0x65, 0xcd, 0xf4, 0x7c, 0x6d, 0x13, 0xb7, 0xd5, 0x1e, 0x6d, 0x92, 0xcc, 0x13, 0xb2, 0x24, 0x5a,
-		0x7c, 0xed, 0x87, 0x21, 0xd7, 0xb2, 0xba, 0xa4, 0xfa, 0x63, 0x7a, 0x64, 0xed, 0x88, 0xc9, 0x59,
-		0x26, 0xf3, 0x55, 0x78, 0x5d, 0x1f, 0x9c, 0x83, 0xe5, 0xe4, 0x2c, 0x93, 0x31, 0x95, 0x33, 0x30,
-		0xa3, 0xb6, 0xdb, 0x0e, 0x21, 0x17, 0x44, 0x6c, 0xa7, 0x92, 0xf7, 0xc5, 0x54, 0x71, 0xe1, 0x1a,
-		0xa4, 0x85, 0x1f, 0xc8, 0xe2, 0x4d, 0x3c, 0xa1, 0xd8, 0x6c, 0xfb, 0x1d, 0x3f, 0x9b, 0x91, 0xd3,
-		0xa6, 0x68, 0x7c, 0x18, 0x72, 0xba, 0xab, 0x04, 0xc7, 0xfa, 0xf1, 0xe5, 0xf8, 0xd9, 0xb4, 0x9c,
-		0xd5, 0x5d, 0xff, 0x48, 0xb4, 0xf8, 0x85, 0x38, 0xe4, 0xa3, 0x8f, 0x25, 0x50, 0x0d, 0xd2, 0x86,
-		0xa5, 0xa9, 0x34, 0xb4, 0xd8, 0x33, 0xb1, 0xb3, 0x23, 0x9e, 0x64, 0xac, 0xac, 0x73, 0x7d, 0xd9,
-		0x47, 0x2e, 0xfc, 0xcb, 0x18, 0xa4, 0x85, 0x18, 0x9d, 0x84, 0xa4, 0xad, 0x7a, 0xfb, 0x94, 0x6e,
-		0xb2, 0x12, 0x97, 0x62, 0x32, 0xbd, 0x26, 0x72, 0xd7, 0x56, 0x4d, 0x1a, 0x02, 0x5c, 0x4e, 0xae,
-		0xc9, 0xb8, 0x1a, 0x58, 0x6d, 0xd1, 0x6d, 0x90, 0xd5, 0xe9, 0x60, 0xd3, 0x73, 0xc5, 0xb8, 0x72,
-		0x79, 0x95, 0x8b, 0xd1, 0x53, 0x30, 0xeb, 0x39, 0xaa, 0x6e, 0x44, 0x74, 0x93, 0x54, 0x57, 0x12,
-		0x0d, 0xbe, 0x72, 0x09, 0x4e, 0x0b, 0xde, 0x16, 0xf6, 0x54, 0x6d, 0x1f, 0xb7, 0x02, 0x50, 0x8a,
-		0x1e, 0x77, 0x9c, 0xe2, 0x0a, 0x35, 0xde, 0x2e, 0xb0, 0xc5, 0xdf, 0x88, 0xc1, 0xac, 0xd8, 0xb8,
-		0xb5, 0x7c, 0x67, 0x6d, 0x00, 0xa8, 0xa6, 0x69, 0x79, 0x61, 0x77, 0xf5, 0x87, 0x72, 0x1f, 0x6e,
-		0xa5, 0xec, 0x83, 0xe4, 0x10, 0xc1, 0x42, 0x07, 0x20, 0x68, 0x19, 0xea, 0xb6, 0x25, 0xc8, 0xf2,
-		0x67, 0x4e, 0xf4, 0xc1, 0x25, 0xdb, 0xea, 0x03, 0x13, 0x91, 0x1d, 0x1e, 0x9a, 0x87, 0xc9, 0x5d,
-		0xdc, 0xd6, 0x4d, 0x7e, 0x92, 0xcc, 0x2e, 0xc4, 0x81, 0x4c, 0xd2, 0x3f, 0x90, 0xa9, 0xfc, 0x09,
-		0x98, 0xd3, 0xac, 0x4e, 0xaf, 0xb9, 0x15, 0xa9, 0xe7, 0xb8, 0xc1, 0x7d, 0x39, 0xf6, 0xc6, 0x33,
-		0x5c, 0xa9, 0x6d, 0x19, 0xaa, 0xd9, 0x5e, 0xb1, 0x9c, 0x76, 0xf0, 0xe0, 0x95, 0x54, 0x3c, 0x6e,
-		0xe8, 0xf1, 0xab, 0xbd, 0xfb, 0xbf, 0x62, 0xb1, 0x5f, 0x88, 0x27, 0x56, 0x1b, 0x95, 0x2f, 0xc6,
-		0x17, 0x56, 0x19, 0xb0, 0x21, 0x9c, 0x21, 0xe3, 0x3d, 0x03, 0x6b, 0xa4, 0x83, 0xf0, 0x9d, 0xa7,
-		0x60, 0xbe, 0x6d, 0xb5, 0x2d, 0xca, 0x74, 0x8e, 0xfc, 0xe2, 0x4f, 0x6e, 0x33, 0xbe, 0x74, 0x61,
-		0xe4, 0x63, 0xde, 0xd2, 0x26, 0xcc, 0x71, 0x65, 0x85, 0x3e, 0x3a, 0x62, 0x1b, 0x1b, 0x74, 0xe4,
-		0xa9, 0x5a, 0xe1, 0x97, 0xbf, 0x4d, 0x97, 0x6f, 0x79, 0x96, 0x43, 0x49, 0x1b, 0xdb, 0xfb, 0x94,
-		0x64, 0x38, 0x11, 0xe1, 0x63, 0x93, 0x14, 0x3b, 0x23, 0x18, 0xff, 0x19, 0x67, 0x9c, 0x0b, 0x31,
-		0x36, 0x39, 0xb4, 0x54, 0x85, 0xe9, 0xe3, 0x70, 0xfd, 0x73, 0xce, 0x95, 0xc3, 0x61, 0x92, 0x55,
-		0x98, 0xa1, 0x24, 0x5a, 0xd7, 0xf5, 0xac, 0x0e, 0xcd, 0x80, 0x47, 0xd3, 0xfc, 0x8b, 0x6f, 0xb3,
-		0x59, 0x93, 0x27, 0xb0, 0xaa, 0x8f, 0x2a, 0x95, 0x80, 0x3e, 0x2d, 0x6b, 0x61, 0xcd, 0x18, 0xc1,
-		0xf0, 0x75, 0x6e, 0x88, 0xaf, 0x5f, 0xba, 0x01, 0xf3, 0xe4, 0x37, 0x4d, 0x50, 0x61, 0x4b, 0x46,
-		0x1f, 0xc1, 0x15, 0x7e, 0xe3, 0x63, 0x6c, 0x62, 0xce, 0xf9, 0x04, 0x21, 0x9b, 0x42, 0xa3, 0xd8,
-		0xc6, 0x9e, 0x87, 0x1d, 0x57, 0x51, 0x8d, 0x41, 0xe6, 0x85, 0xce, 0x30, 0x0a, 0x3f, 0xf7, 0xdd,
-		0xe8, 0x28, 0xae, 0x32, 0x64, 0xd9, 0x30, 0x4a, 0x3b, 0x70, 0x6a, 0x40, 0x54, 0x8c, 0xc1, 0xf9,
-		0x69, 0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f,
-		0xcf, 0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2d, 0x97,
-		0x9f, 0x1b, 0x8d, 0x41, 0xf7, 0x19, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x86,
-		0xf4, 0x9e, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5,
-		0x2d, 0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x96, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a,
-		0x64, 0x01, 0x1b, 0x4d, 0xf1, 0x97, 0x05, 0x85, 0xc0, 0x70, 0x8a, 0x63, 0xb8, 0xf5, 0x1d, 0x41,
-		0xe1, 0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb4, 0xcc, 0x71, 0x8c, 0xf8, 0x1c, 0x67,
-		0x00, 0x0e, 0x21, 0x04, 0x57, 0x20, 0x33, 0xee, 0x40, 0xfc, 0x95, 0xef, 0x8a, 0xe9, 0x21, 0x46,
-		0x60, 0x15, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xca, 0x29, 0xf2, 0x21, 0x18,
-		0xef, 0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee,
-		0x62, 0x53, 0xdb, 0x1f, 0x8f, 0xe1, 0x97, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3,
-		0x3a, 0xee, 0xbe, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x8d, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7,
-		0x3c, 0x0e, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x87,
-		0xed, 0xaf, 0x8b, 0xa9, 0xc7, 0xb0, 0x1b, 0x61, 0xc6, 0x2b, 0x90, 0x71, 0xf5, 0xb7, 0xc6, 0xa2,
-		0xf9, 0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe1, 0xf4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf,
-		0xc1, 0xc9, 0x4e, 0x0e, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2e, 0xe5, 0xdf, 0x14, 0x29, 0x01, 0xf7,
-		0x70, 0x35, 0xc8, 0xae, 0xc1, 0x55, 0xf7, 0x8e, 0xe7, 0xb5, 0xbf, 0x25, 0xbc, 0xc6, 0xb0, 0x11,
-		0xaf, 0x6d, 0xc3, 0x49, 0xce, 0x78, 0xbc, 0x71, 0xfd, 0xdb, 0x22, 0xb1, 0x32, 0xf4, 0x4e, 0x74,
-		0x74, 0x7f, 0x14, 0x16, 0x7c, 0x77, 0x8a, 0xf2, 0xd4, 0x55, 0x3a, 0xaa, 0x3d, 0x06, 0xf3, 0x2f,
-		0x73, 0x66, 0x91, 0xf1, 0xfd, 0xfa, 0xd6, 0xdd, 0x50, 0x6d, 0x42, 0xfe, 0x1a, 0x14, 0x04, 0x79,
-		0xd7, 0x74, 0xb0, 0x66, 0xb5, 0x4d, 0xfd, 0x2d, 0xdc, 0x1a, 0x83, 0xfa, 0x57, 0x7a, 0x86, 0x6a,
-		0x27, 0x04, 0x27, 0xcc, 0x6b, 0x20, 0xf9, 0xb5, 0x8a, 0xa2, 0x77, 0x6c, 0xcb, 0xf1, 0x46, 0x30,
-		0x7e, 0x59, 0x8c, 0x94, 0x8f, 0x5b, 0xa3, 0xb0, 0x52, 0x1d, 0xd8, 0x93, 0xe7, 0x71, 0x43, 0xf2,
-		0x2b, 0x9c, 0x68, 0x3a, 0x40, 0xf1, 0xc4, 0xa1, 0x59, 0x1d, 0x5b, 0x75, 0xc6, 0xc9, 0x7f, 0x7f,
-		0x47, 0x24, 0x0e, 0x0e, 0xe1, 0x89, 0x83, 0x54, 0x74, 0x64, 0xb5, 0x1f, 0x83, 0xe1, 0xab, 0x22,
-		0x71, 0x08, 0x0c, 0xa7, 0x10, 0x05, 0xc3, 0x18, 0x14, 0x7f, 0x57, 0x50, 0x08, 0x0c, 0xa1, 0x78,
-		0x25, 0x58, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xd1, 0x54, 0x7f, 0xef, 0xbb,
-		0xd1, 0x22, 0x4c, 0x0e, 0x41, 0x49, 0x26, 0xe2, 0xc7, 0xae, 0x74, 0xcf, 0x34, 0xda, 0xb0, 0x5f,
-		0x15, 0x99, 0x28, 0x04, 0x23, 0xb6, 0x85, 0x2a, 0x44, 0xe2, 0x76, 0x8d, 0xec, 0x14, 0xc6, 0xa0,
-		0xfb, 0xfb, 0x3d, 0xc6, 0x35, 0x05, 0x96, 0x70, 0x86, 0xea, 0x9f, 0xae, 0x79, 0x80, 0x0f, 0xc7,
-		0x8a, 0xce, 0x5f, 0xeb, 0xa9, 0x7f, 0x76, 0x18, 0x92, 0xe5, 0x90, 0x99, 0x9e, 0x7a, 0x0a, 0x8d,
-		0x7a, 0xcf, 0xa8, 0xf0, 0x93, 0x1f, 0xf0, 0xfe, 0x46, 0xcb, 0xa9, 0xd2, 0x3a, 0x09, 0xf2, 0x68,
-		0xd1, 0x33, 0x9a, 0xec, 0x63, 0x1f, 0xf8, 0x71, 0x1e, 0xa9, 0x79, 0x4a, 0x57, 0x61, 0x3a, 0x52,
-		0xf0, 0x8c, 0xa6, 0xfa, 0x93, 0x9c, 0x2a, 0x17, 0xae, 0x77, 0x4a, 0x17, 0x20, 0x49, 0x8a, 0x97,
-		0xd1, 0xf0, 0x3f, 0xc5, 0xe1, 0x54, 0xbd, 0xf4, 0x11, 0x48, 0x8b, 0xa2, 0x65, 0x34, 0xf4, 0x4f,
-		0x73, 0xa8, 0x0f, 0x21, 0x70, 0x51, 0xb0, 0x8c, 0x86, 0xff, 0x19, 0x01, 0x17, 0x10, 0x02, 0x1f,
-		0xdf, 0x85, 0x5f, 0xfb, 0xb3, 0x49, 0xbe, 0xe8, 0x08, 0xdf, 0x5d, 0x81, 0x29, 0x5e, 0xa9, 0x8c,
-		0x46, 0x7f, 0x82, 0xdf, 0x5c, 0x20, 0x4a, 0x97, 0x60, 0x72, 0x4c, 0x87, 0xff, 0x39, 0x0e, 0x65,
-		0xfa, 0xa5, 0x2a, 0x64, 0x43, 0xd5, 0xc9, 0x68, 0xf8, 0x4f, 0x71, 0x78, 0x18, 0x45, 0x4c, 0xe7,
-		0xd5, 0xc9, 0x68, 0x82, 0x3f, 0x2f, 0x4c, 0xe7, 0x08, 0xe2, 0x36, 0x51, 0x98, 0x8c, 0x46, 0x7f,
-		0x52, 0x78, 0x5d, 0x40, 0x4a, 0x2f, 0x41, 0xc6, 0x5f, 0x6c, 0x46, 0xe3, 0x7f, 0x9a, 0xe3, 0x03,
-		0x0c, 0xf1, 0x40, 0x68, 0xb1, 0x1b, 0x4d, 0xf1, 0x17, 0x84, 0x07, 0x42, 0x28, 0x32, 0x8d, 0x7a,
-		0x0b, 0x98, 0xd1, 0x4c, 0x3f, 0x23, 0xa6, 0x51, 0x4f, 0xfd, 0x42, 0x46, 0x93, 0xe6, 0xfc, 0xd1,
-		0x14, 0x7f, 0x51, 0x8c, 0x26, 0xd5, 0x27, 0x66, 0xf4, 0x56, 0x04, 0xa3, 0x39, 0x7e, 0x56, 0x98,
-		0xd1, 0x53, 0x10, 0x94, 0x1a, 0x80, 0xfa, 0xab, 0x81, 0xd1, 0x7c, 0x9f, 0xe2, 0x7c, 0xb3, 0x7d,
-		0xc5, 0x40, 0xe9, 0x55, 0x38, 0x39, 0xb8, 0x12, 0x18, 0xcd, 0xfa, 0x73, 0x1f, 0xf4, 0xec, 0xdd,
-		0xc2, 0x85, 0x40, 0x69, 0x3b, 0x58, 0x52, 0xc2, 0x55, 0xc0, 0x68, 0xda, 0x4f, 0x7f, 0x10, 0x4d,
-		0xdc, 0xe1, 0x22, 0xa0, 0x54, 0x06, 0x08, 0x16, 0xe0, 0xd1, 0x5c, 0x9f, 0xe1, 0x5c, 0x21, 0x10,
-		0x99, 0x1a, 0x7c, 0xfd, 0x1d, 0x8d, 0xbf, 0x2b, 0xa6, 0x06, 0x47, 0x90, 0xa9, 0x21, 0x96, 0xde,
-		0xd1, 0xe8, 0xcf, 0x8a, 0xa9, 0x21, 0x20, 0x24, 0xb2, 0x43, 0xab, 0xdb, 0x68, 0x86, 0xcf, 0x89,
-		0xc8, 0x0e, 0xa1, 0x4a, 0x9b, 0x30, 0xdb, 0xb7, 0x20, 0x8e, 0xa6, 0xfa, 0x05, 0x4e, 0x25, 0xf5,
-		0xae, 0x87, 0xe1, 0xc5, 0x8b, 0x2f, 0x86, 0xa3, 0xd9, 0x3e, 0xdf, 0xb3, 0x78, 0xf1, 0xb5, 0xb0,
-		0x74, 0x05, 0xd2, 0x66, 0xd7, 0x30, 0xc8, 0xe4, 0x41, 0x47, 0xbf, 0x1b, 0x58, 0xf8, 0xcf, 0xdf,
-		0xe7, 0xde, 0x11, 0x80, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x5d, 0xdc, 0x1a, 0x85, 0xfc, 0xce, 0xf7,
-		0x45, 0xc2, 0x24, 0xda, 0xa5, 0x97, 0x00, 0xd8, 0xd1, 0x08, 0x7d, 0x18, 0x38, 0x02, 0xfb, 0x5f,
-		0xbe, 0xcf, 0x5f, 0xc6, 0x09, 0x20, 0x01, 0x01, 0x7b, 0xb5, 0xe7, 0x68, 0x82, 0xef, 0x46, 0x09,
-		0xe8, 0x88, 0x5c, 0x86, 0xa9, 0x37, 0x5d, 0xcb, 0xf4, 0xd4, 0xf6, 0x28, 0xf4, 0x7f, 0xe5, 0x68,
-		0xa1, 0x4f, 0x1c, 0xd6, 0xb1, 0x1c, 0xec, 0xa9, 0x6d, 0x77, 0x14, 0xf6, 0xbf, 0x71, 0xac, 0x0f,
-		0x20, 0x60, 0x4d, 0x75, 0xbd, 0x71, 0xfa, 0xfd, 0x7b, 0x02, 0x2c, 0x00, 0xc4, 0x68, 0xf2, 0xfb,
-		0x00, 0x1f, 0x8e, 0xc2, 0xfe, 0xbe, 0x30, 0x9a, 0xeb, 0x97, 0x3e, 0x02, 0x19, 0xf2, 0x93, 0xbd,
-		0x61, 0x37, 0x02, 0xfc, 0xdf, 0x39, 0x38, 0x40, 0x90, 0x3b, 0xbb, 0x5e, 0xcb, 0xd3, 0x47, 0x3b,
-		0xfb, 0x7b, 0x7c, 0xa4, 0x85, 0x7e, 0xa9, 0x0c, 0x59, 0xd7, 0x6b, 0xb5, 0xba, 0xbc, 0x3e, 0x1d,
-		0x01, 0xff, 0x1f, 0xdf, 0xf7, 0x8f, 0x2c, 0x7c, 0x0c, 0x19, 0xed, 0x5b, 0x07, 0x9e, 0x6d, 0xd1,
-		0x07, 0x1e, 0xa3, 0x18, 0x3e, 0xe0, 0x0c, 0x21, 0x48, 0xa9, 0x0a, 0x39, 0xd2, 0x17, 0x07, 0xdb,
-		0x98, 0x3e, 0x9d, 0x1a, 0x41, 0xf1, 0x3f, 0xb9, 0x03, 0x22, 0xa0, 0xca, 0x8f, 0x7d, 0xfd, 0xbd,
-		0xc5, 0xd8, 0x37, 0xdf, 0x5b, 0x8c, 0xfd, 0xee, 0x7b, 0x8b, 0xb1, 0x4f, 0x7e, 0x6b, 0x71, 0xe2,
-		0x9b, 0xdf, 0x5a, 0x9c, 0xf8, 0xed, 0x6f, 0x2d, 0x4e, 0x0c, 0x3e, 0x25, 0x86, 0x55, 0x6b, 0xd5,
-		0x62, 0xe7, 0xc3, 0x6f, 0x14, 0xdb, 0xba, 0xb7, 0xdf, 0xdd, 0x5d, 0xd1, 0xac, 0x0e, 0x3d, 0xc6,
-		0x0d, 0x4e, 0x6b, 0xfd, 0x4d, 0x0e, 0xfc, 0x20, 0x46, 0x36, 0xcc, 0xd1, 0xb3, 0x5c, 0xd5, 0x3c,
-		0x1c, 0xf6, 0xad, 0xce, 0x45, 0x48, 0x94, 0xcd, 0x43, 0x74, 0x9a, 0x65, 0x37, 0xa5, 0xeb, 0x18,
-		0xfc, 0x1d, 0xaf, 0x29, 0x72, 0xbd, 0xe3, 0x18, 0x68, 0x3e, 0x78, 0x11, 0x33, 0x76, 0x36, 0xc7,
-		0xdf, 0xae, 0xac, 0xfc, 0x54, 0xec, 0x78, 0xdd, 0x48, 0x97, 0xcd, 0x43, 0xda, 0x8b, 0x46, 0xec,
-		0x8d, 0xa7, 0x47, 0x1e, 0x72, 0x1f, 0x98, 0xd6, 0x2d, 0x93, 0x98, 0x6d, 0xef, 0x8a, 0x03, 0xee,
-		0xc5, 0xde, 0x03, 0xee, 0x57, 0xb1, 0x61, 0x5c, 0x27, 0x7a, 0xdb, 0x04, 0xb2, 0x9b, 0x62, 0xaf,
-		0x13, 0xc3, 0xcf, 0xc4, 0x61, 0xb1, 0xef, 0x2c, 0x9b, 0x47, 0xc0, 0x30, 0x27, 0x94, 0x20, 0x5d,
-		0x13, 0x81, 0x55, 0x80, 0x29, 0x17, 0x6b, 0x96, 0xd9, 0x72, 0xa9, 0x23, 0x12, 0xb2, 0xb8, 0x24,
-		0x8e, 0x30, 0x55, 0xd3, 0x72, 0xf9, 0x5b, 0x92, 0xec, 0xa2, 0xf2, 0xf3, 0xc7, 0x74, 0xc4, 0xb4,
-		0xb8, 0x93, 0xf0, 0xc6, 0x73, 0x63, 0x7a, 0x43, 0x74, 0x22, 0x72, 0xec, 0x3f, 0xae, 0x57, 0x7e,
-		0x36, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0xd3, 0xca, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x57, 0x20,
-		0xb3, 0x2d, 0x74, 0x8e, 0xed, 0x97, 0xbb, 0xc7, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xf9,
-		0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c, 0xf3, 0xbf, 0x53, 0x70, 0x5a, 0xb3, 0xdc, 0x8e, 0xe5,
-		0x2a, 0xec, 0xf9, 0x08, 0xbb, 0xe0, 0x3e, 0xc9, 0x85, 0x9b, 0x46, 0x3f, 0x24, 0x29, 0x5e, 0x87,
-		0xb9, 0x35, 0x92, 0x2a, 0xc8, 0x16, 0x28, 0x78, 0xbc, 0x33, 0xf0, 0x45, 0xd2, 0xe5, 0x48, 0xb5,
-		0xcf, 0x1f, 0x2f, 0x85, 0x45, 0xc5, 0x9f, 0x8c, 0x81, 0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x5f,
-		0xa9, 0xd0, 0x25, 0x00, 0xfa, 0x01, 0x52, 0xf0, 0xc5, 0x50, 0xfe, 0x7c, 0x61, 0x25, 0xdc, 0xb9,
-		0x15, 0x76, 0x27, 0xfa, 0x39, 0x42, 0x86, 0xea, 0x92, 0x9f, 0x4f, 0xbe, 0x06, 0x10, 0x34, 0xa0,
-		0x07, 0xe0, 0x54, 0xb3, 0x5a, 0x5e, 0x2f, 0xcb, 0x0a, 0x7b, 0xb3, 0x7d, 0xb3, 0xd9, 0xa8, 0x57,
-		0xd7, 0xae, 0xae, 0xd5, 0x6b, 0xd2, 0x04, 0x3a, 0x09, 0x28, 0xdc, 0xe8, 0xbf, 0x94, 0x72, 0x02,
-		0x66, 0xc3, 0x72, 0xf6, 0x7a, 0x7c, 0x9c, 0x94, 0x89, 0x7a, 0xc7, 0x36, 0x30, 0x7d, 0xee, 0xa7,
-		0xe8, 0xc2, 0x6b, 0xa3, 0x2b, 0x90, 0x5f, 0xff, 0x57, 0xec, 0x95, 0xe9, 0xb9, 0x00, 0xee, 0xfb,
-		0xbc, 0xb4, 0x0e, 0xb3, 0xaa, 0xa6, 0x61, 0x3b, 0x42, 0x39, 0x22, 0x4f, 0x13, 0x42, 0xfa, 0x24,
-		0x93, 0x23, 0x03, 0xb6, 0x4b, 0x90, 0x72, 0x69, 0xef, 0x47, 0x51, 0x7c, 0x83, 0x53, 0x70, 0xf5,
-		0x92, 0x09, 0xb3, 0xa4, 0xec, 0x53, 0x1d, 0x1c, 0x32, 0xe3, 0xe8, 0x43, 0x86, 0x7f, 0xf0, 0xe5,
-		0x67, 0xe9, 0x73, 0xcd, 0x87, 0xa3, 0xc3, 0x32, 0x20, 0x9c, 0x64, 0x89, 0x73, 0x07, 0x86, 0x62,
-		0xc8, 0x8b, 0xfb, 0x71, 0x83, 0x8f, 0xbe, 0xd9, 0x3f, 0xe4, 0x37, 0x5b, 0x1c, 0x14, 0x03, 0xa1,
-		0x3b, 0x4d, 0x73, 0x56, 0xd6, 0x50, 0xa9, 0x0f, 0x9b, 0xd3, 0x6f, 0x3c, 0x15, 0x5a, 0x9a, 0x18,
-		0x25, 0xff, 0xf3, 0x0c, 0x65, 0xbe, 0x12, 0xbe, 0x8d, 0x3f, 0xf7, 0x7e, 0x2b, 0x01, 0x8b, 0x5c,
-		0x79, 0x57, 0x75, 0xf1, 0xb9, 0x9b, 0xcf, 0xed, 0x62, 0x4f, 0x7d, 0xee, 0x9c, 0x66, 0xe9, 0x22,
-		0x57, 0xcf, 0xf1, 0xe9, 0x48, 0xda, 0x57, 0x78, 0xfb, 0xc2, 0xc0, 0xa7, 0x99, 0x0b, 0xc3, 0xa7,
-		0x71, 0x71, 0x07, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa, 0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3,
-		0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4, 0xd8, 0xcc, 0xa9, 0x9c, 0xfe, 0xfa, 0xbb,
-		0x4b, 0x13, 0xff, 0xfa, 0xdd, 0xa5, 0xc4, 0x9a, 0xe9, 0xfd, 0xe6, 0x57, 0x9e, 0x01, 0x4e, 0xb5,
-		0x66, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x67, 0x29, 0x56, 0x7c, 0x0d, 0xa6, 0x6a, 0x58,
-		0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac, 0xf5, 0x30, 0x5f, 0x82, 0xf4, 0x9a, 0xe9,
-		0xb1, 0xb7, 0xd0, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x17, 0x1b, 0x8f, 0xb4, 0x8d, 0x68, 0x11, 0x60,
-		0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff, 0xad, 0x89, 0x56, 0xa5, 0xf6, 0xdb, 0xff,
-		0x61, 0x71, 0xe2, 0xed, 0xf7, 0x16, 0x27, 0x86, 0x0e, 0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x03,
-		0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2, 0x1f, 0x27, 0x39, 0x1d, 0xdd, 0xf4, 0xce,
-		0x69, 0xce, 0xa1, 0xed, 0xd1, 0x72, 0xc5, 0xda, 0xe3, 0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6,
-		0xc1, 0xc3, 0x5a, 0xdc, 0x83, 0xc9, 0x06, 0xc1, 0x11, 0x17, 0x7b, 0x96, 0xa7, 0x1a, 0x7c, 0xfd,
-		0x61, 0x17, 0x44, 0xca, 0x3e, 0x68, 0x8a, 0x33, 0xa9, 0x2e, 0xbe, 0x65, 0x32, 0xb0, 0xba, 0xc7,
-		0xde, 0x0b, 0x4f, 0xd0, 0xc2, 0x25, 0x4d, 0x04, 0xf4, 0x15, 0xf0, 0x79, 0x98, 0x54, 0xbb, 0xec,
-		0x05, 0x86, 0x04, 0xa9, 0x68, 0xe8, 0x45, 0xf1, 0x3a, 0x4c, 0xf1, 0xc7, 0xa8, 0x48, 0x82, 0xc4,
-		0x01, 0x3e, 0xa4, 0xf7, 0xc9, 0xc9, 0xe4, 0x27, 0x5a, 0x81, 0x49, 0x6a, 0x3c, 0xff, 0xe0, 0xa5,
-		0xb0, 0xd2, 0x67, 0xfd, 0x0a, 0x35, 0x52, 0x66, 0x6a, 0xc5, 0x6b, 0x90, 0xae, 0x59, 0x1d, 0xdd,
-		0xb4, 0xa2, 0x6c, 0x19, 0xc6, 0x46, 0x6d, 0xb6, 0xbb, 0x3c, 0x2a, 0x64, 0x76, 0x81, 0x4e, 0x42,
-		0x8a, 0x7d, 0x27, 0xc0, 0x5f, 0xc2, 0xe0, 0x57, 0xc5, 0x2a, 0x4c, 0x51, 0xee, 0x2d, 0x9b, 0x24,
-		0x7f, 0xff, 0x95, 0xcc, 0x0c, 0xff, 0x6a, 0x8c, 0xd3, 0xc7, 0x03, 0x63, 0x11, 0x24, 0x5b, 0xaa,
-		0xa7, 0xf2, 0x7e, 0xd3, 0xdf, 0xc5, 0x8f, 0x42, 0x9a, 0x93, 0xb8, 0xe8, 0x3c, 0x24, 0x2c, 0xdb,
-		0xe5, 0xaf, 0x51, 0x2c, 0x0c, 0xeb, 0xca, 0x96, 0x5d, 0x49, 0x92, 0x98, 0x91, 0x89, 0x72, 0x45,
-		0x1e, 0x1a, 0x16, 0x2f, 0x86, 0xc2, 0x22, 0x34, 0xe4, 0xa1, 0x9f, 0x6c, 0x48, 0xfb, 0xc2, 0xc1,
-		0x0f, 0x96, 0xcf, 0xc5, 0x61, 0x31, 0xd4, 0x7a, 0x13, 0x3b, 0xae, 0x6e, 0x99, 0x2c, 0xa2, 0x78,
-		0xb4, 0xa0, 0x90, 0x91, 0xbc, 0x7d, 0x48, 0xb8, 0x7c, 0x04, 0x12, 0x65, 0xdb, 0x46, 0x0b, 0x90,
-		0xa6, 0xd7, 0x9a, 0xc5, 0xe2, 0x25, 0x29, 0xfb, 0xd7, 0xa4, 0xcd, 0xb5, 0xf6, 0xbc, 0x5b, 0xaa,
-		0xe3, 0x7f, 0x4a, 0x27, 0xae, 0x8b, 0x97, 0x21, 0x53, 0xb5, 0x4c, 0x17, 0x9b, 0x6e, 0x97, 0x56,
-		0x36, 0xbb, 0x86, 0xa5, 0x1d, 0x70, 0x06, 0x76, 0x41, 0x1c, 0xae, 0xda, 0x36, 0x45, 0x26, 0x65,
-		0xf2, 0x93, 0xcd, 0xd9, 0x4a, 0x73, 0xa8, 0x8b, 0x2e, 0x1f, 0xdf, 0x45, 0xbc, 0x93, 0xbe, 0x8f,
-		0x7e, 0x10, 0x83, 0x07, 0xfb, 0x27, 0xd4, 0x01, 0x3e, 0x74, 0x8f, 0x3b, 0x9f, 0x5e, 0x83, 0x4c,
-		0x83, 0x7e, 0xcf, 0x7e, 0x1d, 0x1f, 0xa2, 0x05, 0x98, 0xc2, 0xad, 0xf3, 0x17, 0x2e, 0x3c, 0x77,
-		0x99, 0x45, 0xfb, 0xcb, 0x13, 0xb2, 0x10, 0xa0, 0x45, 0xc8, 0xb8, 0x58, 0xb3, 0xcf, 0x5f, 0xb8,
-		0x78, 0xf0, 0x1c, 0x0b, 0xaf, 0x97, 0x27, 0xe4, 0x40, 0x54, 0x4a, 0x93, 0x5e, 0xbf, 0xff, 0xb9,
-		0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6, 0xc8, 0xa7, 0x27, 0x61, 0x39, 0x8c,
-		0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0x9f, 0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6,
-		0x90, 0x95, 0xe2, 0x48, 0x4f, 0x16, 0x7f, 0x25, 0x06, 0xb9, 0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74,
-		0x05, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde, 0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75,
-		0x74, 0x89, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0xf3, 0xaa, 0x11, 0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88,
-		0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad, 0x5b, 0xfc, 0xa3, 0xd5, 0x84, 0x2c,
-		0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c, 0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5,
-		0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x15, 0x98, 0xb2, 0xbb, 0xbb, 0x8a, 0xc8, 0x18,
-		0xd9, 0xf3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06, 0x48, 0xd9, 0xdd, 0x5d, 0x12, 0x2d,
-		0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4, 0xdf, 0x28, 0xf0, 0x1e, 0x28, 0xb6,
-		0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd2, 0x77, 0xa1, 0x12, 0xb2, 0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0x3c,
-		0x80, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0x2f, 0x04, 0xf6, 0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2,
-		0x78, 0x9f, 0x65, 0x95, 0x57, 0x86, 0x46, 0xe7, 0xa5, 0xe3, 0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd,
-		0xd3, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d, 0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xa3,
-		0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a, 0x5e, 0x86, 0xe9, 0x86, 0xea, 0x78,
-		0x4d, 0xec, 0xbd, 0x8c, 0xd5, 0x16, 0x76, 0xa2, 0xab, 0xee, 0xb4, 0x58, 0x75, 0x11, 0x24, 0xe9,
-		0xd2, 0xca, 0x56, 0x1d, 0xfa, 0xbb, 0xb8, 0x0f, 0x49, 0xfa, 0x3e, 0xa4, 0xbf, 0x22, 0x73, 0x04,
-		0x5b, 0x91, 0x49, 0x2e, 0x3d, 0xf4, 0xb0, 0x2b, 0x8e, 0x11, 0xe8, 0x05, 0x7a, 0x41, 0xac, 0xab,
-		0x89, 0xa3, 0xd7, 0x55, 0x1e, 0x88, 0x7c, 0x75, 0x35, 0x60, 0xaa, 0x42, 0x52, 0xf1, 0x5a, 0xcd,
-		0x37, 0x24, 0x16, 0x18, 0x82, 0x36, 0x60, 0xc6, 0x56, 0x1d, 0x8f, 0x7e, 0x1a, 0xb2, 0x4f, 0x7b,
-		0xc1, 0x63, 0x7d, 0xa9, 0x7f, 0xe6, 0x45, 0x3a, 0xcb, 0xef, 0x32, 0x6d, 0x87, 0x85, 0xc5, 0xff,
-		0x98, 0x84, 0x14, 0x77, 0xc6, 0x47, 0x60, 0x8a, 0xbb, 0x95, 0x47, 0xe7, 0x43, 0x2b, 0xfd, 0x0b,
-		0xd3, 0x8a, 0xbf, 0x80, 0x70, 0x3e, 0x81, 0x41, 0x8f, 0x43, 0x5a, 0xdb, 0x57, 0x75, 0x53, 0xd1,
-		0x5b, 0xbc, 0x20, 0xcc, 0xbe, 0xf7, 0xee, 0xd2, 0x54, 0x95, 0xc8, 0xd6, 0x6a, 0xf2, 0x14, 0x6d,
-		0x5c, 0x6b, 0x91, 0x4a, 0x60, 0x1f, 0xeb, 0xed, 0x7d, 0x8f, 0xcf, 0x30, 0x7e, 0x85, 0x5e, 0x84,
-		0x24, 0x09, 0x08, 0xfe, 0xe1, 0xe0, 0x42, 0x5f, 0x85, 0xef, 0x6f, 0xa1, 0x2b, 0x69, 0x72, 0xe3,
-		0x4f, 0xfe, 0xfb, 0xa5, 0x98, 0x4c, 0x11, 0xa8, 0x0a, 0xd3, 0x86, 0xea, 0x7a, 0x0a, 0x5d, 0xc1,
-		0xc8, 0xed, 0x27, 0x29, 0xc5, 0xe9, 0x7e, 0x87, 0x70, 0xc7, 0x72, 0xd3, 0xb3, 0x04, 0xc5, 0x44,
-		0x2d, 0x74, 0x16, 0x24, 0x4a, 0xa2, 0x59, 0x9d, 0x8e, 0xee, 0xb1, 0xda, 0x2a, 0x45, 0xfd, 0x9e,
-		0x27, 0xf2, 0x2a, 0x15, 0xd3, 0x0a, 0xeb, 0x01, 0xc8, 0xd0, 0x4f, 0x95, 0xa8, 0x0a, 0x7b, 0x09,
-		0x37, 0x4d, 0x04, 0xb4, 0xf1, 0x0c, 0xcc, 0x04, 0xf9, 0x91, 0xa9, 0xa4, 0x19, 0x4b, 0x20, 0xa6,
-		0x8a, 0xcf, 0xc2, 0xbc, 0x89, 0x6f, 0xd3, 0xd7, 0x82, 0x23, 0xda, 0x19, 0xaa, 0x8d, 0x48, 0xdb,
-		0x8d, 0x28, 0xe2, 0x31, 0xc8, 0x6b, 0xc2, 0xf9, 0x4c, 0x17, 0xa8, 0xee, 0xb4, 0x2f, 0xa5, 0x6a,
-		0xa7, 0x21, 0xad, 0xda, 0x36, 0x53, 0xc8, 0xf2, 0xfc, 0x68, 0xdb, 0xb4, 0xe9, 0x49, 0x98, 0xa5,
-		0x7d, 0x74, 0xb0, 0xdb, 0x35, 0x3c, 0x4e, 0x92, 0xa3, 0x3a, 0x33, 0xa4, 0x41, 0x66, 0x72, 0xaa,
-		0xfb, 0x08, 0x4c, 0xe3, 0x9b, 0x7a, 0x0b, 0x9b, 0x1a, 0x66, 0x7a, 0xd3, 0x54, 0x2f, 0x27, 0x84,
-		0x54, 0xe9, 0x09, 0xf0, 0xf3, 0x9e, 0x22, 0x72, 0x72, 0x9e, 0xf1, 0x09, 0x79, 0x99, 0x89, 0x8b,
-		0x05, 0x48, 0xd6, 0x54, 0x4f, 0x25, 0x05, 0x86, 0x77, 0x9b, 0x2d, 0x34, 0x39, 0x99, 0xfc, 0x2c,
-		0xbe, 0x1f, 0x87, 0xe4, 0x0d, 0xcb, 0xc3, 0xe8, 0xf9, 0x50, 0x01, 0x98, 0x1f, 0x14, 0xcf, 0x4d,
-		0xbd, 0x6d, 0xe2, 0xd6, 0x86, 0xdb, 0x0e, 0xfd, 0x5f, 0x81, 0x20, 0x9c, 0xe2, 0x91, 0x70, 0x9a,
-		0x87, 0x49, 0xc7, 0xea, 0x9a, 0x2d, 0xf1, 0xfe, 0x2a, 0xbd, 0x40, 0x75, 0x48, 0xfb, 0x51, 0x92,
-		0x1c, 0x15, 0x25, 0x33, 0x24, 0x4a, 0x48, 0x0c, 0x73, 0x81, 0x3c, 0xb5, 0xcb, 0x83, 0xa5, 0x02,
-		0x19, 0x3f, 0x79, 0xf1, 0x68, 0x1b, 0x2f, 0x60, 0x03, 0x18, 0x59, 0x4c, 0xfc, 0xb1, 0xf7, 0x9d,
-		0xc7, 0x22, 0x4e, 0xf2, 0x1b, 0xb8, 0xf7, 0x22, 0x61, 0xc5, 0xff, 0xc7, 0xc1, 0x14, 0xed, 0x57,
-		0x10, 0x56, 0xec, 0xff, 0x1c, 0x3c, 0x08, 0x19, 0x57, 0x6f, 0x9b, 0xaa, 0xd7, 0x75, 0x30, 0x8f,
-		0xbc, 0x40, 0x50, 0xfc, 0x5a, 0x0c, 0x52, 0x2c, 0x92, 0x43, 0x7e, 0x8b, 0x0d, 0xf6, 0x5b, 0x7c,
-		0x98, 0xdf, 0x12, 0xf7, 0xee, 0xb7, 0x32, 0x80, 0x6f, 0x8c, 0xcb, 0x3f, 0x3d, 0x1f, 0x50, 0x31,
-		0x30, 0x13, 0x9b, 0x7a, 0x9b, 0x4f, 0xd4, 0x10, 0xa8, 0xf8, 0xef, 0x62, 0xa4, 0x88, 0xe5, 0xed,
-		0xa8, 0x0c, 0xd3, 0xc2, 0x2e, 0x65, 0xcf, 0x50, 0xdb, 0x3c, 0x76, 0x1e, 0x1a, 0x6a, 0xdc, 0x55,
-		0x43, 0x6d, 0xcb, 0x59, 0x6e, 0x0f, 0xb9, 0x18, 0x3c, 0x0e, 0xf1, 0x21, 0xe3, 0x10, 0x19, 0xf8,
-		0xc4, 0xbd, 0x0d, 0x7c, 0x64, 0x88, 0x92, 0xbd, 0x43, 0xf4, 0xe5, 0x38, 0xdd, 0xcc, 0xd8, 0x96,
-		0xab, 0x1a, 0x3f, 0x8c, 0x19, 0xf1, 0x00, 0x64, 0x6c, 0xcb, 0x50, 0x58, 0x0b, 0x7b, 0xaf, 0x3b,
-		0x6d, 0x5b, 0x86, 0xdc, 0x37, 0xec, 0x93, 0xf7, 0x69, 0xba, 0xa4, 0xee, 0x83, 0xd7, 0xa6, 0x7a,
-		0xbd, 0xe6, 0x40, 0x8e, 0xb9, 0x82, 0xaf, 0x65, 0xcf, 0x12, 0x1f, 0xd0, 0xc5, 0x31, 0xd6, 0xbf,
-		0xf6, 0x32, 0xb3, 0x99, 0xa6, 0xcc, 0xf5, 0x08, 0x82, 0xa5, 0xfe, 0x41, 0xbb, 0xe0, 0x70, 0x58,
-		0xca, 0x5c, 0xaf, 0xf8, 0x97, 0x62, 0x00, 0xeb, 0xc4, 0xb3, 0xb4, 0xbf, 0x64, 0x15, 0x72, 0xa9,
-		0x09, 0x4a, 0xe4, 0xce, 0x8b, 0xc3, 0x06, 0x8d, 0xdf, 0x3f, 0xe7, 0x86, 0xed, 0xae, 0xc2, 0x74,
-		0x10, 0x8c, 0x2e, 0x16, 0xc6, 0x2c, 0x1e, 0x51, 0x55, 0x37, 0xb1, 0x27, 0xe7, 0x6e, 0x86, 0xae,
-		0x8a, 0xff, 0x24, 0x06, 0x19, 0x6a, 0xd3, 0x06, 0xf6, 0xd4, 0xc8, 0x18, 0xc6, 0xee, 0x7d, 0x0c,
-		0x1f, 0x02, 0x60, 0x34, 0xae, 0xfe, 0x16, 0xe6, 0x91, 0x95, 0xa1, 0x92, 0xa6, 0xfe, 0x16, 0x46,
-		0x17, 0x7d, 0x87, 0x27, 0x8e, 0x76, 0xb8, 0xa8, 0xba, 0xb9, 0xdb, 0x4f, 0xc1, 0x14, 0xfd, 0x57,
-		0x4d, 0xb7, 0x5d, 0x5e, 0x48, 0xa7, 0xcc, 0x6e, 0x67, 0xfb, 0xb6, 0x5b, 0x7c, 0x13, 0xa6, 0xb6,
-		0x6f, 0xb3, 0xb3, 0x91, 0x07, 0x20, 0xe3, 0x58, 0x16, 0x5f, 0x93, 0x59, 0x2d, 0x94, 0x26, 0x02,
-		0xba, 0x04, 0x89, 0xf3, 0x80, 0x78, 0x70, 0x1e, 0x10, 0x1c, 0x68, 0x24, 0xc6, 0x3a, 0xd0, 0x78,
-		0xf2, 0xb7, 0x62, 0x90, 0x0d, 0xe5, 0x07, 0xf4, 0x1c, 0x9c, 0xa8, 0xac, 0x6f, 0x55, 0xaf, 0x2b,
-		0x6b, 0x35, 0xe5, 0xea, 0x7a, 0x79, 0x35, 0xf8, 0x72, 0x69, 0xe1, 0xe4, 0x9d, 0xbb, 0xcb, 0x28,
-		0xa4, 0xbb, 0x63, 0xd2, 0x73, 0x7a, 0x74, 0x0e, 0xe6, 0xa3, 0x90, 0x72, 0xa5, 0x59, 0xdf, 0xdc,
-		0x96, 0x62, 0x0b, 0x27, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21, 0xca, 0xbb, 0x2e, 0x36, 0xbd, 0x7e,
-		0x40, 0x75, 0x6b, 0x63, 0x63, 0x6d, 0x5b, 0x8a, 0xf7, 0x01, 0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3,
-		0x80, 0xcd, 0xb5, 0x75, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d, 0xce, 0x87, 0xb4, 0x37, 0x75, 0x63,
-		0x21, 0xfd, 0xf1, 0xcf, 0x2f, 0x4e, 0xfc, 0xd2, 0x2f, 0x2e, 0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39,
-		0x02, 0x3d, 0x0d, 0xa7, 0x9a, 0x6b, 0xab, 0x9b, 0xf5, 0x9a, 0xb2, 0xd1, 0x5c, 0x15, 0x27, 0xdd,
-		0xa2, 0x77, 0x33, 0x77, 0xee, 0x2e, 0x67, 0x79, 0x97, 0x86, 0x69, 0x37, 0xe4, 0xfa, 0x8d, 0xad,
-		0xed, 0xba, 0x14, 0x63, 0xda, 0x0d, 0x07, 0xdf, 0xb4, 0x3c, 0xf6, 0xbf, 0xdc, 0x9e, 0x85, 0xd3,
-		0x03, 0xb4, 0xfd, 0x8e, 0xcd, 0xde, 0xb9, 0xbb, 0x3c, 0xdd, 0x70, 0x30, 0x9b, 0x3f, 0x14, 0xb1,
-		0x02, 0x85, 0x7e, 0xc4, 0x56, 0x63, 0xab, 0x59, 0x5e, 0x97, 0x96, 0x17, 0xa4, 0x3b, 0x77, 0x97,
-		0x73, 0x22, 0x19, 0x12, 0xfd, 0xa0, 0x67, 0x1f, 0xe6, 0x8e, 0xe7, 0xfd, 0xa7, 0xe0, 0x51, 0x7e,
-		0x06, 0xe8, 0x7a, 0xea, 0x81, 0x6e, 0xb6, 0xfd, 0xc3, 0x5b, 0x7e, 0xcd, 0x77, 0x3e, 0x27, 0xf9,
-		0x39, 0xa3, 0x90, 0x8e, 0x38, 0xc2, 0x1d, 0xfa, 0xe4, 0x72, 0x61, 0xc4, 0x43, 0xbd, 0xd1, 0x5b,
-		0xa7, 0xe1, 0xc7, 0xc3, 0x0b, 0x23, 0x0e, 0xa1, 0x17, 0x8e, 0xdc, 0xdc, 0x15, 0x3f, 0x11, 0x83,
-		0xfc, 0xcb, 0xba, 0xeb, 0x59, 0x8e, 0xae, 0xa9, 0x06, 0xfd, 0x5e, 0xe9, 0xe2, 0xb8, 0xb9, 0xb5,
-		0x67, 0xaa, 0xbf, 0x04, 0xa9, 0x9b, 0xaa, 0xc1, 0x92, 0x5a, 0xf8, 0x59, 0x40, 0xaf, 0xfb, 0x82,
-		0xd4, 0x26, 0x08, 0x18, 0xac, 0xf8, 0xa5, 0x38, 0xcc, 0xd0, 0xc9, 0xe0, 0xb2, 0x7f, 0xc5, 0x45,
-		0xf6, 0x58, 0x0d, 0x48, 0x3a, 0xaa, 0xc7, 0x0f, 0x0d, 0x2b, 0x3f, 0xc2, 0xcf, 0x81, 0x1f, 0x1f,
-		0x7d, 0x9a, 0xbb, 0xd2, 0x7f, 0x54, 0x4c, 0x99, 0xd0, 0xab, 0x90, 0xee, 0xa8, 0xb7, 0x15, 0xca,
-		0x1a, 0xbf, 0x0f, 0xac, 0x53, 0x1d, 0xf5, 0x36, 0xb1, 0x15, 0xb5, 0x60, 0x86, 0x10, 0x6b, 0xfb,
-		0xaa, 0xd9, 0xc6, 0x8c, 0x3f, 0x71, 0x1f, 0xf8, 0xa7, 0x3b, 0xea, 0xed, 0x2a, 0xe5, 0x24, 0x77,
-		0x29, 0xa5, 0x3f, 0xf5, 0xce, 0xd2, 0x04, 0x3d, 0x66, 0xff, 0xb5, 0x18, 0x40, 0xe0, 0x2e, 0xf4,
-		0xff, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0, 0x33, 0xc3, 0x06, 0xa2, 0xc7, 0xd9,
-		0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb, 0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76,
-		0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x63, 0x2c, 0xf2, 0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd,
-		0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa, 0x63, 0x99, 0xfa, 0x01, 0x0f, 0xbb,
-		0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0x97, 0x9a, 0xde, 0xa1, 0x38, 0xf1, 0x14, 0xd7, 0x04,
-		0x75, 0x0b, 0xef, 0xba, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9, 0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1,
-		0xbd, 0x43, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xcd, 0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98,
-		0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2, 0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4f,
-		0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25, 0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7e,
-		0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x97, 0x5a, 0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6,
-		0xeb, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x06, 0xc7, 0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b,
-		0x87, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a, 0x3e, 0x24, 0xa3, 0xc5, 0x79, 0x1a,
-		0x94, 0x86, 0x94, 0x88, 0x6f, 0xaa, 0xba, 0x21, 0x3e, 0x40, 0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca,
-		0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x14, 0x57, 0x1c, 0x16, 0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9,
-		0xa6, 0xcc, 0x11, 0x68, 0x1b, 0x52, 0x9e, 0x75, 0x80, 0x4d, 0xee, 0xa4, 0x63, 0x45, 0xf5, 0x80,
-		0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7, 0x59, 0x41, 0xb4, 0xaf, 0x92, 0x7d,
-		0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49, 0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6,
-		0x5f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3, 0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40,
-		0xea, 0x9a, 0xbb, 0x96, 0x49, 0x3f, 0x13, 0xe5, 0xc5, 0x78, 0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc,
-		0x65, 0x56, 0x95, 0x5f, 0x87, 0x7c, 0xa0, 0x4a, 0xe7, 0x4e, 0xe6, 0x18, 0x73, 0x67, 0xda, 0xc7,
-		0x92, 0x56, 0xf4, 0x32, 0x40, 0x30, 0x31, 0xe9, 0xf1, 0x40, 0x76, 0xf8, 0x18, 0x06, 0xb3, 0x5b,
-		0x6c, 0xb3, 0x02, 0x2c, 0x32, 0x60, 0xae, 0xa3, 0x9b, 0x8a, 0x8b, 0x8d, 0x3d, 0x85, 0xbb, 0x8a,
-		0x50, 0x66, 0xef, 0xc3, 0xd0, 0xce, 0x76, 0x74, 0xb3, 0x89, 0x8d, 0xbd, 0x9a, 0x4f, 0x5b, 0xca,
-		0x7d, 0xfc, 0x9d, 0xa5, 0x09, 0x3e, 0x97, 0x26, 0x8a, 0x0d, 0x7a, 0x44, 0xcd, 0xa7, 0x01, 0x76,
-		0xd1, 0x45, 0xc8, 0xa8, 0xe2, 0x82, 0x1e, 0x1c, 0x1c, 0x35, 0x8d, 0x02, 0x55, 0x36, 0x3b, 0xdf,
-		0xfe, 0xb7, 0xcb, 0xb1, 0xe2, 0x2f, 0xc6, 0x20, 0x55, 0xbb, 0xd1, 0x50, 0x75, 0x07, 0xd5, 0x61,
-		0x36, 0x08, 0xa8, 0x71, 0xe7, 0x66, 0x10, 0x83, 0x62, 0x72, 0xd6, 0x87, 0xed, 0x1a, 0x8f, 0xa4,
-		0xe9, 0xdd, 0x4f, 0xf6, 0x74, 0xbc, 0x0e, 0x53, 0xcc, 0x4a, 0x17, 0x95, 0x60, 0xd2, 0x26, 0x3f,
-		0xf8, 0x89, 0xfc, 0xe2, 0xd0, 0x40, 0xa4, 0xfa, 0xfe, 0x09, 0x22, 0x81, 0x14, 0x7f, 0x10, 0x03,
-		0xa8, 0xdd, 0xb8, 0xb1, 0xed, 0xe8, 0xb6, 0x81, 0xbd, 0xfb, 0xd5, 0xe3, 0x75, 0x38, 0x11, 0xda,
-		0x9a, 0x38, 0xda, 0xd8, 0xbd, 0x9e, 0x0b, 0x36, 0x27, 0x8e, 0x36, 0x90, 0xad, 0xe5, 0x7a, 0x3e,
-		0x5b, 0x62, 0x6c, 0xb6, 0x9a, 0xeb, 0x0d, 0x76, 0x63, 0x13, 0xb2, 0x41, 0xf7, 0x5d, 0x54, 0x83,
-		0xb4, 0xc7, 0x7f, 0x73, 0x6f, 0x16, 0x87, 0x7b, 0x53, 0xc0, 0xb8, 0x47, 0x7d, 0x64, 0xf1, 0xff,
-		0x10, 0xa7, 0xfa, 0x11, 0xfb, 0x87, 0x2b, 0x8c, 0x48, 0xee, 0xe5, 0xb9, 0xf1, 0x7e, 0x54, 0x14,
-		0x9c, 0xab, 0xc7, 0xab, 0x1f, 0x8b, 0xc3, 0xdc, 0x8e, 0xc8, 0x36, 0x7f, 0x68, 0x3d, 0xd1, 0x80,
-		0x29, 0x6c, 0x7a, 0x8e, 0x4e, 0x5d, 0x41, 0xc6, 0xfa, 0xd9, 0x61, 0x63, 0x3d, 0xa0, 0x2f, 0xf4,
-		0xff, 0x15, 0x89, 0x73, 0x6d, 0x4e, 0xd3, 0xe3, 0x85, 0x7f, 0x13, 0x87, 0xc2, 0x30, 0x24, 0x3a,
-		0x03, 0x33, 0x9a, 0x83, 0xa9, 0x40, 0x89, 0x1c, 0xae, 0xe5, 0x85, 0x98, 0x27, 0xfd, 0x0d, 0x20,
-		0x05, 0x14, 0x09, 0x2c, 0xa2, 0x7a, 0xec, 0x8a, 0x29, 0x1f, 0x80, 0x69, 0xda, 0xc7, 0x30, 0xa3,
-		0x9b, 0xba, 0xa7, 0xab, 0x86, 0xb2, 0xab, 0x1a, 0xaa, 0xa9, 0xdd, 0x4b, 0x65, 0xd9, 0x9f, 0xa8,
-		0xf3, 0x9c, 0xb4, 0xc2, 0x38, 0xd1, 0x0d, 0x98, 0x12, 0xf4, 0xc9, 0xfb, 0x40, 0x2f, 0xc8, 0x42,
-		0x55, 0xd4, 0xef, 0xc4, 0x61, 0x56, 0xc6, 0xad, 0x3f, 0x5a, 0x6e, 0xfd, 0x51, 0x00, 0x36, 0xe1,
-		0x48, 0x1e, 0xbc, 0x07, 0xcf, 0xf6, 0x4f, 0xe0, 0x0c, 0xe3, 0xab, 0xb9, 0x5e, 0xc8, 0xb7, 0xdf,
-		0x88, 0x43, 0x2e, 0xec, 0xdb, 0x3f, 0x02, 0xeb, 0x02, 0x5a, 0x0b, 0xb2, 0x41, 0x92, 0xff, 0xa7,
-		0xd5, 0x21, 0xd9, 0xa0, 0x2f, 0xea, 0x8e, 0x4e, 0x03, 0xdf, 0x8b, 0x43, 0xaa, 0xa1, 0x3a, 0x6a,
-		0xc7, 0x45, 0xd7, 0xfa, 0x0a, 0x38, 0x71, 0xca, 0xd6, 0xf7, 0xff, 0xb4, 0xf9, 0xa6, 0x9e, 0x85,
-		0xdc, 0xa7, 0x06, 0xd4, 0x6f, 0x8f, 0x41, 0x9e, 0x6c, 0x11, 0x43, 0x0f, 0xe4, 0xe3, 0xf4, 0x31,
-		0x23, 0xd9, 0xe3, 0x05, 0x4f, 0x83, 0xd0, 0x12, 0x64, 0x89, 0x5a, 0x90, 0xe8, 0x88, 0x0e, 0x74,
-		0xd4, 0xdb, 0x75, 0x26, 0x41, 0xcf, 0x00, 0xda, 0xf7, 0x37, 0xed, 0x4a, 0xe0, 0x02, 0xa2, 0x37,
-		0x1b, 0xb4, 0x08, 0xf5, 0x87, 0x00, 0x88, 0x15, 0x0a, 0x7b, 0xc9, 0x8b, 0xed, 0x71, 0x32, 0x44,
-		0x52, 0xa3, 0x2f, 0x7a, 0xfd, 0x04, 0xab, 0x05, 0x7b, 0x76, 0x8f, 0xbc, 0x0c, 0x5f, 0x3f, 0x5e,
-		0xa4, 0x7e, 0xef, 0xdd, 0xa5, 0x85, 0x43, 0xb5, 0x63, 0x94, 0x8a, 0x03, 0x28, 0x8b, 0xb4, 0x36,
-		0x8c, 0xee, 0x3a, 0x43, 0x11, 0xfc, 0xf9, 0x18, 0xa0, 0x20, 0xe5, 0xca, 0xd8, 0xb5, 0xc9, 0xb6,
-		0x86, 0x14, 0xbd, 0xa1, 0x0a, 0x35, 0x76, 0x74, 0xd1, 0x1b, 0xe0, 0x45, 0xd1, 0x1b, 0x9a, 0x11,
-		0x97, 0x83, 0x04, 0x17, 0xe7, 0x63, 0x38, 0xe0, 0x0d, 0xbd, 0x95, 0xaa, 0xa5, 0x0b, 0x74, 0x5f,
-		0x0e, 0x9b, 0x28, 0xfe, 0x4e, 0x0c, 0x4e, 0xf7, 0x45, 0x93, 0x6f, 0xec, 0x1f, 0x03, 0xe4, 0x84,
-		0x1a, 0xf9, 0xbf, 0xcc, 0x63, 0x46, 0x1f, 0x3b, 0x38, 0x67, 0x9d, 0xbe, 0x5c, 0xf9, 0x61, 0xe5,
-		0x68, 0xf6, 0xe6, 0xde, 0x3f, 0x8a, 0xc1, 0x7c, 0xd8, 0x18, 0xbf, 0x5b, 0x9b, 0x90, 0x0b, 0xdb,
-		0xc2, 0x3b, 0xf4, 0xe8, 0x38, 0x1d, 0xe2, 0x7d, 0x89, 0xe0, 0xd1, 0x2b, 0xc1, 0xc4, 0x65, 0x87,
-		0x45, 0xcf, 0x8d, 0xed, 0x1b, 0x61, 0x53, 0xef, 0x04, 0x4e, 0x8a, 0x2a, 0x26, 0xd9, 0xb0, 0x2c,
-		0x03, 0xfd, 0x71, 0x98, 0x35, 0x2d, 0x4f, 0x21, 0x51, 0x8e, 0x5b, 0x0a, 0xdf, 0xb9, 0xb2, 0xec,
-		0xf7, 0xca, 0xf1, 0x5c, 0xf6, 0x9d, 0x77, 0x97, 0xfa, 0xa9, 0x7a, 0xfc, 0x38, 0x63, 0x5a, 0x5e,
-		0x85, 0xb6, 0x6f, 0xb3, 0x7d, 0xad, 0x03, 0xd3, 0xd1, 0x5b, 0xb3, 0x6c, 0xb9, 0x71, 0xec, 0x5b,
-		0x4f, 0x1f, 0x75, 0xdb, 0xdc, 0x6e, 0xe8, 0x9e, 0xec, 0x9d, 0xa6, 0xdf, 0x7f, 0x67, 0x29, 0xf6,
-		0xe4, 0x57, 0x63, 0x00, 0xc1, 0x16, 0x1e, 0x3d, 0x0d, 0xa7, 0x2a, 0x5b, 0x9b, 0x35, 0xa5, 0xb9,
-		0x5d, 0xde, 0xde, 0x69, 0x46, 0xdf, 0x7c, 0x16, 0x67, 0xc2, 0xae, 0x8d, 0x35, 0x7d, 0x4f, 0xc7,
-		0x2d, 0xf4, 0x38, 0xcc, 0x47, 0xb5, 0xc9, 0x55, 0xbd, 0x26, 0xc5, 0x16, 0x72, 0x77, 0xee, 0x2e,
-		0xa7, 0x59, 0x75, 0x84, 0x5b, 0xe8, 0x2c, 0x9c, 0xe8, 0xd7, 0x5b, 0xdb, 0x5c, 0x95, 0xe2, 0x0b,
-		0xd3, 0x77, 0xee, 0x2e, 0x67, 0xfc, 0x32, 0x0a, 0x15, 0x01, 0x85, 0x35, 0x39, 0x5f, 0x62, 0x01,
-		0xee, 0xdc, 0x5d, 0x4e, 0x31, 0xb7, 0x2d, 0x24, 0x3f, 0xfe, 0xf9, 0xc5, 0x89, 0xca, 0xd5, 0xa1,
-		0xa7, 0xbe, 0x4f, 0x1f, 0xe9, 0xb1, 0xdb, 0xfe, 0x49, 0x6e, 0xe4, 0xa8, 0xf7, 0xff, 0x06, 0x00,
-		0x00, 0xff, 0xff, 0xdf, 0xc6, 0xe4, 0x92, 0x04, 0x66, 0x00, 0x00,
+		// 7533 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x70, 0x24, 0xd7,
+		0x75, 0x1e, 0xe6, 0x81, 0xc1, 0xcc, 0xc1, 0x60, 0xa6, 0xd1, 0xc0, 0x2e, 0x67, 0xb1, 0x22, 0x00,
+		0x0e, 0x5f, 0xcb, 0x17, 0x96, 0x5c, 0x72, 0x77, 0xb9, 0xb3, 0x96, 0x18, 0x0c, 0x66, 0x76, 0x89,
+		0x25, 0x1e, 0xc3, 0x1e, 0x60, 0xf9, 0x70, 0x9c, 0xae, 0x46, 0xcf, 0xc5, 0xa0, 0xb9, 0x3d, 0xdd,
+		0xed, 0xee, 0x9e, 0xdd, 0x05, 0xcb, 0x49, 0xd1, 0xa5, 0x3c, 0xac, 0x4d, 0xc5, 0x91, 0xed, 0x54,
+		0x2c, 0xcb, 0x5a, 0x85, 0xb2, 0x9c, 0xc8, 0x51, 0x94, 0x87, 0x2d, 0x45, 0x89, 0xe3, 0x4a, 0xe2,
+		0xa4, 0x2a, 0x89, 0xac, 0x1f, 0x29, 0xd9, 0x3f, 0xe2, 0x47, 0x12, 0xc6, 0xa1, 0x54, 0x89, 0xa2,
+		0x28, 0xb1, 0x23, 0x33, 0x55, 0x49, 0xa9, 0x94, 0x4a, 0x9d, 0xfb, 0xe8, 0xc7, 0x3c, 0x30, 0x03,
+		0x7a, 0x29, 0xbb, 0xca, 0xbf, 0x66, 0xee, 0xb9, 0xe7, 0x7c, 0x7d, 0xee, 0xb9, 0xe7, 0xde, 0x7b,
+		0xee, 0xb9, 0xb7, 0x1b, 0xfe, 0xe0, 0x32, 0x2c, 0xb7, 0x6d, 0xbb, 0x6d, 0x92, 0xb3, 0x8e, 0x6b,
+		0xfb, 0xf6, 0x5e, 0x77, 0xff, 0x6c, 0x8b, 0x78, 0xba, 0x6b, 0x38, 0xbe, 0xed, 0xae, 0x50, 0x9a,
+		0x5c, 0x64, 0x1c, 0x2b, 0x82, 0xa3, 0xbc, 0x09, 0xb3, 0x57, 0x0c, 0x93, 0xd4, 0x02, 0xc6, 0x26,
+		0xf1, 0xe5, 0xe7, 0x21, 0xbd, 0x6f, 0x98, 0xa4, 0x94, 0x58, 0x4e, 0x9d, 0x99, 0x3e, 0xf7, 0xd0,
+		0x4a, 0x8f, 0xd0, 0x4a, 0x5c, 0xa2, 0x81, 0x64, 0x85, 0x4a, 0x94, 0xbf, 0x91, 0x86, 0xb9, 0x01,
+		0xb5, 0xb2, 0x0c, 0x69, 0x4b, 0xeb, 0x20, 0x62, 0xe2, 0x4c, 0x4e, 0xa1, 0xff, 0xe5, 0x12, 0x4c,
+		0x39, 0x9a, 0x7e, 0x43, 0x6b, 0x93, 0x52, 0x92, 0x92, 0x45, 0x51, 0x5e, 0x04, 0x68, 0x11, 0x87,
+		0x58, 0x2d, 0x62, 0xe9, 0x87, 0xa5, 0xd4, 0x72, 0xea, 0x4c, 0x4e, 0x89, 0x50, 0xe4, 0x27, 0x60,
+		0xd6, 0xe9, 0xee, 0x99, 0x86, 0xae, 0x46, 0xd8, 0x60, 0x39, 0x75, 0x66, 0x52, 0x91, 0x58, 0x45,
+		0x2d, 0x64, 0x7e, 0x14, 0x8a, 0xb7, 0x88, 0x76, 0x23, 0xca, 0x3a, 0x4d, 0x59, 0x0b, 0x48, 0x8e,
+		0x30, 0xae, 0x41, 0xbe, 0x43, 0x3c, 0x4f, 0x6b, 0x13, 0xd5, 0x3f, 0x74, 0x48, 0x29, 0x4d, 0x5b,
+		0xbf, 0xdc, 0xd7, 0xfa, 0xde, 0x96, 0x4f, 0x73, 0xa9, 0x9d, 0x43, 0x87, 0xc8, 0xab, 0x90, 0x23,
+		0x56, 0xb7, 0xc3, 0x10, 0x26, 0x87, 0xd8, 0xaf, 0x6e, 0x75, 0x3b, 0xbd, 0x28, 0x59, 0x14, 0xe3,
+		0x10, 0x53, 0x1e, 0x71, 0x6f, 0x1a, 0x3a, 0x29, 0x65, 0x28, 0xc0, 0xa3, 0x7d, 0x00, 0x4d, 0x56,
+		0xdf, 0x8b, 0x21, 0xe4, 0xe4, 0x35, 0xc8, 0x91, 0xdb, 0x3e, 0xb1, 0x3c, 0xc3, 0xb6, 0x4a, 0x53,
+		0x14, 0xe4, 0xe1, 0x01, 0xbd, 0x48, 0xcc, 0x56, 0x2f, 0x44, 0x28, 0x27, 0x5f, 0x80, 0x29, 0xdb,
+		0xf1, 0x0d, 0xdb, 0xf2, 0x4a, 0xd9, 0xe5, 0xc4, 0x99, 0xe9, 0x73, 0x1f, 0x1a, 0xe8, 0x08, 0xdb,
+		0x8c, 0x47, 0x11, 0xcc, 0xf2, 0x3a, 0x48, 0x9e, 0xdd, 0x75, 0x75, 0xa2, 0xea, 0x76, 0x8b, 0xa8,
+		0x86, 0xb5, 0x6f, 0x97, 0x72, 0x14, 0x60, 0xa9, 0xbf, 0x21, 0x94, 0x71, 0xcd, 0x6e, 0x91, 0x75,
+		0x6b, 0xdf, 0x56, 0x0a, 0x5e, 0xac, 0x2c, 0x9f, 0x84, 0x8c, 0x77, 0x68, 0xf9, 0xda, 0xed, 0x52,
+		0x9e, 0x7a, 0x08, 0x2f, 0x95, 0x7f, 0x39, 0x03, 0xc5, 0x71, 0x5c, 0xec, 0x32, 0x4c, 0xee, 0x63,
+		0x2b, 0x4b, 0xc9, 0xe3, 0xd8, 0x80, 0xc9, 0xc4, 0x8d, 0x98, 0x79, 0x9f, 0x46, 0x5c, 0x85, 0x69,
+		0x8b, 0x78, 0x3e, 0x69, 0x31, 0x8f, 0x48, 0x8d, 0xe9, 0x53, 0xc0, 0x84, 0xfa, 0x5d, 0x2a, 0xfd,
+		0xbe, 0x5c, 0xea, 0x55, 0x28, 0x06, 0x2a, 0xa9, 0xae, 0x66, 0xb5, 0x85, 0x6f, 0x9e, 0x1d, 0xa5,
+		0xc9, 0x4a, 0x5d, 0xc8, 0x29, 0x28, 0xa6, 0x14, 0x48, 0xac, 0x2c, 0xd7, 0x00, 0x6c, 0x8b, 0xd8,
+		0xfb, 0x6a, 0x8b, 0xe8, 0x66, 0x29, 0x3b, 0xc4, 0x4a, 0xdb, 0xc8, 0xd2, 0x67, 0x25, 0x9b, 0x51,
+		0x75, 0x53, 0xbe, 0x14, 0xba, 0xda, 0xd4, 0x10, 0x4f, 0xd9, 0x64, 0x83, 0xac, 0xcf, 0xdb, 0x76,
+		0xa1, 0xe0, 0x12, 0xf4, 0x7b, 0xd2, 0xe2, 0x2d, 0xcb, 0x51, 0x25, 0x56, 0x46, 0xb6, 0x4c, 0xe1,
+		0x62, 0xac, 0x61, 0x33, 0x6e, 0xb4, 0x28, 0x3f, 0x08, 0x01, 0x41, 0xa5, 0x6e, 0x05, 0x74, 0x16,
+		0xca, 0x0b, 0xe2, 0x96, 0xd6, 0x21, 0x0b, 0x6f, 0x42, 0x21, 0x6e, 0x1e, 0x79, 0x1e, 0x26, 0x3d,
+		0x5f, 0x73, 0x7d, 0xea, 0x85, 0x93, 0x0a, 0x2b, 0xc8, 0x12, 0xa4, 0x88, 0xd5, 0xa2, 0xb3, 0xdc,
+		0xa4, 0x82, 0x7f, 0xe5, 0x3f, 0x15, 0x36, 0x38, 0x45, 0x1b, 0xfc, 0x48, 0x7f, 0x8f, 0xc6, 0x90,
+		0x7b, 0xdb, 0xbd, 0x70, 0x11, 0x66, 0x62, 0x0d, 0x18, 0xf7, 0xd1, 0xe5, 0x1f, 0x81, 0x13, 0x03,
+		0xa1, 0xe5, 0x57, 0x61, 0xbe, 0x6b, 0x19, 0x96, 0x4f, 0x5c, 0xc7, 0x25, 0xe8, 0xb1, 0xec, 0x51,
+		0xa5, 0xff, 0x3a, 0x35, 0xc4, 0xe7, 0x76, 0xa3, 0xdc, 0x0c, 0x45, 0x99, 0xeb, 0xf6, 0x13, 0x1f,
+		0xcf, 0x65, 0xbf, 0x39, 0x25, 0xbd, 0xf5, 0xd6, 0x5b, 0x6f, 0x25, 0xcb, 0xff, 0x22, 0x03, 0xf3,
+		0x83, 0xc6, 0xcc, 0xc0, 0xe1, 0x7b, 0x12, 0x32, 0x56, 0xb7, 0xb3, 0x47, 0x5c, 0x6a, 0xa4, 0x49,
+		0x85, 0x97, 0xe4, 0x55, 0x98, 0x34, 0xb5, 0x3d, 0x62, 0x96, 0xd2, 0xcb, 0x89, 0x33, 0x85, 0x73,
+		0x4f, 0x8c, 0x35, 0x2a, 0x57, 0x36, 0x50, 0x44, 0x61, 0x92, 0xf2, 0x47, 0x20, 0xcd, 0xa7, 0x68,
+		0x44, 0x78, 0x7c, 0x3c, 0x04, 0x1c, 0x4b, 0x0a, 0x95, 0x93, 0x4f, 0x43, 0x0e, 0x7f, 0x99, 0x6f,
+		0x64, 0xa8, 0xce, 0x59, 0x24, 0xa0, 0x5f, 0xc8, 0x0b, 0x90, 0xa5, 0xc3, 0xa4, 0x45, 0xc4, 0xd2,
+		0x16, 0x94, 0xd1, 0xb1, 0x5a, 0x64, 0x5f, 0xeb, 0x9a, 0xbe, 0x7a, 0x53, 0x33, 0xbb, 0x84, 0x3a,
+		0x7c, 0x4e, 0xc9, 0x73, 0xe2, 0x75, 0xa4, 0xc9, 0x4b, 0x30, 0xcd, 0x46, 0x95, 0x61, 0xb5, 0xc8,
+		0x6d, 0x3a, 0x7b, 0x4e, 0x2a, 0x6c, 0xa0, 0xad, 0x23, 0x05, 0x1f, 0xff, 0x86, 0x67, 0x5b, 0xc2,
+		0x35, 0xe9, 0x23, 0x90, 0x40, 0x1f, 0x7f, 0xb1, 0x77, 0xe2, 0xbe, 0x7f, 0x70, 0xf3, 0xfa, 0xc6,
+		0xd2, 0xa3, 0x50, 0xa4, 0x1c, 0xcf, 0xf2, 0xae, 0xd7, 0xcc, 0xd2, 0xec, 0x72, 0xe2, 0x4c, 0x56,
+		0x29, 0x30, 0xf2, 0x36, 0xa7, 0x96, 0xbf, 0x9c, 0x84, 0x34, 0x9d, 0x58, 0x8a, 0x30, 0xbd, 0xf3,
+		0x5a, 0xa3, 0xae, 0xd6, 0xb6, 0x77, 0xab, 0x1b, 0x75, 0x29, 0x21, 0x17, 0x00, 0x28, 0xe1, 0xca,
+		0xc6, 0xf6, 0xea, 0x8e, 0x94, 0x0c, 0xca, 0xeb, 0x5b, 0x3b, 0x17, 0x9e, 0x93, 0x52, 0x81, 0xc0,
+		0x2e, 0x23, 0xa4, 0xa3, 0x0c, 0xcf, 0x9e, 0x93, 0x26, 0x65, 0x09, 0xf2, 0x0c, 0x60, 0xfd, 0xd5,
+		0x7a, 0xed, 0xc2, 0x73, 0x52, 0x26, 0x4e, 0x79, 0xf6, 0x9c, 0x34, 0x25, 0xcf, 0x40, 0x8e, 0x52,
+		0xaa, 0xdb, 0xdb, 0x1b, 0x52, 0x36, 0xc0, 0x6c, 0xee, 0x28, 0xeb, 0x5b, 0x57, 0xa5, 0x5c, 0x80,
+		0x79, 0x55, 0xd9, 0xde, 0x6d, 0x48, 0x10, 0x20, 0x6c, 0xd6, 0x9b, 0xcd, 0xd5, 0xab, 0x75, 0x69,
+		0x3a, 0xe0, 0xa8, 0xbe, 0xb6, 0x53, 0x6f, 0x4a, 0xf9, 0x98, 0x5a, 0xcf, 0x9e, 0x93, 0x66, 0x82,
+		0x47, 0xd4, 0xb7, 0x76, 0x37, 0xa5, 0x82, 0x3c, 0x0b, 0x33, 0xec, 0x11, 0x42, 0x89, 0x62, 0x0f,
+		0xe9, 0xc2, 0x73, 0x92, 0x14, 0x2a, 0xc2, 0x50, 0x66, 0x63, 0x84, 0x0b, 0xcf, 0x49, 0x72, 0x79,
+		0x0d, 0x26, 0xa9, 0x1b, 0xca, 0x32, 0x14, 0x36, 0x56, 0xab, 0xf5, 0x0d, 0x75, 0xbb, 0xb1, 0xb3,
+		0xbe, 0xbd, 0xb5, 0xba, 0x21, 0x25, 0x42, 0x9a, 0x52, 0x7f, 0x79, 0x77, 0x5d, 0xa9, 0xd7, 0xa4,
+		0x64, 0x94, 0xd6, 0xa8, 0xaf, 0xee, 0xd4, 0x6b, 0x52, 0xaa, 0xac, 0xc3, 0xfc, 0xa0, 0x09, 0x75,
+		0xe0, 0x10, 0x8a, 0xf8, 0x42, 0x72, 0x88, 0x2f, 0x50, 0xac, 0x5e, 0x5f, 0x28, 0x7f, 0x3d, 0x09,
+		0x73, 0x03, 0x16, 0x95, 0x81, 0x0f, 0x79, 0x01, 0x26, 0x99, 0x2f, 0xb3, 0x65, 0xf6, 0xb1, 0x81,
+		0xab, 0x13, 0xf5, 0xec, 0xbe, 0xa5, 0x96, 0xca, 0x45, 0x43, 0x8d, 0xd4, 0x90, 0x50, 0x03, 0x21,
+		0xfa, 0x1c, 0xf6, 0x87, 0xfa, 0x26, 0x7f, 0xb6, 0x3e, 0x5e, 0x18, 0x67, 0x7d, 0xa4, 0xb4, 0xe3,
+		0x2d, 0x02, 0x93, 0x03, 0x16, 0x81, 0xcb, 0x30, 0xdb, 0x07, 0x34, 0xf6, 0x64, 0xfc, 0xd1, 0x04,
+		0x94, 0x86, 0x19, 0x67, 0xc4, 0x94, 0x98, 0x8c, 0x4d, 0x89, 0x97, 0x7b, 0x2d, 0xf8, 0xc0, 0xf0,
+		0x4e, 0xe8, 0xeb, 0xeb, 0xcf, 0x25, 0xe0, 0xe4, 0xe0, 0x90, 0x72, 0xa0, 0x0e, 0x1f, 0x81, 0x4c,
+		0x87, 0xf8, 0x07, 0xb6, 0x08, 0xab, 0x1e, 0x19, 0xb0, 0x58, 0x63, 0x75, 0x6f, 0x67, 0x73, 0x29,
+		0xf9, 0x52, 0xaf, 0xae, 0x4b, 0xc3, 0x02, 0xdc, 0x3e, 0x4d, 0x3f, 0x96, 0x84, 0x13, 0x03, 0xc1,
+		0x07, 0x2a, 0x7a, 0x3f, 0x80, 0x61, 0x39, 0x5d, 0x9f, 0x85, 0x4e, 0x6c, 0x26, 0xce, 0x51, 0x0a,
+		0x9d, 0xbc, 0x70, 0x96, 0xed, 0xfa, 0x41, 0x7d, 0x8a, 0xd6, 0x03, 0x23, 0x51, 0x86, 0xe7, 0x43,
+		0x45, 0xd3, 0x54, 0xd1, 0xc5, 0x21, 0x2d, 0xed, 0x73, 0xcc, 0xa7, 0x41, 0xd2, 0x4d, 0x83, 0x58,
+		0xbe, 0xea, 0xf9, 0x2e, 0xd1, 0x3a, 0x86, 0xd5, 0xa6, 0x4b, 0x4d, 0xb6, 0x32, 0xb9, 0xaf, 0x99,
+		0x1e, 0x51, 0x8a, 0xac, 0xba, 0x29, 0x6a, 0x51, 0x82, 0x3a, 0x90, 0x1b, 0x91, 0xc8, 0xc4, 0x24,
+		0x58, 0x75, 0x20, 0x51, 0xfe, 0x89, 0x1c, 0x4c, 0x47, 0x02, 0x70, 0xf9, 0x01, 0xc8, 0xbf, 0xa1,
+		0xdd, 0xd4, 0x54, 0xb1, 0xa9, 0x62, 0x96, 0x98, 0x46, 0x5a, 0x83, 0x91, 0xe4, 0xa7, 0x61, 0x9e,
+		0xb2, 0xd8, 0x5d, 0x9f, 0xb8, 0xaa, 0x6e, 0x6a, 0x9e, 0x47, 0x8d, 0x96, 0xa5, 0xac, 0x32, 0xd6,
+		0x6d, 0x63, 0xd5, 0x9a, 0xa8, 0x91, 0xcf, 0xc3, 0x1c, 0x95, 0xe8, 0x74, 0x4d, 0xdf, 0x70, 0x4c,
+		0xa2, 0xe2, 0x36, 0xcf, 0x2b, 0x41, 0x54, 0xb3, 0x59, 0xe4, 0xd8, 0xe4, 0x0c, 0xa8, 0x91, 0x27,
+		0xd7, 0xe0, 0x7e, 0x2a, 0xd6, 0x26, 0x16, 0x71, 0x35, 0x9f, 0xa8, 0xe4, 0x87, 0xbb, 0x9a, 0xe9,
+		0xa9, 0x9a, 0xd5, 0x52, 0x0f, 0x34, 0xef, 0xa0, 0x34, 0x8f, 0x00, 0xd5, 0x64, 0x29, 0xa1, 0x9c,
+		0x42, 0xc6, 0xab, 0x9c, 0xaf, 0x4e, 0xd9, 0x56, 0xad, 0xd6, 0x8b, 0x9a, 0x77, 0x20, 0x57, 0xe0,
+		0x24, 0x45, 0xf1, 0x7c, 0xd7, 0xb0, 0xda, 0xaa, 0x7e, 0x40, 0xf4, 0x1b, 0x6a, 0xd7, 0xdf, 0x7f,
+		0xbe, 0x74, 0x3a, 0xfa, 0x7c, 0xaa, 0x61, 0x93, 0xf2, 0xac, 0x21, 0xcb, 0xae, 0xbf, 0xff, 0xbc,
+		0xdc, 0x84, 0x3c, 0x76, 0x46, 0xc7, 0x78, 0x93, 0xa8, 0xfb, 0xb6, 0x4b, 0xd7, 0xd0, 0xc2, 0x80,
+		0xa9, 0x29, 0x62, 0xc1, 0x95, 0x6d, 0x2e, 0xb0, 0x69, 0xb7, 0x48, 0x65, 0xb2, 0xd9, 0xa8, 0xd7,
+		0x6b, 0xca, 0xb4, 0x40, 0xb9, 0x62, 0xbb, 0xe8, 0x50, 0x6d, 0x3b, 0x30, 0xf0, 0x34, 0x73, 0xa8,
+		0xb6, 0x2d, 0xcc, 0x7b, 0x1e, 0xe6, 0x74, 0x9d, 0xb5, 0xd9, 0xd0, 0x55, 0xbe, 0x19, 0xf3, 0x4a,
+		0x52, 0xcc, 0x58, 0xba, 0x7e, 0x95, 0x31, 0x70, 0x1f, 0xf7, 0xe4, 0x4b, 0x70, 0x22, 0x34, 0x56,
+		0x54, 0x70, 0xb6, 0xaf, 0x95, 0xbd, 0xa2, 0xe7, 0x61, 0xce, 0x39, 0xec, 0x17, 0x94, 0x63, 0x4f,
+		0x74, 0x0e, 0x7b, 0xc5, 0x2e, 0xc2, 0xbc, 0x73, 0xe0, 0xf4, 0xcb, 0x3d, 0x1e, 0x95, 0x93, 0x9d,
+		0x03, 0xa7, 0x57, 0xf0, 0x61, 0xba, 0x33, 0x77, 0x89, 0xae, 0xf9, 0xa4, 0x55, 0xba, 0x2f, 0xca,
+		0x1e, 0xa9, 0x90, 0x57, 0x40, 0xd2, 0x75, 0x95, 0x58, 0xda, 0x9e, 0x49, 0x54, 0xcd, 0x25, 0x96,
+		0xe6, 0x95, 0x96, 0x28, 0x73, 0xda, 0x77, 0xbb, 0x44, 0x29, 0xe8, 0x7a, 0x9d, 0x56, 0xae, 0xd2,
+		0x3a, 0xf9, 0x71, 0x98, 0xb5, 0xf7, 0xde, 0xd0, 0x99, 0x47, 0xaa, 0x8e, 0x4b, 0xf6, 0x8d, 0xdb,
+		0xa5, 0x87, 0xa8, 0x79, 0x8b, 0x58, 0x41, 0xfd, 0xb1, 0x41, 0xc9, 0xf2, 0x63, 0x20, 0xe9, 0xde,
+		0x81, 0xe6, 0x3a, 0x74, 0x4a, 0xf6, 0x1c, 0x4d, 0x27, 0xa5, 0x87, 0x19, 0x2b, 0xa3, 0x6f, 0x09,
+		0x32, 0x8e, 0x08, 0xef, 0x96, 0xb1, 0xef, 0x0b, 0xc4, 0x47, 0xd9, 0x88, 0xa0, 0x34, 0x8e, 0x76,
+		0x06, 0x24, 0xb4, 0x44, 0xec, 0xc1, 0x67, 0x28, 0x5b, 0xc1, 0x39, 0x70, 0xa2, 0xcf, 0x7d, 0x10,
+		0x66, 0x9c, 0x83, 0xe8, 0x43, 0x1f, 0x63, 0x81, 0x9b, 0x73, 0x10, 0x79, 0xe2, 0x73, 0x70, 0x12,
+		0x99, 0x3a, 0xc4, 0xd7, 0x5a, 0x9a, 0xaf, 0x45, 0xb8, 0x9f, 0xa4, 0xdc, 0x68, 0xf6, 0x4d, 0x5e,
+		0x19, 0xd3, 0xd3, 0xed, 0xee, 0x1d, 0x06, 0x8e, 0xf5, 0x14, 0xd3, 0x13, 0x69, 0xc2, 0xb5, 0x3e,
+		0xb0, 0xe0, 0xbc, 0x5c, 0x81, 0x7c, 0xd4, 0xef, 0xe5, 0x1c, 0x30, 0xcf, 0x97, 0x12, 0x18, 0x04,
+		0xad, 0x6d, 0xd7, 0x30, 0x7c, 0x79, 0xbd, 0x2e, 0x25, 0x31, 0x8c, 0xda, 0x58, 0xdf, 0xa9, 0xab,
+		0xca, 0xee, 0xd6, 0xce, 0xfa, 0x66, 0x5d, 0x4a, 0x45, 0x02, 0xfb, 0x6b, 0xe9, 0xec, 0x23, 0xd2,
+		0xa3, 0x18, 0x35, 0x14, 0xe2, 0x3b, 0x35, 0xf9, 0x07, 0xe0, 0x3e, 0x91, 0x56, 0xf1, 0x88, 0xaf,
+		0xde, 0x32, 0x5c, 0x3a, 0x20, 0x3b, 0x1a, 0x5b, 0x1c, 0x03, 0xff, 0x99, 0xe7, 0x5c, 0x4d, 0xe2,
+		0xbf, 0x62, 0xb8, 0x38, 0xdc, 0x3a, 0x9a, 0x2f, 0x6f, 0xc0, 0x92, 0x65, 0xab, 0x9e, 0xaf, 0x59,
+		0x2d, 0xcd, 0x6d, 0xa9, 0x61, 0x42, 0x4b, 0xd5, 0x74, 0x9d, 0x78, 0x9e, 0xcd, 0x16, 0xc2, 0x00,
+		0xe5, 0x43, 0x96, 0xdd, 0xe4, 0xcc, 0xe1, 0x0a, 0xb1, 0xca, 0x59, 0x7b, 0xdc, 0x37, 0x35, 0xcc,
+		0x7d, 0x4f, 0x43, 0xae, 0xa3, 0x39, 0x2a, 0xb1, 0x7c, 0xf7, 0x90, 0xc6, 0xe7, 0x59, 0x25, 0xdb,
+		0xd1, 0x9c, 0x3a, 0x96, 0xbf, 0x2f, 0xdb, 0xa4, 0x6b, 0xe9, 0x6c, 0x5a, 0x9a, 0xbc, 0x96, 0xce,
+		0x4e, 0x4a, 0x99, 0x6b, 0xe9, 0x6c, 0x46, 0x9a, 0xba, 0x96, 0xce, 0x66, 0xa5, 0xdc, 0xb5, 0x74,
+		0x36, 0x27, 0x41, 0xf9, 0x27, 0xd3, 0x90, 0x8f, 0x46, 0xf0, 0xb8, 0x21, 0xd2, 0xe9, 0x1a, 0x96,
+		0xa0, 0xb3, 0xdc, 0x83, 0x47, 0xc6, 0xfb, 0x2b, 0x6b, 0xb8, 0xb8, 0x55, 0x32, 0x2c, 0x5c, 0x56,
+		0x98, 0x24, 0x06, 0x16, 0xe8, 0x7e, 0x84, 0x85, 0x27, 0x59, 0x85, 0x97, 0xe4, 0xab, 0x90, 0x79,
+		0xc3, 0xa3, 0xd8, 0x19, 0x8a, 0xfd, 0xd0, 0xd1, 0xd8, 0xd7, 0x9a, 0x14, 0x3c, 0x77, 0xad, 0xa9,
+		0x6e, 0x6d, 0x2b, 0x9b, 0xab, 0x1b, 0x0a, 0x17, 0x97, 0x4f, 0x41, 0xda, 0xd4, 0xde, 0x3c, 0x8c,
+		0x2f, 0x83, 0x94, 0x24, 0xaf, 0x40, 0xb1, 0x6b, 0xdd, 0x24, 0xae, 0xb1, 0x6f, 0x90, 0x96, 0x4a,
+		0xb9, 0x8a, 0x51, 0xae, 0x42, 0x58, 0xbb, 0x81, 0xfc, 0x63, 0x76, 0xe3, 0x29, 0x48, 0x63, 0x8a,
+		0x2f, 0xbe, 0x58, 0x51, 0xd2, 0x07, 0x38, 0x9c, 0xce, 0xc2, 0x24, 0xb5, 0xaf, 0x0c, 0xc0, 0x2d,
+		0x2c, 0x4d, 0xc8, 0x59, 0x48, 0xaf, 0x6d, 0x2b, 0x38, 0xa4, 0x24, 0xc8, 0x33, 0xaa, 0xda, 0x58,
+		0xaf, 0xaf, 0xd5, 0xa5, 0x64, 0xf9, 0x3c, 0x64, 0x98, 0xd1, 0x70, 0xb8, 0x05, 0x66, 0x93, 0x26,
+		0x78, 0x91, 0x63, 0x24, 0x44, 0xed, 0xee, 0x66, 0xb5, 0xae, 0x48, 0xc9, 0x3e, 0x67, 0x29, 0x7b,
+		0x90, 0x8f, 0x46, 0xf2, 0xdf, 0x9f, 0xed, 0xfc, 0xaf, 0x26, 0x60, 0x3a, 0x12, 0x99, 0x63, 0x48,
+		0xa5, 0x99, 0xa6, 0x7d, 0x4b, 0xd5, 0x4c, 0x43, 0xf3, 0xb8, 0x2b, 0x01, 0x25, 0xad, 0x22, 0x65,
+		0xdc, 0xae, 0xfb, 0x3e, 0x0d, 0xb2, 0x49, 0x29, 0x53, 0xfe, 0x74, 0x02, 0xa4, 0xde, 0xd0, 0xb8,
+		0x47, 0xcd, 0xc4, 0x1f, 0xa5, 0x9a, 0xe5, 0x4f, 0x25, 0xa0, 0x10, 0x8f, 0x87, 0x7b, 0xd4, 0x7b,
+		0xe0, 0x8f, 0x54, 0xbd, 0xdf, 0x4d, 0xc2, 0x4c, 0x2c, 0x0a, 0x1e, 0x57, 0xbb, 0x1f, 0x86, 0x59,
+		0xa3, 0x45, 0x3a, 0x8e, 0xed, 0x63, 0xfa, 0x5d, 0x35, 0xc9, 0x4d, 0x62, 0x96, 0xca, 0x74, 0x92,
+		0x39, 0x7b, 0x74, 0x9c, 0xbd, 0xb2, 0x1e, 0xca, 0x6d, 0xa0, 0x58, 0x65, 0x6e, 0xbd, 0x56, 0xdf,
+		0x6c, 0x6c, 0xef, 0xd4, 0xb7, 0xd6, 0x5e, 0x53, 0x77, 0xb7, 0x5e, 0xda, 0xda, 0x7e, 0x65, 0x4b,
+		0x91, 0x8c, 0x1e, 0xb6, 0x0f, 0x70, 0xd8, 0x37, 0x40, 0xea, 0x55, 0x4a, 0xbe, 0x0f, 0x06, 0xa9,
+		0x25, 0x4d, 0xc8, 0x73, 0x50, 0xdc, 0xda, 0x56, 0x9b, 0xeb, 0xb5, 0xba, 0x5a, 0xbf, 0x72, 0xa5,
+		0xbe, 0xb6, 0xd3, 0x64, 0x99, 0x93, 0x80, 0x7b, 0x27, 0x36, 0xc0, 0xcb, 0x9f, 0x4c, 0xc1, 0xdc,
+		0x00, 0x4d, 0xe4, 0x55, 0xbe, 0xe7, 0x61, 0xdb, 0xb0, 0xa7, 0xc6, 0xd1, 0x7e, 0x05, 0xa3, 0x8e,
+		0x86, 0xe6, 0xfa, 0x7c, 0x8b, 0xf4, 0x18, 0xa0, 0x95, 0x2c, 0x1f, 0x27, 0x57, 0x97, 0x67, 0xa4,
+		0xd8, 0x46, 0xa8, 0x18, 0xd2, 0x59, 0x52, 0xea, 0x49, 0x90, 0x1d, 0xdb, 0x33, 0x7c, 0xe3, 0x26,
+		0x26, 0xf5, 0x45, 0xfa, 0x0a, 0x37, 0x46, 0x69, 0x45, 0x12, 0x35, 0xeb, 0x96, 0x1f, 0x70, 0x5b,
+		0xa4, 0xad, 0xf5, 0x70, 0xe3, 0xe4, 0x9f, 0x52, 0x24, 0x51, 0x13, 0x70, 0x3f, 0x00, 0xf9, 0x96,
+		0xdd, 0xc5, 0x68, 0x91, 0xf1, 0xe1, 0x5a, 0x93, 0x50, 0xa6, 0x19, 0x2d, 0x60, 0xe1, 0xfb, 0x80,
+		0x30, 0x6f, 0x96, 0x57, 0xa6, 0x19, 0x8d, 0xb1, 0x3c, 0x0a, 0x45, 0xad, 0xdd, 0x76, 0x11, 0x5c,
+		0x00, 0xb1, 0x9d, 0x4d, 0x21, 0x20, 0x53, 0xc6, 0x85, 0x6b, 0x90, 0x15, 0x76, 0xc0, 0xc5, 0x1e,
+		0x2d, 0xa1, 0x3a, 0x6c, 0xbb, 0x9e, 0xc4, 0x54, 0x9a, 0x25, 0x2a, 0x1f, 0x80, 0xbc, 0xe1, 0xa9,
+		0xe1, 0x31, 0x40, 0x72, 0x39, 0x79, 0x26, 0xab, 0x4c, 0x1b, 0x5e, 0x90, 0x42, 0x2d, 0x7f, 0x2e,
+		0x09, 0x85, 0xf8, 0x31, 0x86, 0x5c, 0x83, 0xac, 0x69, 0xeb, 0x1a, 0x75, 0x2d, 0x76, 0x86, 0x76,
+		0x66, 0xc4, 0xc9, 0xc7, 0xca, 0x06, 0xe7, 0x57, 0x02, 0xc9, 0x85, 0x7f, 0x9b, 0x80, 0xac, 0x20,
+		0xcb, 0x27, 0x21, 0xed, 0x68, 0xfe, 0x01, 0x85, 0x9b, 0xac, 0x26, 0xa5, 0x84, 0x42, 0xcb, 0x48,
+		0xf7, 0x1c, 0xcd, 0x2a, 0x25, 0x43, 0x3a, 0x96, 0xb1, 0x5f, 0x4d, 0xa2, 0xb5, 0xe8, 0xb6, 0xc9,
+		0xee, 0x74, 0x88, 0xe5, 0x7b, 0xa2, 0x5f, 0x39, 0x7d, 0x8d, 0x93, 0xf1, 0x34, 0xcd, 0x77, 0x35,
+		0xc3, 0x8c, 0xf1, 0xa6, 0x29, 0xaf, 0x24, 0x2a, 0x02, 0xe6, 0x0a, 0x9c, 0x12, 0xb8, 0x2d, 0xe2,
+		0x6b, 0xfa, 0x01, 0x69, 0x85, 0x42, 0x19, 0x9a, 0x1e, 0xb9, 0x8f, 0x33, 0xd4, 0x78, 0xbd, 0x90,
+		0x2d, 0xff, 0x7a, 0x02, 0x66, 0xc5, 0x46, 0xaf, 0x15, 0x18, 0x6b, 0x13, 0x40, 0xb3, 0x2c, 0xdb,
+		0x8f, 0x9a, 0xab, 0xdf, 0x95, 0xfb, 0xe4, 0x56, 0x56, 0x03, 0x21, 0x25, 0x02, 0xb0, 0xd0, 0x01,
+		0x08, 0x6b, 0x86, 0x9a, 0x6d, 0x09, 0xa6, 0xf9, 0x19, 0x15, 0x3d, 0xe8, 0x64, 0xa9, 0x01, 0x60,
+		0x24, 0xdc, 0x11, 0x62, 0x02, 0x67, 0x8f, 0xb4, 0x0d, 0x8b, 0x67, 0x9e, 0x59, 0x41, 0x24, 0x70,
+		0xd2, 0x41, 0x02, 0xa7, 0xfa, 0xe7, 0x60, 0x4e, 0xb7, 0x3b, 0xbd, 0xea, 0x56, 0xa5, 0x9e, 0xf4,
+		0x84, 0xf7, 0x62, 0xe2, 0xf5, 0xa7, 0x38, 0x53, 0xdb, 0x36, 0x35, 0xab, 0xbd, 0x62, 0xbb, 0xed,
+		0xf0, 0xa0, 0x16, 0x23, 0x24, 0x2f, 0x72, 0x5c, 0xeb, 0xec, 0xfd, 0x9f, 0x44, 0xe2, 0xe7, 0x92,
+		0xa9, 0xab, 0x8d, 0xea, 0xe7, 0x93, 0x0b, 0x57, 0x99, 0x60, 0x43, 0x18, 0x43, 0x21, 0xfb, 0x26,
+		0xd1, 0xb1, 0x81, 0xf0, 0xad, 0x27, 0x60, 0xbe, 0x6d, 0xb7, 0x6d, 0x8a, 0x74, 0x16, 0xff, 0xf1,
+		0x93, 0xde, 0x5c, 0x40, 0x5d, 0x18, 0x79, 0x2c, 0x5c, 0xd9, 0x82, 0x39, 0xce, 0xac, 0xd2, 0xa3,
+		0x26, 0xb6, 0x11, 0x92, 0x8f, 0xcc, 0xc2, 0x95, 0x7e, 0xf1, 0x1b, 0x74, 0xf9, 0x56, 0x66, 0xb9,
+		0x28, 0xd6, 0xb1, 0xbd, 0x52, 0x45, 0x81, 0x13, 0x31, 0x3c, 0x36, 0x48, 0x89, 0x3b, 0x02, 0xf1,
+		0x5f, 0x71, 0xc4, 0xb9, 0x08, 0x62, 0x93, 0x8b, 0x56, 0xd6, 0x60, 0xe6, 0x38, 0x58, 0xff, 0x9a,
+		0x63, 0xe5, 0x49, 0x14, 0xe4, 0x2a, 0x14, 0x29, 0x88, 0xde, 0xf5, 0x7c, 0xbb, 0x43, 0x67, 0xc0,
+		0xa3, 0x61, 0xfe, 0xcd, 0x37, 0xd8, 0xa8, 0x29, 0xa0, 0xd8, 0x5a, 0x20, 0x55, 0xa9, 0x00, 0x3d,
+		0x5d, 0xc3, 0x53, 0xaf, 0x11, 0x08, 0x5f, 0xe1, 0x8a, 0x04, 0xfc, 0x95, 0xeb, 0x30, 0x8f, 0xff,
+		0xe9, 0x04, 0x15, 0xd5, 0x64, 0x74, 0xca, 0xae, 0xf4, 0xeb, 0x1f, 0x65, 0x03, 0x73, 0x2e, 0x00,
+		0x88, 0xe8, 0x14, 0xe9, 0xc5, 0x36, 0xf1, 0x7d, 0xe2, 0x7a, 0xaa, 0x66, 0x0e, 0x52, 0x2f, 0x92,
+		0xf3, 0x28, 0xfd, 0xcc, 0xb7, 0xe3, 0xbd, 0x78, 0x95, 0x49, 0xae, 0x9a, 0x66, 0x65, 0x17, 0xee,
+		0x1b, 0xe0, 0x15, 0x63, 0x60, 0x7e, 0x92, 0x63, 0xce, 0xf7, 0x79, 0x06, 0xc2, 0x36, 0x40, 0xd0,
+		0x83, 0xbe, 0x1c, 0x03, 0xf3, 0x67, 0x39, 0xa6, 0xcc, 0x65, 0x45, 0x97, 0x22, 0xe2, 0x35, 0x98,
+		0xbd, 0x49, 0xdc, 0x3d, 0xdb, 0xe3, 0x79, 0xa6, 0x31, 0xe0, 0x3e, 0xc5, 0xe1, 0x8a, 0x5c, 0x90,
+		0x26, 0x9e, 0x10, 0xeb, 0x12, 0x64, 0xf7, 0x35, 0x9d, 0x8c, 0x01, 0x71, 0x97, 0x43, 0x4c, 0x21,
+		0x3f, 0x8a, 0xae, 0x42, 0xbe, 0x6d, 0xf3, 0x35, 0x6a, 0xb4, 0xf8, 0xa7, 0xb9, 0xf8, 0xb4, 0x90,
+		0xe1, 0x10, 0x8e, 0xed, 0x74, 0x4d, 0x5c, 0xc0, 0x46, 0x43, 0xfc, 0x0d, 0x01, 0x21, 0x64, 0x38,
+		0xc4, 0x31, 0xcc, 0xfa, 0xb6, 0x80, 0xf0, 0x22, 0xf6, 0x7c, 0x01, 0x8f, 0x9f, 0xcc, 0x43, 0xdb,
+		0x1a, 0x47, 0x89, 0xcf, 0x70, 0x04, 0xe0, 0x22, 0x08, 0x70, 0x19, 0x72, 0xe3, 0x76, 0xc4, 0xdf,
+		0xfc, 0xb6, 0x18, 0x1e, 0xa2, 0x07, 0xae, 0x42, 0x51, 0x4c, 0x50, 0x78, 0x5c, 0x3d, 0x1a, 0xe2,
+		0x6f, 0x71, 0x88, 0x42, 0x44, 0x8c, 0x37, 0xc3, 0x27, 0x9e, 0xdf, 0x26, 0xe3, 0x80, 0x7c, 0x4e,
+		0x34, 0x83, 0x8b, 0x70, 0x53, 0xee, 0x11, 0x4b, 0x3f, 0x18, 0x0f, 0xe1, 0x17, 0x84, 0x29, 0x85,
+		0x0c, 0x42, 0xac, 0xc1, 0x4c, 0x47, 0x73, 0xbd, 0x03, 0xcd, 0x1c, 0xab, 0x3b, 0xfe, 0x36, 0xc7,
+		0xc8, 0x07, 0x42, 0xdc, 0x22, 0x5d, 0xeb, 0x38, 0x30, 0x9f, 0x17, 0x16, 0xe9, 0x5a, 0x31, 0xa0,
+		0x06, 0xcc, 0x7b, 0x3e, 0x4d, 0xca, 0x1d, 0x07, 0xed, 0xef, 0x88, 0xa1, 0xc7, 0x64, 0x37, 0xa3,
+		0x88, 0x97, 0x21, 0xe7, 0x19, 0x6f, 0x8e, 0x05, 0xf3, 0x05, 0xd1, 0xd3, 0x54, 0x00, 0x85, 0x5f,
+		0x83, 0x53, 0x03, 0x97, 0x89, 0x31, 0xc0, 0xfe, 0x2e, 0x07, 0x3b, 0x39, 0x60, 0xa9, 0xe0, 0x53,
+		0xc2, 0x71, 0x21, 0xff, 0x9e, 0x98, 0x12, 0x48, 0x0f, 0x56, 0x03, 0x77, 0x0d, 0x9e, 0xb6, 0x7f,
+		0x3c, 0xab, 0xfd, 0x7d, 0x61, 0x35, 0x26, 0x1b, 0xb3, 0xda, 0x0e, 0x9c, 0xe4, 0x88, 0xc7, 0xeb,
+		0xd7, 0x7f, 0x20, 0x26, 0x56, 0x26, 0xbd, 0x1b, 0xef, 0xdd, 0x1f, 0x84, 0x85, 0xc0, 0x9c, 0x22,
+		0x3c, 0xf5, 0x54, 0xcc, 0x64, 0x8d, 0x46, 0xfe, 0x45, 0x8e, 0x2c, 0x66, 0xfc, 0x20, 0xbe, 0xf5,
+		0x36, 0x35, 0x07, 0xc1, 0x5f, 0x85, 0x92, 0x00, 0xef, 0x5a, 0x2e, 0xd1, 0xed, 0xb6, 0x65, 0xbc,
+		0x49, 0x5a, 0x63, 0x40, 0xff, 0x52, 0x4f, 0x57, 0xed, 0x46, 0xc4, 0x11, 0x79, 0x1d, 0xa4, 0x20,
+		0x56, 0x51, 0x8d, 0x8e, 0x63, 0xbb, 0xfe, 0x08, 0xc4, 0x2f, 0x8a, 0x9e, 0x0a, 0xe4, 0xd6, 0xa9,
+		0x58, 0xa5, 0x0e, 0xec, 0xa4, 0x7a, 0x5c, 0x97, 0xfc, 0x12, 0x07, 0x9a, 0x09, 0xa5, 0xf8, 0xc4,
+		0xa1, 0xdb, 0x1d, 0x47, 0x73, 0xc7, 0x99, 0xff, 0xfe, 0xa1, 0x98, 0x38, 0xb8, 0x08, 0x9f, 0x38,
+		0x30, 0xa2, 0xc3, 0xd5, 0x7e, 0x0c, 0x84, 0x2f, 0x8b, 0x89, 0x43, 0xc8, 0x70, 0x08, 0x11, 0x30,
+		0x8c, 0x01, 0xf1, 0x8f, 0x04, 0x84, 0x90, 0x41, 0x88, 0x97, 0xc3, 0x85, 0xd6, 0x25, 0x6d, 0xc3,
+		0xf3, 0x5d, 0x16, 0x14, 0x1f, 0x0d, 0xf5, 0x8f, 0xbf, 0x1d, 0x0f, 0xc2, 0x94, 0x88, 0x28, 0xce,
+		0x44, 0x3c, 0x4d, 0x4b, 0xf7, 0x4c, 0xa3, 0x15, 0xfb, 0x65, 0x31, 0x13, 0x45, 0xc4, 0x50, 0xb7,
+		0x48, 0x84, 0x88, 0x66, 0xd7, 0x71, 0xa7, 0x30, 0x06, 0xdc, 0x3f, 0xe9, 0x51, 0xae, 0x29, 0x64,
+		0x11, 0x33, 0x12, 0xff, 0x74, 0xad, 0x1b, 0xe4, 0x70, 0x2c, 0xef, 0xfc, 0x95, 0x9e, 0xf8, 0x67,
+		0x97, 0x49, 0xb2, 0x39, 0xa4, 0xd8, 0x13, 0x4f, 0xc9, 0xa3, 0xee, 0x25, 0x95, 0x7e, 0xf4, 0x3d,
+		0xde, 0xde, 0x78, 0x38, 0x55, 0xd9, 0x00, 0x89, 0x53, 0xc2, 0x00, 0x76, 0x24, 0xd8, 0x47, 0xdf,
+		0x0b, 0xfc, 0x3c, 0x16, 0xf3, 0x54, 0xae, 0xc0, 0x4c, 0x2c, 0xe0, 0x19, 0x0d, 0xf5, 0xe7, 0x39,
+		0x54, 0x3e, 0x1a, 0xef, 0x54, 0xce, 0x43, 0x1a, 0x83, 0x97, 0xd1, 0xe2, 0x7f, 0x81, 0x8b, 0x53,
+		0xf6, 0xca, 0x87, 0x21, 0x2b, 0x82, 0x96, 0xd1, 0xa2, 0x7f, 0x91, 0x8b, 0x06, 0x22, 0x28, 0x2e,
+		0x02, 0x96, 0xd1, 0xe2, 0x7f, 0x49, 0x88, 0x0b, 0x11, 0x14, 0x1f, 0xdf, 0x84, 0xbf, 0xfa, 0x97,
+		0xd3, 0x4c, 0x5c, 0x88, 0x54, 0xf0, 0xa4, 0x9c, 0x45, 0x2a, 0xa3, 0xa5, 0x3f, 0xc6, 0x1f, 0x2e,
+		0x24, 0x2a, 0x17, 0x61, 0x72, 0x4c, 0x83, 0xff, 0x15, 0x2e, 0xca, 0xf8, 0x2b, 0x6b, 0x30, 0x1d,
+		0x89, 0x4e, 0x46, 0x8b, 0xff, 0x38, 0x17, 0x8f, 0x4a, 0xa1, 0xea, 0x3c, 0x3a, 0x19, 0x0d, 0xf0,
+		0x57, 0x85, 0xea, 0x5c, 0x02, 0xcd, 0x26, 0x02, 0x93, 0xd1, 0xd2, 0x1f, 0x17, 0x56, 0x17, 0x22,
+		0x95, 0x17, 0x20, 0x17, 0x2c, 0x36, 0xa3, 0xe5, 0x7f, 0x82, 0xcb, 0x87, 0x32, 0x68, 0x81, 0xae,
+		0x75, 0x0c, 0x88, 0x9f, 0x14, 0x16, 0x88, 0x48, 0xe1, 0x30, 0xea, 0x0d, 0x60, 0x46, 0x23, 0xfd,
+		0x94, 0x18, 0x46, 0x3d, 0xf1, 0x0b, 0xf6, 0x26, 0x9d, 0xf3, 0x47, 0x43, 0xfc, 0x35, 0xd1, 0x9b,
+		0x94, 0x1f, 0xd5, 0xe8, 0x8d, 0x08, 0x46, 0x63, 0xfc, 0xb4, 0x50, 0xa3, 0x27, 0x20, 0xa8, 0x34,
+		0x40, 0xee, 0x8f, 0x06, 0x46, 0xe3, 0x7d, 0x82, 0xe3, 0xcd, 0xf6, 0x05, 0x03, 0x95, 0x57, 0xe0,
+		0xe4, 0xe0, 0x48, 0x60, 0x34, 0xea, 0xcf, 0xbc, 0xd7, 0xb3, 0x77, 0x8b, 0x06, 0x02, 0x95, 0x1d,
+		0x98, 0x1f, 0x14, 0x05, 0x8c, 0x86, 0xfd, 0xe4, 0x7b, 0xf1, 0x89, 0x3b, 0x1a, 0x04, 0x54, 0x56,
+		0x01, 0xc2, 0x05, 0x78, 0x34, 0xd6, 0xa7, 0x38, 0x56, 0x44, 0x08, 0x87, 0x06, 0x5f, 0x7f, 0x47,
+		0xcb, 0xdf, 0x15, 0x43, 0x83, 0x4b, 0xe0, 0xd0, 0x10, 0x4b, 0xef, 0x68, 0xe9, 0x4f, 0x8b, 0xa1,
+		0x21, 0x44, 0xd0, 0xb3, 0x23, 0xab, 0xdb, 0x68, 0x84, 0xcf, 0x08, 0xcf, 0x8e, 0x48, 0x55, 0xb6,
+		0x60, 0xb6, 0x6f, 0x41, 0x1c, 0x0d, 0xf5, 0x73, 0x1c, 0x4a, 0xea, 0x5d, 0x0f, 0xa3, 0x8b, 0x17,
+		0x5f, 0x0c, 0x47, 0xa3, 0x7d, 0xb6, 0x67, 0xf1, 0xe2, 0x6b, 0x61, 0xe5, 0x32, 0x64, 0xad, 0xae,
+		0x69, 0xe2, 0xe0, 0x91, 0x8f, 0xbe, 0x4b, 0x58, 0xfa, 0x6f, 0xdf, 0xe5, 0xd6, 0x11, 0x02, 0x95,
+		0xf3, 0x30, 0x49, 0x3a, 0x7b, 0xa4, 0x35, 0x4a, 0xf2, 0x5b, 0xdf, 0x15, 0x13, 0x26, 0x72, 0x57,
+		0x5e, 0x00, 0x60, 0xa9, 0x11, 0x7a, 0x78, 0x38, 0x42, 0xf6, 0xbf, 0x7f, 0x97, 0x5f, 0xde, 0x09,
+		0x45, 0x42, 0x00, 0x76, 0x15, 0xe8, 0x68, 0x80, 0x6f, 0xc7, 0x01, 0x68, 0x8f, 0x5c, 0x82, 0x29,
+		0xbc, 0x52, 0xe9, 0x6b, 0xed, 0x51, 0xd2, 0xff, 0x83, 0x4b, 0x0b, 0x7e, 0x34, 0x58, 0xc7, 0x76,
+		0x89, 0xaf, 0xb5, 0xbd, 0x51, 0xb2, 0xff, 0x93, 0xcb, 0x06, 0x02, 0x28, 0xac, 0x6b, 0x9e, 0x3f,
+		0x4e, 0xbb, 0x7f, 0x4f, 0x08, 0x0b, 0x01, 0x54, 0x1a, 0xff, 0xdf, 0x20, 0x87, 0xa3, 0x64, 0x7f,
+		0x5f, 0x28, 0xcd, 0xf9, 0x2b, 0x1f, 0x86, 0x1c, 0xfe, 0x65, 0x37, 0xf2, 0x46, 0x08, 0xff, 0x2f,
+		0x2e, 0x1c, 0x4a, 0xe0, 0x93, 0x3d, 0xbf, 0xe5, 0x1b, 0xa3, 0x8d, 0xfd, 0x1d, 0xde, 0xd3, 0x82,
+		0xbf, 0xb2, 0x0a, 0xd3, 0x9e, 0xdf, 0x6a, 0x75, 0x79, 0x7c, 0x3a, 0x42, 0xfc, 0x0f, 0xbe, 0x1b,
+		0xa4, 0x2c, 0x02, 0x19, 0xec, 0xed, 0x5b, 0x37, 0x7c, 0xc7, 0xa6, 0x07, 0x1e, 0xa3, 0x10, 0xde,
+		0xe3, 0x08, 0x11, 0x91, 0xca, 0x1a, 0xe4, 0xb1, 0x2d, 0x2e, 0x71, 0x08, 0x3d, 0x9d, 0x1a, 0x01,
+		0xf1, 0xbf, 0xb9, 0x01, 0x62, 0x42, 0xd5, 0x1f, 0xfa, 0xca, 0xbb, 0x8b, 0x89, 0xaf, 0xbd, 0xbb,
+		0x98, 0xf8, 0xdd, 0x77, 0x17, 0x13, 0x1f, 0xff, 0xfa, 0xe2, 0xc4, 0xd7, 0xbe, 0xbe, 0x38, 0xf1,
+		0x5b, 0x5f, 0x5f, 0x9c, 0x18, 0x9c, 0x25, 0x86, 0xab, 0xf6, 0x55, 0x9b, 0xe5, 0x87, 0x5f, 0x2f,
+		0xb7, 0x0d, 0xff, 0xa0, 0xbb, 0xb7, 0xa2, 0xdb, 0x1d, 0x9a, 0xc6, 0x0d, 0xb3, 0xb5, 0xc1, 0x26,
+		0x07, 0xbe, 0x97, 0x80, 0x53, 0x0c, 0x23, 0xac, 0xd5, 0xac, 0xc3, 0x61, 0xef, 0xf6, 0x5c, 0x80,
+		0xd4, 0xaa, 0x75, 0x28, 0x9f, 0x62, 0xb3, 0x9b, 0xda, 0x75, 0x4d, 0x7e, 0x27, 0x6c, 0x0a, 0xcb,
+		0xbb, 0xae, 0x89, 0x59, 0x6e, 0x71, 0x71, 0x13, 0x0f, 0x53, 0x58, 0xa1, 0xfa, 0xe3, 0x89, 0xe3,
+		0x35, 0x23, 0xbb, 0x6a, 0x1d, 0xd2, 0x56, 0x34, 0x12, 0xaf, 0x3f, 0x39, 0x32, 0xc9, 0x7d, 0xc3,
+		0xb2, 0x6f, 0x59, 0xa8, 0xb6, 0xb3, 0x27, 0x12, 0xdc, 0x8b, 0xbd, 0x09, 0xee, 0x57, 0x88, 0x69,
+		0xbe, 0x84, 0x7c, 0x78, 0x2e, 0xee, 0xed, 0x65, 0xd8, 0xf5, 0x63, 0xf8, 0xa9, 0x24, 0x2c, 0xf6,
+		0xe5, 0xb2, 0xb9, 0x07, 0x0c, 0x33, 0x42, 0x05, 0xb2, 0x35, 0xe1, 0x58, 0x25, 0x7c, 0xb3, 0x46,
+		0xb7, 0xad, 0x96, 0x47, 0x0d, 0x91, 0x52, 0x44, 0x11, 0x0d, 0x61, 0x69, 0x96, 0xed, 0xf1, 0x5b,
+		0x95, 0xac, 0x50, 0xfd, 0xd9, 0x63, 0x1a, 0x62, 0x46, 0x3c, 0x49, 0x58, 0xe3, 0x99, 0x31, 0xad,
+		0x21, 0x1a, 0x11, 0x4b, 0xfb, 0x8f, 0x6b, 0x95, 0x9f, 0x4e, 0xc2, 0x52, 0xaf, 0x55, 0x70, 0x58,
+		0x79, 0xbe, 0xd6, 0x71, 0x86, 0x99, 0xe5, 0x32, 0xe4, 0x76, 0x04, 0xcf, 0xb1, 0xed, 0x72, 0xf7,
+		0x98, 0x76, 0x29, 0x04, 0x8f, 0x12, 0x86, 0x39, 0x37, 0xa6, 0x61, 0x82, 0x76, 0xbc, 0x2f, 0xcb,
+		0xfc, 0xdf, 0x0c, 0x9c, 0xd2, 0x6d, 0xaf, 0x63, 0x7b, 0x2a, 0x3b, 0x1f, 0x61, 0x05, 0x6e, 0x93,
+		0x7c, 0xb4, 0x6a, 0xf4, 0x21, 0x49, 0xf9, 0x25, 0x98, 0x5b, 0xc7, 0xa9, 0x02, 0xb7, 0x40, 0xe1,
+		0xf1, 0xce, 0xc0, 0x8b, 0xa7, 0xcb, 0xb1, 0x68, 0x9f, 0x1f, 0x2f, 0x45, 0x49, 0xe5, 0x1f, 0x4d,
+		0x80, 0xd4, 0xd4, 0x35, 0x53, 0x73, 0xff, 0xb0, 0x50, 0xf2, 0x45, 0x00, 0xfa, 0xc2, 0x52, 0xf8,
+		0x86, 0x51, 0xe1, 0x5c, 0x69, 0x25, 0xda, 0xb8, 0x15, 0xf6, 0x24, 0xfa, 0xfa, 0x42, 0x8e, 0xf2,
+		0xe2, 0xdf, 0xc7, 0x5f, 0x05, 0x08, 0x2b, 0xe4, 0xd3, 0x70, 0x5f, 0x73, 0x6d, 0x75, 0x63, 0x55,
+		0x51, 0xd9, 0x4d, 0xf8, 0xad, 0x66, 0xa3, 0xbe, 0xb6, 0x7e, 0x65, 0xbd, 0x5e, 0x93, 0x26, 0xe4,
+		0x93, 0x20, 0x47, 0x2b, 0x83, 0x4b, 0x29, 0x27, 0x60, 0x36, 0x4a, 0x67, 0xd7, 0xe9, 0x93, 0x18,
+		0x26, 0x1a, 0x1d, 0xc7, 0x24, 0xf4, 0xdc, 0x4f, 0x35, 0x84, 0xd5, 0x46, 0x47, 0x20, 0xbf, 0xf6,
+		0xef, 0xd8, 0x15, 0xeb, 0xb9, 0x50, 0x3c, 0xb0, 0x79, 0x65, 0x03, 0x66, 0xf1, 0xd2, 0x97, 0x13,
+		0x83, 0x1c, 0x31, 0x4f, 0x23, 0x20, 0x3d, 0xc9, 0xe4, 0x92, 0x21, 0xda, 0x45, 0xc8, 0x78, 0xb4,
+		0xf5, 0xa3, 0x20, 0xbe, 0xca, 0x21, 0x38, 0x7b, 0xc5, 0x82, 0x59, 0x0c, 0xfb, 0x30, 0x3b, 0x14,
+		0xaa, 0x71, 0x74, 0x92, 0xe1, 0x9f, 0x7e, 0xf1, 0x69, 0x7a, 0xae, 0xf9, 0x40, 0xbc, 0x5b, 0x06,
+		0xb8, 0x93, 0x22, 0x71, 0xec, 0x50, 0x51, 0x02, 0x05, 0xf1, 0x3c, 0xae, 0xf0, 0xd1, 0x0f, 0xfb,
+		0x67, 0xfc, 0x61, 0x8b, 0x83, 0x7c, 0x20, 0xf2, 0xa4, 0x19, 0x8e, 0xca, 0x2a, 0xaa, 0xf5, 0x61,
+		0x63, 0xfa, 0xf5, 0x27, 0x22, 0x4b, 0x13, 0x83, 0xe4, 0x3f, 0x4f, 0x51, 0xe4, 0xcb, 0xd1, 0xc7,
+		0x04, 0x63, 0xef, 0x37, 0x53, 0xb0, 0xc8, 0x99, 0xf7, 0x34, 0x8f, 0x9c, 0xbd, 0xf9, 0xcc, 0x1e,
+		0xf1, 0xb5, 0x67, 0xce, 0xea, 0xb6, 0x21, 0xe6, 0xea, 0x39, 0x3e, 0x1c, 0xb1, 0x7e, 0x85, 0xd7,
+		0x2f, 0x0c, 0x3c, 0xcd, 0x5c, 0x18, 0x3e, 0x8c, 0xcb, 0xbb, 0x90, 0x5e, 0xb3, 0x0d, 0x0b, 0xa7,
+		0xaa, 0x16, 0xb1, 0xec, 0x0e, 0x1f, 0x3d, 0xac, 0x20, 0x3f, 0x03, 0x19, 0xad, 0x63, 0x77, 0x2d,
+		0x9f, 0x8d, 0x9c, 0xea, 0xa9, 0xaf, 0xbc, 0xb3, 0x34, 0xf1, 0x3b, 0xef, 0x2c, 0xa5, 0xd6, 0x2d,
+		0xff, 0x37, 0xbe, 0xf4, 0x14, 0x70, 0xa8, 0x75, 0xcb, 0x57, 0x38, 0x63, 0x25, 0xfd, 0xcd, 0xb7,
+		0x97, 0x12, 0xe5, 0x57, 0x61, 0xaa, 0x46, 0xf4, 0xf7, 0x83, 0x5c, 0x23, 0x7a, 0x04, 0xb9, 0x46,
+		0xf4, 0x1e, 0xe4, 0x8b, 0x90, 0x5d, 0xb7, 0x7c, 0x76, 0x6b, 0xfd, 0x09, 0x48, 0x19, 0x16, 0xbb,
+		0x08, 0x79, 0xa4, 0x6e, 0xc8, 0x85, 0x82, 0x35, 0xa2, 0x07, 0x82, 0x2d, 0xa2, 0x97, 0x12, 0xa3,
+		0x1e, 0x8d, 0x5c, 0xd5, 0xda, 0x6f, 0xfd, 0xe7, 0xc5, 0x89, 0xb7, 0xde, 0x5d, 0x9c, 0x18, 0xda,
+		0xc5, 0xe5, 0xa1, 0x5d, 0xec, 0xb5, 0x6e, 0xb0, 0x19, 0x39, 0xe8, 0xd9, 0xcf, 0xa7, 0xe1, 0x7e,
+		0xfa, 0x32, 0x93, 0xdb, 0x31, 0x2c, 0xff, 0xac, 0xee, 0x1e, 0x3a, 0x3e, 0x0d, 0x57, 0xec, 0x7d,
+		0xde, 0xb1, 0xb3, 0x61, 0xf5, 0x0a, 0xab, 0x1e, 0xdc, 0xad, 0xe5, 0x7d, 0x98, 0x6c, 0xa0, 0x1c,
+		0x9a, 0xd8, 0xb7, 0x7d, 0xcd, 0xe4, 0xeb, 0x0f, 0x2b, 0x20, 0x95, 0xbd, 0x00, 0x95, 0x64, 0x54,
+		0x43, 0xbc, 0xfb, 0x64, 0x12, 0x6d, 0x9f, 0xdd, 0x23, 0x4f, 0xd1, 0xc0, 0x25, 0x8b, 0x04, 0x7a,
+		0x65, 0x7c, 0x1e, 0x26, 0xb5, 0x2e, 0xbb, 0xc0, 0x90, 0xc2, 0x88, 0x86, 0x16, 0xca, 0x2f, 0xc1,
+		0x14, 0x3f, 0x46, 0xc5, 0x23, 0xfc, 0x1b, 0xe4, 0x90, 0x3e, 0x27, 0xaf, 0xe0, 0x5f, 0x79, 0x05,
+		0x26, 0xa9, 0xf2, 0xfc, 0x05, 0x99, 0xd2, 0x4a, 0x9f, 0xf6, 0x2b, 0x54, 0x49, 0x85, 0xb1, 0x95,
+		0xaf, 0x41, 0xb6, 0x66, 0x77, 0x0c, 0xcb, 0x8e, 0xa3, 0xe5, 0x18, 0x1a, 0xd5, 0xd9, 0xe9, 0x72,
+		0xaf, 0x50, 0x58, 0x01, 0x6f, 0x57, 0xb2, 0xf7, 0x0a, 0xf8, 0x25, 0x0c, 0x5e, 0x2a, 0xaf, 0xc1,
+		0x14, 0xc5, 0xde, 0x76, 0x70, 0xf2, 0x0f, 0xae, 0x70, 0xe6, 0xf8, 0x5b, 0x66, 0x1c, 0x3e, 0x19,
+		0x2a, 0x2b, 0x43, 0xba, 0xa5, 0xf9, 0x1a, 0x6f, 0x37, 0xfd, 0x5f, 0xfe, 0x08, 0x64, 0x39, 0x88,
+		0x27, 0x9f, 0x83, 0x94, 0xed, 0x78, 0xfc, 0x1a, 0xc5, 0xc2, 0xb0, 0xa6, 0x6c, 0x3b, 0xd5, 0x34,
+		0xfa, 0x8c, 0x82, 0xcc, 0x55, 0x65, 0xa8, 0x5b, 0x3c, 0x1f, 0x71, 0x8b, 0x48, 0x97, 0x47, 0xfe,
+		0xb2, 0x2e, 0xed, 0x73, 0x87, 0xc0, 0x59, 0x3e, 0x93, 0x84, 0xc5, 0x48, 0xed, 0x4d, 0xe2, 0x7a,
+		0x86, 0x6d, 0x31, 0x8f, 0xe2, 0xde, 0x22, 0x47, 0x94, 0xe4, 0xf5, 0x43, 0xdc, 0xe5, 0xc3, 0x90,
+		0x5a, 0x75, 0x1c, 0x7c, 0xbd, 0x8e, 0x96, 0x75, 0x9b, 0xf9, 0x4b, 0x5a, 0x09, 0xca, 0x58, 0xe7,
+		0xd9, 0xfb, 0xfe, 0x2d, 0xcd, 0x0d, 0x5e, 0xbd, 0x13, 0xe5, 0xf2, 0x25, 0xc8, 0xad, 0xd9, 0x96,
+		0x47, 0x2c, 0xaf, 0x4b, 0x23, 0x9b, 0x3d, 0xd3, 0xd6, 0x6f, 0x70, 0x04, 0x56, 0x40, 0x83, 0x6b,
+		0x8e, 0x43, 0x25, 0xd3, 0x0a, 0xfe, 0x65, 0x63, 0xb6, 0xda, 0x1c, 0x6a, 0xa2, 0x4b, 0xc7, 0x37,
+		0x11, 0x6f, 0x64, 0x60, 0xa3, 0xef, 0x25, 0xe0, 0x43, 0xfd, 0x03, 0xea, 0x06, 0x39, 0xf4, 0x8e,
+		0x3b, 0x9e, 0x5e, 0x85, 0x5c, 0x83, 0xbe, 0xff, 0xfe, 0x12, 0x39, 0x94, 0x17, 0x60, 0x8a, 0xb4,
+		0xce, 0x9d, 0x3f, 0xff, 0xcc, 0x25, 0xe6, 0xed, 0x2f, 0x4e, 0x28, 0x82, 0x20, 0x2f, 0x42, 0xce,
+		0x23, 0xba, 0x73, 0xee, 0xfc, 0x85, 0x1b, 0xcf, 0x30, 0xf7, 0x7a, 0x71, 0x42, 0x09, 0x49, 0x95,
+		0x2c, 0xb6, 0xfa, 0x9b, 0x9f, 0x59, 0x4a, 0x54, 0x27, 0x21, 0xe5, 0x75, 0x3b, 0x1f, 0xa8, 0x8f,
+		0x7c, 0x72, 0x12, 0x96, 0xa3, 0x92, 0x34, 0xfe, 0xbb, 0xa9, 0x99, 0x46, 0x4b, 0x0b, 0xbf, 0x5c,
+		0x20, 0x45, 0x6c, 0x40, 0x39, 0x86, 0xac, 0x14, 0x47, 0x5a, 0xb2, 0xfc, 0x4b, 0x09, 0xc8, 0x5f,
+		0x17, 0xc8, 0xf8, 0xa9, 0x83, 0xcb, 0x00, 0xc1, 0x93, 0xc4, 0xb0, 0x39, 0xbd, 0xd2, 0xfb, 0xac,
+		0x95, 0x40, 0x46, 0x89, 0xb0, 0xcb, 0x17, 0xa9, 0x23, 0x3a, 0xb6, 0xc7, 0x5f, 0xc7, 0x1a, 0x21,
+		0x1a, 0x30, 0xe3, 0xe5, 0x38, 0x3a, 0xc3, 0xa9, 0x37, 0x6d, 0x1f, 0x6f, 0x0b, 0x38, 0xf6, 0x2d,
+		0xfe, 0x92, 0x6b, 0x4a, 0x91, 0x68, 0xcd, 0x75, 0x5a, 0xd1, 0x40, 0x3a, 0x2a, 0x9d, 0x0b, 0x50,
+		0x30, 0x58, 0xd7, 0x5a, 0x2d, 0x97, 0x78, 0x1e, 0x9f, 0xc4, 0x44, 0x11, 0xdf, 0x01, 0x73, 0xba,
+		0x7b, 0xaa, 0x98, 0x31, 0xf0, 0x2d, 0xba, 0x01, 0xe3, 0x5f, 0xf8, 0x07, 0x9f, 0x01, 0x32, 0x4e,
+		0x77, 0x0f, 0xbd, 0xe5, 0x01, 0xc8, 0x0f, 0x50, 0x66, 0xfa, 0x66, 0xa8, 0x07, 0xfd, 0xec, 0x02,
+		0x6f, 0x81, 0xea, 0xb8, 0x86, 0xed, 0x1a, 0xfe, 0x21, 0xbd, 0x0b, 0x95, 0x52, 0x24, 0x51, 0xd1,
+		0xe0, 0xf4, 0xf2, 0x0d, 0x28, 0x36, 0x69, 0x10, 0x17, 0x6a, 0x7e, 0x3e, 0xd4, 0x2f, 0x31, 0x5a,
+		0xbf, 0xa1, 0x9a, 0x25, 0xfb, 0x34, 0xab, 0xbe, 0x3c, 0xd4, 0x3b, 0x2f, 0x1e, 0xdf, 0x3b, 0xe3,
+		0xab, 0xdd, 0xef, 0x9d, 0x82, 0x0f, 0xf5, 0x56, 0xc6, 0xa6, 0xaf, 0x71, 0x1d, 0x73, 0xd4, 0x1e,
+		0x6d, 0xe1, 0xe8, 0x45, 0x75, 0x61, 0xc4, 0x34, 0xba, 0x30, 0x72, 0x08, 0x95, 0x2f, 0xc1, 0x0c,
+		0x5e, 0x6a, 0x6c, 0x12, 0xff, 0x45, 0xa2, 0xb5, 0x88, 0x1b, 0x5f, 0x75, 0x67, 0xc4, 0xaa, 0x2b,
+		0x43, 0x9a, 0x2e, 0xad, 0x6c, 0xd5, 0xa1, 0xff, 0xcb, 0x07, 0x90, 0x46, 0xd1, 0x70, 0x45, 0xe6,
+		0x12, 0xb4, 0x80, 0xd4, 0xbd, 0x43, 0x9f, 0x78, 0x22, 0x8d, 0x40, 0x0b, 0xf2, 0x73, 0x62, 0x5d,
+		0x4d, 0x1d, 0xbd, 0xae, 0x72, 0x47, 0xe4, 0xab, 0xab, 0x09, 0x53, 0x55, 0x9c, 0x8a, 0xd7, 0x6b,
+		0x81, 0x22, 0x89, 0x50, 0x11, 0x79, 0x13, 0x8a, 0x8e, 0xe6, 0xfa, 0xf4, 0x55, 0x92, 0x03, 0xda,
+		0x0a, 0xee, 0xeb, 0x4b, 0xfd, 0x23, 0x2f, 0xd6, 0x58, 0xfe, 0x94, 0x19, 0x27, 0x4a, 0x2c, 0xff,
+		0x97, 0x34, 0x64, 0xb8, 0x31, 0x3e, 0x0c, 0x53, 0xdc, 0xac, 0xdc, 0x3b, 0xef, 0x5f, 0xe9, 0x5f,
+		0x98, 0x56, 0x82, 0x05, 0x84, 0xe3, 0x09, 0x19, 0xf9, 0x11, 0xc8, 0xea, 0x07, 0x9a, 0x61, 0xa9,
+		0x46, 0x8b, 0x07, 0x84, 0xd3, 0xef, 0xbe, 0xb3, 0x34, 0xb5, 0x86, 0xb4, 0xf5, 0x9a, 0x32, 0x45,
+		0x2b, 0xd7, 0x5b, 0x18, 0x09, 0x1c, 0x10, 0xa3, 0x7d, 0xe0, 0xf3, 0x11, 0xc6, 0x4b, 0xf8, 0xcd,
+		0x15, 0x74, 0x08, 0xfe, 0xa2, 0xe1, 0x42, 0x5f, 0x84, 0x1f, 0x6c, 0xa1, 0xab, 0x59, 0x7c, 0xf0,
+		0xc7, 0xff, 0xd3, 0x52, 0x42, 0xa1, 0x12, 0xf2, 0x1a, 0xcc, 0x98, 0x9a, 0xe7, 0xab, 0x74, 0x05,
+		0xc3, 0xc7, 0x4f, 0x52, 0x88, 0x53, 0xfd, 0x06, 0xe1, 0x86, 0xe5, 0xaa, 0x4f, 0xa3, 0x14, 0x23,
+		0xb5, 0xf0, 0x3d, 0x28, 0x0a, 0x82, 0x77, 0x39, 0x0d, 0x9f, 0xc5, 0x56, 0x19, 0x6a, 0xf7, 0x02,
+		0xd2, 0xd7, 0x28, 0x99, 0x46, 0x58, 0xa7, 0x21, 0x47, 0x5f, 0x6d, 0xa2, 0x2c, 0xec, 0x12, 0x6e,
+		0x16, 0x09, 0xb4, 0xf2, 0x51, 0x28, 0x86, 0xf3, 0x23, 0x63, 0xc9, 0x32, 0x94, 0x90, 0x4c, 0x19,
+		0x9f, 0x86, 0x79, 0x8b, 0xdc, 0xf6, 0xd5, 0x90, 0xcc, 0xb8, 0x73, 0x94, 0x5b, 0xc6, 0xba, 0xeb,
+		0x71, 0x89, 0x87, 0xa1, 0xa0, 0x0b, 0xe3, 0x33, 0x5e, 0xa0, 0xbc, 0x33, 0x01, 0x95, 0xb2, 0x9d,
+		0x82, 0xac, 0xe6, 0x38, 0x8c, 0x61, 0x9a, 0xcf, 0x8f, 0x8e, 0x43, 0xab, 0x1e, 0x87, 0x59, 0xda,
+		0x46, 0x97, 0x78, 0x5d, 0xd3, 0xe7, 0x20, 0x79, 0xca, 0x53, 0xc4, 0x0a, 0x85, 0xd1, 0x29, 0xef,
+		0x83, 0x30, 0x43, 0x6e, 0x1a, 0x2d, 0x62, 0xe9, 0x84, 0xf1, 0xcd, 0x50, 0xbe, 0xbc, 0x20, 0x52,
+		0xa6, 0xc7, 0x20, 0x98, 0xf7, 0x54, 0x31, 0x27, 0x17, 0x18, 0x9e, 0xa0, 0xaf, 0x32, 0x72, 0xb9,
+		0x04, 0xe9, 0x9a, 0xe6, 0x6b, 0x18, 0x60, 0xf8, 0xb7, 0xd9, 0x42, 0x93, 0x57, 0xf0, 0x6f, 0xf9,
+		0x9b, 0x49, 0x48, 0x5f, 0xb7, 0x7d, 0x22, 0x3f, 0x1b, 0x09, 0x00, 0x0b, 0x83, 0xfc, 0xb9, 0x69,
+		0xb4, 0x2d, 0xd2, 0xda, 0xf4, 0xda, 0x91, 0xef, 0x10, 0x84, 0xee, 0x94, 0x8c, 0xb9, 0xd3, 0x3c,
+		0x4c, 0xba, 0x76, 0xd7, 0x6a, 0x89, 0xfb, 0xab, 0xb4, 0x20, 0xd7, 0x21, 0x1b, 0x78, 0x49, 0x7a,
+		0x94, 0x97, 0x14, 0xd1, 0x4b, 0xd0, 0x87, 0x39, 0x41, 0x99, 0xda, 0xe3, 0xce, 0x52, 0x85, 0x5c,
+		0x30, 0x79, 0x95, 0x26, 0x8f, 0xe1, 0xb0, 0xa1, 0x18, 0x2e, 0x26, 0x41, 0xdf, 0x07, 0xc6, 0x63,
+		0x1e, 0x27, 0x05, 0x15, 0xdc, 0x7a, 0x31, 0xb7, 0xe2, 0xdf, 0x44, 0x98, 0xa2, 0xed, 0x0a, 0xdd,
+		0x8a, 0x7d, 0x17, 0xe1, 0x43, 0x78, 0x1d, 0xa9, 0x6d, 0x69, 0x7e, 0xd7, 0x25, 0xdc, 0xf3, 0x42,
+		0x02, 0xbe, 0xad, 0x92, 0x61, 0x9e, 0x1c, 0xb1, 0x5b, 0x62, 0xb0, 0xdd, 0x92, 0xc3, 0xec, 0x96,
+		0x7a, 0xff, 0x76, 0x5b, 0x05, 0x08, 0x94, 0xf1, 0xf8, 0xab, 0xea, 0x03, 0x22, 0x06, 0xa6, 0x62,
+		0xd3, 0x68, 0xf3, 0x81, 0x1a, 0x11, 0x2a, 0xff, 0xc7, 0x04, 0xe4, 0x82, 0x7a, 0x79, 0x15, 0x66,
+		0x84, 0x5e, 0xea, 0xbe, 0xa9, 0xb5, 0xb9, 0xef, 0xdc, 0x3f, 0x54, 0xb9, 0x2b, 0xa6, 0xd6, 0x56,
+		0xa6, 0xb9, 0x3e, 0x58, 0x18, 0xdc, 0x0f, 0xc9, 0x21, 0xfd, 0x10, 0xeb, 0xf8, 0xd4, 0xfb, 0xeb,
+		0xf8, 0x58, 0x17, 0xa5, 0x7b, 0xbb, 0xe8, 0x8b, 0x49, 0xba, 0x99, 0x71, 0x6c, 0x4f, 0x33, 0xbf,
+		0x1f, 0x23, 0xe2, 0x34, 0xe4, 0x1c, 0xdb, 0x54, 0x59, 0x0d, 0xbb, 0xd7, 0x9d, 0x75, 0x6c, 0x53,
+		0xe9, 0xeb, 0xf6, 0xc9, 0x7b, 0x34, 0x5c, 0x32, 0xf7, 0xc0, 0x6a, 0x53, 0xbd, 0x56, 0x73, 0x21,
+		0xcf, 0x4c, 0xc1, 0xd7, 0xb2, 0xa7, 0xd1, 0x06, 0xf8, 0xaf, 0x94, 0xe8, 0x5f, 0x7b, 0x99, 0xda,
+		0x8c, 0x53, 0xc9, 0x1c, 0x04, 0x12, 0x6c, 0xea, 0x2f, 0x25, 0x87, 0x49, 0x30, 0xb7, 0x53, 0x38,
+		0x5f, 0xf9, 0xaf, 0x27, 0x00, 0x36, 0xd0, 0xb2, 0xb4, 0xbd, 0xb8, 0x0a, 0x79, 0x54, 0x05, 0x35,
+		0xf6, 0xe4, 0xc5, 0x61, 0x9d, 0xc6, 0x9f, 0x9f, 0xf7, 0xa2, 0x7a, 0xaf, 0xc1, 0x4c, 0xe8, 0x8c,
+		0x1e, 0x11, 0xca, 0x2c, 0x1e, 0x11, 0x55, 0x37, 0x89, 0xaf, 0xe4, 0x6f, 0x46, 0x4a, 0xe5, 0x7f,
+		0x99, 0x80, 0x1c, 0xd5, 0x09, 0x5f, 0xb4, 0x8d, 0xf5, 0x61, 0xe2, 0xfd, 0xf7, 0xe1, 0xfd, 0x00,
+		0x0c, 0x06, 0x0f, 0x67, 0xb9, 0x67, 0xe5, 0x28, 0x05, 0x8f, 0x5c, 0xe5, 0x0b, 0x81, 0xc1, 0x53,
+		0x47, 0x1b, 0x5c, 0x44, 0xdd, 0xdc, 0xec, 0xf7, 0xc1, 0x14, 0xfd, 0xb4, 0xd3, 0x6d, 0x8f, 0x07,
+		0xd2, 0xf8, 0x3d, 0x87, 0x9d, 0xdb, 0x5e, 0xf9, 0x0d, 0x98, 0xda, 0xb9, 0xcd, 0x72, 0x23, 0xa7,
+		0x21, 0xe7, 0xda, 0x36, 0x5f, 0x93, 0x59, 0x2c, 0x94, 0x45, 0x02, 0x5d, 0x82, 0x44, 0x3e, 0x20,
+		0x19, 0xe6, 0x03, 0xc2, 0x84, 0x46, 0x6a, 0xac, 0x84, 0xc6, 0xe3, 0xbf, 0x99, 0x80, 0xe9, 0xc8,
+		0xfc, 0x20, 0x3f, 0x03, 0x27, 0xaa, 0x1b, 0xdb, 0x6b, 0x2f, 0xa9, 0xeb, 0x35, 0xf5, 0xca, 0xc6,
+		0xea, 0xd5, 0xf0, 0xcd, 0xa5, 0x85, 0x93, 0x77, 0xee, 0x2e, 0xcb, 0x11, 0xde, 0x5d, 0x8b, 0xe6,
+		0xe9, 0xe5, 0xb3, 0x30, 0x1f, 0x17, 0x59, 0xad, 0x36, 0xf1, 0x35, 0xa6, 0xc4, 0xc2, 0x89, 0x3b,
+		0x77, 0x97, 0x67, 0x23, 0x12, 0xab, 0x7b, 0x1e, 0xb1, 0xfc, 0x7e, 0x81, 0xb5, 0xed, 0xcd, 0xcd,
+		0xf5, 0x1d, 0x29, 0xd9, 0x27, 0xc0, 0x27, 0xec, 0xc7, 0x60, 0x36, 0x2e, 0xb0, 0xb5, 0xbe, 0x21,
+		0xa5, 0x16, 0xe4, 0x3b, 0x77, 0x97, 0x0b, 0x11, 0xee, 0x2d, 0xc3, 0x5c, 0xc8, 0xfe, 0xd8, 0x67,
+		0x17, 0x27, 0x7e, 0xe1, 0xe7, 0x17, 0x13, 0xd8, 0xb2, 0x99, 0xd8, 0x1c, 0x21, 0x3f, 0x09, 0xf7,
+		0x35, 0xd7, 0xaf, 0x6e, 0xd5, 0x6b, 0xea, 0x66, 0xf3, 0xaa, 0xc8, 0x74, 0x8b, 0xd6, 0x15, 0xef,
+		0xdc, 0x5d, 0x9e, 0xe6, 0x4d, 0x1a, 0xc6, 0xdd, 0x50, 0xea, 0xd7, 0xb7, 0x77, 0xea, 0x52, 0x82,
+		0x71, 0x37, 0x5c, 0x72, 0xd3, 0xf6, 0xd9, 0xb7, 0xdf, 0x9e, 0x86, 0x53, 0x03, 0xb8, 0x83, 0x86,
+		0xcd, 0xde, 0xb9, 0xbb, 0x3c, 0xd3, 0x70, 0x09, 0x1b, 0x3f, 0x54, 0x62, 0x05, 0x4a, 0xfd, 0x12,
+		0xdb, 0x8d, 0xed, 0xe6, 0xea, 0x86, 0xb4, 0xbc, 0x20, 0xdd, 0xb9, 0xbb, 0x9c, 0x17, 0x93, 0x21,
+		0xf2, 0x87, 0x2d, 0xfb, 0x20, 0x77, 0x3c, 0x5f, 0x7b, 0x12, 0x1e, 0xe2, 0x39, 0x40, 0xcf, 0xd7,
+		0x6e, 0x18, 0x56, 0x3b, 0x48, 0xde, 0xf2, 0x32, 0xdf, 0xf9, 0x9c, 0x64, 0x5c, 0x2b, 0x82, 0x3a,
+		0x22, 0x85, 0x3b, 0xf4, 0xe4, 0x72, 0x61, 0xc4, 0xa1, 0xde, 0xe8, 0xad, 0xd3, 0xf0, 0xf4, 0xf0,
+		0xc2, 0x88, 0x24, 0xf4, 0xc2, 0x91, 0x9b, 0xbb, 0xf2, 0xc7, 0x12, 0x50, 0x78, 0xd1, 0xf0, 0x7c,
+		0xdb, 0x35, 0x74, 0xcd, 0xa4, 0xef, 0x2b, 0x5d, 0x18, 0x77, 0x6e, 0xed, 0x19, 0xea, 0x2f, 0x40,
+		0xe6, 0xa6, 0x66, 0xb2, 0x49, 0x2d, 0x7a, 0x16, 0xd0, 0x6b, 0xbe, 0x70, 0x6a, 0x13, 0x00, 0x4c,
+		0xac, 0xfc, 0x85, 0x24, 0x14, 0xe9, 0x60, 0xf0, 0xd8, 0xa7, 0xbb, 0x70, 0x8f, 0xd5, 0x80, 0xb4,
+		0xab, 0xf9, 0x3c, 0x69, 0x58, 0xfd, 0x01, 0x9e, 0x07, 0x7e, 0x64, 0x74, 0x36, 0x77, 0xa5, 0x3f,
+		0x55, 0x4c, 0x91, 0xe4, 0x57, 0x20, 0xdb, 0xd1, 0x6e, 0xab, 0x14, 0x35, 0x79, 0x0f, 0x50, 0xa7,
+		0x3a, 0xda, 0x6d, 0xd4, 0x55, 0x6e, 0x41, 0x11, 0x81, 0xf5, 0x03, 0xcd, 0x6a, 0x13, 0x86, 0x9f,
+		0xba, 0x07, 0xf8, 0x33, 0x1d, 0xed, 0xf6, 0x1a, 0xc5, 0xc4, 0xa7, 0x54, 0xb2, 0x9f, 0x78, 0x7b,
+		0x69, 0x82, 0xa6, 0xd9, 0x7f, 0x25, 0x01, 0x10, 0x9a, 0x4b, 0xfe, 0xd3, 0x20, 0xe9, 0x41, 0x89,
+		0x3e, 0xde, 0xe3, 0x1d, 0xf8, 0xe8, 0xb0, 0x8e, 0xe8, 0x31, 0x36, 0x5b, 0x98, 0xbf, 0xf6, 0xce,
+		0x52, 0x42, 0x29, 0xea, 0x3d, 0xfd, 0x50, 0x87, 0xe9, 0xae, 0xd3, 0xd2, 0x7c, 0xa2, 0xd2, 0x4d,
+		0x5c, 0xf2, 0x18, 0x8b, 0x3c, 0x30, 0x41, 0xac, 0x8a, 0x68, 0xff, 0x85, 0x04, 0x4c, 0xd7, 0x22,
+		0x87, 0x7c, 0x25, 0x98, 0xea, 0xd8, 0x96, 0x71, 0x83, 0xbb, 0x5d, 0x4e, 0x11, 0x45, 0xcc, 0x78,
+		0xb2, 0x37, 0x35, 0xfd, 0x43, 0x91, 0xf1, 0x14, 0x65, 0x94, 0xba, 0x45, 0xf6, 0x3c, 0x43, 0xd8,
+		0x5a, 0x11, 0x45, 0xdc, 0xba, 0x78, 0x44, 0xef, 0x62, 0xaa, 0x46, 0xd5, 0x6d, 0xcb, 0xd7, 0x74,
+		0x9f, 0xbf, 0xf3, 0x57, 0x14, 0xf4, 0x35, 0x46, 0x46, 0x90, 0x16, 0xf1, 0x35, 0xc3, 0xf4, 0x4a,
+		0xec, 0x20, 0x4c, 0x14, 0x23, 0xea, 0xfe, 0x5a, 0x26, 0x9a, 0xa2, 0x5a, 0x03, 0xc9, 0x76, 0x88,
+		0x1b, 0x0b, 0x29, 0x99, 0x87, 0x96, 0x7e, 0xe3, 0x4b, 0x4f, 0xcd, 0x73, 0x73, 0xf3, 0xa0, 0x92,
+		0x5d, 0x6a, 0x55, 0x8a, 0x42, 0x82, 0x93, 0xe5, 0xd7, 0x40, 0x0a, 0x76, 0x76, 0xaa, 0xd3, 0xdd,
+		0x0b, 0xd3, 0x5a, 0xf3, 0x7d, 0x76, 0x5d, 0xb5, 0x0e, 0xab, 0xa5, 0xaf, 0x86, 0xd0, 0x61, 0x2e,
+		0x09, 0x13, 0x49, 0xc5, 0x00, 0xa7, 0x41, 0x61, 0x30, 0x44, 0x7c, 0x43, 0x33, 0x4c, 0xf1, 0x02,
+		0xba, 0xc2, 0x4b, 0x72, 0x05, 0x32, 0x9e, 0xaf, 0xf9, 0x5d, 0x8f, 0x7f, 0x58, 0xae, 0x3c, 0xcc,
+		0x33, 0xaa, 0xb6, 0xd5, 0x6a, 0x52, 0x4e, 0x85, 0x4b, 0xc8, 0x3b, 0x90, 0xf1, 0xed, 0x1b, 0xc4,
+		0xe2, 0x46, 0x3a, 0x96, 0x57, 0x0f, 0x38, 0x8b, 0x62, 0x58, 0x72, 0x1b, 0xa4, 0x16, 0x31, 0x49,
+		0x9b, 0x05, 0x44, 0x07, 0x1a, 0xee, 0x1b, 0x32, 0xf7, 0x60, 0xd4, 0x14, 0x03, 0xd4, 0x26, 0x05,
+		0x95, 0x5f, 0x8a, 0x1f, 0x33, 0xb3, 0xaf, 0x30, 0x3e, 0x38, 0xac, 0xfd, 0x11, 0xcf, 0x14, 0xc9,
+		0x84, 0x88, 0x34, 0x3a, 0x57, 0xd7, 0xda, 0xb3, 0x2d, 0xfa, 0x9a, 0x28, 0x0f, 0xc6, 0xb3, 0x34,
+		0xbc, 0x29, 0x06, 0xf4, 0x17, 0x29, 0x59, 0x7e, 0x09, 0x0a, 0x21, 0x2b, 0x1d, 0x3b, 0xb9, 0x63,
+		0x8c, 0x9d, 0x99, 0x40, 0x16, 0x6b, 0xe5, 0x17, 0x01, 0xc2, 0x81, 0x49, 0xd3, 0x03, 0xd3, 0xe7,
+		0xca, 0xa3, 0x47, 0xb7, 0xd8, 0x66, 0x85, 0xb2, 0xb2, 0x09, 0x73, 0x1d, 0xc3, 0x52, 0x3d, 0x62,
+		0xee, 0xab, 0xdc, 0x54, 0x08, 0x39, 0x7d, 0x0f, 0xba, 0x76, 0xb6, 0x63, 0x58, 0x4d, 0x62, 0xee,
+		0xd7, 0x02, 0xd8, 0x4a, 0xfe, 0xc7, 0xde, 0x5e, 0x9a, 0xe0, 0x63, 0x69, 0xa2, 0xdc, 0xa0, 0x29,
+		0x6a, 0x3e, 0x0c, 0x88, 0x27, 0x5f, 0x80, 0x9c, 0x26, 0x0a, 0x34, 0x71, 0x70, 0xd4, 0x30, 0x0a,
+		0x59, 0xd9, 0xe8, 0x7c, 0xeb, 0x3f, 0x2c, 0x27, 0xca, 0x3f, 0x9f, 0x80, 0x4c, 0xed, 0x7a, 0x43,
+		0x33, 0x5c, 0xb9, 0x8e, 0x87, 0xd7, 0xc2, 0xa1, 0xc6, 0x1d, 0x9b, 0xa1, 0x0f, 0x8a, 0xc1, 0x59,
+		0x1f, 0xb6, 0x6b, 0x3c, 0x12, 0xa6, 0x77, 0x3f, 0xd9, 0xd3, 0xf0, 0x3a, 0x4c, 0x31, 0x2d, 0xf1,
+		0x35, 0xe3, 0x49, 0x07, 0xff, 0x94, 0x12, 0xb1, 0xa3, 0xec, 0x7e, 0x47, 0xa4, 0xfc, 0x41, 0x06,
+		0x11, 0x45, 0xca, 0xdf, 0x4b, 0x00, 0xd4, 0xae, 0x5f, 0xdf, 0x71, 0x0d, 0xc7, 0x24, 0xfe, 0xbd,
+		0x6a, 0xf1, 0x06, 0x9c, 0x08, 0x5b, 0xec, 0xb9, 0xfa, 0xd8, 0xad, 0x9e, 0x0b, 0x37, 0x27, 0xae,
+		0x3e, 0x10, 0xad, 0xe5, 0xf9, 0x01, 0x5a, 0x6a, 0x6c, 0xb4, 0x9a, 0xe7, 0x0f, 0x36, 0x63, 0x13,
+		0xa6, 0xc3, 0xe6, 0xe3, 0xa7, 0xb8, 0xb2, 0x3e, 0xff, 0xcf, 0xad, 0x59, 0x1e, 0x6e, 0x4d, 0x21,
+		0xc6, 0x2d, 0x1a, 0x48, 0x96, 0xff, 0x1f, 0x1a, 0x35, 0xf0, 0xd8, 0x3f, 0x5e, 0x6e, 0x84, 0x73,
+		0x2f, 0x9f, 0x1b, 0xef, 0x45, 0x44, 0xc1, 0xb1, 0x7a, 0xac, 0xfa, 0xd1, 0x24, 0x7e, 0x83, 0x81,
+		0xcf, 0x36, 0x7f, 0x6c, 0x2d, 0xd1, 0x80, 0x29, 0x62, 0xf9, 0xae, 0x41, 0x4d, 0x81, 0x7d, 0xfd,
+		0xf4, 0xb0, 0xbe, 0x1e, 0xd0, 0x16, 0xfa, 0x7d, 0x23, 0x91, 0xd7, 0xe6, 0x30, 0x3d, 0x56, 0xf8,
+		0xf7, 0x49, 0x28, 0x0d, 0x93, 0xc4, 0x2c, 0x9d, 0xee, 0x12, 0x4a, 0x50, 0x63, 0xc9, 0xb5, 0x82,
+		0x20, 0xf3, 0x49, 0x7f, 0x13, 0x30, 0x80, 0x42, 0xc7, 0x42, 0xd6, 0x63, 0x47, 0x4c, 0x85, 0x50,
+		0x18, 0xab, 0x65, 0x02, 0x45, 0xc3, 0x32, 0x7c, 0x43, 0x33, 0xd5, 0x3d, 0xcd, 0xd4, 0x2c, 0xfd,
+		0xfd, 0x44, 0x96, 0xfd, 0x13, 0x75, 0x81, 0x83, 0x56, 0x19, 0xa6, 0x7c, 0x1d, 0xa6, 0x04, 0x7c,
+		0xfa, 0x1e, 0xc0, 0x0b, 0xb0, 0x48, 0x14, 0xf5, 0xdb, 0x49, 0x98, 0x55, 0x48, 0xeb, 0x4f, 0x96,
+		0x59, 0x7f, 0x10, 0x80, 0x0d, 0x38, 0x9c, 0x07, 0x4b, 0xe9, 0x7b, 0x30, 0x80, 0x73, 0x0c, 0xaf,
+		0xe6, 0xf9, 0x11, 0xdb, 0x7e, 0x35, 0x09, 0xf9, 0xa8, 0x6d, 0xff, 0x04, 0xac, 0x0b, 0xf2, 0x7a,
+		0x38, 0x1b, 0xa4, 0xf9, 0x97, 0x59, 0x87, 0xcc, 0x06, 0x7d, 0x5e, 0x77, 0xf4, 0x34, 0xf0, 0x3b,
+		0x29, 0xc8, 0x34, 0x34, 0x57, 0xeb, 0x78, 0xf2, 0xb5, 0xbe, 0x00, 0x4e, 0x64, 0xd9, 0xfa, 0xbe,
+		0xbf, 0xcd, 0x37, 0xf5, 0xcc, 0xe5, 0x3e, 0x31, 0x20, 0x7e, 0x7b, 0x18, 0x0a, 0xb8, 0x45, 0x8c,
+		0x1c, 0xc8, 0x27, 0xe9, 0x31, 0x23, 0xee, 0xf1, 0xc2, 0xd3, 0x20, 0xfc, 0x78, 0x07, 0xb2, 0x85,
+		0x13, 0x1d, 0xf2, 0x40, 0x47, 0xbb, 0x5d, 0x67, 0x14, 0xf9, 0x29, 0x90, 0x0f, 0x82, 0x4d, 0xbb,
+		0x1a, 0x9a, 0x00, 0xf9, 0x66, 0xc3, 0x1a, 0xc1, 0x8e, 0xb9, 0x3d, 0xdb, 0x6a, 0xa9, 0xec, 0x92,
+		0x17, 0xdb, 0xe3, 0xe4, 0x90, 0x52, 0x43, 0x82, 0xfc, 0x23, 0x2c, 0x16, 0xec, 0xd9, 0x3d, 0xf2,
+		0x30, 0x7c, 0xe3, 0x78, 0x9e, 0xfa, 0x9d, 0x77, 0x96, 0x16, 0x0e, 0xb5, 0x8e, 0x59, 0x29, 0x0f,
+		0x80, 0x2c, 0xd3, 0xd8, 0x30, 0xbe, 0xeb, 0x94, 0xf7, 0xe1, 0xb4, 0xef, 0x6a, 0x96, 0xb7, 0x4f,
+		0xdc, 0x48, 0x24, 0xca, 0xbf, 0xad, 0xd8, 0x62, 0x5f, 0xa7, 0xab, 0x3e, 0xf2, 0x9d, 0x77, 0x96,
+		0xca, 0x0c, 0xf7, 0x08, 0xe6, 0xb2, 0x72, 0x4a, 0xd4, 0x46, 0xa7, 0x6e, 0x5a, 0x17, 0x19, 0x29,
+		0x9f, 0x4d, 0x80, 0x1c, 0xd6, 0x2b, 0xc4, 0x73, 0x6c, 0xcb, 0xa3, 0xc1, 0x75, 0x08, 0xc9, 0x3b,
+		0x79, 0x78, 0x24, 0x11, 0x70, 0x8a, 0xe0, 0x3a, 0x32, 0xf2, 0x2e, 0x85, 0x13, 0x69, 0x92, 0xfb,
+		0xca, 0x80, 0x9b, 0x80, 0x2b, 0x78, 0xf7, 0x4e, 0xb8, 0x61, 0xef, 0x5c, 0x39, 0x51, 0xfe, 0xed,
+		0x04, 0x9c, 0xea, 0xf3, 0xda, 0x40, 0xd9, 0x3f, 0x03, 0xb2, 0x4b, 0x62, 0x16, 0xc0, 0x4f, 0xf9,
+		0x31, 0xa5, 0x8f, 0x3d, 0x08, 0x66, 0xdd, 0xde, 0x8a, 0x0f, 0x6c, 0x2d, 0x60, 0x37, 0x04, 0xff,
+		0x79, 0x02, 0xe6, 0xa3, 0xca, 0x04, 0xcd, 0xda, 0x82, 0x7c, 0x54, 0x17, 0xde, 0xa0, 0x87, 0xc6,
+		0x69, 0x10, 0x6f, 0x4b, 0x4c, 0x5e, 0x7e, 0x39, 0x9c, 0x20, 0x58, 0x52, 0xea, 0x99, 0xb1, 0x6d,
+		0x23, 0x74, 0xea, 0x9d, 0x28, 0xd2, 0x22, 0x5a, 0x4a, 0x37, 0x6c, 0xdb, 0x94, 0xff, 0x2c, 0xcc,
+		0x5a, 0xb6, 0xaf, 0xe2, 0x68, 0x22, 0x2d, 0x95, 0xef, 0x90, 0xd9, 0x2c, 0xfb, 0xf2, 0xf1, 0x4c,
+		0xf6, 0xad, 0x77, 0x96, 0xfa, 0xa1, 0x7a, 0xec, 0x58, 0xb4, 0x6c, 0xbf, 0x4a, 0xeb, 0x77, 0x68,
+		0xb5, 0xec, 0xc2, 0x4c, 0xfc, 0xd1, 0x6c, 0x56, 0xde, 0x3c, 0xf6, 0xa3, 0x67, 0x8e, 0x7a, 0x6c,
+		0x7e, 0x2f, 0xf2, 0x4c, 0x76, 0x77, 0xea, 0xf7, 0xdf, 0x5e, 0x4a, 0x3c, 0xfe, 0xe5, 0x04, 0x40,
+		0x98, 0x2a, 0xc0, 0x6c, 0x72, 0x75, 0x7b, 0xab, 0xa6, 0x36, 0x77, 0x56, 0x77, 0x76, 0x9b, 0xf1,
+		0x1b, 0xd6, 0x22, 0xf7, 0xec, 0x39, 0x44, 0xa7, 0x1f, 0x3a, 0x94, 0x1f, 0x81, 0xf9, 0x38, 0x37,
+		0x96, 0xf0, 0xb3, 0x9c, 0x0b, 0xf9, 0x3b, 0x77, 0x97, 0xb3, 0x2c, 0x0a, 0x23, 0x78, 0x72, 0x7f,
+		0xa2, 0x9f, 0x0f, 0x6f, 0x67, 0x27, 0x17, 0x66, 0xee, 0xdc, 0x5d, 0xce, 0x05, 0xe1, 0x9a, 0x5c,
+		0x06, 0x39, 0xca, 0xc9, 0xf1, 0x52, 0x0b, 0x70, 0xe7, 0xee, 0x72, 0x86, 0x99, 0x6d, 0x21, 0x8d,
+		0x19, 0xe6, 0xea, 0x95, 0xa1, 0xd9, 0xe5, 0x27, 0x8f, 0xb4, 0xd8, 0xed, 0x20, 0x63, 0x1c, 0x4b,
+		0x29, 0xff, 0xff, 0x01, 0x00, 0xd9, 0xb9, 0x54, 0x88, 0x9c, 0x66, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1937,6 +1953,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.TransferDelegationEnabled != that1.TransferDelegationEnabled {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2787,6 +2806,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferDelegationEnabled {
+		i--
+		if m.TransferDelegationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3340,6 +3369,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.TransferDelegationEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -5906,6 +5938,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferDelegationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferDelegationEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -535,6 +535,89 @@ func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgTransferDelegation defines a SDK message for moving delegated tokens of a
+// delegator on a validator to a recipient.
+//
+// Since: cosmos-sdk 0.47
+type MsgTransferDelegation struct {
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	RecipientAddress string      `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	ValidatorAddress string      `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTransferDelegation) Reset()         { *m = MsgTransferDelegation{} }
+func (m *MsgTransferDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegation) ProtoMessage()    {}
+func (*MsgTransferDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{12}
+}
+func (m *MsgTransferDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegation.Merge(m, src)
+}
+func (m *MsgTransferDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegation proto.InternalMessageInfo
+
+// MsgTransferDelegationResponse defines the Msg/TransferDelegation response type.
+//
+// Since: cosmos-sdk 0.47
+type MsgTransferDelegationResponse struct {
+}
+
+func (m *MsgTransferDelegationResponse) Reset()         { *m = MsgTransferDelegationResponse{} }
+func (m *MsgTransferDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegationResponse) ProtoMessage()    {}
+func (*MsgTransferDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{13}
+}
+func (m *MsgTransferDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegationResponse.Merge(m, src)
+}
+func (m *MsgTransferDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -548,71 +631,76 @@ func init() {
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
 	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgTransferDelegation)(nil), "cosmos.staking.v1beta1.MsgTransferDelegation")
+	proto.RegisterType((*MsgTransferDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgTransferDelegationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xc6, 0x4e, 0x28, 0x13, 0xb5, 0x69, 0x37, 0x09, 0x38, 0xab, 0xca, 0xae, 0xdc, 0xd2,
-	0x46, 0x85, 0xac, 0x69, 0x28, 0x02, 0x55, 0xbd, 0xd4, 0x75, 0x2b, 0xaa, 0x62, 0x09, 0x6d, 0x28,
-	0x07, 0x84, 0x64, 0xcd, 0xee, 0x4e, 0x26, 0x23, 0xef, 0xce, 0xb8, 0x3b, 0xe3, 0xa8, 0xbe, 0x72,
-	0xe2, 0x46, 0x25, 0xfe, 0x40, 0x7f, 0x00, 0x42, 0x1c, 0xfa, 0x23, 0x2a, 0x4e, 0x51, 0x4f, 0x88,
-	0x43, 0x81, 0xe4, 0x00, 0x3f, 0x00, 0x71, 0x46, 0x3b, 0x3b, 0x3b, 0xfe, 0x58, 0x7b, 0xb3, 0x41,
-	0xe9, 0x01, 0x71, 0xf2, 0x6a, 0xe6, 0x79, 0x9f, 0x99, 0x79, 0xde, 0x67, 0xde, 0x77, 0x0c, 0xea,
-	0x1e, 0xe3, 0x21, 0xe3, 0x4d, 0x2e, 0x60, 0x8f, 0x50, 0xdc, 0xdc, 0xbf, 0xe1, 0x22, 0x01, 0x6f,
-	0x34, 0xc5, 0x13, 0xbb, 0x1f, 0x31, 0xc1, 0xcc, 0xb7, 0x12, 0x80, 0xad, 0x00, 0xb6, 0x02, 0x58,
-	0x1b, 0x98, 0x31, 0x1c, 0xa0, 0xa6, 0x44, 0xb9, 0x83, 0xdd, 0x26, 0xa4, 0xc3, 0x24, 0xc4, 0xaa,
-	0x4f, 0x4f, 0x09, 0x12, 0x22, 0x2e, 0x60, 0xd8, 0x57, 0x80, 0x35, 0xcc, 0x30, 0x93, 0x9f, 0xcd,
-	0xf8, 0x4b, 0x8d, 0x6e, 0x24, 0x2b, 0x75, 0x93, 0x09, 0xb5, 0x6c, 0x32, 0x55, 0x53, 0xbb, 0x74,
-	0x21, 0x47, 0x7a, 0x8b, 0x1e, 0x23, 0x54, 0xcd, 0x5f, 0x99, 0x73, 0x8a, 0x74, 0xd3, 0x09, 0xea,
-	0x6d, 0x85, 0x0a, 0x79, 0x8c, 0x88, 0x7f, 0x92, 0x89, 0xc6, 0xef, 0x15, 0x60, 0x76, 0x38, 0xbe,
-	0x1b, 0x21, 0x28, 0xd0, 0x17, 0x30, 0x20, 0x3e, 0x14, 0x2c, 0x32, 0x1f, 0x82, 0x65, 0x1f, 0x71,
-	0x2f, 0x22, 0x7d, 0x41, 0x18, 0xad, 0x1a, 0x97, 0x8c, 0xcd, 0xe5, 0xed, 0xcb, 0xf6, 0x6c, 0x41,
-	0xec, 0xf6, 0x08, 0xda, 0xaa, 0xbc, 0x78, 0x55, 0x2f, 0x39, 0xe3, 0xd1, 0x66, 0x07, 0x00, 0x8f,
-	0x85, 0x21, 0xe1, 0x3c, 0xe6, 0x5a, 0x90, 0x5c, 0xd7, 0xe6, 0x71, 0xdd, 0xd5, 0x48, 0x07, 0x0a,
-	0xc4, 0x15, 0xdf, 0x18, 0x81, 0x19, 0x80, 0xd5, 0x90, 0xd0, 0x2e, 0x47, 0xc1, 0x6e, 0xd7, 0x47,
-	0x01, 0xc2, 0x50, 0xee, 0xb1, 0x7c, 0xc9, 0xd8, 0x7c, 0xb3, 0x75, 0x3b, 0x86, 0xff, 0xf2, 0xaa,
-	0x7e, 0x15, 0x13, 0xb1, 0x37, 0x70, 0x6d, 0x8f, 0x85, 0x4a, 0x4f, 0xf5, 0xb3, 0xc5, 0xfd, 0x5e,
-	0x53, 0x0c, 0xfb, 0x88, 0xdb, 0x0f, 0xa8, 0x78, 0xf9, 0x7c, 0x0b, 0xa8, 0x8d, 0x3c, 0xa0, 0xc2,
-	0xb9, 0x10, 0x12, 0xba, 0x83, 0x82, 0xdd, 0xb6, 0xa6, 0x35, 0xef, 0x81, 0x0b, 0x6a, 0x11, 0x16,
-	0x75, 0xa1, 0xef, 0x47, 0x88, 0xf3, 0x6a, 0x45, 0xae, 0x55, 0x7d, 0xf9, 0x7c, 0x6b, 0x4d, 0x45,
-	0xdf, 0x49, 0x66, 0x76, 0x44, 0x44, 0x28, 0x76, 0xce, 0xeb, 0x10, 0x35, 0x1e, 0xd3, 0xec, 0xa7,
-	0xea, 0x6a, 0x9a, 0xc5, 0xe3, 0x68, 0x74, 0x48, 0x4a, 0x73, 0x1f, 0x2c, 0xf5, 0x07, 0x6e, 0x0f,
-	0x0d, 0xab, 0x4b, 0x52, 0xc6, 0x35, 0x3b, 0x31, 0x9c, 0x9d, 0x1a, 0xce, 0xbe, 0x43, 0x87, 0xad,
-	0xea, 0x4f, 0x23, 0x46, 0x2f, 0x1a, 0xf6, 0x05, 0xb3, 0x3f, 0x1b, 0xb8, 0x0f, 0xd1, 0xd0, 0x51,
-	0xd1, 0xe6, 0x87, 0x60, 0x71, 0x1f, 0x06, 0x03, 0x54, 0x7d, 0x43, 0xd2, 0x6c, 0xa4, 0xd9, 0x88,
-	0x5d, 0x36, 0x96, 0x0a, 0x92, 0xe6, 0x33, 0x41, 0xdf, 0xba, 0xf9, 0xcd, 0xb3, 0x7a, 0xe9, 0xcf,
-	0x67, 0xf5, 0xd2, 0xd7, 0x7f, 0xfc, 0x78, 0x3d, 0xab, 0x8b, 0x1c, 0xcd, 0x1c, 0xb3, 0x71, 0x11,
-	0x58, 0x59, 0x8b, 0x39, 0x88, 0xf7, 0x19, 0xe5, 0xa8, 0xf1, 0x5d, 0x19, 0x9c, 0xef, 0x70, 0x7c,
-	0xcf, 0x27, 0xe2, 0x35, 0xf9, 0x6f, 0xa6, 0xf6, 0x0b, 0x27, 0xd6, 0x1e, 0x82, 0x95, 0x91, 0x0b,
-	0xbb, 0x11, 0x14, 0x48, 0x79, 0xee, 0xe3, 0x82, 0x7e, 0x6b, 0x23, 0x6f, 0xcc, 0x6f, 0x6d, 0xe4,
-	0x39, 0xe7, 0xbc, 0x09, 0xb7, 0x9b, 0x7b, 0xb3, 0xad, 0x5d, 0x39, 0xd1, 0x32, 0x45, 0x6c, 0x7d,
-	0xab, 0x36, 0x91, 0xc9, 0x6c, 0xce, 0x2c, 0x50, 0x9d, 0x4e, 0x8a, 0xce, 0xd8, 0x5f, 0x06, 0x58,
-	0xee, 0x70, 0xac, 0xd8, 0xd0, 0xec, 0x2b, 0x62, 0x9c, 0xce, 0x15, 0x39, 0x79, 0x9a, 0x3e, 0x02,
-	0x4b, 0x30, 0x64, 0x03, 0x2a, 0x64, 0x76, 0x0a, 0x78, 0x5b, 0xc1, 0xa7, 0x24, 0xc9, 0x9c, 0xa8,
-	0xb1, 0x0e, 0x56, 0xc7, 0x4e, 0xad, 0xd5, 0x38, 0x58, 0x90, 0x15, 0xb4, 0x85, 0x30, 0xa1, 0x0e,
-	0xf2, 0x4f, 0x59, 0x94, 0x4f, 0xc1, 0xfa, 0x48, 0x14, 0x1e, 0x79, 0x85, 0x85, 0x59, 0xd5, 0x61,
-	0x3b, 0x91, 0x37, 0x93, 0xcd, 0xe7, 0x42, 0xb3, 0x95, 0x0b, 0xb3, 0xb5, 0xb9, 0xc8, 0x2a, 0x5d,
-	0x39, 0x5d, 0xa5, 0x7b, 0xb2, 0x60, 0x4c, 0x29, 0x9a, 0x0a, 0x6e, 0x76, 0xe4, 0x3d, 0xec, 0x07,
-	0x28, 0x36, 0x72, 0x37, 0x6e, 0xb0, 0xaa, 0x3e, 0x58, 0x99, 0x62, 0xf8, 0x79, 0xda, 0x7d, 0x5b,
-	0x67, 0xe2, 0x0d, 0x3c, 0xfd, 0xb5, 0x6e, 0xc8, 0x3b, 0xa7, 0x82, 0xe3, 0xe9, 0xc6, 0xdf, 0x06,
-	0x38, 0xdb, 0xe1, 0xf8, 0x11, 0xf5, 0xff, 0x67, 0x7e, 0xde, 0x05, 0xeb, 0x13, 0xe7, 0x7e, 0x5d,
-	0x02, 0xff, 0xb0, 0x00, 0x2e, 0xc6, 0xf5, 0x1f, 0x52, 0x0f, 0x05, 0x8f, 0xa8, 0xcb, 0xa8, 0x4f,
-	0x28, 0x3e, 0xae, 0xc5, 0xfe, 0xe7, 0xf4, 0x36, 0xaf, 0x81, 0x15, 0x2f, 0xee, 0x71, 0xb1, 0x68,
-	0x7b, 0x88, 0xe0, 0xbd, 0xe4, 0x5e, 0x94, 0x9d, 0x73, 0xe9, 0xf0, 0x27, 0x72, 0xf4, 0xd8, 0xc4,
-	0x5c, 0x05, 0x57, 0xf2, 0xf4, 0x4a, 0xf3, 0xb4, 0xfd, 0xfd, 0x22, 0x28, 0x77, 0x38, 0x36, 0x1f,
-	0x83, 0x95, 0xe9, 0xf7, 0xdb, 0xf5, 0x79, 0xad, 0x32, 0xdb, 0x88, 0xad, 0xed, 0xe2, 0x58, 0x6d,
-	0x91, 0x1e, 0x38, 0x3b, 0xd9, 0xb0, 0x37, 0x73, 0x48, 0x26, 0x90, 0xd6, 0xfb, 0x45, 0x91, 0x7a,
-	0xb1, 0xaf, 0xc0, 0x19, 0xdd, 0x6b, 0x2e, 0xe7, 0x44, 0xa7, 0x20, 0xeb, 0xdd, 0x02, 0x20, 0xcd,
-	0xfe, 0x18, 0xac, 0x4c, 0xd7, 0xee, 0x3c, 0xf5, 0xa6, 0xb0, 0xb9, 0xea, 0xcd, 0xab, 0x60, 0x2e,
-	0x00, 0x63, 0xe5, 0xe6, 0x9d, 0x1c, 0x86, 0x11, 0xcc, 0xda, 0x2a, 0x04, 0xd3, 0x6b, 0x7c, 0x6b,
-	0x80, 0x8d, 0xf9, 0x57, 0xee, 0x66, 0x5e, 0xce, 0xe7, 0x45, 0x59, 0xb7, 0xff, 0x4d, 0x54, 0xba,
-	0xa3, 0xd6, 0xfd, 0x17, 0x87, 0x35, 0xe3, 0xe0, 0xb0, 0x66, 0xfc, 0x76, 0x58, 0x33, 0x9e, 0x1e,
-	0xd5, 0x4a, 0x07, 0x47, 0xb5, 0xd2, 0xcf, 0x47, 0xb5, 0xd2, 0x97, 0xef, 0xe5, 0xbe, 0x6a, 0x9e,
-	0xe8, 0xff, 0x36, 0xf2, 0x7d, 0xe3, 0x2e, 0xc9, 0xea, 0xf3, 0xc1, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0x6a, 0x50, 0xaf, 0xc0, 0x0d, 0x00, 0x00,
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x93, 0x34, 0x94, 0x59, 0x75, 0xb7, 0xf5, 0xee, 0x42, 0xd6, 0x2a, 0x49, 0x95, 0x96,
+	0x76, 0x55, 0x58, 0x87, 0x2e, 0xad, 0x40, 0x55, 0x2f, 0x4d, 0xd3, 0x8a, 0xaa, 0x44, 0x42, 0xde,
+	0x96, 0x03, 0x42, 0x8a, 0xc6, 0xf6, 0xc4, 0x3b, 0x8a, 0x3d, 0xe3, 0x7a, 0x26, 0xab, 0x86, 0x23,
+	0x27, 0x6e, 0x54, 0xe2, 0x1f, 0xe8, 0x91, 0x13, 0xe2, 0xd0, 0x7f, 0x01, 0xa9, 0xe2, 0xb4, 0xea,
+	0x09, 0x71, 0x28, 0xb0, 0x7b, 0x80, 0x3f, 0x00, 0x71, 0x46, 0xb6, 0xc7, 0x93, 0x1f, 0x4e, 0xbc,
+	0x5e, 0xc8, 0x1e, 0x10, 0xa7, 0x58, 0x33, 0xdf, 0xfb, 0xde, 0xcc, 0xf7, 0xbe, 0x79, 0x33, 0x01,
+	0x75, 0x8b, 0x32, 0x8f, 0xb2, 0x26, 0xe3, 0xb0, 0x8f, 0x89, 0xd3, 0xdc, 0xbb, 0x66, 0x22, 0x0e,
+	0xaf, 0x35, 0xf9, 0x13, 0xdd, 0x0f, 0x28, 0xa7, 0xea, 0x1b, 0x31, 0x40, 0x17, 0x00, 0x5d, 0x00,
+	0xb4, 0x0d, 0x87, 0x52, 0xc7, 0x45, 0xcd, 0x08, 0x65, 0x0e, 0x7a, 0x4d, 0x48, 0x86, 0x71, 0x88,
+	0x56, 0x9f, 0x9e, 0xe2, 0xd8, 0x43, 0x8c, 0x43, 0xcf, 0x17, 0x80, 0x35, 0x87, 0x3a, 0x34, 0xfa,
+	0x6c, 0x86, 0x5f, 0x62, 0x74, 0x23, 0xce, 0xd4, 0x8d, 0x27, 0x44, 0xda, 0x78, 0xaa, 0x26, 0x56,
+	0x69, 0x42, 0x86, 0xe4, 0x12, 0x2d, 0x8a, 0x89, 0x98, 0xbf, 0x34, 0x67, 0x17, 0xc9, 0xa2, 0x63,
+	0xd4, 0x9b, 0x02, 0xe5, 0xb1, 0x10, 0x11, 0xfe, 0xc4, 0x13, 0x8d, 0xdf, 0xca, 0x40, 0xed, 0x30,
+	0xe7, 0x4e, 0x80, 0x20, 0x47, 0x9f, 0x42, 0x17, 0xdb, 0x90, 0xd3, 0x40, 0x7d, 0x00, 0x96, 0x6c,
+	0xc4, 0xac, 0x00, 0xfb, 0x1c, 0x53, 0x52, 0x55, 0x2e, 0x28, 0x9b, 0x4b, 0xdb, 0x17, 0xf5, 0xd9,
+	0x82, 0xe8, 0xed, 0x11, 0xb4, 0x55, 0x7e, 0xf1, 0xaa, 0x5e, 0x30, 0xc6, 0xa3, 0xd5, 0x0e, 0x00,
+	0x16, 0xf5, 0x3c, 0xcc, 0x58, 0xc8, 0x55, 0x8c, 0xb8, 0xae, 0xcc, 0xe3, 0xba, 0x23, 0x91, 0x06,
+	0xe4, 0x88, 0x09, 0xbe, 0x31, 0x02, 0xd5, 0x05, 0xab, 0x1e, 0x26, 0x5d, 0x86, 0xdc, 0x5e, 0xd7,
+	0x46, 0x2e, 0x72, 0x60, 0xb4, 0xc6, 0xd2, 0x05, 0x65, 0xf3, 0xf5, 0xd6, 0xad, 0x10, 0xfe, 0xf3,
+	0xab, 0xfa, 0x65, 0x07, 0xf3, 0xdd, 0x81, 0xa9, 0x5b, 0xd4, 0x13, 0x7a, 0x8a, 0x9f, 0x2d, 0x66,
+	0xf7, 0x9b, 0x7c, 0xe8, 0x23, 0xa6, 0xdf, 0x27, 0xfc, 0xe5, 0xf3, 0x2d, 0x20, 0x16, 0x72, 0x9f,
+	0x70, 0xe3, 0x9c, 0x87, 0xc9, 0x0e, 0x72, 0x7b, 0x6d, 0x49, 0xab, 0xde, 0x05, 0xe7, 0x44, 0x12,
+	0x1a, 0x74, 0xa1, 0x6d, 0x07, 0x88, 0xb1, 0x6a, 0x39, 0xca, 0x55, 0x7d, 0xf9, 0x7c, 0x6b, 0x4d,
+	0x44, 0xdf, 0x8e, 0x67, 0x76, 0x78, 0x80, 0x89, 0x63, 0x9c, 0x95, 0x21, 0x62, 0x3c, 0xa4, 0xd9,
+	0x4b, 0xd4, 0x95, 0x34, 0xa7, 0x8e, 0xa2, 0x91, 0x21, 0x09, 0xcd, 0x3d, 0x50, 0xf1, 0x07, 0x66,
+	0x1f, 0x0d, 0xab, 0x95, 0x48, 0xc6, 0x35, 0x3d, 0x36, 0x9c, 0x9e, 0x18, 0x4e, 0xbf, 0x4d, 0x86,
+	0xad, 0xea, 0x8f, 0x23, 0x46, 0x2b, 0x18, 0xfa, 0x9c, 0xea, 0x9f, 0x0c, 0xcc, 0x07, 0x68, 0x68,
+	0x88, 0x68, 0xf5, 0x06, 0x38, 0xb5, 0x07, 0xdd, 0x01, 0xaa, 0xbe, 0x16, 0xd1, 0x6c, 0x24, 0xd5,
+	0x08, 0x5d, 0x36, 0x56, 0x0a, 0x9c, 0xd4, 0x33, 0x46, 0xdf, 0xbc, 0xfe, 0xd5, 0xb3, 0x7a, 0xe1,
+	0x8f, 0x67, 0xf5, 0xc2, 0x97, 0xbf, 0x7f, 0x7f, 0x35, 0xad, 0x4b, 0x34, 0x9a, 0xda, 0x66, 0xe3,
+	0x3c, 0xd0, 0xd2, 0x16, 0x33, 0x10, 0xf3, 0x29, 0x61, 0xa8, 0xf1, 0x4d, 0x09, 0x9c, 0xed, 0x30,
+	0xe7, 0xae, 0x8d, 0xf9, 0x09, 0xf9, 0x6f, 0xa6, 0xf6, 0xc5, 0x63, 0x6b, 0x0f, 0xc1, 0xca, 0xc8,
+	0x85, 0xdd, 0x00, 0x72, 0x24, 0x3c, 0xf7, 0x61, 0x4e, 0xbf, 0xb5, 0x91, 0x35, 0xe6, 0xb7, 0x36,
+	0xb2, 0x8c, 0x65, 0x6b, 0xc2, 0xed, 0xea, 0xee, 0x6c, 0x6b, 0x97, 0x8f, 0x95, 0x26, 0x8f, 0xad,
+	0x6f, 0xd6, 0x26, 0x2a, 0x99, 0xae, 0x99, 0x06, 0xaa, 0xd3, 0x45, 0x91, 0x15, 0xfb, 0x53, 0x01,
+	0x4b, 0x1d, 0xe6, 0x08, 0x36, 0x34, 0xfb, 0x88, 0x28, 0x8b, 0x39, 0x22, 0xc7, 0x2f, 0xd3, 0x07,
+	0xa0, 0x02, 0x3d, 0x3a, 0x20, 0xbc, 0x5a, 0xca, 0xe7, 0x6d, 0x01, 0x9f, 0x92, 0x24, 0xb5, 0xa3,
+	0xc6, 0x3a, 0x58, 0x1d, 0xdb, 0xb5, 0x54, 0x63, 0xbf, 0x18, 0x75, 0xd0, 0x16, 0x72, 0x30, 0x31,
+	0x90, 0xbd, 0x60, 0x51, 0x3e, 0x06, 0xeb, 0x23, 0x51, 0x58, 0x60, 0xe5, 0x16, 0x66, 0x55, 0x86,
+	0xed, 0x04, 0xd6, 0x4c, 0x36, 0x9b, 0x71, 0xc9, 0x56, 0xca, 0xcd, 0xd6, 0x66, 0x3c, 0xad, 0x74,
+	0x79, 0xb1, 0x4a, 0xf7, 0x81, 0x96, 0x56, 0x34, 0x11, 0x5c, 0xed, 0x44, 0xe7, 0xd0, 0x77, 0x51,
+	0x68, 0xe4, 0x6e, 0x78, 0xc1, 0x8a, 0xfe, 0xa0, 0xa5, 0x9a, 0xe1, 0xc3, 0xe4, 0xf6, 0x6d, 0x9d,
+	0x0e, 0x17, 0xf0, 0xf4, 0x97, 0xba, 0x62, 0x2c, 0x8f, 0x82, 0xc3, 0xe9, 0xc6, 0x5f, 0x0a, 0x38,
+	0xd3, 0x61, 0xce, 0x23, 0x62, 0xff, 0xcf, 0xfc, 0xdc, 0x03, 0xeb, 0x13, 0xfb, 0x3e, 0x29, 0x81,
+	0xbf, 0x2b, 0x82, 0xf3, 0x61, 0xff, 0x87, 0xc4, 0x42, 0xee, 0x23, 0x62, 0x52, 0x62, 0x63, 0xe2,
+	0x1c, 0x75, 0xc5, 0xfe, 0xe7, 0xf4, 0x56, 0xaf, 0x80, 0x15, 0x2b, 0xbc, 0xe3, 0x42, 0xd1, 0x76,
+	0x11, 0x76, 0x76, 0xe3, 0x73, 0x51, 0x32, 0x96, 0x93, 0xe1, 0x8f, 0xa2, 0xd1, 0x23, 0x0b, 0x73,
+	0x19, 0x5c, 0xca, 0xd2, 0x4b, 0x76, 0x9e, 0x1f, 0x8a, 0x51, 0x05, 0x1f, 0x06, 0x90, 0xb0, 0x1e,
+	0x0a, 0x4e, 0x44, 0xd1, 0x00, 0x59, 0xd8, 0xc7, 0x88, 0xf0, 0xfc, 0x8a, 0xca, 0x90, 0xcc, 0xc2,
+	0x94, 0xfe, 0x45, 0x61, 0x16, 0xdc, 0x6e, 0xea, 0xe0, 0xad, 0x99, 0x32, 0x26, 0x42, 0x6f, 0x7f,
+	0x5b, 0x01, 0xa5, 0x0e, 0x73, 0xd4, 0xc7, 0x60, 0x65, 0xfa, 0xa1, 0x7c, 0x75, 0xde, 0x9b, 0x24,
+	0xfd, 0xe2, 0xd1, 0xb6, 0xf3, 0x63, 0xe5, 0x59, 0xec, 0x83, 0x33, 0x93, 0x2f, 0xa3, 0xcd, 0x0c,
+	0x92, 0x09, 0xa4, 0xf6, 0x5e, 0x5e, 0xa4, 0x4c, 0xf6, 0x39, 0x38, 0x2d, 0x2f, 0xf5, 0x8b, 0x19,
+	0xd1, 0x09, 0x48, 0x7b, 0x27, 0x07, 0x48, 0xb2, 0x3f, 0x06, 0x2b, 0xd3, 0x97, 0x64, 0x96, 0x7a,
+	0x53, 0x58, 0x6d, 0x3b, 0x3f, 0x56, 0xa6, 0x34, 0x01, 0x18, 0xeb, 0xeb, 0x6f, 0x67, 0x30, 0x8c,
+	0x60, 0xda, 0x56, 0x2e, 0x98, 0xcc, 0xf1, 0xb5, 0x02, 0x36, 0xe6, 0xf7, 0xb6, 0xeb, 0x59, 0x35,
+	0x9f, 0x17, 0xa5, 0xdd, 0xfa, 0x27, 0x51, 0x72, 0x45, 0x5f, 0x00, 0x75, 0x46, 0x4f, 0xc8, 0xda,
+	0x56, 0x1a, 0xae, 0xdd, 0x38, 0x16, 0x3c, 0xc9, 0xdd, 0xba, 0xf7, 0xe2, 0xa0, 0xa6, 0xec, 0x1f,
+	0xd4, 0x94, 0x5f, 0x0f, 0x6a, 0xca, 0xd3, 0xc3, 0x5a, 0x61, 0xff, 0xb0, 0x56, 0xf8, 0xe9, 0xb0,
+	0x56, 0xf8, 0xec, 0xdd, 0xcc, 0xa7, 0xeb, 0x13, 0xf9, 0x07, 0x36, 0x7a, 0xc4, 0x9a, 0x95, 0xe8,
+	0x8a, 0x79, 0xff, 0xef, 0x01, 0x00, 0x97, 0xea, 0x64, 0xe5, 0xa5, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -645,6 +733,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// TransferDelegation defines a method for moving the delegation of a
+	// delegator on a validator to another delegator, without unbonding it.
+	//
+	// Since: cosmos-sdk 0.47
+	TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -709,6 +802,15 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error) {
+	out := new(MsgTransferDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/TransferDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -729,6 +831,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// TransferDelegation defines a method for moving the delegation of a
+	// delegator on a validator to another delegator, without unbonding it.
+	//
+	// Since: cosmos-sdk 0.47
+	TransferDelegation(context.Context, *MsgTransferDelegation) (*MsgTransferDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -753,6 +860,9 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) TransferDelegation(ctx context.Context, req *MsgTransferDelegation) (*MsgTransferDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -866,6 +976,24 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/TransferDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDelegation(ctx, req.(*MsgTransferDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -894,6 +1022,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "TransferDelegation",
+			Handler:    _Msg_TransferDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1406,6 +1538,83 @@ func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1610,6 +1819,38 @@ func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3091,6 +3332,235 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -98,3 +98,51 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 func BuildUpgradeNeededMsg(plan types.Plan) string {
 	return fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
 }
+
+// LiveHaltBlockAge is the maximum age of the block at the height of an emergency
+// halt for the node to halt. Older blocks are replayed, or synced by a node
+// catching up with a chain which was already resumed, so the node keeps
+// processing them instead of stopping at every past halt.
+const LiveHaltBlockAge = time.Hour
+
+// EndBlocker checks if there is a scheduled emergency halt. At its height, the
+// node halts gracefully once the block is committed, like with the halt-height
+// configuration, unless the block is older than LiveHaltBlockAge. After it, the node refuses to process blocks until the chain
+// is resumed, either by a MsgResume or by a new upgrade plan included in the
+// block, or until the validators skip the halt with the unsafe-skip-halts flag.
+func EndBlocker(k keeper.Keeper, ctx sdk.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	halt, found := k.GetHalt(ctx)
+	if !found || ctx.BlockHeight() < halt.Height {
+		return
+	}
+
+	// If skip halt has been set for the halt height, we clear the halt
+	if k.IsSkipHaltHeight(halt.Height) {
+		ctx.Logger().Info(fmt.Sprintf("CHAIN HALT SKIPPED at height %d: %s", halt.Height, halt.Reason))
+		k.ClearHalt(ctx)
+		return
+	}
+
+	haltMsg := BuildHaltMsg(halt)
+	if ctx.BlockHeight() == halt.Height {
+		if age := time.Since(ctx.BlockTime()); age > LiveHaltBlockAge {
+			ctx.Logger().Info(fmt.Sprintf("%s, not halting the node as the block is %s old", haltMsg, age.Round(time.Second)))
+			return
+		}
+
+		ctx.Logger().Error(haltMsg)
+		k.HaltNode()
+		return
+	}
+
+	haltedMsg := fmt.Sprintf("%s, the chain must be resumed before processing blocks, or the halt skipped with --%s=%d", haltMsg, FlagUnsafeSkipHalts, halt.Height)
+	ctx.Logger().Error(haltedMsg)
+	panic(haltedMsg)
+}
+
+// BuildHaltMsg prints the message that notifies that the chain halts.
+func BuildHaltMsg(halt types.Halt) string {
+	return fmt.Sprintf("CHAIN HALTED at height %d: %s", halt.Height, halt.Reason)
+}
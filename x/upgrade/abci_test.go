@@ -17,7 +17,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -27,7 +29,7 @@ type TestSuite struct {
 	module  module.AppModule
 	keeper  keeper.Keeper
 	querier sdk.Querier
-	handler govtypes.Handler
+	handler govv1beta1.Handler
 	ctx     sdk.Context
 }
 
@@ -474,3 +476,78 @@ func TestBinaryVersion(t *testing.T) {
 		}
 	}
 }
+
+// mockHalter records the requests to halt the node.
+type mockHalter struct {
+	halts int
+}
+
+func (h *mockHalter) SetProtocolVersion(uint64) {}
+
+func (h *mockHalter) HaltAfterCommit() { h.halts++ }
+
+func TestEmergencyHalt(t *testing.T) {
+	app := simapp.Setup(t, false)
+	halter := &mockHalter{}
+	k := keeper.NewKeeper(map[int64]bool{}, app.GetKey(types.StoreKey), app.AppCodec(), t.TempDir(), halter,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now()})
+	am := upgrade.NewAppModule(k)
+
+	t.Log("Verify the chain is not halted before the halt height")
+	require.NoError(t, k.ScheduleHalt(ctx, types.Halt{Height: 12, Reason: "security incident"}))
+	require.NotPanics(t, func() {
+		am.EndBlock(ctx.WithBlockHeight(11), abci.RequestEndBlock{})
+	})
+	require.Equal(t, 0, halter.halts)
+	require.False(t, k.IsHalted(ctx.WithBlockHeight(12)))
+
+	t.Log("Verify the node halts after committing the block at the halt height")
+	require.NotPanics(t, func() {
+		am.EndBlock(ctx.WithBlockHeight(12), abci.RequestEndBlock{})
+	})
+	require.Equal(t, 1, halter.halts)
+
+	t.Log("Verify the node doesn't halt when replaying or syncing past blocks")
+	require.NotPanics(t, func() {
+		am.EndBlock(ctx.WithBlockHeight(12).WithBlockTime(time.Now().Add(-upgrade.LiveHaltBlockAge-time.Minute)), abci.RequestEndBlock{})
+	})
+	require.Equal(t, 1, halter.halts)
+
+	t.Log("Verify the following blocks are refused until the chain is resumed")
+	haltedCtx := ctx.WithBlockHeight(13)
+	require.True(t, k.IsHalted(haltedCtx))
+	require.PanicsWithValue(t, "CHAIN HALTED at height 12: security incident, the chain must be resumed before processing blocks, or the halt skipped with --unsafe-skip-halts=12", func() {
+		am.EndBlock(haltedCtx, abci.RequestEndBlock{})
+	})
+
+	resumedCtx, _ := haltedCtx.CacheContext()
+	k.ClearHalt(resumedCtx)
+	require.NotPanics(t, func() {
+		am.EndBlock(resumedCtx, abci.RequestEndBlock{})
+	})
+
+	t.Log("Verify the validators can skip the halt to resume the chain")
+	skipCtx, _ := haltedCtx.CacheContext()
+	skipKeeper := k
+	skipKeeper.SetSkipHaltHeights(map[int64]bool{12: true})
+	require.NotPanics(t, func() {
+		upgrade.NewAppModule(skipKeeper).EndBlock(skipCtx, abci.RequestEndBlock{})
+	})
+	require.False(t, k.IsHalted(skipCtx))
+	require.Equal(t, 1, halter.halts)
+
+	t.Log("Verify a new upgrade plan resumes the chain")
+	require.NoError(t, k.ScheduleUpgrade(haltedCtx, types.Plan{Name: "fix", Height: 20}))
+	require.False(t, k.IsHalted(haltedCtx))
+	require.NotPanics(t, func() {
+		am.EndBlock(haltedCtx, abci.RequestEndBlock{})
+	})
+	require.Equal(t, 1, halter.halts)
+
+	t.Log("Verify a new upgrade plan doesn't cancel a scheduled halt")
+	require.NoError(t, k.ScheduleHalt(haltedCtx, types.Halt{Height: 15, Reason: "security incident"}))
+	require.NoError(t, k.ScheduleUpgrade(haltedCtx, types.Plan{Name: "fix", Height: 20}))
+	_, found := k.GetHalt(haltedCtx)
+	require.True(t, found)
+}
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetMigrationProgressCmd(),
+		GetHaltCmd(),
 	)
 
 	return cmd
@@ -154,3 +156,73 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetMigrationProgressCmd returns the progress of the incremental migrations
+func GetMigrationProgressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration_progress [optional module_name]",
+		Short: "get the progress of the incremental module migrations",
+		Long: "Gets the list of the modules running incremental migrations, with the\n" +
+			"version they are migrating from and to. Following the command with a\n" +
+			"specific module name will return only that module's information.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.QueryMigrationProgressRequest{}
+			if len(args) == 1 {
+				params.ModuleName = args[0]
+			}
+
+			res, err := queryClient.MigrationProgress(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetHaltCmd returns the query emergency halt command.
+func GetHaltCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt",
+		Short: "get emergency halt (if one exists)",
+		Long: "Gets the emergency halt of the chain scheduled by the governance or the\n" +
+			"security council, if one exists. The nodes halt once the block at the\n" +
+			"halt height is committed.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryHaltRequest{}
+			res, err := queryClient.Halt(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			if res.Halt == nil {
+				return fmt.Errorf("no halt scheduled")
+			}
+
+			return clientCtx.PrintProto(res.GetHalt())
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
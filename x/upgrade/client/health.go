@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// NewHaltHealthCheck returns an API server health check failing once the chain
+// reached the height of the current upgrade plan, where the node halts until
+// it is restarted with the upgraded binary, or the height of an emergency halt.
+func NewHaltHealthCheck(clientCtx client.Context) api.HealthCheck {
+	return api.NewHealthCheck("upgrade", func(ctx context.Context) api.HealthCheckResult {
+		queryClient := types.NewQueryClient(clientCtx)
+		res, err := queryClient.CurrentPlan(ctx, &types.QueryCurrentPlanRequest{})
+		if err != nil {
+			return api.HealthFailing("failed to query the current upgrade plan: %s", err)
+		}
+		haltRes, err := queryClient.Halt(ctx, &types.QueryHaltRequest{})
+		if err != nil {
+			return api.HealthFailing("failed to query the emergency halt: %s", err)
+		}
+		if res.Plan == nil && haltRes.Halt == nil {
+			return api.HealthOK("no upgrade scheduled")
+		}
+
+		block, err := tmservice.NewServiceClient(clientCtx).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return api.HealthFailing("failed to query the latest block: %s", err)
+		}
+		height := block.GetBlock().GetHeader().Height
+
+		// the node halts once the block at the emergency halt height is
+		// committed
+		if haltRes.Halt != nil && height >= haltRes.Halt.Height {
+			return api.HealthFailing("halted at height %d: %s", haltRes.Halt.Height, haltRes.Halt.Reason)
+		}
+		if res.Plan == nil {
+			return api.HealthOK("emergency halt scheduled at height %d", haltRes.Halt.Height)
+		}
+
+		// the node halts in the BeginBlock of the upgrade height, so the
+		// latest committed block is the one preceding it
+		if height+1 >= res.Plan.Height {
+			return api.HealthFailing("halted for upgrade %q at height %d", res.Plan.Name, res.Plan.Height)
+		}
+		return api.HealthOK("upgrade %q scheduled at height %d", res.Plan.Name, res.Plan.Height)
+	})
+}
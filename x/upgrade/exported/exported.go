@@ -5,3 +5,9 @@ package exported
 type ProtocolVersionSetter interface {
 	SetProtocolVersion(uint64)
 }
+
+// Halter defines the interface fulfilled by BaseApp which allows halting the
+// node gracefully once the current block is committed.
+type Halter interface {
+	HaltAfterCommit()
+}
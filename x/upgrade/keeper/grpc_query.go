@@ -22,6 +22,18 @@ func (k Keeper) CurrentPlan(c context.Context, req *types.QueryCurrentPlanReques
 	return &types.QueryCurrentPlanResponse{Plan: &plan}, nil
 }
 
+// Halt implements the Query/Halt gRPC method
+func (k Keeper) Halt(c context.Context, req *types.QueryHaltRequest) (*types.QueryHaltResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	halt, found := k.GetHalt(ctx)
+	if !found {
+		return &types.QueryHaltResponse{}, nil
+	}
+
+	return &types.QueryHaltResponse{Halt: &halt}, nil
+}
+
 // AppliedPlan implements the Query/AppliedPlan gRPC method
 func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanRequest) (*types.QueryAppliedPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -71,6 +83,30 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 	}, nil
 }
 
+// MigrationProgress implements the Query/MigrationProgress gRPC method
+func (k Keeper) MigrationProgress(c context.Context, req *types.QueryMigrationProgressRequest) (*types.QueryMigrationProgressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		migrations := []*types.MigrationProgress{}
+		if progress, found := k.GetMigrationProgress(ctx, req.ModuleName); found {
+			migrations = append(migrations, &types.MigrationProgress{
+				Name:      progress.ModuleName,
+				Version:   progress.Version,
+				ToVersion: progress.ToVersion,
+				Cursor:    progress.Cursor,
+				Started:   progress.Cursor != nil,
+			})
+		}
+		return &types.QueryMigrationProgressResponse{Migrations: migrations}, nil
+	}
+
+	return &types.QueryMigrationProgressResponse{
+		Migrations: k.GetAllMigrationProgress(ctx),
+	}, nil
+}
+
 // Authority implements the Query/Authority gRPC method, returning the account capable of performing upgrades
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
@@ -205,6 +205,46 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestMigrationProgress() {
+	res, err := suite.queryClient.MigrationProgress(gocontext.Background(), &types.QueryMigrationProgressRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Migrations)
+
+	suite.app.UpgradeKeeper.SetMigrationProgress(suite.ctx, module.MigrationProgress{ModuleName: "bank", Version: 3, ToVersion: 4, Cursor: []byte("cursor")})
+	suite.app.UpgradeKeeper.SetMigrationProgress(suite.ctx, module.MigrationProgress{ModuleName: "staking", Version: 2, ToVersion: 4})
+	bank := &types.MigrationProgress{Name: "bank", Version: 3, ToVersion: 4, Cursor: []byte("cursor"), Started: true}
+	staking := &types.MigrationProgress{Name: "staking", Version: 2, ToVersion: 4}
+
+	res, err = suite.queryClient.MigrationProgress(gocontext.Background(), &types.QueryMigrationProgressRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.MigrationProgress{bank, staking}, res.Migrations)
+
+	res, err = suite.queryClient.MigrationProgress(gocontext.Background(), &types.QueryMigrationProgressRequest{ModuleName: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.MigrationProgress{staking}, res.Migrations)
+
+	suite.app.UpgradeKeeper.DeleteMigrationProgress(suite.ctx, "staking")
+	res, err = suite.queryClient.MigrationProgress(gocontext.Background(), &types.QueryMigrationProgressRequest{ModuleName: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Migrations)
+
+	progress, found := suite.app.UpgradeKeeper.GetMigrationProgress(suite.ctx, "bank")
+	suite.Require().True(found)
+	suite.Require().Equal(module.MigrationProgress{ModuleName: "bank", Version: 3, ToVersion: 4, Cursor: []byte("cursor")}, progress)
+
+	// an empty cursor is told apart from a migration which has not started yet
+	suite.app.UpgradeKeeper.SetMigrationProgress(suite.ctx, module.MigrationProgress{ModuleName: "bank", Version: 3, ToVersion: 4, Cursor: []byte{}})
+	progress, found = suite.app.UpgradeKeeper.GetMigrationProgress(suite.ctx, "bank")
+	suite.Require().True(found)
+	suite.Require().NotNil(progress.Cursor)
+	suite.Require().Empty(progress.Cursor)
+
+	suite.app.UpgradeKeeper.SetMigrationProgress(suite.ctx, module.MigrationProgress{ModuleName: "bank", Version: 3, ToVersion: 4})
+	progress, found = suite.app.UpgradeKeeper.GetMigrationProgress(suite.ctx, "bank")
+	suite.Require().True(found)
+	suite.Require().Nil(progress.Cursor)
+}
+
 func (suite *UpgradeTestSuite) TestAuthority() {
 	res, err := suite.queryClient.Authority(gocontext.Background(), &types.QueryAuthorityRequest{})
 	suite.Require().NoError(err)
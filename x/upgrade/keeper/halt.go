@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	xp "github.com/cosmos/cosmos-sdk/x/upgrade/exported"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SetSecurityCouncil sets the address, usually a group policy, allowed to
+// schedule emergency halts and to resume the chain along with the authority.
+// It must be called before the keeper is passed to the upgrade module.
+func (k *Keeper) SetSecurityCouncil(council string) {
+	if _, err := sdk.AccAddressFromBech32(council); err != nil {
+		panic(sdkerrors.Wrap(err, "security council"))
+	}
+
+	k.securityCouncil = council
+}
+
+// SecurityCouncil returns the address allowed to schedule emergency halts
+// along with the authority, empty if none is set.
+func (k Keeper) SecurityCouncil() string {
+	return k.securityCouncil
+}
+
+// SetSkipHaltHeights sets the heights of the emergency halts skipped by the
+// node, which resumes the chain instead of refusing to process blocks. All the
+// validators must skip the same halts. It must be called before the keeper is
+// passed to the upgrade module.
+func (k *Keeper) SetSkipHaltHeights(heights map[int64]bool) {
+	k.skipHaltHeights = heights
+}
+
+// IsSkipHaltHeight checks if the emergency halt at the given height is skipped.
+func (k Keeper) IsSkipHaltHeight(height int64) bool {
+	return k.skipHaltHeights[height]
+}
+
+// validateHaltAuthority checks that the given address is either the authority
+// or the security council.
+func (k Keeper) validateHaltAuthority(authority string) error {
+	if authority == k.authority || (k.securityCouncil != "" && authority == k.securityCouncil) {
+		return nil
+	}
+
+	if k.securityCouncil == "" {
+		return sdkerrors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, authority)
+	}
+	return sdkerrors.Wrapf(gov.ErrInvalidSigner, "expected %s or %s got %s", k.authority, k.securityCouncil, authority)
+}
+
+// ScheduleHalt schedules an emergency halt of the chain, replacing any halt
+// scheduled before. The nodes halt after committing the block at the height of
+// the halt, and refuse to process the following blocks until the chain is
+// resumed with ClearHalt or a new upgrade plan.
+func (k Keeper) ScheduleHalt(ctx sdk.Context, halt types.Halt) error {
+	if halt.Height < ctx.BlockHeight() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "halt cannot be scheduled in the past")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HaltKey(), k.cdc.MustMarshal(&halt))
+
+	return nil
+}
+
+// GetHalt returns the emergency halt of the chain, scheduled or in progress.
+func (k Keeper) GetHalt(ctx sdk.Context) (halt types.Halt, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HaltKey())
+	if bz == nil {
+		return halt, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &halt)
+	return halt, true
+}
+
+// IsHalted returns true if the chain halted before the current block and has
+// not been resumed yet.
+func (k Keeper) IsHalted(ctx sdk.Context) bool {
+	halt, found := k.GetHalt(ctx)
+	return found && halt.Height < ctx.BlockHeight()
+}
+
+// ClearHalt cancels the scheduled emergency halt, or resumes the chain if it
+// already halted.
+func (k Keeper) ClearHalt(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HaltKey())
+}
+
+// HaltNode halts the node gracefully once the current block is committed, if
+// the protocol version setter implements xp.Halter.
+func (k Keeper) HaltNode() {
+	if halter, ok := k.versionSetter.(xp.Halter); ok {
+		halter.HaltAfterCommit()
+	}
+}
@@ -36,6 +36,8 @@ type Keeper struct {
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and cancelling an upgrade. Usually the gov module account
+	securityCouncil    string                          // the address capable of scheduling emergency halts and resuming the chain along with the authority, if any
+	skipHaltHeights    map[int64]bool                  // map of emergency halt heights to skip, resuming the chain
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -43,7 +45,8 @@ type Keeper struct {
 // storeKey - a store key with which to access upgrade's store
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
-// vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
+// vs - the interface implemented by baseapp which allows setting baseapp's protocol version field,
+// and halting the node at the height of an emergency halt if it implements xp.Halter
 func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, authority string) Keeper {
 	return Keeper{
 		homePath:           homePath,
@@ -164,6 +167,69 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	return 0, false
 }
 
+var _ module.MigrationStore = Keeper{}
+
+// GetMigrationProgress implements the module.MigrationStore interface, returning
+// the progress of the incremental migration of a module
+func (k Keeper) GetMigrationProgress(ctx sdk.Context, moduleName string) (module.MigrationProgress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.MigrationProgressByte})
+	bz := store.Get([]byte(moduleName))
+	if bz == nil {
+		return module.MigrationProgress{}, false
+	}
+
+	var progress types.MigrationProgress
+	k.cdc.MustUnmarshal(bz, &progress)
+
+	// an empty cursor is decoded as nil, which would restart the migration
+	cursor := progress.Cursor
+	if progress.Started && cursor == nil {
+		cursor = []byte{}
+	}
+
+	return module.MigrationProgress{
+		ModuleName: progress.Name,
+		Version:    progress.Version,
+		ToVersion:  progress.ToVersion,
+		Cursor:     cursor,
+	}, true
+}
+
+// SetMigrationProgress implements the module.MigrationStore interface, saving
+// the progress of the incremental migration of a module to state
+func (k Keeper) SetMigrationProgress(ctx sdk.Context, progress module.MigrationProgress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.MigrationProgressByte})
+	store.Set([]byte(progress.ModuleName), k.cdc.MustMarshal(&types.MigrationProgress{
+		Name:      progress.ModuleName,
+		Version:   progress.Version,
+		ToVersion: progress.ToVersion,
+		Cursor:    progress.Cursor,
+		Started:   progress.Cursor != nil,
+	}))
+}
+
+// DeleteMigrationProgress implements the module.MigrationStore interface,
+// deleting the progress of the incremental migration of a module from state
+func (k Keeper) DeleteMigrationProgress(ctx sdk.Context, moduleName string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.MigrationProgressByte})
+	store.Delete([]byte(moduleName))
+}
+
+// GetAllMigrationProgress gets the progress of all the incremental migrations
+func (k Keeper) GetAllMigrationProgress(ctx sdk.Context) []*types.MigrationProgress {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.MigrationProgressByte})
+	defer it.Close()
+
+	migrations := make([]*types.MigrationProgress, 0)
+	for ; it.Valid(); it.Next() {
+		var progress types.MigrationProgress
+		k.cdc.MustUnmarshal(it.Value(), &progress)
+		migrations = append(migrations, &progress)
+	}
+	return migrations
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will cancel and overwrite it.
 // ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
@@ -185,6 +251,11 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 
 	store := ctx.KVStore(k.storeKey)
 
+	// a new upgrade plan resumes the chain after an emergency halt
+	if k.IsHalted(ctx) {
+		k.ClearHalt(ctx)
+	}
+
 	// clear any old IBC state stored by previous plan
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
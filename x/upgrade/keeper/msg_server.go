@@ -49,3 +49,33 @@ func (k msgServer) CancelUpgrade(goCtx context.Context, req *types.MsgCancelUpgr
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// ScheduleHalt implements the Msg/ScheduleHalt Msg service.
+func (k msgServer) ScheduleHalt(goCtx context.Context, req *types.MsgScheduleHalt) (*types.MsgScheduleHaltResponse, error) {
+	if err := k.validateHaltAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.ScheduleHalt(ctx, types.Halt{Height: req.Height, Reason: req.Reason})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleHaltResponse{}, nil
+}
+
+// Resume implements the Msg/Resume Msg service.
+func (k msgServer) Resume(goCtx context.Context, req *types.MsgResume) (*types.MsgResumeResponse, error) {
+	if err := k.validateHaltAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetHalt(ctx); !found {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "no halt scheduled")
+	}
+	k.ClearHalt(ctx)
+
+	return &types.MsgResumeResponse{}, nil
+}
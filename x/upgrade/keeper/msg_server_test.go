@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -113,3 +115,148 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleHalt() {
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+	council := s.addrs[0].String()
+	k := s.app.UpgradeKeeper
+	k.SetSecurityCouncil(council)
+	msgSrvr := keeper.NewMsgServerImpl(k)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgScheduleHalt
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgScheduleHalt{
+				Authority: sdk.AccAddress("unauthorized").String(),
+				Height:    123450000,
+				Reason:    "security incident",
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"halt in the past",
+			&types.MsgScheduleHalt{
+				Authority: govAccAddr,
+				Height:    s.ctx.BlockHeight() - 1,
+				Reason:    "security incident",
+			},
+			true,
+			"halt cannot be scheduled in the past",
+		},
+		{
+			"halt scheduled by the authority",
+			&types.MsgScheduleHalt{
+				Authority: govAccAddr,
+				Height:    123450000,
+				Reason:    "security incident",
+			},
+			false,
+			"",
+		},
+		{
+			"halt scheduled by the security council",
+			&types.MsgScheduleHalt{
+				Authority: council,
+				Height:    s.ctx.BlockHeight(),
+				Reason:    "chain halt needed right now",
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgSrvr.ScheduleHalt(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				halt, found := s.app.UpgradeKeeper.GetHalt(s.ctx)
+				s.Require().True(found)
+				s.Require().Equal(types.Halt{Height: tc.req.Height, Reason: tc.req.Reason}, halt)
+			}
+		})
+	}
+
+	// the security council can only be used if it is set
+	_, err := s.msgSrvr.ScheduleHalt(s.ctx, &types.MsgScheduleHalt{Authority: council, Height: 123450000, Reason: "security incident"})
+	s.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+}
+
+func (s *KeeperTestSuite) TestResume() {
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+	council := s.addrs[0].String()
+	k := s.app.UpgradeKeeper
+	k.SetSecurityCouncil(council)
+	msgSrvr := keeper.NewMsgServerImpl(k)
+
+	testCases := []struct {
+		name      string
+		halt      *types.Halt
+		req       *types.MsgResume
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.Halt{Height: 123450000, Reason: "security incident"},
+			&types.MsgResume{
+				Authority: sdk.AccAddress("unauthorized").String(),
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"no halt scheduled",
+			nil,
+			&types.MsgResume{
+				Authority: govAccAddr,
+			},
+			true,
+			"no halt scheduled",
+		},
+		{
+			"scheduled halt cancelled by the authority",
+			&types.Halt{Height: 123450000, Reason: "security incident"},
+			&types.MsgResume{
+				Authority: govAccAddr,
+			},
+			false,
+			"",
+		},
+		{
+			"chain resumed by the security council",
+			&types.Halt{Height: s.ctx.BlockHeight() - 1, Reason: "security incident"},
+			&types.MsgResume{
+				Authority: council,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.halt != nil {
+				ctx.KVStore(s.app.GetKey(types.StoreKey)).Set(types.HaltKey(), s.app.AppCodec().MustMarshal(tc.halt))
+			}
+
+			_, err := msgSrvr.Resume(ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				_, found := s.app.UpgradeKeeper.GetHalt(ctx)
+				s.Require().False(found)
+			}
+		})
+	}
+}
@@ -24,6 +24,14 @@ func init() {
 
 const (
 	consensusVersion uint64 = 2
+
+	// FlagUnsafeSkipHalts is the start flag skipping the emergency halts at
+	// the given heights.
+	FlagUnsafeSkipHalts = "unsafe-skip-halts"
+
+	// AppConfigSecurityCouncil is the app.toml key of the security council
+	// allowed to schedule emergency halts, which must be the same on every node.
+	AppConfigSecurityCouncil = "upgrade.security_council"
 )
 
 var (
@@ -79,6 +87,11 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 	}
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().IntSlice(FlagUnsafeSkipHalts, []int{}, "Skip a set of emergency halt heights to resume the chain without a MsgResume")
+}
+
 // RegisterInvariants does nothing, there are no invariants to enforce
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
@@ -137,7 +150,8 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	BeginBlocker(am.keeper, ctx, req)
 }
 
-// EndBlock does nothing
+// EndBlock halts the chain at the height of a scheduled emergency halt
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(am.keeper, ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -28,6 +28,13 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// MigrationProgressByte is a prefix to look up module names (key) and the
+	// progress of their incremental migrations (value)
+	MigrationProgressByte = 0x4
+
+	// HaltByte specifies the Byte under which the emergency halt of the chain is stored in the store
+	HaltByte = 0x5
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +51,11 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// HaltKey is the key under which the emergency halt of the chain is saved
+func HaltKey() []byte {
+	return []byte{HaltByte}
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -7,8 +7,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgScheduleHalt{}, &MsgResume{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgScheduleHalt{}, &MsgResume{}
 )
 
 // Route implements the LegacyMsg interface.
@@ -66,3 +66,63 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// Route implements the LegacyMsg interface.
+func (m MsgScheduleHalt) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgScheduleHalt) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgScheduleHalt) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgScheduleHalt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if m.Height <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "halt height must be positive")
+	}
+
+	if len(m.Reason) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "halt reason cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgScheduleHalt.
+func (m *MsgScheduleHalt) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgResume) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgResume) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResume) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResume) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgResume.
+func (m *MsgResume) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -107,3 +107,103 @@ func TestMsgCancelUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgScheduleHalt(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgScheduleHalt
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid authority address",
+			&types.MsgScheduleHalt{
+				Authority: "authority",
+				Height:    123450000,
+				Reason:    "security incident",
+			},
+			true,
+			"authority: decoding bech32 failed",
+		},
+		{
+			"invalid height",
+			&types.MsgScheduleHalt{
+				Authority: authority.String(),
+				Reason:    "security incident",
+			},
+			true,
+			"halt height must be positive",
+		},
+		{
+			"empty reason",
+			&types.MsgScheduleHalt{
+				Authority: authority.String(),
+				Height:    123450000,
+			},
+			true,
+			"halt reason cannot be empty",
+		},
+		{
+			"all good",
+			&types.MsgScheduleHalt{
+				Authority: authority.String(),
+				Height:    123450000,
+				Reason:    "security incident",
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Type(), sdk.MsgTypeURL(&types.MsgScheduleHalt{}))
+			}
+		})
+	}
+}
+
+func TestMsgResume(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgResume
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid authority address",
+			&types.MsgResume{
+				Authority: "authority",
+			},
+			true,
+			"authority: decoding bech32 failed",
+		},
+		{
+			"all good",
+			&types.MsgResume{
+				Authority: authority.String(),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Type(), sdk.MsgTypeURL(&types.MsgResume{}))
+			}
+		})
+	}
+}
@@ -406,6 +406,193 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryMigrationProgressRequest is the request type for the
+// Query/MigrationProgress RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryMigrationProgressRequest struct {
+	// module_name is a field to query the incremental migration of a specific
+	// module. Leaving this empty will fetch the incremental migrations of all the
+	// migrating modules.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryMigrationProgressRequest) Reset()         { *m = QueryMigrationProgressRequest{} }
+func (m *QueryMigrationProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationProgressRequest) ProtoMessage()    {}
+func (*QueryMigrationProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryMigrationProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationProgressRequest.Merge(m, src)
+}
+func (m *QueryMigrationProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationProgressRequest proto.InternalMessageInfo
+
+func (m *QueryMigrationProgressRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryMigrationProgressResponse is the response type for the
+// Query/MigrationProgress RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryMigrationProgressResponse struct {
+	// migrations is the list of the incremental migrations in progress.
+	Migrations []*MigrationProgress `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+}
+
+func (m *QueryMigrationProgressResponse) Reset()         { *m = QueryMigrationProgressResponse{} }
+func (m *QueryMigrationProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationProgressResponse) ProtoMessage()    {}
+func (*QueryMigrationProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryMigrationProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationProgressResponse.Merge(m, src)
+}
+func (m *QueryMigrationProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationProgressResponse proto.InternalMessageInfo
+
+func (m *QueryMigrationProgressResponse) GetMigrations() []*MigrationProgress {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+// QueryHaltRequest is the request type for the Query/Halt RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryHaltRequest struct {
+}
+
+func (m *QueryHaltRequest) Reset()         { *m = QueryHaltRequest{} }
+func (m *QueryHaltRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltRequest) ProtoMessage()    {}
+func (*QueryHaltRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryHaltRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaltRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaltRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHaltRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltRequest.Merge(m, src)
+}
+func (m *QueryHaltRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaltRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHaltRequest proto.InternalMessageInfo
+
+// QueryHaltResponse is the response type for the Query/Halt RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryHaltResponse struct {
+	// halt is the emergency halt of the chain, if any.
+	Halt *Halt `protobuf:"bytes,1,opt,name=halt,proto3" json:"halt,omitempty"`
+}
+
+func (m *QueryHaltResponse) Reset()         { *m = QueryHaltResponse{} }
+func (m *QueryHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHaltResponse) ProtoMessage()    {}
+func (*QueryHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHaltResponse.Merge(m, src)
+}
+func (m *QueryHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHaltResponse proto.InternalMessageInfo
+
+func (m *QueryHaltResponse) GetHalt() *Halt {
+	if m != nil {
+		return m.Halt
+	}
+	return nil
+}
+
 // QueryAuthorityRequest is the request type for Query/Authority
 //
 // Since: cosmos-sdk 0.46
@@ -416,7 +603,7 @@ func (m *QueryAuthorityRequest) Reset()         { *m = QueryAuthorityRequest{} }
 func (m *QueryAuthorityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityRequest) ProtoMessage()    {}
 func (*QueryAuthorityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{8}
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
 }
 func (m *QueryAuthorityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +643,7 @@ func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{}
 func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityResponse) ProtoMessage()    {}
 func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{9}
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
 }
 func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,6 +688,10 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryMigrationProgressRequest)(nil), "cosmos.upgrade.v1beta1.QueryMigrationProgressRequest")
+	proto.RegisterType((*QueryMigrationProgressResponse)(nil), "cosmos.upgrade.v1beta1.QueryMigrationProgressResponse")
+	proto.RegisterType((*QueryHaltRequest)(nil), "cosmos.upgrade.v1beta1.QueryHaltRequest")
+	proto.RegisterType((*QueryHaltResponse)(nil), "cosmos.upgrade.v1beta1.QueryHaltResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
 }
@@ -510,48 +701,55 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0x66, 0x0a, 0x3f, 0x7e, 0xf2, 0x6a, 0xd0, 0x4c, 0x62, 0x59, 0x57, 0x52, 0x71, 0x40, 0x85,
-	0x48, 0x77, 0xa0, 0x5c, 0x0c, 0x46, 0xa3, 0x92, 0x18, 0x31, 0x4a, 0xb4, 0x46, 0x0f, 0x5e, 0x9a,
-	0xa1, 0x3b, 0x69, 0x37, 0xb6, 0x3b, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x2e, 0x9e, 0x3c, 0x9a, 0x18,
-	0xaf, 0xde, 0xbc, 0xf8, 0x97, 0x78, 0x24, 0xf1, 0xe2, 0xc1, 0x83, 0x01, 0xff, 0x04, 0xff, 0x00,
-	0xb3, 0xb3, 0xb3, 0xa4, 0xa5, 0xbb, 0x0b, 0x7a, 0x6a, 0x77, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x3b,
-	0xdf, 0x6b, 0x81, 0xb4, 0x84, 0xec, 0x09, 0x49, 0xa3, 0xa0, 0x1d, 0x32, 0x97, 0xd3, 0xed, 0xe5,
-	0x4d, 0xae, 0xd8, 0x32, 0xdd, 0x8a, 0x78, 0xb8, 0xe3, 0x04, 0xa1, 0x50, 0x02, 0x57, 0x12, 0x8c,
-	0x63, 0x30, 0x8e, 0xc1, 0xd8, 0xd3, 0x6d, 0x21, 0xda, 0x5d, 0x4e, 0x59, 0xe0, 0x51, 0xe6, 0xfb,
-	0x42, 0x31, 0xe5, 0x09, 0x5f, 0x26, 0x2c, 0x7b, 0x2e, 0x47, 0x39, 0x55, 0xd1, 0x28, 0x72, 0x11,
-	0xa6, 0x9e, 0xc5, 0xad, 0xd6, 0xa2, 0x30, 0xe4, 0xbe, 0x7a, 0xda, 0x65, 0x7e, 0x83, 0x6f, 0x45,
-	0x5c, 0x2a, 0xf2, 0x18, 0xac, 0xe1, 0x92, 0x0c, 0x84, 0x2f, 0x39, 0x5e, 0x82, 0xb1, 0xa0, 0xcb,
-	0x7c, 0x0b, 0xcd, 0xa0, 0xf9, 0x72, 0x7d, 0xda, 0xc9, 0x76, 0xe8, 0x68, 0x8e, 0x46, 0x92, 0x9a,
-	0x69, 0x74, 0x2f, 0x08, 0xba, 0x1e, 0x77, 0xfb, 0x1a, 0x61, 0x0c, 0x63, 0x3e, 0xeb, 0x71, 0x2d,
-	0x36, 0xd1, 0xd0, 0xdf, 0x49, 0xdd, 0x34, 0x1f, 0x80, 0x9b, 0xe6, 0x15, 0x18, 0xef, 0x70, 0xaf,
-	0xdd, 0x51, 0x9a, 0x31, 0xda, 0x30, 0x4f, 0x64, 0x1d, 0x88, 0xe6, 0xbc, 0x48, 0x5c, 0xb8, 0x6b,
-	0x31, 0xda, 0x97, 0x91, 0x7c, 0xae, 0x98, 0xe2, 0x69, 0xb7, 0xcb, 0x50, 0xee, 0x32, 0xa9, 0x9a,
-	0x03, 0x12, 0x10, 0x1f, 0x3d, 0xd4, 0x27, 0xab, 0x25, 0x0b, 0x11, 0x0f, 0x66, 0x0b, 0xa5, 0x8c,
-	0x93, 0x9b, 0x60, 0x99, 0x91, 0xdd, 0x66, 0x2b, 0x85, 0x34, 0x65, 0x8c, 0xb1, 0x4a, 0x33, 0x68,
-	0xfe, 0x6c, 0xa3, 0x12, 0x65, 0x2a, 0xc4, 0x4d, 0x1e, 0x8d, 0x9d, 0x41, 0xe7, 0x4b, 0xe4, 0x36,
-	0xd8, 0xba, 0xd5, 0x13, 0xe1, 0x46, 0x5d, 0xfe, 0x92, 0x87, 0x32, 0xbe, 0xc4, 0x3e, 0xb7, 0x3d,
-	0x5d, 0x68, 0xf6, 0xbd, 0x22, 0x48, 0x8e, 0x36, 0xe2, 0x17, 0xd5, 0x83, 0x4b, 0x99, 0x74, 0xe3,
-	0x70, 0x03, 0xce, 0x19, 0xfe, 0xb6, 0x29, 0x59, 0x68, 0x66, 0x74, 0xbe, 0x5c, 0xbf, 0x9a, 0x77,
-	0x67, 0x03, 0x42, 0x8d, 0xc9, 0xde, 0x80, 0x2e, 0x99, 0x82, 0x0b, 0xc9, 0xbd, 0x44, 0xaa, 0x23,
-	0x42, 0x4f, 0xed, 0xa4, 0x69, 0xa9, 0x43, 0xe5, 0x78, 0xc1, 0x58, 0xb0, 0xe0, 0x7f, 0xe6, 0xba,
-	0x21, 0x97, 0xd2, 0xd8, 0x4f, 0x1f, 0xeb, 0xbf, 0xc7, 0xe1, 0x3f, 0x4d, 0xc2, 0x9f, 0x10, 0x94,
-	0xfb, 0x72, 0x86, 0x69, 0x9e, 0xbb, 0x9c, 0xb0, 0xda, 0x4b, 0xa7, 0x27, 0x24, 0xb6, 0xc8, 0xe2,
-	0xdb, 0x6f, 0xbf, 0x3e, 0x94, 0xae, 0xe1, 0x39, 0x9a, 0xb3, 0x28, 0xad, 0x84, 0xd4, 0x8c, 0xe3,
-	0x8b, 0x3f, 0x23, 0x28, 0xf7, 0x65, 0xf1, 0x04, 0x83, 0xc3, 0x21, 0x3f, 0xc1, 0x60, 0x46, 0xcc,
-	0xc9, 0x8a, 0x36, 0x58, 0xc3, 0x37, 0xf2, 0x0c, 0xb2, 0x84, 0xa4, 0x0d, 0xd2, 0xdd, 0x38, 0x1f,
-	0x7b, 0xf8, 0x07, 0x82, 0x4a, 0x76, 0x68, 0xf1, 0x6a, 0xa1, 0x83, 0xc2, 0xa5, 0xb1, 0x6f, 0xfd,
-	0x13, 0xd7, 0x0c, 0xb2, 0xae, 0x07, 0xb9, 0x8b, 0xef, 0xd0, 0xe2, 0x9f, 0xa4, 0xa1, 0x1d, 0xa2,
-	0xbb, 0x7d, 0x9b, 0xba, 0xf7, 0xae, 0x84, 0xf0, 0x17, 0x04, 0x93, 0x83, 0x49, 0xc7, 0xf5, 0x42,
-	0x6b, 0x99, 0x5b, 0x65, 0xaf, 0xfc, 0x15, 0xc7, 0x8c, 0x41, 0xf5, 0x18, 0x0b, 0xf8, 0x7a, 0xde,
-	0x18, 0xc7, 0x16, 0x0d, 0x7f, 0x44, 0x30, 0x71, 0xb4, 0x0e, 0xb8, 0x56, 0x1c, 0x80, 0x63, 0xfb,
-	0x64, 0x3b, 0xa7, 0x85, 0x1b, 0x77, 0x0b, 0xda, 0xdd, 0x2c, 0xbe, 0x92, 0x9b, 0x96, 0x94, 0x72,
-	0xff, 0xc1, 0xd7, 0x83, 0x2a, 0xda, 0x3f, 0xa8, 0xa2, 0x9f, 0x07, 0x55, 0xf4, 0xfe, 0xb0, 0x3a,
-	0xb2, 0x7f, 0x58, 0x1d, 0xf9, 0x7e, 0x58, 0x1d, 0x79, 0xb5, 0xd8, 0xf6, 0x54, 0x27, 0xda, 0x74,
-	0x5a, 0xa2, 0x97, 0xca, 0x24, 0x1f, 0x35, 0xe9, 0xbe, 0xa6, 0x6f, 0x8e, 0x34, 0xd5, 0x4e, 0xc0,
-	0xe5, 0xe6, 0xb8, 0xfe, 0x0b, 0x59, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18, 0x7f, 0x99, 0xff,
-	0xc4, 0x06, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc6, 0x99, 0x65, 0xbf, 0x7c, 0xe5, 0x5d, 0x83, 0x30, 0x89, 0x4b, 0xad, 0x58, 0xb1, 0xa0,
+	0xee, 0x2a, 0xbb, 0x85, 0x25, 0x1a, 0x83, 0xd1, 0xa0, 0x44, 0x03, 0x46, 0x09, 0xae, 0xd1, 0x83,
+	0x97, 0x4d, 0xd9, 0x4e, 0x76, 0x1b, 0xfa, 0x8b, 0xce, 0x94, 0x48, 0x08, 0x07, 0x3d, 0x79, 0x34,
+	0x31, 0x5e, 0xbd, 0x79, 0xf1, 0xe6, 0xc5, 0xbf, 0xc1, 0x23, 0x89, 0x17, 0x0f, 0x1e, 0x0c, 0xf8,
+	0x87, 0x98, 0x4e, 0xa7, 0xd8, 0x65, 0xb7, 0x65, 0xf1, 0x04, 0x9d, 0x79, 0x9f, 0xe7, 0xfd, 0x4c,
+	0x3b, 0xef, 0x93, 0x05, 0xb5, 0xe9, 0x52, 0xdb, 0xa5, 0x5a, 0xe0, 0xb5, 0x7c, 0xdd, 0x20, 0xda,
+	0xd6, 0xdc, 0x3a, 0x61, 0xfa, 0x9c, 0xb6, 0x19, 0x10, 0x7f, 0xbb, 0xea, 0xf9, 0x2e, 0x73, 0x71,
+	0x31, 0xaa, 0xa9, 0x8a, 0x9a, 0xaa, 0xa8, 0x91, 0x27, 0x5a, 0xae, 0xdb, 0xb2, 0x88, 0xa6, 0x7b,
+	0xa6, 0xa6, 0x3b, 0x8e, 0xcb, 0x74, 0x66, 0xba, 0x0e, 0x8d, 0x54, 0xf2, 0x74, 0x8a, 0x73, 0xec,
+	0xc2, 0xab, 0xd4, 0x73, 0x30, 0xfe, 0x34, 0x6c, 0xb5, 0x14, 0xf8, 0x3e, 0x71, 0xd8, 0x9a, 0xa5,
+	0x3b, 0x75, 0xb2, 0x19, 0x10, 0xca, 0xd4, 0xc7, 0x20, 0x75, 0x6f, 0x51, 0xcf, 0x75, 0x28, 0xc1,
+	0xb3, 0x90, 0xf7, 0x2c, 0xdd, 0x91, 0xd0, 0x24, 0x2a, 0x15, 0x6a, 0x13, 0xd5, 0xde, 0x84, 0x55,
+	0xae, 0xe1, 0x95, 0x6a, 0x45, 0x34, 0xba, 0xe7, 0x79, 0x96, 0x49, 0x8c, 0x44, 0x23, 0x8c, 0x21,
+	0xef, 0xe8, 0x36, 0xe1, 0x66, 0xc3, 0x75, 0xfe, 0xbf, 0x5a, 0x03, 0xa9, 0xbb, 0x5c, 0x34, 0x2f,
+	0xc2, 0x50, 0x9b, 0x98, 0xad, 0x36, 0xe3, 0x8a, 0xc1, 0xba, 0x78, 0x52, 0x57, 0x40, 0xe5, 0x9a,
+	0xe7, 0x11, 0x85, 0xb1, 0x14, 0x56, 0x3b, 0x34, 0xa0, 0xcf, 0x98, 0xce, 0x48, 0xdc, 0xed, 0x22,
+	0x14, 0x2c, 0x9d, 0xb2, 0x46, 0x87, 0x05, 0x84, 0x4b, 0xcb, 0x7c, 0x65, 0x21, 0x27, 0x21, 0xd5,
+	0x84, 0xa9, 0x4c, 0x2b, 0x41, 0x72, 0x0b, 0x24, 0x71, 0x64, 0xa3, 0xd1, 0x8c, 0x4b, 0x1a, 0x34,
+	0xac, 0x91, 0x72, 0x93, 0xa8, 0x74, 0xba, 0x5e, 0x0c, 0x7a, 0x3a, 0x84, 0x4d, 0x1e, 0xe5, 0x4f,
+	0xa1, 0xd1, 0x9c, 0x7a, 0x07, 0x64, 0xde, 0xea, 0x89, 0x6b, 0x04, 0x16, 0x79, 0x41, 0x7c, 0x1a,
+	0x7e, 0xc4, 0x04, 0xad, 0xcd, 0x37, 0x1a, 0x89, 0x57, 0x04, 0xd1, 0xd2, 0x6a, 0xf8, 0xa2, 0x6c,
+	0x38, 0xdf, 0x53, 0x2e, 0x08, 0x57, 0xe1, 0x8c, 0xd0, 0x6f, 0x89, 0x2d, 0x09, 0x4d, 0x0e, 0x96,
+	0x0a, 0xb5, 0xcb, 0x69, 0xdf, 0xac, 0xc3, 0xa8, 0x3e, 0x62, 0x77, 0xf8, 0xaa, 0x8b, 0x70, 0x21,
+	0x6a, 0x67, 0xb6, 0x7c, 0x7e, 0xdd, 0xd6, 0x7c, 0xb7, 0xe5, 0x13, 0xda, 0x3f, 0xf0, 0x06, 0x28,
+	0x69, 0x0e, 0x82, 0x79, 0x05, 0xc0, 0x8e, 0x37, 0x63, 0xdc, 0x72, 0x2a, 0x6e, 0x97, 0x4d, 0x42,
+	0xac, 0x62, 0x18, 0xe5, 0xcd, 0x96, 0x75, 0x8b, 0xc5, 0xf7, 0xfa, 0x01, 0x8c, 0x25, 0xd6, 0xfe,
+	0x5e, 0xe8, 0xb6, 0x6e, 0xb1, 0xe3, 0x2e, 0x34, 0xd7, 0xf0, 0x4a, 0x75, 0x1c, 0xce, 0x46, 0x37,
+	0x34, 0x60, 0x6d, 0xd7, 0x37, 0xd9, 0x76, 0xec, 0x5f, 0x83, 0xe2, 0xd1, 0x0d, 0xd1, 0x44, 0x82,
+	0xff, 0x75, 0xc3, 0x08, 0x21, 0xc5, 0x7b, 0x89, 0x1f, 0x6b, 0x5f, 0x86, 0xe1, 0x3f, 0x2e, 0xc2,
+	0x1f, 0x11, 0x14, 0x12, 0x13, 0x87, 0xb5, 0x34, 0x94, 0x94, 0xb1, 0x95, 0x67, 0xfb, 0x17, 0x44,
+	0x58, 0xea, 0xcc, 0x9b, 0xef, 0xbf, 0xdf, 0xe7, 0xae, 0xe0, 0x69, 0x2d, 0x25, 0x32, 0x9a, 0x91,
+	0xa8, 0x11, 0x0e, 0x32, 0xfe, 0x84, 0xa0, 0x90, 0x98, 0xca, 0x63, 0x00, 0xbb, 0xc7, 0x5d, 0x9e,
+	0xed, 0x5f, 0x20, 0x00, 0xe7, 0x39, 0x60, 0x05, 0x5f, 0x4f, 0x03, 0xd4, 0x23, 0x11, 0x07, 0xd4,
+	0x76, 0xc2, 0x8b, 0xb7, 0x8b, 0x7f, 0x22, 0x28, 0xf6, 0x1e, 0x5f, 0xbc, 0x90, 0x49, 0x90, 0x19,
+	0x1f, 0xf2, 0xed, 0x7f, 0xd2, 0x8a, 0x83, 0xac, 0xf0, 0x83, 0x2c, 0xe2, 0xbb, 0x5a, 0x76, 0x38,
+	0x77, 0xa5, 0x89, 0xb6, 0x93, 0xc8, 0xac, 0xdd, 0xb7, 0x39, 0x84, 0x3f, 0x23, 0x18, 0xe9, 0x9c,
+	0x79, 0x5c, 0xcb, 0x44, 0xeb, 0x99, 0x2f, 0xf2, 0xfc, 0x89, 0x34, 0xe2, 0x18, 0x1a, 0x3f, 0x46,
+	0x19, 0x5f, 0x4d, 0x3b, 0xc6, 0x91, 0xc8, 0xc1, 0x5f, 0x11, 0x8c, 0x75, 0x0d, 0x2a, 0xbe, 0x91,
+	0xdd, 0x3b, 0x25, 0x61, 0xe4, 0x9b, 0x27, 0x95, 0x09, 0xea, 0x1a, 0xa7, 0x9e, 0xc1, 0xd7, 0x52,
+	0xa9, 0x63, 0x69, 0xc3, 0x8b, 0x11, 0x5f, 0x23, 0xc8, 0x87, 0x33, 0x8f, 0x4b, 0x99, 0x4d, 0x13,
+	0xf1, 0x22, 0x97, 0xfb, 0xa8, 0x14, 0x44, 0xd3, 0x9c, 0x48, 0xc1, 0x13, 0x69, 0x44, 0x61, 0xd0,
+	0xe0, 0x0f, 0x08, 0x86, 0x0f, 0xb3, 0x04, 0x57, 0xb2, 0xa7, 0xe7, 0x48, 0x18, 0xc9, 0xd5, 0x7e,
+	0xcb, 0x05, 0x52, 0x99, 0x23, 0x4d, 0xe1, 0x4b, 0xa9, 0xa3, 0x16, 0x4b, 0xee, 0x3f, 0xfc, 0xb6,
+	0xaf, 0xa0, 0xbd, 0x7d, 0x05, 0xfd, 0xda, 0x57, 0xd0, 0xbb, 0x03, 0x65, 0x60, 0xef, 0x40, 0x19,
+	0xf8, 0x71, 0xa0, 0x0c, 0xbc, 0x9c, 0x69, 0x99, 0xac, 0x1d, 0xac, 0x57, 0x9b, 0xae, 0x1d, 0xdb,
+	0x44, 0x7f, 0x2a, 0xd4, 0xd8, 0xd0, 0x5e, 0x1d, 0x7a, 0xb2, 0x6d, 0x8f, 0xd0, 0xf5, 0x21, 0xfe,
+	0x4b, 0x64, 0xfe, 0xcf, 0x00, 0xf5, 0x12, 0xc9, 0x09, 0x0b, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -581,6 +779,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// MigrationProgress queries the progress of the incremental migrations of
+	// the modules from state.
+	//
+	// Since: cosmos-sdk 0.47
+	MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error)
+	// Halt queries the emergency halt of the chain, scheduled or in progress.
+	//
+	// Since: cosmos-sdk 0.47
+	Halt(ctx context.Context, in *QueryHaltRequest, opts ...grpc.CallOption) (*QueryHaltResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -632,6 +839,24 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error) {
+	out := new(QueryMigrationProgressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/MigrationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Halt(ctx context.Context, in *QueryHaltRequest, opts ...grpc.CallOption) (*QueryHaltResponse, error) {
+	out := new(QueryHaltResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Halt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Authority", in, out, opts...)
@@ -658,6 +883,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// MigrationProgress queries the progress of the incremental migrations of
+	// the modules from state.
+	//
+	// Since: cosmos-sdk 0.47
+	MigrationProgress(context.Context, *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error)
+	// Halt queries the emergency halt of the chain, scheduled or in progress.
+	//
+	// Since: cosmos-sdk 0.47
+	Halt(context.Context, *QueryHaltRequest) (*QueryHaltResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -680,6 +914,12 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) MigrationProgress(ctx context.Context, req *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationProgress not implemented")
+}
+func (*UnimplementedQueryServer) Halt(ctx context.Context, req *QueryHaltRequest) (*QueryHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Halt not implemented")
+}
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -760,6 +1000,42 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MigrationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/MigrationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationProgress(ctx, req.(*QueryMigrationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Halt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Halt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/Halt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Halt(ctx, req.(*QueryHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -798,6 +1074,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "MigrationProgress",
+			Handler:    _Query_MigrationProgress_Handler,
+		},
+		{
+			MethodName: "Halt",
+			Handler:    _Query_Halt_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
@@ -1048,7 +1332,7 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryMigrationProgressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1058,20 +1342,27 @@ func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMigrationProgressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMigrationProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryMigrationProgressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1081,74 +1372,192 @@ func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryMigrationProgressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryMigrationProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHaltRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryHaltRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHaltRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Halt != nil {
+		{
+			size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1212,6 +1621,56 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMigrationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMigrationProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHaltRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Halt != nil {
+		l = m.Halt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAuthorityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1846,6 +2305,308 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMigrationProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, &MigrationProgress{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHaltResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHaltResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Halt == nil {
+				m.Halt = &Halt{}
+			}
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -216,11 +217,110 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// MigrationProgress specifies the progress of the incremental migration of a
+// module, run one batch per block after an upgrade.
+//
+// Since: cosmos-sdk 0.47
+type MigrationProgress struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version the app module is being migrated from
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// consensus version the app module is migrated to
+	ToVersion uint64 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// cursor to resume the migration from in the next block
+	Cursor []byte `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// started is whether the migration ran at least one batch, telling an empty
+	// cursor to resume from apart from a migration which has not started yet
+	Started bool `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+}
+
+func (m *MigrationProgress) Reset()         { *m = MigrationProgress{} }
+func (m *MigrationProgress) String() string { return proto.CompactTextString(m) }
+func (*MigrationProgress) ProtoMessage()    {}
+func (*MigrationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *MigrationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationProgress.Merge(m, src)
+}
+func (m *MigrationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationProgress proto.InternalMessageInfo
+
+// Halt specifies an emergency halt of the chain, scheduled by the upgrade
+// authority or the security council. The nodes halt after committing the block
+// at its height, and refuse to process the following blocks until the chain is
+// resumed.
+//
+// Since: cosmos-sdk 0.47
+type Halt struct {
+	// height of the last block committed before halting
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// reason of the halt
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Halt) Reset()         { *m = Halt{} }
+func (m *Halt) String() string { return proto.CompactTextString(m) }
+func (*Halt) ProtoMessage()    {}
+func (*Halt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *Halt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Halt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Halt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Halt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Halt.Merge(m, src)
+}
+func (m *Halt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Halt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Halt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Halt proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*MigrationProgress)(nil), "cosmos.upgrade.v1beta1.MigrationProgress")
+	proto.RegisterType((*Halt)(nil), "cosmos.upgrade.v1beta1.Halt")
 }
 
 func init() {
@@ -228,36 +328,41 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbb, 0x6f, 0xd4, 0x30,
-	0x18, 0x8f, 0xdb, 0xb4, 0x50, 0x9f, 0x58, 0xcc, 0x51, 0xcc, 0x09, 0x92, 0xd3, 0x89, 0xe1, 0x06,
-	0x48, 0xd4, 0x22, 0x31, 0xdc, 0xc6, 0x75, 0x40, 0x42, 0x20, 0x55, 0x29, 0x30, 0xb0, 0x54, 0xbe,
-	0xc4, 0x97, 0xb3, 0x70, 0xec, 0x28, 0x76, 0x0a, 0xf7, 0x5f, 0x74, 0x41, 0x62, 0xec, 0x9f, 0x73,
-	0x63, 0x47, 0xc4, 0xc0, 0xe3, 0x6e, 0xe1, 0xcf, 0x40, 0xb6, 0x63, 0xc4, 0xa3, 0x23, 0x53, 0xbe,
-	0xef, 0xcb, 0xef, 0xf1, 0x3d, 0x0c, 0xef, 0xe7, 0x52, 0x55, 0x52, 0xa5, 0x6d, 0x5d, 0x36, 0xa4,
-	0xa0, 0xe9, 0xd9, 0xc1, 0x8c, 0x6a, 0x72, 0xe0, 0xf3, 0xa4, 0x6e, 0xa4, 0x96, 0x68, 0xdf, 0xa1,
-	0x12, 0x5f, 0xed, 0x50, 0x83, 0x3b, 0xa5, 0x94, 0x25, 0xa7, 0xa9, 0x45, 0xcd, 0xda, 0x79, 0x4a,
-	0xc4, 0xd2, 0x51, 0x06, 0xfd, 0x52, 0x96, 0xd2, 0x86, 0xa9, 0x89, 0xba, 0x6a, 0xfc, 0x37, 0x41,
-	0xb3, 0x8a, 0x2a, 0x4d, 0xaa, 0xda, 0x01, 0x46, 0x9f, 0x01, 0x0c, 0x8f, 0x39, 0x11, 0x08, 0xc1,
-	0x50, 0x90, 0x8a, 0x62, 0x30, 0x04, 0xe3, 0xbd, 0xcc, 0xc6, 0x68, 0x02, 0x43, 0x83, 0xc7, 0x5b,
-	0x43, 0x30, 0xee, 0x1d, 0x0e, 0x12, 0x27, 0x96, 0x78, 0xb1, 0xe4, 0xa5, 0x17, 0x9b, 0xc2, 0xd5,
-	0x97, 0x38, 0x38, 0xff, 0x1a, 0x03, 0x0c, 0x32, 0xcb, 0x41, 0xfb, 0x70, 0x77, 0x41, 0x59, 0xb9,
-	0xd0, 0x78, 0x7b, 0x08, 0xc6, 0xdb, 0x59, 0x97, 0x19, 0x1f, 0x26, 0xe6, 0x12, 0x87, 0xce, 0xc7,
-	0xc4, 0xe8, 0x39, 0xbc, 0xd5, 0x4d, 0x5a, 0x9c, 0xe6, 0x9c, 0x51, 0xa1, 0x4f, 0x95, 0x26, 0x9a,
-	0xe2, 0x1d, 0x6b, 0xdc, 0xff, 0xc7, 0xf8, 0x89, 0x58, 0x4e, 0xb7, 0x30, 0xc8, 0x6e, 0x7a, 0xda,
-	0x91, 0x65, 0x9d, 0x18, 0xd2, 0xe4, 0xfa, 0xc7, 0x8b, 0x38, 0xf8, 0x71, 0x11, 0x83, 0xd1, 0x07,
-	0x00, 0x6f, 0x9f, 0xc8, 0xb9, 0x7e, 0x47, 0x1a, 0xfa, 0xca, 0x21, 0x8f, 0x1b, 0x59, 0x4b, 0x45,
-	0x38, 0xea, 0xc3, 0x1d, 0xcd, 0x34, 0xf7, 0x03, 0xbb, 0x04, 0x0d, 0x61, 0xaf, 0xa0, 0x2a, 0x6f,
-	0x58, 0xad, 0x99, 0x14, 0x76, 0xf0, 0xbd, 0xec, 0xf7, 0x12, 0x7a, 0x0c, 0xc3, 0x9a, 0x13, 0x61,
-	0xa7, 0xea, 0x1d, 0xde, 0x4d, 0xae, 0xbe, 0x54, 0x62, 0x76, 0x3a, 0x0d, 0xcd, 0x56, 0x32, 0x8b,
-	0x9f, 0x40, 0xdf, 0x15, 0x06, 0xa3, 0x1c, 0xde, 0x3b, 0x22, 0x22, 0xa7, 0xfc, 0x3f, 0x37, 0xf7,
-	0x87, 0xc9, 0x53, 0x78, 0xe3, 0x85, 0x2c, 0x5a, 0x4e, 0x5f, 0xd3, 0x46, 0x99, 0xce, 0xaf, 0xba,
-	0x30, 0x86, 0xd7, 0xce, 0xdc, 0x6f, 0x2b, 0x17, 0x66, 0x3e, 0xb5, 0x5b, 0x04, 0x46, 0x6a, 0xfa,
-	0x6c, 0xf5, 0x3d, 0x0a, 0x56, 0xeb, 0x08, 0x5c, 0xae, 0x23, 0xf0, 0x6d, 0x1d, 0x81, 0xf3, 0x4d,
-	0x14, 0x5c, 0x6e, 0xa2, 0xe0, 0xd3, 0x26, 0x0a, 0xde, 0x3c, 0x28, 0x99, 0x5e, 0xb4, 0xb3, 0x24,
-	0x97, 0x55, 0xda, 0xbd, 0x6d, 0xf7, 0x79, 0xa8, 0x8a, 0xb7, 0xe9, 0xfb, 0x5f, 0x0f, 0x5d, 0x2f,
-	0x6b, 0xaa, 0x66, 0xbb, 0xf6, 0x84, 0x8f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x55, 0x74, 0xf2,
-	0xb7, 0x07, 0x03, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0x6e, 0x68, 0x5e, 0x60, 0xc0, 0x84, 0x60, 0x22, 0xea, 0x44, 0x11, 0x43, 0x06,
+	0xb0, 0xd5, 0x22, 0x31, 0x44, 0x2c, 0xa4, 0x03, 0x08, 0x51, 0x29, 0x72, 0x81, 0x81, 0x25, 0xba,
+	0xd8, 0x17, 0xc7, 0xc2, 0xf1, 0xb3, 0xee, 0xce, 0x85, 0xfc, 0x17, 0x5d, 0x90, 0x3a, 0xf6, 0xcf,
+	0xc9, 0xd8, 0x11, 0x31, 0xf0, 0x23, 0x59, 0xf8, 0x33, 0x90, 0xcf, 0xbe, 0xaa, 0x85, 0x2e, 0x48,
+	0x4c, 0x7e, 0xdf, 0xdd, 0xf7, 0xbd, 0xef, 0xbd, 0xe7, 0x77, 0xf0, 0x30, 0x40, 0xb1, 0x40, 0xe1,
+	0xe5, 0x59, 0xc4, 0x69, 0xc8, 0xbc, 0xe3, 0xbd, 0x29, 0x93, 0x74, 0x4f, 0x63, 0x37, 0xe3, 0x28,
+	0xd1, 0x6a, 0x97, 0x2c, 0x57, 0x9f, 0x56, 0xac, 0xce, 0xfd, 0x08, 0x31, 0x4a, 0x98, 0xa7, 0x58,
+	0xd3, 0x7c, 0xe6, 0xd1, 0x74, 0x59, 0x4a, 0x3a, 0xad, 0x08, 0x23, 0x54, 0xa1, 0x57, 0x44, 0xd5,
+	0x69, 0xf7, 0x4f, 0x81, 0x8c, 0x17, 0x4c, 0x48, 0xba, 0xc8, 0x4a, 0x42, 0xff, 0x2b, 0x01, 0x73,
+	0x9c, 0xd0, 0xd4, 0xb2, 0xc0, 0x4c, 0xe9, 0x82, 0xd9, 0xa4, 0x47, 0x06, 0x0d, 0x5f, 0xc5, 0xd6,
+	0x10, 0xcc, 0x82, 0x6f, 0x6f, 0xf5, 0xc8, 0xa0, 0xb9, 0xdf, 0x71, 0xcb, 0x64, 0xae, 0x4e, 0xe6,
+	0xbe, 0xd1, 0xc9, 0x46, 0xb0, 0xfa, 0xd6, 0x35, 0x4e, 0xbe, 0x77, 0x89, 0x4d, 0x7c, 0xa5, 0xb1,
+	0xda, 0x50, 0x9f, 0xb3, 0x38, 0x9a, 0x4b, 0xbb, 0xd6, 0x23, 0x83, 0x9a, 0x5f, 0xa1, 0xc2, 0x27,
+	0x4e, 0x67, 0x68, 0x9b, 0xa5, 0x4f, 0x11, 0x5b, 0xaf, 0xe1, 0x6e, 0xd5, 0x69, 0x38, 0x09, 0x92,
+	0x98, 0xa5, 0x72, 0x22, 0x24, 0x95, 0xcc, 0xde, 0x56, 0xc6, 0xad, 0xbf, 0x8c, 0x9f, 0xa7, 0xcb,
+	0xd1, 0x96, 0x4d, 0xfc, 0x3b, 0x5a, 0x76, 0xa0, 0x54, 0x47, 0x85, 0x68, 0xb8, 0x73, 0x7a, 0xd6,
+	0x35, 0x7e, 0x9d, 0x75, 0x49, 0xff, 0x33, 0x81, 0x7b, 0x47, 0x38, 0x93, 0x1f, 0x29, 0x67, 0x6f,
+	0x4b, 0xe6, 0x98, 0x63, 0x86, 0x82, 0x26, 0x56, 0x0b, 0xb6, 0x65, 0x2c, 0x13, 0xdd, 0x70, 0x09,
+	0xac, 0x1e, 0x34, 0x43, 0x26, 0x02, 0x1e, 0x67, 0x32, 0xc6, 0x54, 0x35, 0xde, 0xf0, 0x2f, 0x1f,
+	0x59, 0x4f, 0xc1, 0xcc, 0x12, 0x9a, 0xaa, 0xae, 0x9a, 0xfb, 0x0f, 0xdc, 0xeb, 0xff, 0x94, 0x5b,
+	0xcc, 0x74, 0x64, 0x16, 0x53, 0xf1, 0x15, 0x7f, 0x08, 0xba, 0x2a, 0x9b, 0xf4, 0x03, 0xd8, 0x3d,
+	0xa0, 0x69, 0xc0, 0x92, 0xff, 0x5c, 0xdc, 0x15, 0x93, 0x17, 0x70, 0xeb, 0x10, 0xc3, 0x3c, 0x61,
+	0xef, 0x18, 0x17, 0x31, 0x5e, 0xff, 0x87, 0x6d, 0xb8, 0x71, 0x5c, 0x5e, 0xab, 0x74, 0xa6, 0xaf,
+	0xa1, 0x9a, 0x22, 0x51, 0x53, 0x3c, 0x25, 0x70, 0xfb, 0x30, 0x8e, 0x38, 0x2d, 0x2c, 0xc6, 0x1c,
+	0x23, 0xce, 0x84, 0xf8, 0xb7, 0x6c, 0xd6, 0x2e, 0x80, 0xc4, 0x89, 0xbe, 0xac, 0xa9, 0xcb, 0x86,
+	0x44, 0x5d, 0x5a, 0x1b, 0xea, 0x41, 0xce, 0x05, 0x72, 0xb5, 0x16, 0x37, 0xfd, 0x0a, 0x15, 0x09,
+	0x85, 0xa4, 0x5c, 0xb2, 0x50, 0xad, 0xc2, 0x8e, 0xaf, 0xe1, 0xd0, 0x54, 0xa5, 0x3d, 0x03, 0xf3,
+	0x25, 0x4d, 0xe4, 0xa5, 0x65, 0x23, 0x57, 0x96, 0xad, 0x0d, 0x75, 0xce, 0xa8, 0xb8, 0x18, 0x56,
+	0x85, 0x4a, 0xf5, 0xe8, 0xd5, 0xea, 0xa7, 0x63, 0xac, 0xd6, 0x0e, 0x39, 0x5f, 0x3b, 0xe4, 0xc7,
+	0xda, 0x21, 0x27, 0x1b, 0xc7, 0x38, 0xdf, 0x38, 0xc6, 0x97, 0x8d, 0x63, 0xbc, 0x7f, 0x14, 0xc5,
+	0x72, 0x9e, 0x4f, 0xdd, 0x00, 0x17, 0x5e, 0xf5, 0x68, 0xcb, 0xcf, 0x63, 0x11, 0x7e, 0xf0, 0x3e,
+	0x5d, 0xbc, 0x60, 0xb9, 0xcc, 0x98, 0x98, 0xd6, 0xd5, 0x6e, 0x3e, 0xf9, 0x3d, 0x00, 0x34, 0xc2,
+	0x50, 0xba, 0xe0, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -380,6 +485,69 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MigrationProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrationProgress)
+	if !ok {
+		that2, ok := that.(MigrationProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.ToVersion != that1.ToVersion {
+		return false
+	}
+	if !bytes.Equal(this.Cursor, that1.Cursor) {
+		return false
+	}
+	if this.Started != that1.Started {
+		return false
+	}
+	return true
+}
+func (this *Halt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Halt)
+	if !ok {
+		that2, ok := that.(Halt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -561,6 +729,98 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MigrationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Halt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Halt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Halt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -650,6 +910,48 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *MigrationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUpgrade(uint64(m.Version))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovUpgrade(uint64(m.ToVersion))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Started {
+		n += 2
+	}
+	return n
+}
+
+func (m *Halt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1220,6 +1522,281 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MigrationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Halt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Halt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Halt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0